@@ -0,0 +1,181 @@
+package scim
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// filter is a parsed SCIM filter (RFC 7644 section 3.4.2.2). Only the subset
+// used by identity providers is supported: attribute comparisons joined by
+// 'and' and 'or', without grouping nor value paths. 'and' binds tighter than
+// 'or', so a filter is a disjunction of conjunctions. The listings only
+// accept the filters that their store can apply, see equalities.
+type filter [][]comparison
+
+type comparison struct {
+	attribute string
+	operator  string
+	value     string
+}
+
+func parseFilter(s string) (filter, error) {
+	tokens, err := tokenizeFilter(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(tokens) == 0 {
+		return nil, nil
+	}
+
+	f := filter{nil}
+	for i := 0; i < len(tokens); {
+		c := comparison{
+			attribute: normalizeAttribute(tokens[i]),
+		}
+		if i+1 >= len(tokens) {
+			return nil, fmt.Errorf("missing operator after %q", tokens[i])
+		}
+		c.operator = strings.ToLower(tokens[i+1])
+		i += 2
+
+		switch c.operator {
+		case "pr":
+		case "eq", "ne", "co", "sw", "ew":
+			if i >= len(tokens) {
+				return nil, fmt.Errorf("missing value after %q", c.operator)
+			}
+			c.value, err = parseFilterValue(tokens[i])
+			if err != nil {
+				return nil, err
+			}
+			i++
+		default:
+			return nil, fmt.Errorf("unsupported operator %q", c.operator)
+		}
+
+		if strings.ContainsAny(c.attribute, "[]()") {
+			return nil, fmt.Errorf("unsupported attribute path %q", c.attribute)
+		}
+		f[len(f)-1] = append(f[len(f)-1], c)
+
+		if i == len(tokens) {
+			break
+		}
+		switch strings.ToLower(tokens[i]) {
+		case "and":
+		case "or":
+			f = append(f, nil)
+		default:
+			return nil, fmt.Errorf("unsupported logical operator %q", tokens[i])
+		}
+		i++
+		if i == len(tokens) {
+			return nil, fmt.Errorf("missing expression after %q", tokens[i-1])
+		}
+	}
+
+	return f, nil
+}
+
+// tokenizeFilter splits a filter on white spaces, keeping the quoted strings
+// whole.
+func tokenizeFilter(s string) ([]string, error) {
+	var tokens []string
+	for i := 0; i < len(s); {
+		switch {
+		case s[i] == ' ':
+			i++
+		case s[i] == '"':
+			j := i + 1
+			for ; j < len(s) && s[j] != '"'; j++ {
+				if s[j] == '\\' {
+					j++
+				}
+			}
+			if j >= len(s) {
+				return nil, fmt.Errorf("unterminated string in %q", s)
+			}
+			tokens = append(tokens, s[i:j+1])
+			i = j + 1
+		default:
+			j := i
+			for ; j < len(s) && s[j] != ' '; j++ {
+			}
+			tokens = append(tokens, s[i:j])
+			i = j
+		}
+	}
+	return tokens, nil
+}
+
+func parseFilterValue(token string) (string, error) {
+	if strings.HasPrefix(token, `"`) {
+		var v string
+		if err := json.Unmarshal([]byte(token), &v); err != nil {
+			return "", fmt.Errorf("invalid string %s: %w", token, err)
+		}
+		return v, nil
+	}
+
+	switch strings.ToLower(token) {
+	case "true", "false", "null":
+		return strings.ToLower(token), nil
+	}
+	return token, nil
+}
+
+// normalizeAttribute lower cases an attribute path and strips its schema URN.
+func normalizeAttribute(attribute string) string {
+	attribute = strings.ToLower(attribute)
+	for _, schema := range []string{userSchema, groupSchema} {
+		prefix := strings.ToLower(schema) + ":"
+		if strings.HasPrefix(attribute, prefix) {
+			return strings.TrimPrefix(attribute, prefix)
+		}
+	}
+	return attribute
+}
+
+// equalities returns the values a filter requires the attributes to be equal
+// to, so that it can be applied by the store. Only the filters comparing the
+// given attributes with 'eq', joined by 'and', are supported.
+func (f filter) equalities(attributes ...string) (map[string]string, error) {
+	values := map[string]string{}
+	if len(f) == 0 {
+		return values, nil
+	}
+	if len(f) > 1 {
+		return nil, errors.New("unsupported logical operator \"or\"")
+	}
+
+	for _, c := range f[0] {
+		if c.operator != "eq" {
+			return nil, fmt.Errorf("unsupported operator %q on %q", c.operator, c.attribute)
+		}
+		if !slices.Contains(attributes, c.attribute) {
+			return nil, fmt.Errorf("unsupported attribute %q", c.attribute)
+		}
+		if _, ok := values[c.attribute]; ok {
+			return nil, fmt.Errorf("attribute %q compared more than once", c.attribute)
+		}
+		values[c.attribute] = c.value
+	}
+	return values, nil
+}
+
+// equalityValue returns the value the filter requires attribute to be equal
+// to, if any.
+func (f filter) equalityValue(attribute string) (string, bool) {
+	if len(f) != 1 {
+		return "", false
+	}
+	for _, c := range f[0] {
+		if c.attribute == attribute && c.operator == "eq" {
+			return c.value, true
+		}
+	}
+	return "", false
+}
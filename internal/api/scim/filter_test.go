@@ -0,0 +1,93 @@
+package scim
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilter_Equalities(t *testing.T) {
+	tests := []struct {
+		name          string
+		filter        string
+		expectsValues map[string]string
+		expectsError  bool
+	}{
+		{
+			name:          "With empty filter, returns no value",
+			filter:        "",
+			expectsValues: map[string]string{},
+		},
+		{
+			name:          "With equality, returns the value",
+			filter:        `userName eq "jane.doe@chorus-tre.ch"`,
+			expectsValues: map[string]string{"username": "jane.doe@chorus-tre.ch"},
+		},
+		{
+			name:          "With schema URN prefixed attribute, returns the value",
+			filter:        `urn:ietf:params:scim:schemas:core:2.0:User:userName eq "jane.doe@chorus-tre.ch"`,
+			expectsValues: map[string]string{"username": "jane.doe@chorus-tre.ch"},
+		},
+		{
+			name:          "With conjunction, returns every value",
+			filter:        `userName eq "jane \"doe\"" and active eq true`,
+			expectsValues: map[string]string{"username": `jane "doe"`, "active": "true"},
+		},
+		{
+			name:         "With disjunction, returns an error",
+			filter:       `userName eq "jane" or userName eq "john"`,
+			expectsError: true,
+		},
+		{
+			name:         "With other operator, returns an error",
+			filter:       `userName sw "jane"`,
+			expectsError: true,
+		},
+		{
+			name:         "With other attribute, returns an error",
+			filter:       `name.familyName eq "Doe"`,
+			expectsError: true,
+		},
+		{
+			name:         "With attribute compared twice, returns an error",
+			filter:       `userName eq "jane" and userName eq "john"`,
+			expectsError: true,
+		},
+		{
+			name:         "With unsupported operator, returns an error",
+			filter:       `meta.lastModified gt "2024-01-01T00:00:00Z"`,
+			expectsError: true,
+		},
+		{
+			name:         "With value path, returns an error",
+			filter:       `emails[type eq "work"] pr`,
+			expectsError: true,
+		},
+		{
+			name:         "With dangling logical operator, returns an error",
+			filter:       `userName pr and`,
+			expectsError: true,
+		},
+		{
+			name:         "With unterminated string, returns an error",
+			filter:       `userName eq "jane`,
+			expectsError: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			f, err := parseFilter(test.filter)
+			var values map[string]string
+			if err == nil {
+				values, err = f.equalities("username", "active")
+			}
+			if test.expectsError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expectsValues, values)
+			}
+		})
+	}
+}
@@ -0,0 +1,313 @@
+package scim
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	workspace_model "github.com/CHORUS-TRE/chorus-backend/pkg/workspace/model"
+	workspace_service "github.com/CHORUS-TRE/chorus-backend/pkg/workspace/service"
+)
+
+func (h *Handler) listGroups(w http.ResponseWriter, r *http.Request, t tenant) error {
+	f, startIndex, count, err := parseListParams(r)
+	if err != nil {
+		return err
+	}
+	excludeMembers := strings.Contains(strings.ToLower(r.URL.Query().Get("excludedAttributes")), "members")
+
+	values, err := f.equalities("displayname")
+	if err != nil {
+		return badRequest("invalidFilter", err.Error())
+	}
+
+	workspaces, _, total, err := h.workspace.ListWorkspaces(r.Context(), workspace_service.ListWorkspacesReq{
+		TenantID: t.id,
+		Cursor:   listCursor(startIndex, count),
+		Filter:   workspace_service.WorkspaceFilter{Name: values["displayname"]},
+		Sort:     workspace_service.Sort{SortOrder: "ASC", SortType: "ID"},
+	})
+	if err != nil {
+		return err
+	}
+
+	resources := make([]*groupResource, 0, len(workspaces))
+	for _, ws := range workspaces {
+		var members []*workspace_model.WorkspaceMember
+		if !excludeMembers {
+			if members, err = h.workspace.ListWorkspaceMembers(r.Context(), t.id, ws.ID); err != nil {
+				return err
+			}
+		}
+		resources = append(resources, groupFromBusiness(ws, members))
+	}
+
+	writeJSON(w, http.StatusOK, page(resources, startIndex, count, total))
+	return nil
+}
+
+func (h *Handler) getGroup(w http.ResponseWriter, r *http.Request, t tenant, id uint64) error {
+	return h.writeGroup(w, r, t, id)
+}
+
+// replaceGroup sets the members of the workspace to the ones of the group.
+func (h *Handler) replaceGroup(w http.ResponseWriter, r *http.Request, t tenant, id uint64) error {
+	var res groupResource
+	if err := decodeBody(r, &res); err != nil {
+		return err
+	}
+
+	ws, err := h.fetchWorkspace(r, t, id)
+	if err != nil {
+		return err
+	}
+	if err := checkDisplayName(ws, res.DisplayName); err != nil {
+		return err
+	}
+
+	userIDs, err := parseMembers(res.Members)
+	if err != nil {
+		return err
+	}
+	if err := h.setMembers(r, t, id, userIDs); err != nil {
+		return err
+	}
+
+	return h.writeGroup(w, r, t, id)
+}
+
+func (h *Handler) patchGroup(w http.ResponseWriter, r *http.Request, t tenant, id uint64) error {
+	var patch patchRequest
+	if err := decodeBody(r, &patch); err != nil {
+		return err
+	}
+
+	ws, err := h.fetchWorkspace(r, t, id)
+	if err != nil {
+		return err
+	}
+
+	for _, op := range patch.Operations {
+		if err := h.patchGroupOperation(r, t, ws, op); err != nil {
+			return err
+		}
+	}
+
+	return h.writeGroup(w, r, t, id)
+}
+
+func (h *Handler) patchGroupOperation(r *http.Request, t tenant, ws *workspace_model.Workspace, op patchOperation) error {
+	path := normalizeAttribute(op.Path)
+
+	switch strings.ToLower(op.Op) {
+	case "add", "replace":
+		if path == "" {
+			var res groupResource
+			if err := json.Unmarshal(op.Value, &res); err != nil {
+				return badRequest(scimTypeInvalid, "value must be an object when there is no path")
+			}
+			if err := checkDisplayName(ws, res.DisplayName); err != nil {
+				return err
+			}
+			if res.Members == nil {
+				return nil
+			}
+			path, op.Value = "members", mustMarshal(res.Members)
+		}
+
+		switch path {
+		case "displayname":
+			var displayName string
+			if err := json.Unmarshal(op.Value, &displayName); err != nil {
+				return badRequest(scimTypeInvalid, "invalid value for displayName: "+err.Error())
+			}
+			return checkDisplayName(ws, displayName)
+		case "members":
+			var members []member
+			if err := json.Unmarshal(op.Value, &members); err != nil {
+				return badRequest(scimTypeInvalid, "invalid value for members: "+err.Error())
+			}
+			userIDs, err := parseMembers(members)
+			if err != nil {
+				return err
+			}
+			if strings.EqualFold(op.Op, "replace") {
+				return h.setMembers(r, t, ws.ID, userIDs)
+			}
+			for _, userID := range userIDs {
+				if err := h.addMember(r, t, ws.ID, userID); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+		return badRequest("invalidPath", "unsupported path "+op.Path)
+
+	case "remove":
+		userIDs, err := removedMembers(path, op.Value)
+		if err != nil {
+			return err
+		}
+		if userIDs == nil {
+			return h.setMembers(r, t, ws.ID, nil)
+		}
+		for _, userID := range userIDs {
+			if err := h.removeMember(r, t, ws.ID, userID); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	return badRequest(scimTypeInvalid, "unsupported operation "+op.Op)
+}
+
+// removedMembers returns the members targeted by a 'remove' operation, either
+// through a 'members[value eq "id"]' path or through the value, or nil when
+// all of them are removed.
+func removedMembers(path string, value json.RawMessage) ([]uint64, error) {
+	if path == "members" {
+		if len(value) == 0 {
+			return nil, nil
+		}
+		var members []member
+		if err := json.Unmarshal(value, &members); err != nil {
+			return nil, badRequest(scimTypeInvalid, "invalid value for members: "+err.Error())
+		}
+		return parseMembers(members)
+	}
+
+	expr, ok := strings.CutPrefix(path, "members[")
+	if !ok || !strings.HasSuffix(expr, "]") {
+		return nil, badRequest("invalidPath", "unsupported path "+path)
+	}
+	f, err := parseFilter(strings.TrimSuffix(expr, "]"))
+	if err != nil {
+		return nil, badRequest("invalidFilter", err.Error())
+	}
+	userID, ok := f.equalityValue("value")
+	if !ok {
+		return nil, badRequest("invalidFilter", "only 'value eq' member filters are supported")
+	}
+	id, err := strconv.ParseUint(userID, 10, 64)
+	if err != nil {
+		return nil, badRequest(scimTypeInvalid, "invalid member "+userID)
+	}
+	return []uint64{id}, nil
+}
+
+// setMembers adds and removes members so that the workspace members are
+// exactly userIDs.
+func (h *Handler) setMembers(r *http.Request, t tenant, workspaceID uint64, userIDs []uint64) error {
+	current, err := h.workspace.ListWorkspaceMembers(r.Context(), t.id, workspaceID)
+	if err != nil {
+		return err
+	}
+
+	wanted := map[uint64]bool{}
+	for _, userID := range userIDs {
+		wanted[userID] = true
+	}
+
+	for _, m := range current {
+		if wanted[m.UserID] {
+			delete(wanted, m.UserID)
+			continue
+		}
+		if err := h.removeMember(r, t, workspaceID, m.UserID); err != nil {
+			return err
+		}
+	}
+
+	for _, userID := range userIDs {
+		if !wanted[userID] {
+			continue
+		}
+		if err := h.addMember(r, t, workspaceID, userID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (h *Handler) addMember(r *http.Request, t tenant, workspaceID, userID uint64) error {
+	err := h.workspace.AddWorkspaceMember(r.Context(), t.id, workspaceID, userID)
+	if err != nil {
+		if se := serviceError(err); se.status == http.StatusNotFound {
+			return badRequest(scimTypeInvalid, "unknown member "+strconv.FormatUint(userID, 10))
+		}
+		return err
+	}
+	return nil
+}
+
+// removeMember removes the member, removing a user that is not a member
+// being a no-op.
+func (h *Handler) removeMember(r *http.Request, t tenant, workspaceID, userID uint64) error {
+	err := h.workspace.RemoveWorkspaceMember(r.Context(), t.id, workspaceID, userID)
+	if err != nil {
+		if se := serviceError(err); se.status == http.StatusNotFound {
+			return nil
+		}
+		return err
+	}
+	return nil
+}
+
+func (h *Handler) writeGroup(w http.ResponseWriter, r *http.Request, t tenant, id uint64) error {
+	ws, err := h.fetchWorkspace(r, t, id)
+	if err != nil {
+		return err
+	}
+
+	members, err := h.workspace.ListWorkspaceMembers(r.Context(), t.id, id)
+	if err != nil {
+		return err
+	}
+
+	writeJSON(w, http.StatusOK, groupFromBusiness(ws, members))
+	return nil
+}
+
+// fetchWorkspace returns the workspace, hiding the deleted ones.
+func (h *Handler) fetchWorkspace(r *http.Request, t tenant, id uint64) (*workspace_model.Workspace, error) {
+	ws, err := h.workspace.GetWorkspace(r.Context(), t.id, id)
+	if err != nil {
+		if se := serviceError(err); se.status == http.StatusNotFound {
+			return nil, notFound("unknown group " + strconv.FormatUint(id, 10))
+		}
+		return nil, err
+	}
+	if ws.Status == workspace_model.WorkspaceDeleted {
+		return nil, notFound("unknown group " + strconv.FormatUint(id, 10))
+	}
+	return ws, nil
+}
+
+// checkDisplayName rejects renaming a group, the name of the workspace
+// being managed in chorus.
+func checkDisplayName(ws *workspace_model.Workspace, displayName string) error {
+	if displayName != "" && displayName != ws.Name {
+		return badRequest("mutability", "displayName is read-only")
+	}
+	return nil
+}
+
+func parseMembers(members []member) ([]uint64, error) {
+	userIDs := []uint64{}
+	for _, m := range members {
+		id, err := strconv.ParseUint(m.Value, 10, 64)
+		if err != nil {
+			return nil, badRequest(scimTypeInvalid, "invalid member "+m.Value)
+		}
+		userIDs = append(userIDs, id)
+	}
+	return userIDs, nil
+}
+
+func mustMarshal(v interface{}) json.RawMessage {
+	b, _ := json.Marshal(v)
+	return b
+}
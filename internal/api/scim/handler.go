@@ -0,0 +1,380 @@
+// Package scim implements the SCIM 2.0 provisioning endpoints (RFC 7643 and
+// RFC 7644). SCIM users map to the users of the tenant and SCIM groups map to
+// the members of its workspaces.
+package scim
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"go.uber.org/zap"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
+	"github.com/CHORUS-TRE/chorus-backend/internal/utils/grpc"
+	"github.com/CHORUS-TRE/chorus-backend/internal/utils/pagination"
+	tenant_model "github.com/CHORUS-TRE/chorus-backend/pkg/tenant/model"
+	user_model "github.com/CHORUS-TRE/chorus-backend/pkg/user/model"
+	user_service "github.com/CHORUS-TRE/chorus-backend/pkg/user/service"
+	workspace_model "github.com/CHORUS-TRE/chorus-backend/pkg/workspace/model"
+	workspace_service "github.com/CHORUS-TRE/chorus-backend/pkg/workspace/service"
+)
+
+const (
+	// PathPrefix is the path under which the SCIM endpoints are served.
+	PathPrefix = "/scim/v2"
+
+	contentType     = "application/scim+json"
+	maxBodySize     = 1 << 20
+	defaultSource   = "scim"
+	internalSource  = "internal"
+	defaultCount    = 100
+	maxResults      = 500
+	scimTypeInvalid = "invalidValue"
+)
+
+type Tenanter interface {
+	ListTenants(ctx context.Context) ([]*tenant_model.Tenant, error)
+}
+
+type Userer interface {
+	GetUsers(ctx context.Context, req user_service.GetUsersReq) ([]*user_model.User, *pagination.ResponseCursor[pagination.KeysetCursor], uint64, error)
+	GetUser(ctx context.Context, req user_service.GetUserReq) (*user_model.User, error)
+	CreateUser(ctx context.Context, req user_service.CreateUserReq) (uint64, error)
+	UpdateUser(ctx context.Context, req user_service.UpdateUserReq) error
+	SoftDeleteUser(ctx context.Context, req user_service.DeleteUserReq) error
+}
+
+type Workspaceer interface {
+	GetWorkspace(ctx context.Context, tenantID, workspaceID uint64) (*workspace_model.Workspace, error)
+	ListWorkspaces(ctx context.Context, req workspace_service.ListWorkspacesReq) ([]*workspace_model.Workspace, *pagination.ResponseCursor[pagination.KeysetCursor], uint64, error)
+	ListWorkspaceMembers(ctx context.Context, tenantID, workspaceID uint64) ([]*workspace_model.WorkspaceMember, error)
+	AddWorkspaceMember(ctx context.Context, tenantID, workspaceID, userID uint64) error
+	RemoveWorkspaceMember(ctx context.Context, tenantID, workspaceID, userID uint64) error
+}
+
+// Handler serves the SCIM endpoints. Each request is authenticated with the
+// bearer token of a tenant and operates on that tenant only.
+type Handler struct {
+	tenant    Tenanter
+	user      Userer
+	workspace Workspaceer
+}
+
+func NewHandler(tenant Tenanter, user Userer, workspace Workspaceer) *Handler {
+	return &Handler{
+		tenant:    tenant,
+		user:      user,
+		workspace: workspace,
+	}
+}
+
+// tenant is the tenant a SCIM request has been authenticated for.
+type tenant struct {
+	id     uint64
+	source string
+}
+
+// scimError is an error reported to the SCIM client.
+type scimError struct {
+	status   int
+	scimType string
+	detail   string
+}
+
+func (e *scimError) Error() string {
+	return e.detail
+}
+
+func badRequest(scimType, detail string) *scimError {
+	return &scimError{status: http.StatusBadRequest, scimType: scimType, detail: detail}
+}
+
+func notFound(detail string) *scimError {
+	return &scimError{status: http.StatusNotFound, detail: detail}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	t, ok := h.authenticate(r)
+	if !ok {
+		w.Header().Set("WWW-Authenticate", `Bearer realm="scim"`)
+		writeError(w, &scimError{status: http.StatusUnauthorized, detail: "invalid bearer token"})
+		return
+	}
+
+	ctx := context.WithValue(r.Context(), logger.TenantIDContextKey{}, t.id)
+	r = r.WithContext(ctx)
+	r.Body = http.MaxBytesReader(w, r.Body, maxBodySize)
+
+	segments := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, PathPrefix), "/"), "/")
+
+	var err error
+	switch {
+	case segments[0] == "Users" && len(segments) == 1:
+		switch r.Method {
+		case http.MethodGet:
+			err = h.listUsers(w, r, t)
+		case http.MethodPost:
+			err = h.createUser(w, r, t)
+		default:
+			err = errMethodNotAllowed
+		}
+	case segments[0] == "Users" && len(segments) == 2:
+		var id uint64
+		if id, err = parseID(segments[1]); err != nil {
+			break
+		}
+		switch r.Method {
+		case http.MethodGet:
+			err = h.getUser(w, r, t, id)
+		case http.MethodPut:
+			err = h.replaceUser(w, r, t, id)
+		case http.MethodPatch:
+			err = h.patchUser(w, r, t, id)
+		case http.MethodDelete:
+			err = h.deleteUser(w, r, t, id)
+		default:
+			err = errMethodNotAllowed
+		}
+	case segments[0] == "Groups" && len(segments) == 1:
+		switch r.Method {
+		case http.MethodGet:
+			err = h.listGroups(w, r, t)
+		case http.MethodPost:
+			err = errGroupsNotManaged
+		default:
+			err = errMethodNotAllowed
+		}
+	case segments[0] == "Groups" && len(segments) == 2:
+		var id uint64
+		if id, err = parseID(segments[1]); err != nil {
+			break
+		}
+		switch r.Method {
+		case http.MethodGet:
+			err = h.getGroup(w, r, t, id)
+		case http.MethodPut:
+			err = h.replaceGroup(w, r, t, id)
+		case http.MethodPatch:
+			err = h.patchGroup(w, r, t, id)
+		case http.MethodDelete:
+			err = errGroupsNotManaged
+		default:
+			err = errMethodNotAllowed
+		}
+	case segments[0] == "ServiceProviderConfig" && len(segments) == 1 && r.Method == http.MethodGet:
+		writeJSON(w, http.StatusOK, serviceProviderConfig)
+	case segments[0] == "ResourceTypes" && len(segments) == 1 && r.Method == http.MethodGet:
+		writeJSON(w, http.StatusOK, resourceTypes)
+	default:
+		err = notFound("unknown endpoint " + r.URL.Path)
+	}
+
+	if err != nil {
+		var se *scimError
+		if !errors.As(err, &se) {
+			se = serviceError(err)
+		}
+		if se.status >= http.StatusInternalServerError {
+			logger.TechLog.Error(ctx, "scim request failed", zap.String("path", r.URL.Path), zap.Error(err))
+		}
+		writeError(w, se)
+	}
+}
+
+var (
+	errMethodNotAllowed = &scimError{status: http.StatusMethodNotAllowed, detail: "method not allowed"}
+	errGroupsNotManaged = &scimError{status: http.StatusNotImplemented, detail: "groups map to workspaces, which cannot be created or deleted through SCIM"}
+	errEmptyValue       = errors.New("value must not be empty")
+)
+
+// authenticate returns the active tenant whose SCIM bearer token matches the
+// one of the request.
+func (h *Handler) authenticate(r *http.Request) (tenant, bool) {
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok || token == "" {
+		return tenant{}, false
+	}
+
+	tenants, err := h.tenant.ListTenants(r.Context())
+	if err != nil {
+		logger.TechLog.Error(r.Context(), "unable to list tenants", zap.Error(err))
+		return tenant{}, false
+	}
+
+	hash := tenant_model.HashSCIMToken(token)
+	for _, t := range tenants {
+		if t.Status != tenant_model.TenantActive || t.Settings == nil {
+			continue
+		}
+		scim := t.Settings.SCIM
+		if !scim.Enabled || scim.TokenHash == "" {
+			continue
+		}
+		if subtle.ConstantTimeCompare([]byte(hash), []byte(scim.TokenHash)) == 1 {
+			source := scim.Source
+			if source == "" {
+				source = defaultSource
+			}
+			// The internal users sign in with a local password, which the
+			// provisioned ones must not have.
+			if source == internalSource {
+				logger.TechLog.Error(r.Context(), "scim source cannot be internal", zap.Uint64("tenant_id", t.ID))
+				return tenant{}, false
+			}
+			return tenant{id: t.ID, source: source}, true
+		}
+	}
+
+	return tenant{}, false
+}
+
+// serviceError converts an error returned by a service into a SCIM error,
+// using the same status codes as the grpc-gateway.
+func serviceError(err error) *scimError {
+	status := runtime.HTTPStatusFromCode(grpc.ErrorCode(err))
+	se := &scimError{status: status, detail: err.Error()}
+	switch status {
+	case http.StatusBadRequest:
+		se.scimType = scimTypeInvalid
+	case http.StatusConflict:
+		se.scimType = "uniqueness"
+	case http.StatusInternalServerError:
+		se.detail = "internal error"
+	}
+	return se
+}
+
+func parseID(s string) (uint64, error) {
+	id, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return 0, notFound("unknown resource " + s)
+	}
+	return id, nil
+}
+
+// parseListParams returns the parsed filter and the 1-based start index and
+// count of the page to list.
+func parseListParams(r *http.Request) (filter, int, int, error) {
+	q := r.URL.Query()
+
+	f, err := parseFilter(q.Get("filter"))
+	if err != nil {
+		return nil, 0, 0, badRequest("invalidFilter", err.Error())
+	}
+
+	startIndex := 1
+	if s := q.Get("startIndex"); s != "" {
+		if startIndex, err = strconv.Atoi(s); err != nil {
+			return nil, 0, 0, badRequest(scimTypeInvalid, "invalid startIndex "+s)
+		}
+		if startIndex < 1 {
+			startIndex = 1
+		}
+	}
+
+	count := defaultCount
+	if s := q.Get("count"); s != "" {
+		if count, err = strconv.Atoi(s); err != nil {
+			return nil, 0, 0, badRequest(scimTypeInvalid, "invalid count "+s)
+		}
+		if count < 0 {
+			count = 0
+		}
+		if count > maxResults {
+			count = maxResults
+		}
+	}
+
+	return f, startIndex, count, nil
+}
+
+// listCursor returns the cursor of the page of count resources starting at
+// the 1-based startIndex. A page of one resource is requested when count is
+// zero, the stores requiring a page size, and dropped by page.
+func listCursor(startIndex, count int) *pagination.RequestCursor[pagination.KeysetCursor] {
+	cursor := pagination.NewRequestCursor[pagination.KeysetCursor](uint64(max(count, 1)))
+	cursor.Offset = uint64(startIndex - 1)
+	return cursor
+}
+
+// page returns the listResponse of the page of resources starting at
+// startIndex, out of total resources matching the filter.
+func page[T any](resources []T, startIndex, count int, total uint64) listResponse {
+	if len(resources) > count {
+		resources = resources[:count]
+	}
+
+	return listResponse{
+		Schemas:      []string{listResponseSchema},
+		TotalResults: int(total),
+		StartIndex:   startIndex,
+		ItemsPerPage: len(resources),
+		Resources:    resources,
+	}
+}
+
+func decodeBody(r *http.Request, dest interface{}) error {
+	if err := json.NewDecoder(r.Body).Decode(dest); err != nil {
+		return badRequest("invalidSyntax", "unable to decode request body: "+err.Error())
+	}
+	return nil
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		logger.TechLog.Error(context.Background(), "unable to write scim response", zap.Error(err))
+	}
+}
+
+func writeError(w http.ResponseWriter, err *scimError) {
+	writeJSON(w, err.status, errorResponse{
+		Schemas:  []string{errorSchema},
+		Status:   strconv.Itoa(err.status),
+		ScimType: err.scimType,
+		Detail:   err.detail,
+	})
+}
+
+var serviceProviderConfig = map[string]interface{}{
+	"schemas":        []string{serviceProviderConfigSchema},
+	"patch":          map[string]bool{"supported": true},
+	"bulk":           map[string]interface{}{"supported": false, "maxOperations": 0, "maxPayloadSize": 0},
+	"filter":         map[string]interface{}{"supported": true, "maxResults": maxResults},
+	"changePassword": map[string]bool{"supported": false},
+	"sort":           map[string]bool{"supported": false},
+	"etag":           map[string]bool{"supported": false},
+	"authenticationSchemes": []map[string]interface{}{{
+		"type":        "oauthbearertoken",
+		"name":        "OAuth Bearer Token",
+		"description": "Authentication with the SCIM bearer token of the tenant",
+		"primary":     true,
+	}},
+}
+
+var resourceTypes = listResponse{
+	Schemas:      []string{listResponseSchema},
+	TotalResults: 2,
+	StartIndex:   1,
+	ItemsPerPage: 2,
+	Resources: []map[string]interface{}{{
+		"schemas":  []string{resourceTypeSchema},
+		"id":       "User",
+		"name":     "User",
+		"endpoint": "/Users",
+		"schema":   userSchema,
+	}, {
+		"schemas":  []string{resourceTypeSchema},
+		"id":       "Group",
+		"name":     "Group",
+		"endpoint": "/Groups",
+		"schema":   groupSchema,
+	}},
+}
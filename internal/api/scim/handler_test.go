@@ -0,0 +1,113 @@
+package scim
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/config"
+	"github.com/CHORUS-TRE/chorus-backend/internal/utils/pagination"
+	common_service "github.com/CHORUS-TRE/chorus-backend/pkg/common/service"
+	tenant_model "github.com/CHORUS-TRE/chorus-backend/pkg/tenant/model"
+	user_model "github.com/CHORUS-TRE/chorus-backend/pkg/user/model"
+	user_service "github.com/CHORUS-TRE/chorus-backend/pkg/user/service"
+	workspace_model "github.com/CHORUS-TRE/chorus-backend/pkg/workspace/model"
+	workspace_service "github.com/CHORUS-TRE/chorus-backend/pkg/workspace/service"
+	"github.com/CHORUS-TRE/chorus-backend/tests/unit"
+)
+
+const token = "scim-token"
+
+type tenantLister struct{}
+
+func (tenantLister) ListTenants(ctx context.Context) ([]*tenant_model.Tenant, error) {
+	return []*tenant_model.Tenant{{
+		ID:       1,
+		Status:   tenant_model.TenantActive,
+		Settings: &tenant_model.TenantSettings{SCIM: tenant_model.SCIMSettings{Enabled: true, TokenHash: tenant_model.HashSCIMToken(token)}},
+	}}, nil
+}
+
+type userer struct {
+	Userer
+	users   []*user_model.User
+	total   uint64
+	listReq user_service.GetUsersReq
+	created error
+}
+
+func (u *userer) GetUsers(ctx context.Context, req user_service.GetUsersReq) ([]*user_model.User, *pagination.ResponseCursor[pagination.KeysetCursor], uint64, error) {
+	u.listReq = req
+	return u.users, nil, u.total, nil
+}
+
+func (u *userer) CreateUser(ctx context.Context, req user_service.CreateUserReq) (uint64, error) {
+	return 0, u.created
+}
+
+type workspaceStore struct {
+	workspace_service.WorkspaceStore
+}
+
+func (workspaceStore) GetWorkspace(ctx context.Context, tenantID, workspaceID uint64) (*workspace_model.Workspace, error) {
+	return nil, sql.ErrNoRows
+}
+
+func serve(h *Handler, method, target, body string) (*httptest.ResponseRecorder, map[string]interface{}) {
+	r := httptest.NewRequest(method, target, strings.NewReader(body))
+	r.Header.Set("Authorization", "Bearer "+token)
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+
+	var res map[string]interface{}
+	_ = json.Unmarshal(w.Body.Bytes(), &res)
+	return w, res
+}
+
+func TestHandler_UnknownGroup(t *testing.T) {
+	unit.InitTestLogger()
+	workspaces := workspace_service.NewWorkspaceService(config.Config{}, workspaceStore{}, nil, nil, nil, nil)
+	h := NewHandler(tenantLister{}, &userer{}, workspaces)
+
+	for _, method := range []string{http.MethodGet, http.MethodPut, http.MethodPatch} {
+		t.Run(method, func(t *testing.T) {
+			w, res := serve(h, method, PathPrefix+"/Groups/999", `{}`)
+			require.Equal(t, http.StatusNotFound, w.Code)
+			require.Equal(t, "unknown group 999", res["detail"])
+		})
+	}
+}
+
+func TestHandler_ListUsers(t *testing.T) {
+	unit.InitTestLogger()
+	u := &userer{users: []*user_model.User{{ID: 3, Username: "jane.doe@chorus-tre.ch", Status: user_model.UserActive}}, total: 12}
+	h := NewHandler(tenantLister{}, u, nil)
+
+	w, res := serve(h, http.MethodGet, PathPrefix+`/Users?startIndex=3&count=1&filter=userName+eq+"Jane.Doe@chorus-tre.ch"+and+active+eq+true`, "")
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, float64(12), res["totalResults"])
+	require.Equal(t, float64(1), res["itemsPerPage"])
+	require.Equal(t, uint64(2), u.listReq.Cursor.Offset)
+	require.Equal(t, uint64(1), u.listReq.Cursor.PageSize)
+	require.Equal(t, user_service.UserFilter{Username: "Jane.Doe@chorus-tre.ch", Status: "active"}, u.listReq.Filter)
+
+	w, _ = serve(h, http.MethodGet, PathPrefix+`/Users?filter=name.familyName+co+"doe"`, "")
+	require.Equal(t, http.StatusBadRequest, w.Code, "the filters the store cannot apply are rejected")
+}
+
+func TestHandler_CreateExistingUser(t *testing.T) {
+	unit.InitTestLogger()
+	u := &userer{created: fmt.Errorf("unable to create user: %w", &common_service.ResourceAlreadyExistsErr{})}
+	h := NewHandler(tenantLister{}, u, nil)
+
+	w, res := serve(h, http.MethodPost, PathPrefix+"/Users", `{"userName":"jane.doe@chorus-tre.ch","name":{"givenName":"Jane","familyName":"Doe"}}`)
+	require.Equal(t, http.StatusConflict, w.Code)
+	require.Equal(t, "uniqueness", res["scimType"])
+}
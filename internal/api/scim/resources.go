@@ -0,0 +1,157 @@
+package scim
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	user_model "github.com/CHORUS-TRE/chorus-backend/pkg/user/model"
+	workspace_model "github.com/CHORUS-TRE/chorus-backend/pkg/workspace/model"
+)
+
+const (
+	userSchema                  = "urn:ietf:params:scim:schemas:core:2.0:User"
+	groupSchema                 = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	listResponseSchema          = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	patchOpSchema               = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+	errorSchema                 = "urn:ietf:params:scim:api:messages:2.0:Error"
+	serviceProviderConfigSchema = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+	resourceTypeSchema          = "urn:ietf:params:scim:schemas:core:2.0:ResourceType"
+)
+
+type meta struct {
+	ResourceType string     `json:"resourceType"`
+	Created      *time.Time `json:"created,omitempty"`
+	LastModified *time.Time `json:"lastModified,omitempty"`
+	Location     string     `json:"location,omitempty"`
+}
+
+type name struct {
+	Formatted  string `json:"formatted,omitempty"`
+	GivenName  string `json:"givenName,omitempty"`
+	FamilyName string `json:"familyName,omitempty"`
+}
+
+type email struct {
+	Value   string `json:"value"`
+	Type    string `json:"type,omitempty"`
+	Primary bool   `json:"primary,omitempty"`
+}
+
+type userResource struct {
+	Schemas     []string `json:"schemas"`
+	ID          string   `json:"id,omitempty"`
+	ExternalID  string   `json:"externalId,omitempty"`
+	UserName    string   `json:"userName"`
+	Name        *name    `json:"name,omitempty"`
+	DisplayName string   `json:"displayName,omitempty"`
+	Active      *bool    `json:"active,omitempty"`
+	Emails      []email  `json:"emails,omitempty"`
+	Meta        *meta    `json:"meta,omitempty"`
+}
+
+type member struct {
+	Value   string `json:"value"`
+	Ref     string `json:"$ref,omitempty"`
+	Display string `json:"display,omitempty"`
+}
+
+type groupResource struct {
+	Schemas     []string `json:"schemas"`
+	ID          string   `json:"id,omitempty"`
+	ExternalID  string   `json:"externalId,omitempty"`
+	DisplayName string   `json:"displayName"`
+	Members     []member `json:"members,omitempty"`
+	Meta        *meta    `json:"meta,omitempty"`
+}
+
+type listResponse struct {
+	Schemas      []string    `json:"schemas"`
+	TotalResults int         `json:"totalResults"`
+	StartIndex   int         `json:"startIndex"`
+	ItemsPerPage int         `json:"itemsPerPage"`
+	Resources    interface{} `json:"Resources"`
+}
+
+type patchRequest struct {
+	Schemas    []string         `json:"schemas"`
+	Operations []patchOperation `json:"Operations"`
+}
+
+type patchOperation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path,omitempty"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+type errorResponse struct {
+	Schemas  []string `json:"schemas"`
+	Status   string   `json:"status"`
+	ScimType string   `json:"scimType,omitempty"`
+	Detail   string   `json:"detail,omitempty"`
+}
+
+func userLocation(id uint64) string {
+	return PathPrefix + "/Users/" + strconv.FormatUint(id, 10)
+}
+
+func groupLocation(id uint64) string {
+	return PathPrefix + "/Groups/" + strconv.FormatUint(id, 10)
+}
+
+func userFromBusiness(u *user_model.User) *userResource {
+	active := u.Status == user_model.UserActive
+	created, updated := u.CreatedAt, u.UpdatedAt
+
+	res := &userResource{
+		Schemas:  []string{userSchema},
+		ID:       strconv.FormatUint(u.ID, 10),
+		UserName: u.Username,
+		Name: &name{
+			Formatted:  strings.TrimSpace(u.FirstName + " " + u.LastName),
+			GivenName:  u.FirstName,
+			FamilyName: u.LastName,
+		},
+		DisplayName: strings.TrimSpace(u.FirstName + " " + u.LastName),
+		Active:      &active,
+		Meta: &meta{
+			ResourceType: "User",
+			Created:      &created,
+			LastModified: &updated,
+			Location:     userLocation(u.ID),
+		},
+	}
+
+	// Usernames are email addresses, which is where the mails are sent.
+	if strings.Contains(u.Username, "@") {
+		res.Emails = []email{{Value: u.Username, Type: "work", Primary: true}}
+	}
+
+	return res
+}
+
+func groupFromBusiness(w *workspace_model.Workspace, members []*workspace_model.WorkspaceMember) *groupResource {
+	created, updated := w.CreatedAt, w.UpdatedAt
+
+	res := &groupResource{
+		Schemas:     []string{groupSchema},
+		ID:          strconv.FormatUint(w.ID, 10),
+		DisplayName: w.Name,
+		Meta: &meta{
+			ResourceType: "Group",
+			Created:      &created,
+			LastModified: &updated,
+			Location:     groupLocation(w.ID),
+		},
+	}
+
+	for _, m := range members {
+		res.Members = append(res.Members, member{
+			Value: strconv.FormatUint(m.UserID, 10),
+			Ref:   userLocation(m.UserID),
+		})
+	}
+
+	return res
+}
@@ -0,0 +1,321 @@
+package scim
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	user_model "github.com/CHORUS-TRE/chorus-backend/pkg/user/model"
+	user_service "github.com/CHORUS-TRE/chorus-backend/pkg/user/service"
+)
+
+// userFields are the user fields that can be provisioned through SCIM. The
+// other SCIM attributes are accepted but ignored.
+type userFields struct {
+	userName   string
+	givenName  string
+	familyName string
+	active     bool
+}
+
+func (h *Handler) listUsers(w http.ResponseWriter, r *http.Request, t tenant) error {
+	f, startIndex, count, err := parseListParams(r)
+	if err != nil {
+		return err
+	}
+
+	values, err := f.equalities("username", "active")
+	if err != nil {
+		return badRequest("invalidFilter", err.Error())
+	}
+	req := user_service.GetUsersReq{
+		TenantID: t.id,
+		Cursor:   listCursor(startIndex, count),
+		Filter:   user_service.UserFilter{Username: values["username"]},
+		Sort:     user_service.Sort{SortOrder: "ASC", SortType: "ID"},
+	}
+	switch values["active"] {
+	case "":
+	case "true":
+		req.Filter.Status = user_model.UserActive.String()
+	case "false":
+		req.Filter.Status = user_model.UserDisabled.String()
+	default:
+		return badRequest("invalidFilter", "invalid value for active: "+values["active"])
+	}
+
+	users, _, total, err := h.user.GetUsers(r.Context(), req)
+	if err != nil {
+		return err
+	}
+
+	resources := make([]*userResource, 0, len(users))
+	for _, u := range users {
+		resources = append(resources, userFromBusiness(u))
+	}
+
+	writeJSON(w, http.StatusOK, page(resources, startIndex, count, total))
+	return nil
+}
+
+func (h *Handler) getUser(w http.ResponseWriter, r *http.Request, t tenant, id uint64) error {
+	user, err := h.fetchUser(r, t, id)
+	if err != nil {
+		return err
+	}
+
+	writeJSON(w, http.StatusOK, userFromBusiness(user))
+	return nil
+}
+
+func (h *Handler) createUser(w http.ResponseWriter, r *http.Request, t tenant) error {
+	var res userResource
+	if err := decodeBody(r, &res); err != nil {
+		return err
+	}
+
+	fields := userFields{active: true}
+	if err := fields.set(&res); err != nil {
+		return err
+	}
+
+	// An existing user is reported as a conflict by the user service.
+	id, err := h.user.CreateUser(r.Context(), user_service.CreateUserReq{
+		TenantID: t.id,
+		User: &user_service.UserReq{
+			FirstName: fields.givenName,
+			LastName:  fields.familyName,
+			Username:  fields.userName,
+			Source:    t.source,
+			Status:    fields.status(),
+			Roles:     []user_model.UserRole{user_model.RoleAuthenticated},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	user, err := h.fetchUser(r, t, id)
+	if err != nil {
+		return err
+	}
+
+	w.Header().Set("Location", userLocation(id))
+	writeJSON(w, http.StatusCreated, userFromBusiness(user))
+	return nil
+}
+
+func (h *Handler) replaceUser(w http.ResponseWriter, r *http.Request, t tenant, id uint64) error {
+	var res userResource
+	if err := decodeBody(r, &res); err != nil {
+		return err
+	}
+
+	user, err := h.fetchUser(r, t, id)
+	if err != nil {
+		return err
+	}
+
+	fields := userFields{active: user.Status == user_model.UserActive}
+	if err := fields.set(&res); err != nil {
+		return err
+	}
+
+	return h.updateUser(w, r, t, user, fields)
+}
+
+func (h *Handler) patchUser(w http.ResponseWriter, r *http.Request, t tenant, id uint64) error {
+	var patch patchRequest
+	if err := decodeBody(r, &patch); err != nil {
+		return err
+	}
+
+	user, err := h.fetchUser(r, t, id)
+	if err != nil {
+		return err
+	}
+
+	fields := userFields{
+		userName:   user.Username,
+		givenName:  user.FirstName,
+		familyName: user.LastName,
+		active:     user.Status == user_model.UserActive,
+	}
+	for _, op := range patch.Operations {
+		if err := fields.patch(op); err != nil {
+			return err
+		}
+	}
+
+	return h.updateUser(w, r, t, user, fields)
+}
+
+func (h *Handler) deleteUser(w http.ResponseWriter, r *http.Request, t tenant, id uint64) error {
+	if _, err := h.fetchUser(r, t, id); err != nil {
+		return err
+	}
+
+	if err := h.user.SoftDeleteUser(r.Context(), user_service.DeleteUserReq{TenantID: t.id, ID: id}); err != nil {
+		return err
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+// fetchUser returns the user, hiding the deleted ones.
+func (h *Handler) fetchUser(r *http.Request, t tenant, id uint64) (*user_model.User, error) {
+	user, err := h.user.GetUser(r.Context(), user_service.GetUserReq{TenantID: t.id, ID: id})
+	if err != nil {
+		if se := serviceError(err); se.status == http.StatusNotFound {
+			return nil, notFound("unknown user " + strconv.FormatUint(id, 10))
+		}
+		return nil, err
+	}
+	if user.Status == user_model.UserDeleted {
+		return nil, notFound("unknown user " + strconv.FormatUint(id, 10))
+	}
+	return user, nil
+}
+
+func (h *Handler) updateUser(w http.ResponseWriter, r *http.Request, t tenant, user *user_model.User, fields userFields) error {
+	err := h.user.UpdateUser(r.Context(), user_service.UpdateUserReq{
+		TenantID: t.id,
+		User: &user_service.UserUpdateReq{
+			ID:        user.ID,
+			FirstName: fields.givenName,
+			LastName:  fields.familyName,
+			Username:  fields.userName,
+			Source:    user.Source,
+			Status:    fields.status(),
+			Roles:     user.Roles,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	user, err = h.fetchUser(r, t, user.ID)
+	if err != nil {
+		return err
+	}
+
+	writeJSON(w, http.StatusOK, userFromBusiness(user))
+	return nil
+}
+
+func (f *userFields) status() user_model.UserStatus {
+	if f.active {
+		return user_model.UserActive
+	}
+	return user_model.UserDisabled
+}
+
+// set sets the fields from a full user resource, as sent by POST and PUT.
+func (f *userFields) set(res *userResource) error {
+	if res.UserName == "" {
+		return badRequest(scimTypeInvalid, "userName is required")
+	}
+	if res.Name == nil || res.Name.GivenName == "" || res.Name.FamilyName == "" {
+		return badRequest(scimTypeInvalid, "name.givenName and name.familyName are required")
+	}
+
+	f.userName = res.UserName
+	f.givenName = res.Name.GivenName
+	f.familyName = res.Name.FamilyName
+	if res.Active != nil {
+		f.active = *res.Active
+	}
+	return nil
+}
+
+// patch applies a PATCH operation. 'add' and 'replace' are equivalent as all
+// the provisioned attributes are single-valued.
+func (f *userFields) patch(op patchOperation) error {
+	switch strings.ToLower(op.Op) {
+	case "add", "replace":
+	case "remove":
+		switch normalizeAttribute(op.Path) {
+		case "username", "name", "name.givenname", "name.familyname":
+			return badRequest("mutability", op.Path+" is required and cannot be removed")
+		}
+		return nil
+	default:
+		return badRequest(scimTypeInvalid, "unsupported operation "+op.Op)
+	}
+
+	if op.Path == "" {
+		var values map[string]json.RawMessage
+		if err := json.Unmarshal(op.Value, &values); err != nil {
+			return badRequest(scimTypeInvalid, "value must be an object when there is no path")
+		}
+		for path, value := range values {
+			if err := f.patchAttribute(normalizeAttribute(path), value); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	return f.patchAttribute(normalizeAttribute(op.Path), op.Value)
+}
+
+func (f *userFields) patchAttribute(path string, value json.RawMessage) error {
+	var err error
+	switch path {
+	case "username":
+		err = unmarshalString(value, &f.userName)
+	case "name.givenname":
+		err = unmarshalString(value, &f.givenName)
+	case "name.familyname":
+		err = unmarshalString(value, &f.familyName)
+	case "name":
+		var n name
+		if err = json.Unmarshal(value, &n); err == nil {
+			if n.GivenName != "" {
+				f.givenName = n.GivenName
+			}
+			if n.FamilyName != "" {
+				f.familyName = n.FamilyName
+			}
+		}
+	case "active":
+		err = unmarshalBool(value, &f.active)
+	}
+	if err != nil {
+		return badRequest(scimTypeInvalid, "invalid value for "+path+": "+err.Error())
+	}
+	return nil
+}
+
+func unmarshalString(value json.RawMessage, dest *string) error {
+	var s string
+	if err := json.Unmarshal(value, &s); err != nil {
+		return err
+	}
+	if s == "" {
+		return errEmptyValue
+	}
+	*dest = s
+	return nil
+}
+
+// unmarshalBool accepts booleans as well as their string representation,
+// which some identity providers send.
+func unmarshalBool(value json.RawMessage, dest *bool) error {
+	if err := json.Unmarshal(value, dest); err == nil {
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(value, &s); err != nil {
+		return err
+	}
+	b, err := strconv.ParseBool(s)
+	if err != nil {
+		return err
+	}
+	*dest = b
+	return nil
+}
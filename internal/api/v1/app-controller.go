@@ -2,11 +2,13 @@ package v1
 
 import (
 	"context"
+	"strings"
 
 	"github.com/CHORUS-TRE/chorus-backend/internal/api/v1/chorus"
 	"github.com/CHORUS-TRE/chorus-backend/internal/api/v1/converter"
 	jwt_model "github.com/CHORUS-TRE/chorus-backend/internal/jwt/model"
 	"github.com/CHORUS-TRE/chorus-backend/internal/utils/grpc"
+	"github.com/CHORUS-TRE/chorus-backend/internal/utils/pagination"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/app/service"
 
 	"google.golang.org/grpc/codes"
@@ -82,6 +84,136 @@ func (c AppController) DeleteApp(ctx context.Context, req *chorus.DeleteAppReque
 	return &chorus.DeleteAppReply{Result: &chorus.DeleteAppResult{}}, nil
 }
 
+func (c AppController) RestoreApp(ctx context.Context, req *chorus.RestoreAppRequest) (*chorus.RestoreAppReply, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "could not extract tenant id from jwt-token")
+	}
+
+	err = c.app.RestoreApp(ctx, tenantID, req.Id)
+	if err != nil {
+		return nil, status.Errorf(grpc.ErrorCode(err), "unable to call 'RestoreApp': %v", err.Error())
+	}
+	return &chorus.RestoreAppReply{Result: &chorus.RestoreAppResult{}}, nil
+}
+
+// ResolveAppImage resolves the tag of an app again into a new version pinned
+// to the digest it now points to.
+func (c AppController) ResolveAppImage(ctx context.Context, req *chorus.ResolveAppImageRequest) (*chorus.ResolveAppImageReply, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "could not extract tenant id from jwt-token")
+	}
+
+	userID, err := jwt_model.ExtractUserID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "could not extract user id from jwt-token")
+	}
+
+	id, err := c.app.ResolveAppImage(ctx, service.ResolveAppImageReq{
+		TenantID:  tenantID,
+		UserID:    userID,
+		AppID:     req.Id,
+		Version:   req.Version,
+		Changelog: req.Changelog,
+	})
+	if err != nil {
+		return nil, status.Errorf(grpc.ErrorCode(err), "unable to call 'ResolveAppImage': %v", err.Error())
+	}
+	return &chorus.ResolveAppImageReply{Result: &chorus.ResolveAppImageResult{Id: id}}, nil
+}
+
+// ListAppVersions extracts the versions of an app from the service and inserts them into a reply object.
+func (c AppController) ListAppVersions(ctx context.Context, req *chorus.ListAppVersionsRequest) (*chorus.ListAppVersionsReply, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "could not extract tenant id from jwt-token")
+	}
+
+	res, err := c.app.ListAppVersions(ctx, tenantID, req.Id)
+	if err != nil {
+		return nil, status.Errorf(grpc.ErrorCode(err), "unable to call 'ListAppVersions': %v", err.Error())
+	}
+
+	var versions []*chorus.AppVersion
+	for _, r := range res {
+		version, err := converter.AppVersionFromBusiness(r)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "conversion error: %v", err.Error())
+		}
+		versions = append(versions, version)
+	}
+	return &chorus.ListAppVersionsReply{Result: versions}, nil
+}
+
+// CreateAppVersion extracts the version from the request and passes it to the app service.
+func (c AppController) CreateAppVersion(ctx context.Context, req *chorus.CreateAppVersionRequest) (*chorus.CreateAppVersionReply, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "could not extract tenant id from jwt-token")
+	}
+
+	userID, err := jwt_model.ExtractUserID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "could not extract user id from jwt-token")
+	}
+
+	id, err := c.app.CreateAppVersion(ctx, service.CreateAppVersionReq{
+		TenantID:        tenantID,
+		UserID:          userID,
+		AppID:           req.AppId,
+		Version:         req.Version,
+		Changelog:       req.Changelog,
+		DockerImageName: req.DockerImageName,
+		DockerImageTag:  req.DockerImageTag,
+		Default:         req.Default,
+	})
+	if err != nil {
+		return nil, status.Errorf(grpc.ErrorCode(err), "unable to call 'CreateAppVersion': %v", err.Error())
+	}
+	return &chorus.CreateAppVersionReply{Result: &chorus.CreateAppVersionResult{Id: id}}, nil
+}
+
+// UpdateAppVersion extracts the version from the request and passes it to the app service.
+func (c AppController) UpdateAppVersion(ctx context.Context, req *chorus.UpdateAppVersionRequest) (*chorus.UpdateAppVersionReply, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "could not extract tenant id from jwt-token")
+	}
+
+	err = c.app.UpdateAppVersion(ctx, service.UpdateAppVersionReq{
+		TenantID:     tenantID,
+		AppVersionID: req.Id,
+		Changelog:    req.Changelog,
+		Deprecated:   req.Deprecated,
+		Default:      req.Default,
+	})
+	if err != nil {
+		return nil, status.Errorf(grpc.ErrorCode(err), "unable to call 'UpdateAppVersion': %v", err.Error())
+	}
+	return &chorus.UpdateAppVersionReply{Result: &chorus.UpdateAppVersionResult{}}, nil
+}
+
 // NewAppController returns a fresh admin service controller instance.
 func NewAppController(app service.Apper) AppController {
 	return AppController{app: app}
@@ -98,13 +230,45 @@ func (c AppController) ListApps(ctx context.Context, req *chorus.ListAppsRequest
 		return nil, status.Error(codes.InvalidArgument, "could not extract tenant id from jwt-token")
 	}
 
-	pagination := converter.PaginationToBusiness(req.Pagination)
+	var cursor *pagination.RequestCursor[pagination.KeysetCursor]
+	if req.Cursor != nil {
+		cursor, err = pagination.RequestCursorFromPb[pagination.KeysetCursor](req.Cursor)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid cursor: %v", err.Error())
+		}
+		if cursor.PageSize == 0 {
+			cursor.PageSize = 20
+		}
+	}
 
-	res, err := c.app.ListApps(ctx, tenantID, pagination)
+	if req.Sort == nil {
+		req.Sort = &chorus.AppSort{Type: "ID", Order: "ASC"}
+	}
+	if req.Filter == nil {
+		req.Filter = &chorus.AppFilter{}
+	}
+
+	res, resCursor, totalItems, err := c.app.ListApps(ctx, service.ListAppsReq{
+		TenantID: tenantID,
+		Cursor:   cursor,
+		Filter: service.AppFilter{
+			Status:  strings.ToLower(req.Filter.Status),
+			OwnerID: req.Filter.OwnerId,
+		},
+		Sort: service.Sort{
+			SortOrder: strings.ToUpper(req.Sort.Order),
+			SortType:  strings.ToUpper(req.Sort.Type),
+		},
+	})
 	if err != nil {
 		return nil, status.Errorf(grpc.ErrorCode(err), "unable to call 'ListApps': %v", err.Error())
 	}
 
+	resCursorPb, err := resCursor.ToPb()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "conversion error: %v", err.Error())
+	}
+
 	var apps []*chorus.App
 	for _, r := range res {
 		app, err := converter.AppFromBusiness(r)
@@ -113,7 +277,7 @@ func (c AppController) ListApps(ctx context.Context, req *chorus.ListAppsRequest
 		}
 		apps = append(apps, app)
 	}
-	return &chorus.ListAppsReply{Result: apps}, nil
+	return &chorus.ListAppsReply{Result: apps, Cursor: resCursorPb, TotalItems: totalItems}, nil
 }
 
 // CreateApp extracts the app from the request and passes it to the app service.
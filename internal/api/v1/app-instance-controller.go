@@ -2,11 +2,13 @@ package v1
 
 import (
 	"context"
+	"strings"
 
 	"github.com/CHORUS-TRE/chorus-backend/internal/api/v1/chorus"
 	"github.com/CHORUS-TRE/chorus-backend/internal/api/v1/converter"
 	jwt_model "github.com/CHORUS-TRE/chorus-backend/internal/jwt/model"
 	"github.com/CHORUS-TRE/chorus-backend/internal/utils/grpc"
+	"github.com/CHORUS-TRE/chorus-backend/internal/utils/pagination"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/app-instance/service"
 
 	"google.golang.org/grpc/codes"
@@ -38,7 +40,25 @@ func (c AppInstanceController) GetAppInstance(ctx context.Context, req *chorus.G
 		return nil, status.Errorf(codes.Internal, "conversion error: %v", err.Error())
 	}
 
-	return &chorus.GetAppInstanceReply{Result: &chorus.GetAppInstanceResult{AppInstance: tgAppInstance}}, nil
+	result := &chorus.GetAppInstanceResult{AppInstance: tgAppInstance}
+	if req.IncludeRuntime {
+		userID, err := jwt_model.ExtractUserID(ctx)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "could not extract user id from jwt-token")
+		}
+
+		details, err := c.appInstance.GetAppInstanceRuntime(ctx, tenantID, userID, req.Id)
+		if err != nil {
+			return nil, status.Errorf(grpc.ErrorCode(err), "unable to call 'GetAppInstanceRuntime': %v", err.Error())
+		}
+
+		result.Runtime, err = converter.RuntimeDetailsFromBusiness(details)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "conversion error: %v", err.Error())
+		}
+	}
+
+	return &chorus.GetAppInstanceReply{Result: result}, nil
 }
 
 func (c AppInstanceController) UpdateAppInstance(ctx context.Context, req *chorus.UpdateAppInstanceRequest) (*chorus.UpdateAppInstanceReply, error) {
@@ -82,6 +102,86 @@ func (c AppInstanceController) DeleteAppInstance(ctx context.Context, req *choru
 	return &chorus.DeleteAppInstanceReply{Result: &chorus.DeleteAppInstanceResult{}}, nil
 }
 
+func (c AppInstanceController) RestoreAppInstance(ctx context.Context, req *chorus.RestoreAppInstanceRequest) (*chorus.RestoreAppInstanceReply, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "could not extract tenant id from jwt-token")
+	}
+
+	err = c.appInstance.RestoreAppInstance(ctx, tenantID, req.Id)
+	if err != nil {
+		return nil, status.Errorf(grpc.ErrorCode(err), "unable to call 'RestoreAppInstance': %v", err.Error())
+	}
+	return &chorus.RestoreAppInstanceReply{Result: &chorus.RestoreAppInstanceResult{}}, nil
+}
+
+// UpgradeAppInstance moves the app instance to a newer version of its app,
+// the membership of its workspace being checked by the service.
+func (c AppInstanceController) UpgradeAppInstance(ctx context.Context, req *chorus.UpgradeAppInstanceRequest) (*chorus.UpgradeAppInstanceReply, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "could not extract tenant id from jwt-token")
+	}
+
+	userID, err := jwt_model.ExtractUserID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "could not extract user id from jwt-token")
+	}
+
+	err = c.appInstance.UpgradeAppInstance(ctx, tenantID, userID, req.Id, req.AppVersionId)
+	if err != nil {
+		return nil, status.Errorf(grpc.ErrorCode(err), "unable to call 'UpgradeAppInstance': %v", err.Error())
+	}
+	return &chorus.UpgradeAppInstanceReply{Result: &chorus.UpgradeAppInstanceResult{}}, nil
+}
+
+// StreamAppInstanceLogs streams the logs of the container of the app instance,
+// the membership of its workspace being checked by the service.
+func (c AppInstanceController) StreamAppInstanceLogs(req *chorus.StreamAppInstanceLogsRequest, stream chorus.AppInstanceService_StreamAppInstanceLogsServer) error {
+	if req == nil {
+		return status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := stream.Context()
+
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, "could not extract tenant id from jwt-token")
+	}
+
+	userID, err := jwt_model.ExtractUserID(ctx)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, "could not extract user id from jwt-token")
+	}
+
+	sinceTime, err := converter.FromProtoTimestamp(req.SinceTime)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid since time: %v", err.Error())
+	}
+
+	err = c.appInstance.StreamAppInstanceLogs(ctx, service.StreamAppInstanceLogsReq{
+		TenantID:      tenantID,
+		UserID:        userID,
+		AppInstanceID: req.Id,
+		Follow:        req.Follow,
+		TailLines:     req.TailLines,
+		SinceTime:     sinceTime,
+	}, func(line string) error {
+		return stream.Send(&chorus.LogLine{Content: line})
+	})
+	if err != nil {
+		return status.Errorf(grpc.ErrorCode(err), "unable to call 'StreamAppInstanceLogs': %v", err.Error())
+	}
+	return nil
+}
+
 // NewAppInstanceController returns a fresh admin service controller instance.
 func NewAppInstanceController(appInstance service.AppInstanceer) AppInstanceController {
 	return AppInstanceController{appInstance: appInstance}
@@ -98,13 +198,45 @@ func (c AppInstanceController) ListAppInstances(ctx context.Context, req *chorus
 		return nil, status.Error(codes.InvalidArgument, "could not extract tenant id from jwt-token")
 	}
 
-	pagination := converter.PaginationToBusiness(req.Pagination)
+	var cursor *pagination.RequestCursor[pagination.KeysetCursor]
+	if req.Cursor != nil {
+		cursor, err = pagination.RequestCursorFromPb[pagination.KeysetCursor](req.Cursor)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid cursor: %v", err.Error())
+		}
+		if cursor.PageSize == 0 {
+			cursor.PageSize = 20
+		}
+	}
+
+	if req.Sort == nil {
+		req.Sort = &chorus.AppInstanceSort{Type: "ID", Order: "ASC"}
+	}
+	if req.Filter == nil {
+		req.Filter = &chorus.AppInstanceFilter{}
+	}
 
-	res, err := c.appInstance.ListAppInstances(ctx, tenantID, pagination)
+	res, resCursor, totalItems, err := c.appInstance.ListAppInstances(ctx, service.ListAppInstancesReq{
+		TenantID: tenantID,
+		Cursor:   cursor,
+		Filter: service.AppInstanceFilter{
+			Status:  strings.ToLower(req.Filter.Status),
+			OwnerID: req.Filter.OwnerId,
+		},
+		Sort: service.Sort{
+			SortOrder: strings.ToUpper(req.Sort.Order),
+			SortType:  strings.ToUpper(req.Sort.Type),
+		},
+	})
 	if err != nil {
 		return nil, status.Errorf(grpc.ErrorCode(err), "unable to call 'ListAppInstances': %v", err.Error())
 	}
 
+	resCursorPb, err := resCursor.ToPb()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "conversion error: %v", err.Error())
+	}
+
 	var appInstances []*chorus.AppInstance
 	for _, r := range res {
 		appInstance, err := converter.AppInstanceFromBusiness(r)
@@ -113,7 +245,7 @@ func (c AppInstanceController) ListAppInstances(ctx context.Context, req *chorus
 		}
 		appInstances = append(appInstances, appInstance)
 	}
-	return &chorus.ListAppInstancesReply{Result: appInstances}, nil
+	return &chorus.ListAppInstancesReply{Result: appInstances, Cursor: resCursorPb, TotalItems: totalItems}, nil
 }
 
 // CreateAppInstance extracts the appInstance from the request and passes it to the appInstance service.
@@ -9,6 +9,7 @@ package chorus
 import (
 	context "context"
 	_ "github.com/golang/protobuf/ptypes/empty"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
 	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
@@ -32,7 +33,9 @@ type ListAppInstancesRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Pagination *PaginationQuery `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Cursor *RequestCursor     `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Filter *AppInstanceFilter `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	Sort   *AppInstanceSort   `protobuf:"bytes,4,opt,name=sort,proto3" json:"sort,omitempty"`
 }
 
 func (x *ListAppInstancesRequest) Reset() {
@@ -67,25 +70,156 @@ func (*ListAppInstancesRequest) Descriptor() ([]byte, []int) {
 	return file_app_instance_service_proto_rawDescGZIP(), []int{0}
 }
 
-func (x *ListAppInstancesRequest) GetPagination() *PaginationQuery {
+func (x *ListAppInstancesRequest) GetCursor() *RequestCursor {
 	if x != nil {
-		return x.Pagination
+		return x.Cursor
 	}
 	return nil
 }
 
+func (x *ListAppInstancesRequest) GetFilter() *AppInstanceFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListAppInstancesRequest) GetSort() *AppInstanceSort {
+	if x != nil {
+		return x.Sort
+	}
+	return nil
+}
+
+type AppInstanceFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Only return app instances with this status (`active`, `inactive`, `deleted`).
+	// Deleted app instances are excluded when empty.
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// Only return app instances owned by this user
+	OwnerId uint64 `protobuf:"varint,2,opt,name=ownerId,proto3" json:"ownerId,omitempty"`
+}
+
+func (x *AppInstanceFilter) Reset() {
+	*x = AppInstanceFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_instance_service_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppInstanceFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppInstanceFilter) ProtoMessage() {}
+
+func (x *AppInstanceFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_app_instance_service_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppInstanceFilter.ProtoReflect.Descriptor instead.
+func (*AppInstanceFilter) Descriptor() ([]byte, []int) {
+	return file_app_instance_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AppInstanceFilter) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AppInstanceFilter) GetOwnerId() uint64 {
+	if x != nil {
+		return x.OwnerId
+	}
+	return 0
+}
+
+type AppInstanceSort struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Can be one of `ASC`, `DESC`
+	Order string `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	// Can be one of `ID`, `CREATEDAT`
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+}
+
+func (x *AppInstanceSort) Reset() {
+	*x = AppInstanceSort{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_instance_service_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppInstanceSort) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppInstanceSort) ProtoMessage() {}
+
+func (x *AppInstanceSort) ProtoReflect() protoreflect.Message {
+	mi := &file_app_instance_service_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppInstanceSort.ProtoReflect.Descriptor instead.
+func (*AppInstanceSort) Descriptor() ([]byte, []int) {
+	return file_app_instance_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AppInstanceSort) GetOrder() string {
+	if x != nil {
+		return x.Order
+	}
+	return ""
+}
+
+func (x *AppInstanceSort) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
 type ListAppInstancesReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Result []*AppInstance `protobuf:"bytes,1,rep,name=result,proto3" json:"result,omitempty"`
+	Result     []*AppInstance  `protobuf:"bytes,1,rep,name=result,proto3" json:"result,omitempty"`
+	Cursor     *ResponseCursor `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	TotalItems uint64          `protobuf:"varint,3,opt,name=totalItems,proto3" json:"totalItems,omitempty"`
 }
 
 func (x *ListAppInstancesReply) Reset() {
 	*x = ListAppInstancesReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_instance_service_proto_msgTypes[1]
+		mi := &file_app_instance_service_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -98,7 +232,7 @@ func (x *ListAppInstancesReply) String() string {
 func (*ListAppInstancesReply) ProtoMessage() {}
 
 func (x *ListAppInstancesReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_instance_service_proto_msgTypes[1]
+	mi := &file_app_instance_service_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -111,7 +245,7 @@ func (x *ListAppInstancesReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListAppInstancesReply.ProtoReflect.Descriptor instead.
 func (*ListAppInstancesReply) Descriptor() ([]byte, []int) {
-	return file_app_instance_service_proto_rawDescGZIP(), []int{1}
+	return file_app_instance_service_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *ListAppInstancesReply) GetResult() []*AppInstance {
@@ -121,18 +255,34 @@ func (x *ListAppInstancesReply) GetResult() []*AppInstance {
 	return nil
 }
 
+func (x *ListAppInstancesReply) GetCursor() *ResponseCursor {
+	if x != nil {
+		return x.Cursor
+	}
+	return nil
+}
+
+func (x *ListAppInstancesReply) GetTotalItems() uint64 {
+	if x != nil {
+		return x.TotalItems
+	}
+	return 0
+}
+
 type GetAppInstanceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Also return the live state of the app instance in the runtime
+	IncludeRuntime bool `protobuf:"varint,2,opt,name=includeRuntime,proto3" json:"includeRuntime,omitempty"`
 }
 
 func (x *GetAppInstanceRequest) Reset() {
 	*x = GetAppInstanceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_instance_service_proto_msgTypes[2]
+		mi := &file_app_instance_service_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -145,7 +295,7 @@ func (x *GetAppInstanceRequest) String() string {
 func (*GetAppInstanceRequest) ProtoMessage() {}
 
 func (x *GetAppInstanceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_instance_service_proto_msgTypes[2]
+	mi := &file_app_instance_service_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -158,7 +308,7 @@ func (x *GetAppInstanceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAppInstanceRequest.ProtoReflect.Descriptor instead.
 func (*GetAppInstanceRequest) Descriptor() ([]byte, []int) {
-	return file_app_instance_service_proto_rawDescGZIP(), []int{2}
+	return file_app_instance_service_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GetAppInstanceRequest) GetId() uint64 {
@@ -168,18 +318,27 @@ func (x *GetAppInstanceRequest) GetId() uint64 {
 	return 0
 }
 
+func (x *GetAppInstanceRequest) GetIncludeRuntime() bool {
+	if x != nil {
+		return x.IncludeRuntime
+	}
+	return false
+}
+
 type GetAppInstanceResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	AppInstance *AppInstance `protobuf:"bytes,1,opt,name=appInstance,proto3" json:"appInstance,omitempty"`
+	// Only set when includeRuntime is requested
+	Runtime *RuntimeDetails `protobuf:"bytes,2,opt,name=runtime,proto3" json:"runtime,omitempty"`
 }
 
 func (x *GetAppInstanceResult) Reset() {
 	*x = GetAppInstanceResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_instance_service_proto_msgTypes[3]
+		mi := &file_app_instance_service_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -192,7 +351,7 @@ func (x *GetAppInstanceResult) String() string {
 func (*GetAppInstanceResult) ProtoMessage() {}
 
 func (x *GetAppInstanceResult) ProtoReflect() protoreflect.Message {
-	mi := &file_app_instance_service_proto_msgTypes[3]
+	mi := &file_app_instance_service_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -205,7 +364,7 @@ func (x *GetAppInstanceResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAppInstanceResult.ProtoReflect.Descriptor instead.
 func (*GetAppInstanceResult) Descriptor() ([]byte, []int) {
-	return file_app_instance_service_proto_rawDescGZIP(), []int{3}
+	return file_app_instance_service_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GetAppInstanceResult) GetAppInstance() *AppInstance {
@@ -215,6 +374,13 @@ func (x *GetAppInstanceResult) GetAppInstance() *AppInstance {
 	return nil
 }
 
+func (x *GetAppInstanceResult) GetRuntime() *RuntimeDetails {
+	if x != nil {
+		return x.Runtime
+	}
+	return nil
+}
+
 type GetAppInstanceReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -226,7 +392,7 @@ type GetAppInstanceReply struct {
 func (x *GetAppInstanceReply) Reset() {
 	*x = GetAppInstanceReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_instance_service_proto_msgTypes[4]
+		mi := &file_app_instance_service_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -239,7 +405,7 @@ func (x *GetAppInstanceReply) String() string {
 func (*GetAppInstanceReply) ProtoMessage() {}
 
 func (x *GetAppInstanceReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_instance_service_proto_msgTypes[4]
+	mi := &file_app_instance_service_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -252,7 +418,7 @@ func (x *GetAppInstanceReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAppInstanceReply.ProtoReflect.Descriptor instead.
 func (*GetAppInstanceReply) Descriptor() ([]byte, []int) {
-	return file_app_instance_service_proto_rawDescGZIP(), []int{4}
+	return file_app_instance_service_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GetAppInstanceReply) GetResult() *GetAppInstanceResult {
@@ -273,7 +439,7 @@ type CreateAppInstanceReply struct {
 func (x *CreateAppInstanceReply) Reset() {
 	*x = CreateAppInstanceReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_instance_service_proto_msgTypes[5]
+		mi := &file_app_instance_service_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -286,7 +452,7 @@ func (x *CreateAppInstanceReply) String() string {
 func (*CreateAppInstanceReply) ProtoMessage() {}
 
 func (x *CreateAppInstanceReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_instance_service_proto_msgTypes[5]
+	mi := &file_app_instance_service_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -299,7 +465,7 @@ func (x *CreateAppInstanceReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateAppInstanceReply.ProtoReflect.Descriptor instead.
 func (*CreateAppInstanceReply) Descriptor() ([]byte, []int) {
-	return file_app_instance_service_proto_rawDescGZIP(), []int{5}
+	return file_app_instance_service_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *CreateAppInstanceReply) GetResult() *CreateAppInstanceResult {
@@ -320,7 +486,7 @@ type CreateAppInstanceResult struct {
 func (x *CreateAppInstanceResult) Reset() {
 	*x = CreateAppInstanceResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_instance_service_proto_msgTypes[6]
+		mi := &file_app_instance_service_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -333,7 +499,7 @@ func (x *CreateAppInstanceResult) String() string {
 func (*CreateAppInstanceResult) ProtoMessage() {}
 
 func (x *CreateAppInstanceResult) ProtoReflect() protoreflect.Message {
-	mi := &file_app_instance_service_proto_msgTypes[6]
+	mi := &file_app_instance_service_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -346,7 +512,7 @@ func (x *CreateAppInstanceResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateAppInstanceResult.ProtoReflect.Descriptor instead.
 func (*CreateAppInstanceResult) Descriptor() ([]byte, []int) {
-	return file_app_instance_service_proto_rawDescGZIP(), []int{6}
+	return file_app_instance_service_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *CreateAppInstanceResult) GetId() uint64 {
@@ -367,7 +533,7 @@ type UpdateAppInstanceRequest struct {
 func (x *UpdateAppInstanceRequest) Reset() {
 	*x = UpdateAppInstanceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_instance_service_proto_msgTypes[7]
+		mi := &file_app_instance_service_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -380,7 +546,7 @@ func (x *UpdateAppInstanceRequest) String() string {
 func (*UpdateAppInstanceRequest) ProtoMessage() {}
 
 func (x *UpdateAppInstanceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_instance_service_proto_msgTypes[7]
+	mi := &file_app_instance_service_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -393,7 +559,7 @@ func (x *UpdateAppInstanceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateAppInstanceRequest.ProtoReflect.Descriptor instead.
 func (*UpdateAppInstanceRequest) Descriptor() ([]byte, []int) {
-	return file_app_instance_service_proto_rawDescGZIP(), []int{7}
+	return file_app_instance_service_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *UpdateAppInstanceRequest) GetAppInstance() *AppInstance {
@@ -412,7 +578,7 @@ type UpdateAppInstanceResult struct {
 func (x *UpdateAppInstanceResult) Reset() {
 	*x = UpdateAppInstanceResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_instance_service_proto_msgTypes[8]
+		mi := &file_app_instance_service_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -425,7 +591,7 @@ func (x *UpdateAppInstanceResult) String() string {
 func (*UpdateAppInstanceResult) ProtoMessage() {}
 
 func (x *UpdateAppInstanceResult) ProtoReflect() protoreflect.Message {
-	mi := &file_app_instance_service_proto_msgTypes[8]
+	mi := &file_app_instance_service_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -438,7 +604,7 @@ func (x *UpdateAppInstanceResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateAppInstanceResult.ProtoReflect.Descriptor instead.
 func (*UpdateAppInstanceResult) Descriptor() ([]byte, []int) {
-	return file_app_instance_service_proto_rawDescGZIP(), []int{8}
+	return file_app_instance_service_proto_rawDescGZIP(), []int{10}
 }
 
 type UpdateAppInstanceReply struct {
@@ -452,7 +618,7 @@ type UpdateAppInstanceReply struct {
 func (x *UpdateAppInstanceReply) Reset() {
 	*x = UpdateAppInstanceReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_instance_service_proto_msgTypes[9]
+		mi := &file_app_instance_service_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -465,7 +631,7 @@ func (x *UpdateAppInstanceReply) String() string {
 func (*UpdateAppInstanceReply) ProtoMessage() {}
 
 func (x *UpdateAppInstanceReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_instance_service_proto_msgTypes[9]
+	mi := &file_app_instance_service_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -478,7 +644,7 @@ func (x *UpdateAppInstanceReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateAppInstanceReply.ProtoReflect.Descriptor instead.
 func (*UpdateAppInstanceReply) Descriptor() ([]byte, []int) {
-	return file_app_instance_service_proto_rawDescGZIP(), []int{9}
+	return file_app_instance_service_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *UpdateAppInstanceReply) GetResult() *UpdateAppInstanceResult {
@@ -499,7 +665,7 @@ type DeleteAppInstanceRequest struct {
 func (x *DeleteAppInstanceRequest) Reset() {
 	*x = DeleteAppInstanceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_instance_service_proto_msgTypes[10]
+		mi := &file_app_instance_service_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -512,7 +678,7 @@ func (x *DeleteAppInstanceRequest) String() string {
 func (*DeleteAppInstanceRequest) ProtoMessage() {}
 
 func (x *DeleteAppInstanceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_instance_service_proto_msgTypes[10]
+	mi := &file_app_instance_service_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -525,7 +691,7 @@ func (x *DeleteAppInstanceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteAppInstanceRequest.ProtoReflect.Descriptor instead.
 func (*DeleteAppInstanceRequest) Descriptor() ([]byte, []int) {
-	return file_app_instance_service_proto_rawDescGZIP(), []int{10}
+	return file_app_instance_service_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *DeleteAppInstanceRequest) GetId() uint64 {
@@ -544,7 +710,7 @@ type DeleteAppInstanceResult struct {
 func (x *DeleteAppInstanceResult) Reset() {
 	*x = DeleteAppInstanceResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_instance_service_proto_msgTypes[11]
+		mi := &file_app_instance_service_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -557,7 +723,7 @@ func (x *DeleteAppInstanceResult) String() string {
 func (*DeleteAppInstanceResult) ProtoMessage() {}
 
 func (x *DeleteAppInstanceResult) ProtoReflect() protoreflect.Message {
-	mi := &file_app_instance_service_proto_msgTypes[11]
+	mi := &file_app_instance_service_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -570,7 +736,7 @@ func (x *DeleteAppInstanceResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteAppInstanceResult.ProtoReflect.Descriptor instead.
 func (*DeleteAppInstanceResult) Descriptor() ([]byte, []int) {
-	return file_app_instance_service_proto_rawDescGZIP(), []int{11}
+	return file_app_instance_service_proto_rawDescGZIP(), []int{13}
 }
 
 type DeleteAppInstanceReply struct {
@@ -584,7 +750,7 @@ type DeleteAppInstanceReply struct {
 func (x *DeleteAppInstanceReply) Reset() {
 	*x = DeleteAppInstanceReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_instance_service_proto_msgTypes[12]
+		mi := &file_app_instance_service_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -597,7 +763,7 @@ func (x *DeleteAppInstanceReply) String() string {
 func (*DeleteAppInstanceReply) ProtoMessage() {}
 
 func (x *DeleteAppInstanceReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_instance_service_proto_msgTypes[12]
+	mi := &file_app_instance_service_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -610,7 +776,7 @@ func (x *DeleteAppInstanceReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteAppInstanceReply.ProtoReflect.Descriptor instead.
 func (*DeleteAppInstanceReply) Descriptor() ([]byte, []int) {
-	return file_app_instance_service_proto_rawDescGZIP(), []int{12}
+	return file_app_instance_service_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *DeleteAppInstanceReply) GetResult() *DeleteAppInstanceResult {
@@ -620,233 +786,730 @@ func (x *DeleteAppInstanceReply) GetResult() *DeleteAppInstanceResult {
 	return nil
 }
 
-var File_app_instance_service_proto protoreflect.FileDescriptor
+type RestoreAppInstanceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_app_instance_service_proto_rawDesc = []byte{
-	0x0a, 0x1a, 0x61, 0x70, 0x70, 0x2d, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x2d, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x63, 0x68,
-	0x6f, 0x72, 0x75, 0x73, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f,
-	0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
-	0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x12, 0x61,
-	0x70, 0x70, 0x2d, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x22, 0x52, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74,
-	0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x0a,
-	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x17, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x44, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x70, 0x70,
-	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2b,
-	0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13,
-	0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61,
-	0x6e, 0x63, 0x65, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x27, 0x0a, 0x15, 0x47,
-	0x65, 0x74, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x02, 0x69, 0x64, 0x22, 0x4d, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x49, 0x6e,
-	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x35, 0x0a, 0x0b,
-	0x61, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x13, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x41, 0x70, 0x70, 0x49, 0x6e,
-	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x0b, 0x61, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61,
-	0x6e, 0x63, 0x65, 0x22, 0x4b, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x73,
-	0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x34, 0x0a, 0x06, 0x72, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x68, 0x6f,
-	0x72, 0x75, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
-	0x63, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x22, 0x51, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x73,
-	0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x37, 0x0a, 0x06, 0x72, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x68, 0x6f,
-	0x72, 0x75, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x73,
-	0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x22, 0x29, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70,
-	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x51,
-	0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61,
-	0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x0b, 0x61, 0x70,
-	0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x13, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74,
-	0x61, 0x6e, 0x63, 0x65, 0x52, 0x0b, 0x61, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
-	0x65, 0x22, 0x19, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x49, 0x6e,
-	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x51, 0x0a, 0x16,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
-	0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x37, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
-	0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22,
-	0x2a, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74,
-	0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x19, 0x0a, 0x17, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
-	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x51, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x41, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x12, 0x37, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1f, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x41, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x32, 0xa6, 0x08, 0x0a, 0x12, 0x41, 0x70,
-	0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x12, 0xc8, 0x01, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61,
-	0x6e, 0x63, 0x65, 0x12, 0x1d, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x47, 0x65, 0x74,
-	0x41, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x41,
-	0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
-	0x7a, 0x92, 0x41, 0x50, 0x0a, 0x12, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
-	0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x13, 0x47, 0x65, 0x74, 0x20, 0x61, 0x6e,
-	0x20, 0x61, 0x70, 0x70, 0x20, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x1a, 0x25, 0x54,
-	0x68, 0x69, 0x73, 0x20, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x20, 0x72, 0x65, 0x74,
-	0x75, 0x72, 0x6e, 0x73, 0x20, 0x61, 0x6e, 0x20, 0x61, 0x70, 0x70, 0x20, 0x69, 0x6e, 0x73, 0x74,
-	0x61, 0x6e, 0x63, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x72, 0x65, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x70, 0x70, 0x2d, 0x69, 0x6e, 0x73,
-	0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0xd0, 0x01, 0x0a, 0x10,
-	0x4c, 0x69, 0x73, 0x74, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73,
-	0x12, 0x1f, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x70,
-	0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1d, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41,
-	0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x22, 0x7c, 0x92, 0x41, 0x57, 0x0a, 0x12, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
-	0x63, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x20,
-	0x61, 0x70, 0x70, 0x20, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x1a, 0x2d, 0x54,
-	0x68, 0x69, 0x73, 0x20, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x20, 0x72, 0x65, 0x74,
-	0x75, 0x72, 0x6e, 0x73, 0x20, 0x61, 0x20, 0x6c, 0x69, 0x73, 0x74, 0x20, 0x6f, 0x66, 0x20, 0x61,
-	0x70, 0x70, 0x20, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x2f, 0x76, 0x31,
-	0x2f, 0x61, 0x70, 0x70, 0x2d, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0xc5,
-	0x01, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74,
-	0x61, 0x6e, 0x63, 0x65, 0x12, 0x13, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x41, 0x70,
-	0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x1a, 0x1e, 0x2e, 0x63, 0x68, 0x6f, 0x72,
-	0x75, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74,
-	0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x7b, 0x92, 0x41, 0x53, 0x0a, 0x12,
-	0x41, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x12, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x20, 0x61, 0x6e, 0x20, 0x61, 0x70,
-	0x70, 0x20, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x1a, 0x25, 0x54, 0x68, 0x69, 0x73,
-	0x20, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x20, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x73, 0x20, 0x61, 0x6e, 0x20, 0x61, 0x70, 0x70, 0x20, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
-	0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x3a, 0x01, 0x2a, 0x22, 0x1a, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x72, 0x65, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x70, 0x70, 0x2d, 0x69, 0x6e, 0x73,
-	0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0xd2, 0x01, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x20, 0x2e, 0x63,
-	0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x49,
-	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e,
-	0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x70,
-	0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x7b,
-	0x92, 0x41, 0x53, 0x0a, 0x12, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x20,
-	0x61, 0x6e, 0x20, 0x61, 0x70, 0x70, 0x20, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x1a,
-	0x25, 0x54, 0x68, 0x69, 0x73, 0x20, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x20, 0x75,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x20, 0x61, 0x6e, 0x20, 0x61, 0x70, 0x70, 0x20, 0x69, 0x6e,
-	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x3a, 0x01, 0x2a, 0x1a,
-	0x1a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x70,
-	0x70, 0x2d, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0xd4, 0x01, 0x0a, 0x11,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
-	0x65, 0x12, 0x20, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x22, 0x7d, 0x92, 0x41, 0x53, 0x0a, 0x12, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x73,
-	0x74, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x16, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x20, 0x61, 0x6e, 0x20, 0x61, 0x70, 0x70, 0x20, 0x69, 0x6e, 0x73, 0x74,
-	0x61, 0x6e, 0x63, 0x65, 0x1a, 0x25, 0x54, 0x68, 0x69, 0x73, 0x20, 0x65, 0x6e, 0x64, 0x70, 0x6f,
-	0x69, 0x6e, 0x74, 0x20, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x73, 0x20, 0x61, 0x6e, 0x20, 0x61,
-	0x70, 0x70, 0x20, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x21, 0x2a, 0x1f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f,
-	0x61, 0x70, 0x70, 0x2d, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x69,
-	0x64, 0x7d, 0x42, 0xba, 0x01, 0x92, 0x41, 0xac, 0x01, 0x12, 0x82, 0x01, 0x0a, 0x1b, 0x63, 0x68,
-	0x6f, 0x72, 0x75, 0x73, 0x20, 0x61, 0x70, 0x70, 0x20, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
-	0x65, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x22, 0x5e, 0x0a, 0x1b, 0x63, 0x68, 0x6f,
-	0x72, 0x75, 0x73, 0x20, 0x61, 0x70, 0x70, 0x20, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
-	0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x2c, 0x68, 0x74, 0x74, 0x70, 0x73, 0x3a,
-	0x2f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x43, 0x48, 0x4f,
-	0x52, 0x55, 0x53, 0x2d, 0x54, 0x52, 0x45, 0x2f, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2d, 0x62,
-	0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x1a, 0x11, 0x64, 0x65, 0x76, 0x40, 0x63, 0x68, 0x6f, 0x72,
-	0x75, 0x73, 0x2d, 0x74, 0x72, 0x65, 0x2e, 0x63, 0x68, 0x32, 0x03, 0x31, 0x2e, 0x30, 0x2a, 0x01,
-	0x01, 0x32, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a,
-	0x73, 0x6f, 0x6e, 0x3a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x5a, 0x08, 0x2e, 0x3b, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-var (
-	file_app_instance_service_proto_rawDescOnce sync.Once
-	file_app_instance_service_proto_rawDescData = file_app_instance_service_proto_rawDesc
-)
+func (x *RestoreAppInstanceRequest) Reset() {
+	*x = RestoreAppInstanceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_instance_service_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_app_instance_service_proto_rawDescGZIP() []byte {
-	file_app_instance_service_proto_rawDescOnce.Do(func() {
-		file_app_instance_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_app_instance_service_proto_rawDescData)
-	})
-	return file_app_instance_service_proto_rawDescData
+func (x *RestoreAppInstanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_app_instance_service_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
-var file_app_instance_service_proto_goTypes = []interface{}{
-	(*ListAppInstancesRequest)(nil),  // 0: chorus.ListAppInstancesRequest
-	(*ListAppInstancesReply)(nil),    // 1: chorus.ListAppInstancesReply
-	(*GetAppInstanceRequest)(nil),    // 2: chorus.GetAppInstanceRequest
-	(*GetAppInstanceResult)(nil),     // 3: chorus.GetAppInstanceResult
-	(*GetAppInstanceReply)(nil),      // 4: chorus.GetAppInstanceReply
-	(*CreateAppInstanceReply)(nil),   // 5: chorus.CreateAppInstanceReply
-	(*CreateAppInstanceResult)(nil),  // 6: chorus.CreateAppInstanceResult
-	(*UpdateAppInstanceRequest)(nil), // 7: chorus.UpdateAppInstanceRequest
-	(*UpdateAppInstanceResult)(nil),  // 8: chorus.UpdateAppInstanceResult
-	(*UpdateAppInstanceReply)(nil),   // 9: chorus.UpdateAppInstanceReply
-	(*DeleteAppInstanceRequest)(nil), // 10: chorus.DeleteAppInstanceRequest
-	(*DeleteAppInstanceResult)(nil),  // 11: chorus.DeleteAppInstanceResult
-	(*DeleteAppInstanceReply)(nil),   // 12: chorus.DeleteAppInstanceReply
-	(*PaginationQuery)(nil),          // 13: chorus.PaginationQuery
-	(*AppInstance)(nil),              // 14: chorus.AppInstance
+func (*RestoreAppInstanceRequest) ProtoMessage() {}
+
+func (x *RestoreAppInstanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_instance_service_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_app_instance_service_proto_depIdxs = []int32{
-	13, // 0: chorus.ListAppInstancesRequest.pagination:type_name -> chorus.PaginationQuery
-	14, // 1: chorus.ListAppInstancesReply.result:type_name -> chorus.AppInstance
-	14, // 2: chorus.GetAppInstanceResult.appInstance:type_name -> chorus.AppInstance
-	3,  // 3: chorus.GetAppInstanceReply.result:type_name -> chorus.GetAppInstanceResult
-	6,  // 4: chorus.CreateAppInstanceReply.result:type_name -> chorus.CreateAppInstanceResult
-	14, // 5: chorus.UpdateAppInstanceRequest.appInstance:type_name -> chorus.AppInstance
-	8,  // 6: chorus.UpdateAppInstanceReply.result:type_name -> chorus.UpdateAppInstanceResult
-	11, // 7: chorus.DeleteAppInstanceReply.result:type_name -> chorus.DeleteAppInstanceResult
-	2,  // 8: chorus.AppInstanceService.GetAppInstance:input_type -> chorus.GetAppInstanceRequest
-	0,  // 9: chorus.AppInstanceService.ListAppInstances:input_type -> chorus.ListAppInstancesRequest
-	14, // 10: chorus.AppInstanceService.CreateAppInstance:input_type -> chorus.AppInstance
-	7,  // 11: chorus.AppInstanceService.UpdateAppInstance:input_type -> chorus.UpdateAppInstanceRequest
-	10, // 12: chorus.AppInstanceService.DeleteAppInstance:input_type -> chorus.DeleteAppInstanceRequest
-	4,  // 13: chorus.AppInstanceService.GetAppInstance:output_type -> chorus.GetAppInstanceReply
-	1,  // 14: chorus.AppInstanceService.ListAppInstances:output_type -> chorus.ListAppInstancesReply
-	5,  // 15: chorus.AppInstanceService.CreateAppInstance:output_type -> chorus.CreateAppInstanceReply
-	9,  // 16: chorus.AppInstanceService.UpdateAppInstance:output_type -> chorus.UpdateAppInstanceReply
-	12, // 17: chorus.AppInstanceService.DeleteAppInstance:output_type -> chorus.DeleteAppInstanceReply
-	13, // [13:18] is the sub-list for method output_type
-	8,  // [8:13] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+
+// Deprecated: Use RestoreAppInstanceRequest.ProtoReflect.Descriptor instead.
+func (*RestoreAppInstanceRequest) Descriptor() ([]byte, []int) {
+	return file_app_instance_service_proto_rawDescGZIP(), []int{15}
 }
 
-func init() { file_app_instance_service_proto_init() }
-func file_app_instance_service_proto_init() {
-	if File_app_instance_service_proto != nil {
-		return
+func (x *RestoreAppInstanceRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
 	}
-	file_common_proto_init()
-	file_app_instance_proto_init()
-	if !protoimpl.UnsafeEnabled {
-		file_app_instance_service_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListAppInstancesRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_app_instance_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListAppInstancesReply); i {
-			case 0:
-				return &v.state
+	return 0
+}
+
+type RestoreAppInstanceResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RestoreAppInstanceResult) Reset() {
+	*x = RestoreAppInstanceResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_instance_service_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreAppInstanceResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreAppInstanceResult) ProtoMessage() {}
+
+func (x *RestoreAppInstanceResult) ProtoReflect() protoreflect.Message {
+	mi := &file_app_instance_service_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreAppInstanceResult.ProtoReflect.Descriptor instead.
+func (*RestoreAppInstanceResult) Descriptor() ([]byte, []int) {
+	return file_app_instance_service_proto_rawDescGZIP(), []int{16}
+}
+
+type RestoreAppInstanceReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result *RestoreAppInstanceResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *RestoreAppInstanceReply) Reset() {
+	*x = RestoreAppInstanceReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_instance_service_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreAppInstanceReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreAppInstanceReply) ProtoMessage() {}
+
+func (x *RestoreAppInstanceReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_instance_service_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreAppInstanceReply.ProtoReflect.Descriptor instead.
+func (*RestoreAppInstanceReply) Descriptor() ([]byte, []int) {
+	return file_app_instance_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RestoreAppInstanceReply) GetResult() *RestoreAppInstanceResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type UpgradeAppInstanceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// appVersionId is the version to upgrade to, the default version of the
+	// app when empty.
+	AppVersionId uint64 `protobuf:"varint,2,opt,name=appVersionId,proto3" json:"appVersionId,omitempty"`
+}
+
+func (x *UpgradeAppInstanceRequest) Reset() {
+	*x = UpgradeAppInstanceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_instance_service_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpgradeAppInstanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpgradeAppInstanceRequest) ProtoMessage() {}
+
+func (x *UpgradeAppInstanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_instance_service_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpgradeAppInstanceRequest.ProtoReflect.Descriptor instead.
+func (*UpgradeAppInstanceRequest) Descriptor() ([]byte, []int) {
+	return file_app_instance_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *UpgradeAppInstanceRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpgradeAppInstanceRequest) GetAppVersionId() uint64 {
+	if x != nil {
+		return x.AppVersionId
+	}
+	return 0
+}
+
+type UpgradeAppInstanceResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UpgradeAppInstanceResult) Reset() {
+	*x = UpgradeAppInstanceResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_instance_service_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpgradeAppInstanceResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpgradeAppInstanceResult) ProtoMessage() {}
+
+func (x *UpgradeAppInstanceResult) ProtoReflect() protoreflect.Message {
+	mi := &file_app_instance_service_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpgradeAppInstanceResult.ProtoReflect.Descriptor instead.
+func (*UpgradeAppInstanceResult) Descriptor() ([]byte, []int) {
+	return file_app_instance_service_proto_rawDescGZIP(), []int{19}
+}
+
+type UpgradeAppInstanceReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result *UpgradeAppInstanceResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *UpgradeAppInstanceReply) Reset() {
+	*x = UpgradeAppInstanceReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_instance_service_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpgradeAppInstanceReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpgradeAppInstanceReply) ProtoMessage() {}
+
+func (x *UpgradeAppInstanceReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_instance_service_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpgradeAppInstanceReply.ProtoReflect.Descriptor instead.
+func (*UpgradeAppInstanceReply) Descriptor() ([]byte, []int) {
+	return file_app_instance_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *UpgradeAppInstanceReply) GetResult() *UpgradeAppInstanceResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type StreamAppInstanceLogsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Keep streaming the logs as they are written
+	Follow bool `protobuf:"varint,2,opt,name=follow,proto3" json:"follow,omitempty"`
+	// Only return this number of lines from the end of the logs (at most 10000)
+	TailLines int64 `protobuf:"varint,3,opt,name=tailLines,proto3" json:"tailLines,omitempty"`
+	// Only return the logs written after this time
+	SinceTime *timestamp.Timestamp `protobuf:"bytes,4,opt,name=sinceTime,proto3" json:"sinceTime,omitempty"`
+}
+
+func (x *StreamAppInstanceLogsRequest) Reset() {
+	*x = StreamAppInstanceLogsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_instance_service_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamAppInstanceLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamAppInstanceLogsRequest) ProtoMessage() {}
+
+func (x *StreamAppInstanceLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_instance_service_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamAppInstanceLogsRequest.ProtoReflect.Descriptor instead.
+func (*StreamAppInstanceLogsRequest) Descriptor() ([]byte, []int) {
+	return file_app_instance_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *StreamAppInstanceLogsRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *StreamAppInstanceLogsRequest) GetFollow() bool {
+	if x != nil {
+		return x.Follow
+	}
+	return false
+}
+
+func (x *StreamAppInstanceLogsRequest) GetTailLines() int64 {
+	if x != nil {
+		return x.TailLines
+	}
+	return 0
+}
+
+func (x *StreamAppInstanceLogsRequest) GetSinceTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.SinceTime
+	}
+	return nil
+}
+
+var File_app_instance_service_proto protoreflect.FileDescriptor
+
+var file_app_instance_service_proto_rawDesc = []byte{
+	0x0a, 0x1a, 0x61, 0x70, 0x70, 0x2d, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x2d, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x63, 0x68,
+	0x6f, 0x72, 0x75, 0x73, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f,
+	0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0c,
+	0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x12, 0x61, 0x70,
+	0x70, 0x2d, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x0d, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0xba, 0x01, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x06, 0x63,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x68,
+	0x6f, 0x72, 0x75, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x43, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x31, 0x0a, 0x06, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x68, 0x6f,
+	0x72, 0x75, 0x73, 0x2e, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x2b, 0x0a,
+	0x04, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x68,
+	0x6f, 0x72, 0x75, 0x73, 0x2e, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x53, 0x6f, 0x72, 0x74, 0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02,
+	0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x45, 0x0a, 0x11,
+	0x41, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x77, 0x6e,
+	0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x49, 0x64, 0x22, 0x3b, 0x0a, 0x0f, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x53, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x22, 0x94, 0x01, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2b, 0x0a, 0x06, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x63, 0x68, 0x6f,
+	0x72, 0x75, 0x73, 0x2e, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52,
+	0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2e, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73,
+	0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x52,
+	0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x49, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x4f, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x41, 0x70,
+	0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x26, 0x0a, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x52, 0x75, 0x6e, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x7f, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x41,
+	0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x12, 0x35, 0x0a, 0x0b, 0x61, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x41,
+	0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x0b, 0x61, 0x70, 0x70, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x30, 0x0a, 0x07, 0x72, 0x75, 0x6e, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75,
+	0x73, 0x2e, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
+	0x52, 0x07, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x4b, 0x0a, 0x13, 0x47, 0x65, 0x74,
+	0x41, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x34, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x51, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x41, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x37, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1f, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x41, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x29, 0x0a, 0x17, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x02, 0x69, 0x64, 0x22, 0x51, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x70,
+	0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x35, 0x0a, 0x0b, 0x61, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x41,
+	0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x0b, 0x61, 0x70, 0x70, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x19, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x22, 0x51, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x37, 0x0a, 0x06,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63,
+	0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x2a, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41,
+	0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0x19, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x70, 0x70, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x51, 0x0a, 0x16,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x37, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22,
+	0x2b, 0x0a, 0x19, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x1a, 0x0a, 0x18,
+	0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x53, 0x0a, 0x17, 0x52, 0x65, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x12, 0x38, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x52, 0x65, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x4f, 0x0a,
+	0x19, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x70,
+	0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0c, 0x61, 0x70, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x1a,
+	0x0a, 0x18, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x53, 0x0a, 0x17, 0x55, 0x70,
+	0x67, 0x72, 0x61, 0x64, 0x65, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x38, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x55,
+	0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22,
+	0x9e, 0x01, 0x0a, 0x1c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x61, 0x69, 0x6c,
+	0x4c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x61, 0x69,
+	0x6c, 0x4c, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x09, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x54,
+	0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x54, 0x69, 0x6d, 0x65,
+	0x32, 0xda, 0x0f, 0x0a, 0x12, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0xc8, 0x01, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x41,
+	0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1d, 0x2e, 0x63, 0x68, 0x6f,
+	0x72, 0x75, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x63, 0x68, 0x6f, 0x72,
+	0x75, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x7a, 0x92, 0x41, 0x50, 0x0a, 0x12, 0x41, 0x70, 0x70,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
+	0x13, 0x47, 0x65, 0x74, 0x20, 0x61, 0x6e, 0x20, 0x61, 0x70, 0x70, 0x20, 0x69, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x1a, 0x25, 0x54, 0x68, 0x69, 0x73, 0x20, 0x65, 0x6e, 0x64, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x61, 0x6e, 0x20, 0x61,
+	0x70, 0x70, 0x20, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x21, 0x12, 0x1f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f,
+	0x61, 0x70, 0x70, 0x2d, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x69,
+	0x64, 0x7d, 0x12, 0xd0, 0x01, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x70, 0x70, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x1f, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75,
+	0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x7c, 0x92, 0x41, 0x57, 0x0a, 0x12, 0x41, 0x70,
+	0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x20, 0x61, 0x70, 0x70, 0x20, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x73, 0x1a, 0x2d, 0x54, 0x68, 0x69, 0x73, 0x20, 0x65, 0x6e, 0x64, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x61, 0x20, 0x6c, 0x69,
+	0x73, 0x74, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x70, 0x70, 0x20, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x72, 0x65, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x70, 0x70, 0x2d, 0x69, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0xc5, 0x01, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x41, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x13, 0x2e, 0x63, 0x68,
+	0x6f, 0x72, 0x75, 0x73, 0x2e, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x1a, 0x1e, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x41, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x7b, 0x92, 0x41, 0x53, 0x0a, 0x12, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x20, 0x61, 0x6e, 0x20, 0x61, 0x70, 0x70, 0x20, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x1a, 0x25, 0x54, 0x68, 0x69, 0x73, 0x20, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x20, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x73, 0x20, 0x61, 0x6e, 0x20, 0x61, 0x70, 0x70, 0x20,
+	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x3a, 0x01,
+	0x2a, 0x22, 0x1a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f,
+	0x61, 0x70, 0x70, 0x2d, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0xd2, 0x01,
+	0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x12, 0x20, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x7b, 0x92, 0x41, 0x53, 0x0a, 0x12, 0x41, 0x70, 0x70, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x16,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x20, 0x61, 0x6e, 0x20, 0x61, 0x70, 0x70, 0x20, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x1a, 0x25, 0x54, 0x68, 0x69, 0x73, 0x20, 0x65, 0x6e, 0x64,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x20, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x20, 0x61, 0x6e,
+	0x20, 0x61, 0x70, 0x70, 0x20, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x1f, 0x3a, 0x01, 0x2a, 0x1a, 0x1a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73,
+	0x74, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x70, 0x70, 0x2d, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x73, 0x12, 0xd4, 0x01, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x70, 0x70,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x20, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75,
+	0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x63, 0x68, 0x6f,
+	0x72, 0x75, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x7d, 0x92, 0x41, 0x53, 0x0a,
+	0x12, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x20, 0x61, 0x6e, 0x20, 0x61,
+	0x70, 0x70, 0x20, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x1a, 0x25, 0x54, 0x68, 0x69,
+	0x73, 0x20, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x20, 0x64, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x73, 0x20, 0x61, 0x6e, 0x20, 0x61, 0x70, 0x70, 0x20, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x2a, 0x1f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72,
+	0x65, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x70, 0x70, 0x2d, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x9d, 0x02, 0x0a, 0x12, 0x52, 0x65,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x12, 0x21, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x52, 0x65, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0xc2, 0x01, 0x92, 0x41, 0x8c, 0x01, 0x0a, 0x12, 0x41, 0x70, 0x70,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
+	0x17, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x20, 0x61, 0x6e, 0x20, 0x61, 0x70, 0x70, 0x20,
+	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x1a, 0x5d, 0x54, 0x68, 0x69, 0x73, 0x20, 0x65,
+	0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x20, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73,
+	0x20, 0x61, 0x20, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x20, 0x61, 0x70, 0x70, 0x20, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x2c, 0x20, 0x64, 0x75, 0x72, 0x69, 0x6e, 0x67, 0x20,
+	0x74, 0x68, 0x65, 0x20, 0x67, 0x72, 0x61, 0x63, 0x65, 0x20, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x20, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x20, 0x69, 0x74, 0x73, 0x20, 0x64,
+	0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2c, 0x3a, 0x01, 0x2a,
+	0x22, 0x27, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x61,
+	0x70, 0x70, 0x2d, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64,
+	0x7d, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x12, 0xa5, 0x02, 0x0a, 0x12, 0x55, 0x70,
+	0x67, 0x72, 0x61, 0x64, 0x65, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x12, 0x21, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64,
+	0x65, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x55, 0x70, 0x67,
+	0x72, 0x61, 0x64, 0x65, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0xca, 0x01, 0x92, 0x41, 0x94, 0x01, 0x0a, 0x12, 0x41, 0x70, 0x70,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
+	0x17, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x20, 0x61, 0x6e, 0x20, 0x61, 0x70, 0x70, 0x20,
+	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x1a, 0x65, 0x54, 0x68, 0x69, 0x73, 0x20, 0x65,
+	0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x20, 0x6d, 0x6f, 0x76, 0x65, 0x73, 0x20, 0x61, 0x6e,
+	0x20, 0x61, 0x70, 0x70, 0x20, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x20, 0x74, 0x6f,
+	0x20, 0x61, 0x20, 0x6e, 0x65, 0x77, 0x65, 0x72, 0x20, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x20, 0x6f, 0x66, 0x20, 0x69, 0x74, 0x73, 0x20, 0x61, 0x70, 0x70, 0x2c, 0x20, 0x61, 0x6e, 0x64,
+	0x20, 0x72, 0x65, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x73, 0x20, 0x69, 0x74, 0x20, 0x77, 0x68,
+	0x65, 0x6e, 0x20, 0x69, 0x74, 0x20, 0x69, 0x73, 0x20, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x2c, 0x3a, 0x01, 0x2a, 0x22, 0x27, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72,
+	0x65, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x70, 0x70, 0x2d, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64,
+	0x65, 0x12, 0xe9, 0x02, 0x0a, 0x15, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x41, 0x70, 0x70, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x24, 0x2e, 0x63, 0x68,
+	0x6f, 0x72, 0x75, 0x73, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x41, 0x70, 0x70, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x0f, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x4c, 0x6f, 0x67, 0x4c, 0x69,
+	0x6e, 0x65, 0x22, 0x96, 0x02, 0x92, 0x41, 0xe6, 0x01, 0x0a, 0x12, 0x41, 0x70, 0x70, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x22, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6c, 0x6f, 0x67, 0x73, 0x20, 0x6f,
+	0x66, 0x20, 0x61, 0x6e, 0x20, 0x61, 0x70, 0x70, 0x20, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x1a, 0xab, 0x01, 0x54, 0x68, 0x69, 0x73, 0x20, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x20, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6c, 0x6f,
+	0x67, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69,
+	0x6e, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x6e, 0x20, 0x61, 0x70, 0x70, 0x20, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x2c, 0x20, 0x74, 0x6f, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x69, 0x74, 0x73, 0x20, 0x77, 0x6f,
+	0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x20, 0x53, 0x65, 0x6e, 0x64, 0x20, 0x27, 0x41,
+	0x63, 0x63, 0x65, 0x70, 0x74, 0x3a, 0x20, 0x74, 0x65, 0x78, 0x74, 0x2f, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x2d, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x27, 0x20, 0x74, 0x6f, 0x20, 0x72, 0x65, 0x63,
+	0x65, 0x69, 0x76, 0x65, 0x20, 0x74, 0x68, 0x65, 0x6d, 0x20, 0x61, 0x73, 0x20, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2d, 0x73, 0x65, 0x6e, 0x74, 0x20, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x26, 0x12, 0x24, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x74,
+	0x2f, 0x76, 0x31, 0x2f, 0x61, 0x70, 0x70, 0x2d, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x6c, 0x6f, 0x67, 0x73, 0x30, 0x01, 0x42, 0xba, 0x01,
+	0x92, 0x41, 0xac, 0x01, 0x12, 0x82, 0x01, 0x0a, 0x1b, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x20,
+	0x61, 0x70, 0x70, 0x20, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x20, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x22, 0x5e, 0x0a, 0x1b, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x20, 0x61,
+	0x70, 0x70, 0x20, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x20, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x2c, 0x68, 0x74, 0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x43, 0x48, 0x4f, 0x52, 0x55, 0x53, 0x2d, 0x54,
+	0x52, 0x45, 0x2f, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2d, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e,
+	0x64, 0x1a, 0x11, 0x64, 0x65, 0x76, 0x40, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2d, 0x74, 0x72,
+	0x65, 0x2e, 0x63, 0x68, 0x32, 0x03, 0x31, 0x2e, 0x30, 0x2a, 0x01, 0x01, 0x32, 0x10, 0x61, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x10,
+	0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e,
+	0x5a, 0x08, 0x2e, 0x3b, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_app_instance_service_proto_rawDescOnce sync.Once
+	file_app_instance_service_proto_rawDescData = file_app_instance_service_proto_rawDesc
+)
+
+func file_app_instance_service_proto_rawDescGZIP() []byte {
+	file_app_instance_service_proto_rawDescOnce.Do(func() {
+		file_app_instance_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_app_instance_service_proto_rawDescData)
+	})
+	return file_app_instance_service_proto_rawDescData
+}
+
+var file_app_instance_service_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_app_instance_service_proto_goTypes = []interface{}{
+	(*ListAppInstancesRequest)(nil),      // 0: chorus.ListAppInstancesRequest
+	(*AppInstanceFilter)(nil),            // 1: chorus.AppInstanceFilter
+	(*AppInstanceSort)(nil),              // 2: chorus.AppInstanceSort
+	(*ListAppInstancesReply)(nil),        // 3: chorus.ListAppInstancesReply
+	(*GetAppInstanceRequest)(nil),        // 4: chorus.GetAppInstanceRequest
+	(*GetAppInstanceResult)(nil),         // 5: chorus.GetAppInstanceResult
+	(*GetAppInstanceReply)(nil),          // 6: chorus.GetAppInstanceReply
+	(*CreateAppInstanceReply)(nil),       // 7: chorus.CreateAppInstanceReply
+	(*CreateAppInstanceResult)(nil),      // 8: chorus.CreateAppInstanceResult
+	(*UpdateAppInstanceRequest)(nil),     // 9: chorus.UpdateAppInstanceRequest
+	(*UpdateAppInstanceResult)(nil),      // 10: chorus.UpdateAppInstanceResult
+	(*UpdateAppInstanceReply)(nil),       // 11: chorus.UpdateAppInstanceReply
+	(*DeleteAppInstanceRequest)(nil),     // 12: chorus.DeleteAppInstanceRequest
+	(*DeleteAppInstanceResult)(nil),      // 13: chorus.DeleteAppInstanceResult
+	(*DeleteAppInstanceReply)(nil),       // 14: chorus.DeleteAppInstanceReply
+	(*RestoreAppInstanceRequest)(nil),    // 15: chorus.RestoreAppInstanceRequest
+	(*RestoreAppInstanceResult)(nil),     // 16: chorus.RestoreAppInstanceResult
+	(*RestoreAppInstanceReply)(nil),      // 17: chorus.RestoreAppInstanceReply
+	(*UpgradeAppInstanceRequest)(nil),    // 18: chorus.UpgradeAppInstanceRequest
+	(*UpgradeAppInstanceResult)(nil),     // 19: chorus.UpgradeAppInstanceResult
+	(*UpgradeAppInstanceReply)(nil),      // 20: chorus.UpgradeAppInstanceReply
+	(*StreamAppInstanceLogsRequest)(nil), // 21: chorus.StreamAppInstanceLogsRequest
+	(*RequestCursor)(nil),                // 22: chorus.RequestCursor
+	(*AppInstance)(nil),                  // 23: chorus.AppInstance
+	(*ResponseCursor)(nil),               // 24: chorus.ResponseCursor
+	(*RuntimeDetails)(nil),               // 25: chorus.RuntimeDetails
+	(*timestamp.Timestamp)(nil),          // 26: google.protobuf.Timestamp
+	(*LogLine)(nil),                      // 27: chorus.LogLine
+}
+var file_app_instance_service_proto_depIdxs = []int32{
+	22, // 0: chorus.ListAppInstancesRequest.cursor:type_name -> chorus.RequestCursor
+	1,  // 1: chorus.ListAppInstancesRequest.filter:type_name -> chorus.AppInstanceFilter
+	2,  // 2: chorus.ListAppInstancesRequest.sort:type_name -> chorus.AppInstanceSort
+	23, // 3: chorus.ListAppInstancesReply.result:type_name -> chorus.AppInstance
+	24, // 4: chorus.ListAppInstancesReply.cursor:type_name -> chorus.ResponseCursor
+	23, // 5: chorus.GetAppInstanceResult.appInstance:type_name -> chorus.AppInstance
+	25, // 6: chorus.GetAppInstanceResult.runtime:type_name -> chorus.RuntimeDetails
+	5,  // 7: chorus.GetAppInstanceReply.result:type_name -> chorus.GetAppInstanceResult
+	8,  // 8: chorus.CreateAppInstanceReply.result:type_name -> chorus.CreateAppInstanceResult
+	23, // 9: chorus.UpdateAppInstanceRequest.appInstance:type_name -> chorus.AppInstance
+	10, // 10: chorus.UpdateAppInstanceReply.result:type_name -> chorus.UpdateAppInstanceResult
+	13, // 11: chorus.DeleteAppInstanceReply.result:type_name -> chorus.DeleteAppInstanceResult
+	16, // 12: chorus.RestoreAppInstanceReply.result:type_name -> chorus.RestoreAppInstanceResult
+	19, // 13: chorus.UpgradeAppInstanceReply.result:type_name -> chorus.UpgradeAppInstanceResult
+	26, // 14: chorus.StreamAppInstanceLogsRequest.sinceTime:type_name -> google.protobuf.Timestamp
+	4,  // 15: chorus.AppInstanceService.GetAppInstance:input_type -> chorus.GetAppInstanceRequest
+	0,  // 16: chorus.AppInstanceService.ListAppInstances:input_type -> chorus.ListAppInstancesRequest
+	23, // 17: chorus.AppInstanceService.CreateAppInstance:input_type -> chorus.AppInstance
+	9,  // 18: chorus.AppInstanceService.UpdateAppInstance:input_type -> chorus.UpdateAppInstanceRequest
+	12, // 19: chorus.AppInstanceService.DeleteAppInstance:input_type -> chorus.DeleteAppInstanceRequest
+	15, // 20: chorus.AppInstanceService.RestoreAppInstance:input_type -> chorus.RestoreAppInstanceRequest
+	18, // 21: chorus.AppInstanceService.UpgradeAppInstance:input_type -> chorus.UpgradeAppInstanceRequest
+	21, // 22: chorus.AppInstanceService.StreamAppInstanceLogs:input_type -> chorus.StreamAppInstanceLogsRequest
+	6,  // 23: chorus.AppInstanceService.GetAppInstance:output_type -> chorus.GetAppInstanceReply
+	3,  // 24: chorus.AppInstanceService.ListAppInstances:output_type -> chorus.ListAppInstancesReply
+	7,  // 25: chorus.AppInstanceService.CreateAppInstance:output_type -> chorus.CreateAppInstanceReply
+	11, // 26: chorus.AppInstanceService.UpdateAppInstance:output_type -> chorus.UpdateAppInstanceReply
+	14, // 27: chorus.AppInstanceService.DeleteAppInstance:output_type -> chorus.DeleteAppInstanceReply
+	17, // 28: chorus.AppInstanceService.RestoreAppInstance:output_type -> chorus.RestoreAppInstanceReply
+	20, // 29: chorus.AppInstanceService.UpgradeAppInstance:output_type -> chorus.UpgradeAppInstanceReply
+	27, // 30: chorus.AppInstanceService.StreamAppInstanceLogs:output_type -> chorus.LogLine
+	23, // [23:31] is the sub-list for method output_type
+	15, // [15:23] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
+}
+
+func init() { file_app_instance_service_proto_init() }
+func file_app_instance_service_proto_init() {
+	if File_app_instance_service_proto != nil {
+		return
+	}
+	file_common_proto_init()
+	file_cursor_proto_init()
+	file_app_instance_proto_init()
+	file_runtime_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_app_instance_service_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAppInstancesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_instance_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppInstanceFilter); i {
+			case 0:
+				return &v.state
 			case 1:
 				return &v.sizeCache
 			case 2:
@@ -856,7 +1519,7 @@ func file_app_instance_service_proto_init() {
 			}
 		}
 		file_app_instance_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAppInstanceRequest); i {
+			switch v := v.(*AppInstanceSort); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -868,7 +1531,7 @@ func file_app_instance_service_proto_init() {
 			}
 		}
 		file_app_instance_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAppInstanceResult); i {
+			switch v := v.(*ListAppInstancesReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -880,7 +1543,7 @@ func file_app_instance_service_proto_init() {
 			}
 		}
 		file_app_instance_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAppInstanceReply); i {
+			switch v := v.(*GetAppInstanceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -892,7 +1555,7 @@ func file_app_instance_service_proto_init() {
 			}
 		}
 		file_app_instance_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateAppInstanceReply); i {
+			switch v := v.(*GetAppInstanceResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -904,7 +1567,7 @@ func file_app_instance_service_proto_init() {
 			}
 		}
 		file_app_instance_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateAppInstanceResult); i {
+			switch v := v.(*GetAppInstanceReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -916,7 +1579,7 @@ func file_app_instance_service_proto_init() {
 			}
 		}
 		file_app_instance_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateAppInstanceRequest); i {
+			switch v := v.(*CreateAppInstanceReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -928,7 +1591,7 @@ func file_app_instance_service_proto_init() {
 			}
 		}
 		file_app_instance_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateAppInstanceResult); i {
+			switch v := v.(*CreateAppInstanceResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -940,7 +1603,7 @@ func file_app_instance_service_proto_init() {
 			}
 		}
 		file_app_instance_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateAppInstanceReply); i {
+			switch v := v.(*UpdateAppInstanceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -952,7 +1615,7 @@ func file_app_instance_service_proto_init() {
 			}
 		}
 		file_app_instance_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteAppInstanceRequest); i {
+			switch v := v.(*UpdateAppInstanceResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -964,7 +1627,7 @@ func file_app_instance_service_proto_init() {
 			}
 		}
 		file_app_instance_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteAppInstanceResult); i {
+			switch v := v.(*UpdateAppInstanceReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -976,6 +1639,30 @@ func file_app_instance_service_proto_init() {
 			}
 		}
 		file_app_instance_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteAppInstanceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_instance_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteAppInstanceResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_instance_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeleteAppInstanceReply); i {
 			case 0:
 				return &v.state
@@ -987,6 +1674,90 @@ func file_app_instance_service_proto_init() {
 				return nil
 			}
 		}
+		file_app_instance_service_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreAppInstanceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_instance_service_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreAppInstanceResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_instance_service_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreAppInstanceReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_instance_service_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpgradeAppInstanceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_instance_service_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpgradeAppInstanceResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_instance_service_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpgradeAppInstanceReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_instance_service_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamAppInstanceLogsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -994,7 +1765,7 @@ func file_app_instance_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_app_instance_service_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   13,
+			NumMessages:   22,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -1025,6 +1796,9 @@ type AppInstanceServiceClient interface {
 	CreateAppInstance(ctx context.Context, in *AppInstance, opts ...grpc.CallOption) (*CreateAppInstanceReply, error)
 	UpdateAppInstance(ctx context.Context, in *UpdateAppInstanceRequest, opts ...grpc.CallOption) (*UpdateAppInstanceReply, error)
 	DeleteAppInstance(ctx context.Context, in *DeleteAppInstanceRequest, opts ...grpc.CallOption) (*DeleteAppInstanceReply, error)
+	RestoreAppInstance(ctx context.Context, in *RestoreAppInstanceRequest, opts ...grpc.CallOption) (*RestoreAppInstanceReply, error)
+	UpgradeAppInstance(ctx context.Context, in *UpgradeAppInstanceRequest, opts ...grpc.CallOption) (*UpgradeAppInstanceReply, error)
+	StreamAppInstanceLogs(ctx context.Context, in *StreamAppInstanceLogsRequest, opts ...grpc.CallOption) (AppInstanceService_StreamAppInstanceLogsClient, error)
 }
 
 type appInstanceServiceClient struct {
@@ -1080,6 +1854,56 @@ func (c *appInstanceServiceClient) DeleteAppInstance(ctx context.Context, in *De
 	return out, nil
 }
 
+func (c *appInstanceServiceClient) RestoreAppInstance(ctx context.Context, in *RestoreAppInstanceRequest, opts ...grpc.CallOption) (*RestoreAppInstanceReply, error) {
+	out := new(RestoreAppInstanceReply)
+	err := c.cc.Invoke(ctx, "/chorus.AppInstanceService/RestoreAppInstance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appInstanceServiceClient) UpgradeAppInstance(ctx context.Context, in *UpgradeAppInstanceRequest, opts ...grpc.CallOption) (*UpgradeAppInstanceReply, error) {
+	out := new(UpgradeAppInstanceReply)
+	err := c.cc.Invoke(ctx, "/chorus.AppInstanceService/UpgradeAppInstance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appInstanceServiceClient) StreamAppInstanceLogs(ctx context.Context, in *StreamAppInstanceLogsRequest, opts ...grpc.CallOption) (AppInstanceService_StreamAppInstanceLogsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_AppInstanceService_serviceDesc.Streams[0], "/chorus.AppInstanceService/StreamAppInstanceLogs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &appInstanceServiceStreamAppInstanceLogsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type AppInstanceService_StreamAppInstanceLogsClient interface {
+	Recv() (*LogLine, error)
+	grpc.ClientStream
+}
+
+type appInstanceServiceStreamAppInstanceLogsClient struct {
+	grpc.ClientStream
+}
+
+func (x *appInstanceServiceStreamAppInstanceLogsClient) Recv() (*LogLine, error) {
+	m := new(LogLine)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // AppInstanceServiceServer is the server API for AppInstanceService service.
 type AppInstanceServiceServer interface {
 	GetAppInstance(context.Context, *GetAppInstanceRequest) (*GetAppInstanceReply, error)
@@ -1087,6 +1911,9 @@ type AppInstanceServiceServer interface {
 	CreateAppInstance(context.Context, *AppInstance) (*CreateAppInstanceReply, error)
 	UpdateAppInstance(context.Context, *UpdateAppInstanceRequest) (*UpdateAppInstanceReply, error)
 	DeleteAppInstance(context.Context, *DeleteAppInstanceRequest) (*DeleteAppInstanceReply, error)
+	RestoreAppInstance(context.Context, *RestoreAppInstanceRequest) (*RestoreAppInstanceReply, error)
+	UpgradeAppInstance(context.Context, *UpgradeAppInstanceRequest) (*UpgradeAppInstanceReply, error)
+	StreamAppInstanceLogs(*StreamAppInstanceLogsRequest, AppInstanceService_StreamAppInstanceLogsServer) error
 }
 
 // UnimplementedAppInstanceServiceServer can be embedded to have forward compatible implementations.
@@ -1108,6 +1935,15 @@ func (*UnimplementedAppInstanceServiceServer) UpdateAppInstance(context.Context,
 func (*UnimplementedAppInstanceServiceServer) DeleteAppInstance(context.Context, *DeleteAppInstanceRequest) (*DeleteAppInstanceReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteAppInstance not implemented")
 }
+func (*UnimplementedAppInstanceServiceServer) RestoreAppInstance(context.Context, *RestoreAppInstanceRequest) (*RestoreAppInstanceReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreAppInstance not implemented")
+}
+func (*UnimplementedAppInstanceServiceServer) UpgradeAppInstance(context.Context, *UpgradeAppInstanceRequest) (*UpgradeAppInstanceReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpgradeAppInstance not implemented")
+}
+func (*UnimplementedAppInstanceServiceServer) StreamAppInstanceLogs(*StreamAppInstanceLogsRequest, AppInstanceService_StreamAppInstanceLogsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamAppInstanceLogs not implemented")
+}
 
 func RegisterAppInstanceServiceServer(s *grpc.Server, srv AppInstanceServiceServer) {
 	s.RegisterService(&_AppInstanceService_serviceDesc, srv)
@@ -1203,6 +2039,63 @@ func _AppInstanceService_DeleteAppInstance_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AppInstanceService_RestoreAppInstance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreAppInstanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppInstanceServiceServer).RestoreAppInstance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chorus.AppInstanceService/RestoreAppInstance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppInstanceServiceServer).RestoreAppInstance(ctx, req.(*RestoreAppInstanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppInstanceService_UpgradeAppInstance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpgradeAppInstanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppInstanceServiceServer).UpgradeAppInstance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chorus.AppInstanceService/UpgradeAppInstance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppInstanceServiceServer).UpgradeAppInstance(ctx, req.(*UpgradeAppInstanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppInstanceService_StreamAppInstanceLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamAppInstanceLogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AppInstanceServiceServer).StreamAppInstanceLogs(m, &appInstanceServiceStreamAppInstanceLogsServer{stream})
+}
+
+type AppInstanceService_StreamAppInstanceLogsServer interface {
+	Send(*LogLine) error
+	grpc.ServerStream
+}
+
+type appInstanceServiceStreamAppInstanceLogsServer struct {
+	grpc.ServerStream
+}
+
+func (x *appInstanceServiceStreamAppInstanceLogsServer) Send(m *LogLine) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _AppInstanceService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "chorus.AppInstanceService",
 	HandlerType: (*AppInstanceServiceServer)(nil),
@@ -1227,7 +2120,21 @@ var _AppInstanceService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteAppInstance",
 			Handler:    _AppInstanceService_DeleteAppInstance_Handler,
 		},
+		{
+			MethodName: "RestoreAppInstance",
+			Handler:    _AppInstanceService_RestoreAppInstance_Handler,
+		},
+		{
+			MethodName: "UpgradeAppInstance",
+			Handler:    _AppInstanceService_UpgradeAppInstance_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamAppInstanceLogs",
+			Handler:       _AppInstanceService_StreamAppInstanceLogs_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "app-instance-service.proto",
 }
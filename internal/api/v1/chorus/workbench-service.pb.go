@@ -9,6 +9,7 @@ package chorus
 import (
 	context "context"
 	_ "github.com/golang/protobuf/ptypes/empty"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
 	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
@@ -32,7 +33,9 @@ type ListWorkbenchsRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Pagination *PaginationQuery `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Cursor *RequestCursor   `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Filter *WorkbenchFilter `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	Sort   *WorkbenchSort   `protobuf:"bytes,4,opt,name=sort,proto3" json:"sort,omitempty"`
 }
 
 func (x *ListWorkbenchsRequest) Reset() {
@@ -67,25 +70,156 @@ func (*ListWorkbenchsRequest) Descriptor() ([]byte, []int) {
 	return file_workbench_service_proto_rawDescGZIP(), []int{0}
 }
 
-func (x *ListWorkbenchsRequest) GetPagination() *PaginationQuery {
+func (x *ListWorkbenchsRequest) GetCursor() *RequestCursor {
 	if x != nil {
-		return x.Pagination
+		return x.Cursor
 	}
 	return nil
 }
 
+func (x *ListWorkbenchsRequest) GetFilter() *WorkbenchFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListWorkbenchsRequest) GetSort() *WorkbenchSort {
+	if x != nil {
+		return x.Sort
+	}
+	return nil
+}
+
+type WorkbenchFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Only return workbenchs with this status (`active`, `inactive`, `deleted`).
+	// Deleted workbenchs are excluded when empty.
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// Only return workbenchs owned by this user
+	OwnerId uint64 `protobuf:"varint,2,opt,name=ownerId,proto3" json:"ownerId,omitempty"`
+}
+
+func (x *WorkbenchFilter) Reset() {
+	*x = WorkbenchFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_workbench_service_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WorkbenchFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkbenchFilter) ProtoMessage() {}
+
+func (x *WorkbenchFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_workbench_service_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkbenchFilter.ProtoReflect.Descriptor instead.
+func (*WorkbenchFilter) Descriptor() ([]byte, []int) {
+	return file_workbench_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *WorkbenchFilter) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *WorkbenchFilter) GetOwnerId() uint64 {
+	if x != nil {
+		return x.OwnerId
+	}
+	return 0
+}
+
+type WorkbenchSort struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Can be one of `ASC`, `DESC`
+	Order string `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	// Can be one of `ID`, `NAME`, `CREATEDAT`
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+}
+
+func (x *WorkbenchSort) Reset() {
+	*x = WorkbenchSort{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_workbench_service_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WorkbenchSort) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkbenchSort) ProtoMessage() {}
+
+func (x *WorkbenchSort) ProtoReflect() protoreflect.Message {
+	mi := &file_workbench_service_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkbenchSort.ProtoReflect.Descriptor instead.
+func (*WorkbenchSort) Descriptor() ([]byte, []int) {
+	return file_workbench_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *WorkbenchSort) GetOrder() string {
+	if x != nil {
+		return x.Order
+	}
+	return ""
+}
+
+func (x *WorkbenchSort) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
 type ListWorkbenchsReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Result []*Workbench `protobuf:"bytes,1,rep,name=result,proto3" json:"result,omitempty"`
+	Result     []*Workbench    `protobuf:"bytes,1,rep,name=result,proto3" json:"result,omitempty"`
+	Cursor     *ResponseCursor `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	TotalItems uint64          `protobuf:"varint,3,opt,name=totalItems,proto3" json:"totalItems,omitempty"`
 }
 
 func (x *ListWorkbenchsReply) Reset() {
 	*x = ListWorkbenchsReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_workbench_service_proto_msgTypes[1]
+		mi := &file_workbench_service_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -98,7 +232,7 @@ func (x *ListWorkbenchsReply) String() string {
 func (*ListWorkbenchsReply) ProtoMessage() {}
 
 func (x *ListWorkbenchsReply) ProtoReflect() protoreflect.Message {
-	mi := &file_workbench_service_proto_msgTypes[1]
+	mi := &file_workbench_service_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -111,7 +245,7 @@ func (x *ListWorkbenchsReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListWorkbenchsReply.ProtoReflect.Descriptor instead.
 func (*ListWorkbenchsReply) Descriptor() ([]byte, []int) {
-	return file_workbench_service_proto_rawDescGZIP(), []int{1}
+	return file_workbench_service_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *ListWorkbenchsReply) GetResult() []*Workbench {
@@ -121,18 +255,34 @@ func (x *ListWorkbenchsReply) GetResult() []*Workbench {
 	return nil
 }
 
+func (x *ListWorkbenchsReply) GetCursor() *ResponseCursor {
+	if x != nil {
+		return x.Cursor
+	}
+	return nil
+}
+
+func (x *ListWorkbenchsReply) GetTotalItems() uint64 {
+	if x != nil {
+		return x.TotalItems
+	}
+	return 0
+}
+
 type GetWorkbenchRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Also return the live state of the server of the workbench in the runtime
+	IncludeRuntime bool `protobuf:"varint,2,opt,name=includeRuntime,proto3" json:"includeRuntime,omitempty"`
 }
 
 func (x *GetWorkbenchRequest) Reset() {
 	*x = GetWorkbenchRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_workbench_service_proto_msgTypes[2]
+		mi := &file_workbench_service_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -145,7 +295,7 @@ func (x *GetWorkbenchRequest) String() string {
 func (*GetWorkbenchRequest) ProtoMessage() {}
 
 func (x *GetWorkbenchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_workbench_service_proto_msgTypes[2]
+	mi := &file_workbench_service_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -158,7 +308,7 @@ func (x *GetWorkbenchRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetWorkbenchRequest.ProtoReflect.Descriptor instead.
 func (*GetWorkbenchRequest) Descriptor() ([]byte, []int) {
-	return file_workbench_service_proto_rawDescGZIP(), []int{2}
+	return file_workbench_service_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GetWorkbenchRequest) GetId() uint64 {
@@ -168,18 +318,27 @@ func (x *GetWorkbenchRequest) GetId() uint64 {
 	return 0
 }
 
+func (x *GetWorkbenchRequest) GetIncludeRuntime() bool {
+	if x != nil {
+		return x.IncludeRuntime
+	}
+	return false
+}
+
 type GetWorkbenchResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Workbench *Workbench `protobuf:"bytes,1,opt,name=workbench,proto3" json:"workbench,omitempty"`
+	// Only set when includeRuntime is requested
+	Runtime *RuntimeDetails `protobuf:"bytes,2,opt,name=runtime,proto3" json:"runtime,omitempty"`
 }
 
 func (x *GetWorkbenchResult) Reset() {
 	*x = GetWorkbenchResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_workbench_service_proto_msgTypes[3]
+		mi := &file_workbench_service_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -192,7 +351,7 @@ func (x *GetWorkbenchResult) String() string {
 func (*GetWorkbenchResult) ProtoMessage() {}
 
 func (x *GetWorkbenchResult) ProtoReflect() protoreflect.Message {
-	mi := &file_workbench_service_proto_msgTypes[3]
+	mi := &file_workbench_service_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -205,7 +364,7 @@ func (x *GetWorkbenchResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetWorkbenchResult.ProtoReflect.Descriptor instead.
 func (*GetWorkbenchResult) Descriptor() ([]byte, []int) {
-	return file_workbench_service_proto_rawDescGZIP(), []int{3}
+	return file_workbench_service_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GetWorkbenchResult) GetWorkbench() *Workbench {
@@ -215,6 +374,13 @@ func (x *GetWorkbenchResult) GetWorkbench() *Workbench {
 	return nil
 }
 
+func (x *GetWorkbenchResult) GetRuntime() *RuntimeDetails {
+	if x != nil {
+		return x.Runtime
+	}
+	return nil
+}
+
 type GetWorkbenchReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -226,7 +392,7 @@ type GetWorkbenchReply struct {
 func (x *GetWorkbenchReply) Reset() {
 	*x = GetWorkbenchReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_workbench_service_proto_msgTypes[4]
+		mi := &file_workbench_service_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -239,7 +405,7 @@ func (x *GetWorkbenchReply) String() string {
 func (*GetWorkbenchReply) ProtoMessage() {}
 
 func (x *GetWorkbenchReply) ProtoReflect() protoreflect.Message {
-	mi := &file_workbench_service_proto_msgTypes[4]
+	mi := &file_workbench_service_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -252,7 +418,7 @@ func (x *GetWorkbenchReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetWorkbenchReply.ProtoReflect.Descriptor instead.
 func (*GetWorkbenchReply) Descriptor() ([]byte, []int) {
-	return file_workbench_service_proto_rawDescGZIP(), []int{4}
+	return file_workbench_service_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GetWorkbenchReply) GetResult() *GetWorkbenchResult {
@@ -273,7 +439,7 @@ type CreateWorkbenchReply struct {
 func (x *CreateWorkbenchReply) Reset() {
 	*x = CreateWorkbenchReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_workbench_service_proto_msgTypes[5]
+		mi := &file_workbench_service_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -286,7 +452,7 @@ func (x *CreateWorkbenchReply) String() string {
 func (*CreateWorkbenchReply) ProtoMessage() {}
 
 func (x *CreateWorkbenchReply) ProtoReflect() protoreflect.Message {
-	mi := &file_workbench_service_proto_msgTypes[5]
+	mi := &file_workbench_service_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -299,7 +465,7 @@ func (x *CreateWorkbenchReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateWorkbenchReply.ProtoReflect.Descriptor instead.
 func (*CreateWorkbenchReply) Descriptor() ([]byte, []int) {
-	return file_workbench_service_proto_rawDescGZIP(), []int{5}
+	return file_workbench_service_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *CreateWorkbenchReply) GetResult() *CreateWorkbenchResult {
@@ -320,7 +486,7 @@ type CreateWorkbenchResult struct {
 func (x *CreateWorkbenchResult) Reset() {
 	*x = CreateWorkbenchResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_workbench_service_proto_msgTypes[6]
+		mi := &file_workbench_service_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -333,7 +499,7 @@ func (x *CreateWorkbenchResult) String() string {
 func (*CreateWorkbenchResult) ProtoMessage() {}
 
 func (x *CreateWorkbenchResult) ProtoReflect() protoreflect.Message {
-	mi := &file_workbench_service_proto_msgTypes[6]
+	mi := &file_workbench_service_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -346,7 +512,7 @@ func (x *CreateWorkbenchResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateWorkbenchResult.ProtoReflect.Descriptor instead.
 func (*CreateWorkbenchResult) Descriptor() ([]byte, []int) {
-	return file_workbench_service_proto_rawDescGZIP(), []int{6}
+	return file_workbench_service_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *CreateWorkbenchResult) GetId() uint64 {
@@ -367,7 +533,7 @@ type UpdateWorkbenchRequest struct {
 func (x *UpdateWorkbenchRequest) Reset() {
 	*x = UpdateWorkbenchRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_workbench_service_proto_msgTypes[7]
+		mi := &file_workbench_service_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -380,7 +546,7 @@ func (x *UpdateWorkbenchRequest) String() string {
 func (*UpdateWorkbenchRequest) ProtoMessage() {}
 
 func (x *UpdateWorkbenchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_workbench_service_proto_msgTypes[7]
+	mi := &file_workbench_service_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -393,7 +559,7 @@ func (x *UpdateWorkbenchRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateWorkbenchRequest.ProtoReflect.Descriptor instead.
 func (*UpdateWorkbenchRequest) Descriptor() ([]byte, []int) {
-	return file_workbench_service_proto_rawDescGZIP(), []int{7}
+	return file_workbench_service_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *UpdateWorkbenchRequest) GetWorkbench() *Workbench {
@@ -412,7 +578,7 @@ type UpdateWorkbenchResult struct {
 func (x *UpdateWorkbenchResult) Reset() {
 	*x = UpdateWorkbenchResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_workbench_service_proto_msgTypes[8]
+		mi := &file_workbench_service_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -425,7 +591,7 @@ func (x *UpdateWorkbenchResult) String() string {
 func (*UpdateWorkbenchResult) ProtoMessage() {}
 
 func (x *UpdateWorkbenchResult) ProtoReflect() protoreflect.Message {
-	mi := &file_workbench_service_proto_msgTypes[8]
+	mi := &file_workbench_service_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -438,7 +604,7 @@ func (x *UpdateWorkbenchResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateWorkbenchResult.ProtoReflect.Descriptor instead.
 func (*UpdateWorkbenchResult) Descriptor() ([]byte, []int) {
-	return file_workbench_service_proto_rawDescGZIP(), []int{8}
+	return file_workbench_service_proto_rawDescGZIP(), []int{10}
 }
 
 type UpdateWorkbenchReply struct {
@@ -452,7 +618,7 @@ type UpdateWorkbenchReply struct {
 func (x *UpdateWorkbenchReply) Reset() {
 	*x = UpdateWorkbenchReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_workbench_service_proto_msgTypes[9]
+		mi := &file_workbench_service_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -465,7 +631,7 @@ func (x *UpdateWorkbenchReply) String() string {
 func (*UpdateWorkbenchReply) ProtoMessage() {}
 
 func (x *UpdateWorkbenchReply) ProtoReflect() protoreflect.Message {
-	mi := &file_workbench_service_proto_msgTypes[9]
+	mi := &file_workbench_service_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -478,7 +644,7 @@ func (x *UpdateWorkbenchReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateWorkbenchReply.ProtoReflect.Descriptor instead.
 func (*UpdateWorkbenchReply) Descriptor() ([]byte, []int) {
-	return file_workbench_service_proto_rawDescGZIP(), []int{9}
+	return file_workbench_service_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *UpdateWorkbenchReply) GetResult() *UpdateWorkbenchResult {
@@ -499,7 +665,7 @@ type DeleteWorkbenchRequest struct {
 func (x *DeleteWorkbenchRequest) Reset() {
 	*x = DeleteWorkbenchRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_workbench_service_proto_msgTypes[10]
+		mi := &file_workbench_service_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -512,7 +678,7 @@ func (x *DeleteWorkbenchRequest) String() string {
 func (*DeleteWorkbenchRequest) ProtoMessage() {}
 
 func (x *DeleteWorkbenchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_workbench_service_proto_msgTypes[10]
+	mi := &file_workbench_service_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -525,7 +691,7 @@ func (x *DeleteWorkbenchRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteWorkbenchRequest.ProtoReflect.Descriptor instead.
 func (*DeleteWorkbenchRequest) Descriptor() ([]byte, []int) {
-	return file_workbench_service_proto_rawDescGZIP(), []int{10}
+	return file_workbench_service_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *DeleteWorkbenchRequest) GetId() uint64 {
@@ -544,7 +710,7 @@ type DeleteWorkbenchResult struct {
 func (x *DeleteWorkbenchResult) Reset() {
 	*x = DeleteWorkbenchResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_workbench_service_proto_msgTypes[11]
+		mi := &file_workbench_service_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -557,7 +723,7 @@ func (x *DeleteWorkbenchResult) String() string {
 func (*DeleteWorkbenchResult) ProtoMessage() {}
 
 func (x *DeleteWorkbenchResult) ProtoReflect() protoreflect.Message {
-	mi := &file_workbench_service_proto_msgTypes[11]
+	mi := &file_workbench_service_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -570,7 +736,7 @@ func (x *DeleteWorkbenchResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteWorkbenchResult.ProtoReflect.Descriptor instead.
 func (*DeleteWorkbenchResult) Descriptor() ([]byte, []int) {
-	return file_workbench_service_proto_rawDescGZIP(), []int{11}
+	return file_workbench_service_proto_rawDescGZIP(), []int{13}
 }
 
 type DeleteWorkbenchReply struct {
@@ -584,7 +750,7 @@ type DeleteWorkbenchReply struct {
 func (x *DeleteWorkbenchReply) Reset() {
 	*x = DeleteWorkbenchReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_workbench_service_proto_msgTypes[12]
+		mi := &file_workbench_service_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -597,7 +763,7 @@ func (x *DeleteWorkbenchReply) String() string {
 func (*DeleteWorkbenchReply) ProtoMessage() {}
 
 func (x *DeleteWorkbenchReply) ProtoReflect() protoreflect.Message {
-	mi := &file_workbench_service_proto_msgTypes[12]
+	mi := &file_workbench_service_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -610,7 +776,7 @@ func (x *DeleteWorkbenchReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteWorkbenchReply.ProtoReflect.Descriptor instead.
 func (*DeleteWorkbenchReply) Descriptor() ([]byte, []int) {
-	return file_workbench_service_proto_rawDescGZIP(), []int{12}
+	return file_workbench_service_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *DeleteWorkbenchReply) GetResult() *DeleteWorkbenchResult {
@@ -620,233 +786,1767 @@ func (x *DeleteWorkbenchReply) GetResult() *DeleteWorkbenchResult {
 	return nil
 }
 
-var File_workbench_service_proto protoreflect.FileDescriptor
+type RestoreWorkbenchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_workbench_service_proto_rawDesc = []byte{
-	0x0a, 0x17, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x2d, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x63, 0x68, 0x6f, 0x72, 0x75,
-	0x73, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e,
-	0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f, 0x70, 0x65, 0x6e,
-	0x61, 0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x6e,
-	0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
-	0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0c, 0x63, 0x6f,
-	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0f, 0x77, 0x6f, 0x72, 0x6b,
-	0x62, 0x65, 0x6e, 0x63, 0x68, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x50, 0x0a, 0x15, 0x4c,
-	0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75,
-	0x73, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x40, 0x0a,
-	0x13, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x73, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x12, 0x29, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x57, 0x6f,
-	0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22,
-	0x25, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x45, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72,
-	0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2f, 0x0a, 0x09,
-	0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x11, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e,
-	0x63, 0x68, 0x52, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x22, 0x47, 0x0a,
-	0x11, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x12, 0x32, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x57,
-	0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06,
-	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x4d, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x35,
-	0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
-	0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x6f,
-	0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x27, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57,
-	0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x49,
-	0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63,
-	0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b,
-	0x62, 0x65, 0x6e, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x68,
-	0x6f, 0x72, 0x75, 0x73, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x09,
-	0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x22, 0x17, 0x0a, 0x15, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75,
-	0x6c, 0x74, 0x22, 0x4d, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b,
-	0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x35, 0x0a, 0x06, 0x72, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x68, 0x6f,
-	0x72, 0x75, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65,
-	0x6e, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x22, 0x28, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x62,
-	0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x17, 0x0a, 0x15, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x22, 0x4d, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x6f,
-	0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x35, 0x0a, 0x06,
-	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63,
-	0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b,
-	0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x32, 0xc7, 0x07, 0x0a, 0x10, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63,
-	0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0xb5, 0x01, 0x0a, 0x0c, 0x47, 0x65, 0x74,
-	0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x12, 0x1b, 0x2e, 0x63, 0x68, 0x6f, 0x72,
-	0x75, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e,
-	0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x22, 0x6d, 0x92, 0x41, 0x46, 0x0a, 0x10, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63,
-	0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x0f, 0x47, 0x65, 0x74, 0x20, 0x61, 0x20,
-	0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x1a, 0x21, 0x54, 0x68, 0x69, 0x73, 0x20,
-	0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73,
-	0x20, 0x61, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x1e, 0x12, 0x1c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x2f, 0x76, 0x31,
-	0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d,
-	0x12, 0xbf, 0x01, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e,
-	0x63, 0x68, 0x73, 0x12, 0x1d, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
-	0x71, 0x92, 0x41, 0x4f, 0x0a, 0x10, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x20, 0x77, 0x6f, 0x72,
-	0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x73, 0x1a, 0x2a, 0x54, 0x68, 0x69, 0x73, 0x20, 0x65, 0x6e,
-	0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x61,
-	0x20, 0x6c, 0x69, 0x73, 0x74, 0x20, 0x6f, 0x66, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e,
-	0x63, 0x68, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12, 0x17, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x72, 0x65, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63,
-	0x68, 0x73, 0x12, 0xb2, 0x01, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72,
-	0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x12, 0x11, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e,
-	0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x1a, 0x1c, 0x2e, 0x63, 0x68, 0x6f, 0x72,
-	0x75, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e,
-	0x63, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x6e, 0x92, 0x41, 0x49, 0x0a, 0x10, 0x57, 0x6f,
-	0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x12,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x20, 0x61, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e,
-	0x63, 0x68, 0x1a, 0x21, 0x54, 0x68, 0x69, 0x73, 0x20, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e,
-	0x74, 0x20, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x73, 0x20, 0x61, 0x20, 0x77, 0x6f, 0x72, 0x6b,
-	0x62, 0x65, 0x6e, 0x63, 0x68, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x3a, 0x01, 0x2a, 0x22, 0x17,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x6f, 0x72,
-	0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x73, 0x12, 0xbf, 0x01, 0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x12, 0x1e, 0x2e, 0x63, 0x68,
-	0x6f, 0x72, 0x75, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x62,
-	0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x63, 0x68,
-	0x6f, 0x72, 0x75, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x62,
-	0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x6e, 0x92, 0x41, 0x49, 0x0a, 0x10,
-	0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x12, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x20, 0x61, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x62,
-	0x65, 0x6e, 0x63, 0x68, 0x1a, 0x21, 0x54, 0x68, 0x69, 0x73, 0x20, 0x65, 0x6e, 0x64, 0x70, 0x6f,
-	0x69, 0x6e, 0x74, 0x20, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x20, 0x61, 0x20, 0x77, 0x6f,
-	0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x3a, 0x01, 0x2a,
-	0x1a, 0x17, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x77,
-	0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x73, 0x12, 0xc1, 0x01, 0x0a, 0x0f, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x12, 0x1e, 0x2e,
-	0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x6f, 0x72,
-	0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e,
-	0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x6f, 0x72,
-	0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x70, 0x92, 0x41, 0x49,
-	0x0a, 0x10, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x12, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x20, 0x61, 0x20, 0x77, 0x6f, 0x72,
-	0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x1a, 0x21, 0x54, 0x68, 0x69, 0x73, 0x20, 0x65, 0x6e, 0x64,
-	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x20, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x73, 0x20, 0x61, 0x20,
-	0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x2a,
-	0x1c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x6f,
-	0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x42, 0xb3, 0x01,
-	0x92, 0x41, 0xa5, 0x01, 0x12, 0x7c, 0x0a, 0x18, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x20, 0x77,
-	0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x22, 0x5b, 0x0a, 0x18, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x62,
-	0x65, 0x6e, 0x63, 0x68, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x2c, 0x68, 0x74,
-	0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x43, 0x48, 0x4f, 0x52, 0x55, 0x53, 0x2d, 0x54, 0x52, 0x45, 0x2f, 0x63, 0x68, 0x6f, 0x72,
-	0x75, 0x73, 0x2d, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x1a, 0x11, 0x64, 0x65, 0x76, 0x40,
-	0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2d, 0x74, 0x72, 0x65, 0x2e, 0x63, 0x68, 0x32, 0x03, 0x31,
-	0x2e, 0x30, 0x2a, 0x01, 0x01, 0x32, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x5a, 0x08, 0x2e, 0x3b, 0x63, 0x68, 0x6f,
-	0x72, 0x75, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-var (
-	file_workbench_service_proto_rawDescOnce sync.Once
-	file_workbench_service_proto_rawDescData = file_workbench_service_proto_rawDesc
-)
+func (x *RestoreWorkbenchRequest) Reset() {
+	*x = RestoreWorkbenchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_workbench_service_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_workbench_service_proto_rawDescGZIP() []byte {
-	file_workbench_service_proto_rawDescOnce.Do(func() {
-		file_workbench_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_workbench_service_proto_rawDescData)
-	})
-	return file_workbench_service_proto_rawDescData
+func (x *RestoreWorkbenchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_workbench_service_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
-var file_workbench_service_proto_goTypes = []interface{}{
-	(*ListWorkbenchsRequest)(nil),  // 0: chorus.ListWorkbenchsRequest
-	(*ListWorkbenchsReply)(nil),    // 1: chorus.ListWorkbenchsReply
-	(*GetWorkbenchRequest)(nil),    // 2: chorus.GetWorkbenchRequest
-	(*GetWorkbenchResult)(nil),     // 3: chorus.GetWorkbenchResult
-	(*GetWorkbenchReply)(nil),      // 4: chorus.GetWorkbenchReply
-	(*CreateWorkbenchReply)(nil),   // 5: chorus.CreateWorkbenchReply
-	(*CreateWorkbenchResult)(nil),  // 6: chorus.CreateWorkbenchResult
-	(*UpdateWorkbenchRequest)(nil), // 7: chorus.UpdateWorkbenchRequest
-	(*UpdateWorkbenchResult)(nil),  // 8: chorus.UpdateWorkbenchResult
-	(*UpdateWorkbenchReply)(nil),   // 9: chorus.UpdateWorkbenchReply
-	(*DeleteWorkbenchRequest)(nil), // 10: chorus.DeleteWorkbenchRequest
-	(*DeleteWorkbenchResult)(nil),  // 11: chorus.DeleteWorkbenchResult
-	(*DeleteWorkbenchReply)(nil),   // 12: chorus.DeleteWorkbenchReply
-	(*PaginationQuery)(nil),        // 13: chorus.PaginationQuery
-	(*Workbench)(nil),              // 14: chorus.Workbench
+func (*RestoreWorkbenchRequest) ProtoMessage() {}
+
+func (x *RestoreWorkbenchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_workbench_service_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_workbench_service_proto_depIdxs = []int32{
-	13, // 0: chorus.ListWorkbenchsRequest.pagination:type_name -> chorus.PaginationQuery
-	14, // 1: chorus.ListWorkbenchsReply.result:type_name -> chorus.Workbench
-	14, // 2: chorus.GetWorkbenchResult.workbench:type_name -> chorus.Workbench
-	3,  // 3: chorus.GetWorkbenchReply.result:type_name -> chorus.GetWorkbenchResult
-	6,  // 4: chorus.CreateWorkbenchReply.result:type_name -> chorus.CreateWorkbenchResult
-	14, // 5: chorus.UpdateWorkbenchRequest.workbench:type_name -> chorus.Workbench
-	8,  // 6: chorus.UpdateWorkbenchReply.result:type_name -> chorus.UpdateWorkbenchResult
-	11, // 7: chorus.DeleteWorkbenchReply.result:type_name -> chorus.DeleteWorkbenchResult
-	2,  // 8: chorus.WorkbenchService.GetWorkbench:input_type -> chorus.GetWorkbenchRequest
-	0,  // 9: chorus.WorkbenchService.ListWorkbenchs:input_type -> chorus.ListWorkbenchsRequest
-	14, // 10: chorus.WorkbenchService.CreateWorkbench:input_type -> chorus.Workbench
-	7,  // 11: chorus.WorkbenchService.UpdateWorkbench:input_type -> chorus.UpdateWorkbenchRequest
-	10, // 12: chorus.WorkbenchService.DeleteWorkbench:input_type -> chorus.DeleteWorkbenchRequest
-	4,  // 13: chorus.WorkbenchService.GetWorkbench:output_type -> chorus.GetWorkbenchReply
-	1,  // 14: chorus.WorkbenchService.ListWorkbenchs:output_type -> chorus.ListWorkbenchsReply
-	5,  // 15: chorus.WorkbenchService.CreateWorkbench:output_type -> chorus.CreateWorkbenchReply
-	9,  // 16: chorus.WorkbenchService.UpdateWorkbench:output_type -> chorus.UpdateWorkbenchReply
-	12, // 17: chorus.WorkbenchService.DeleteWorkbench:output_type -> chorus.DeleteWorkbenchReply
-	13, // [13:18] is the sub-list for method output_type
-	8,  // [8:13] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+
+// Deprecated: Use RestoreWorkbenchRequest.ProtoReflect.Descriptor instead.
+func (*RestoreWorkbenchRequest) Descriptor() ([]byte, []int) {
+	return file_workbench_service_proto_rawDescGZIP(), []int{15}
 }
 
-func init() { file_workbench_service_proto_init() }
-func file_workbench_service_proto_init() {
-	if File_workbench_service_proto != nil {
-		return
+func (x *RestoreWorkbenchRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
 	}
-	file_common_proto_init()
-	file_workbench_proto_init()
-	if !protoimpl.UnsafeEnabled {
-		file_workbench_service_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListWorkbenchsRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_workbench_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListWorkbenchsReply); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
+	return 0
+}
+
+type RestoreWorkbenchResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RestoreWorkbenchResult) Reset() {
+	*x = RestoreWorkbenchResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_workbench_service_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreWorkbenchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreWorkbenchResult) ProtoMessage() {}
+
+func (x *RestoreWorkbenchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_workbench_service_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreWorkbenchResult.ProtoReflect.Descriptor instead.
+func (*RestoreWorkbenchResult) Descriptor() ([]byte, []int) {
+	return file_workbench_service_proto_rawDescGZIP(), []int{16}
+}
+
+type RestoreWorkbenchReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result *RestoreWorkbenchResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *RestoreWorkbenchReply) Reset() {
+	*x = RestoreWorkbenchReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_workbench_service_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreWorkbenchReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreWorkbenchReply) ProtoMessage() {}
+
+func (x *RestoreWorkbenchReply) ProtoReflect() protoreflect.Message {
+	mi := &file_workbench_service_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreWorkbenchReply.ProtoReflect.Descriptor instead.
+func (*RestoreWorkbenchReply) Descriptor() ([]byte, []int) {
+	return file_workbench_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RestoreWorkbenchReply) GetResult() *RestoreWorkbenchResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type DescribeWorkbenchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DescribeWorkbenchRequest) Reset() {
+	*x = DescribeWorkbenchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_workbench_service_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DescribeWorkbenchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeWorkbenchRequest) ProtoMessage() {}
+
+func (x *DescribeWorkbenchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_workbench_service_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeWorkbenchRequest.ProtoReflect.Descriptor instead.
+func (*DescribeWorkbenchRequest) Descriptor() ([]byte, []int) {
+	return file_workbench_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *DescribeWorkbenchRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DescribeWorkbenchResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Workbench *Workbench      `protobuf:"bytes,1,opt,name=workbench,proto3" json:"workbench,omitempty"`
+	Runtime   *RuntimeDetails `protobuf:"bytes,2,opt,name=runtime,proto3" json:"runtime,omitempty"`
+}
+
+func (x *DescribeWorkbenchResult) Reset() {
+	*x = DescribeWorkbenchResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_workbench_service_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DescribeWorkbenchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeWorkbenchResult) ProtoMessage() {}
+
+func (x *DescribeWorkbenchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_workbench_service_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeWorkbenchResult.ProtoReflect.Descriptor instead.
+func (*DescribeWorkbenchResult) Descriptor() ([]byte, []int) {
+	return file_workbench_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *DescribeWorkbenchResult) GetWorkbench() *Workbench {
+	if x != nil {
+		return x.Workbench
+	}
+	return nil
+}
+
+func (x *DescribeWorkbenchResult) GetRuntime() *RuntimeDetails {
+	if x != nil {
+		return x.Runtime
+	}
+	return nil
+}
+
+type DescribeWorkbenchReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result *DescribeWorkbenchResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *DescribeWorkbenchReply) Reset() {
+	*x = DescribeWorkbenchReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_workbench_service_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DescribeWorkbenchReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeWorkbenchReply) ProtoMessage() {}
+
+func (x *DescribeWorkbenchReply) ProtoReflect() protoreflect.Message {
+	mi := &file_workbench_service_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeWorkbenchReply.ProtoReflect.Descriptor instead.
+func (*DescribeWorkbenchReply) Descriptor() ([]byte, []int) {
+	return file_workbench_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DescribeWorkbenchReply) GetResult() *DescribeWorkbenchResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type ListWorkbenchVolumesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *ListWorkbenchVolumesRequest) Reset() {
+	*x = ListWorkbenchVolumesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_workbench_service_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListWorkbenchVolumesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWorkbenchVolumesRequest) ProtoMessage() {}
+
+func (x *ListWorkbenchVolumesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_workbench_service_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWorkbenchVolumesRequest.ProtoReflect.Descriptor instead.
+func (*ListWorkbenchVolumesRequest) Descriptor() ([]byte, []int) {
+	return file_workbench_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListWorkbenchVolumesRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ListWorkbenchVolumesReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result []*Volume `protobuf:"bytes,1,rep,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *ListWorkbenchVolumesReply) Reset() {
+	*x = ListWorkbenchVolumesReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_workbench_service_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListWorkbenchVolumesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWorkbenchVolumesReply) ProtoMessage() {}
+
+func (x *ListWorkbenchVolumesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_workbench_service_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWorkbenchVolumesReply.ProtoReflect.Descriptor instead.
+func (*ListWorkbenchVolumesReply) Descriptor() ([]byte, []int) {
+	return file_workbench_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListWorkbenchVolumesReply) GetResult() []*Volume {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type SnapshotWorkbenchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// name defaults to the name of the workbench
+	Name        string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (x *SnapshotWorkbenchRequest) Reset() {
+	*x = SnapshotWorkbenchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_workbench_service_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SnapshotWorkbenchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnapshotWorkbenchRequest) ProtoMessage() {}
+
+func (x *SnapshotWorkbenchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_workbench_service_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnapshotWorkbenchRequest.ProtoReflect.Descriptor instead.
+func (*SnapshotWorkbenchRequest) Descriptor() ([]byte, []int) {
+	return file_workbench_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SnapshotWorkbenchRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SnapshotWorkbenchRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SnapshotWorkbenchRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type SnapshotWorkbenchResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *SnapshotWorkbenchResult) Reset() {
+	*x = SnapshotWorkbenchResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_workbench_service_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SnapshotWorkbenchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnapshotWorkbenchResult) ProtoMessage() {}
+
+func (x *SnapshotWorkbenchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_workbench_service_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnapshotWorkbenchResult.ProtoReflect.Descriptor instead.
+func (*SnapshotWorkbenchResult) Descriptor() ([]byte, []int) {
+	return file_workbench_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SnapshotWorkbenchResult) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type SnapshotWorkbenchReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result *SnapshotWorkbenchResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *SnapshotWorkbenchReply) Reset() {
+	*x = SnapshotWorkbenchReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_workbench_service_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SnapshotWorkbenchReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnapshotWorkbenchReply) ProtoMessage() {}
+
+func (x *SnapshotWorkbenchReply) ProtoReflect() protoreflect.Message {
+	mi := &file_workbench_service_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnapshotWorkbenchReply.ProtoReflect.Descriptor instead.
+func (*SnapshotWorkbenchReply) Descriptor() ([]byte, []int) {
+	return file_workbench_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SnapshotWorkbenchReply) GetResult() *SnapshotWorkbenchResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type RestoreWorkbenchSnapshotRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *RestoreWorkbenchSnapshotRequest) Reset() {
+	*x = RestoreWorkbenchSnapshotRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_workbench_service_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreWorkbenchSnapshotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreWorkbenchSnapshotRequest) ProtoMessage() {}
+
+func (x *RestoreWorkbenchSnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_workbench_service_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreWorkbenchSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*RestoreWorkbenchSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_workbench_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *RestoreWorkbenchSnapshotRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type RestoreWorkbenchSnapshotResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// workbenchId is the ID of the restored workbench
+	WorkbenchId uint64 `protobuf:"varint,1,opt,name=workbenchId,proto3" json:"workbenchId,omitempty"`
+}
+
+func (x *RestoreWorkbenchSnapshotResult) Reset() {
+	*x = RestoreWorkbenchSnapshotResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_workbench_service_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreWorkbenchSnapshotResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreWorkbenchSnapshotResult) ProtoMessage() {}
+
+func (x *RestoreWorkbenchSnapshotResult) ProtoReflect() protoreflect.Message {
+	mi := &file_workbench_service_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreWorkbenchSnapshotResult.ProtoReflect.Descriptor instead.
+func (*RestoreWorkbenchSnapshotResult) Descriptor() ([]byte, []int) {
+	return file_workbench_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *RestoreWorkbenchSnapshotResult) GetWorkbenchId() uint64 {
+	if x != nil {
+		return x.WorkbenchId
+	}
+	return 0
+}
+
+type RestoreWorkbenchSnapshotReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result *RestoreWorkbenchSnapshotResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *RestoreWorkbenchSnapshotReply) Reset() {
+	*x = RestoreWorkbenchSnapshotReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_workbench_service_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreWorkbenchSnapshotReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreWorkbenchSnapshotReply) ProtoMessage() {}
+
+func (x *RestoreWorkbenchSnapshotReply) ProtoReflect() protoreflect.Message {
+	mi := &file_workbench_service_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreWorkbenchSnapshotReply.ProtoReflect.Descriptor instead.
+func (*RestoreWorkbenchSnapshotReply) Descriptor() ([]byte, []int) {
+	return file_workbench_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *RestoreWorkbenchSnapshotReply) GetResult() *RestoreWorkbenchSnapshotResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type ListWorkbenchSnapshotsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkspaceId uint64 `protobuf:"varint,1,opt,name=workspaceId,proto3" json:"workspaceId,omitempty"`
+}
+
+func (x *ListWorkbenchSnapshotsRequest) Reset() {
+	*x = ListWorkbenchSnapshotsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_workbench_service_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListWorkbenchSnapshotsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWorkbenchSnapshotsRequest) ProtoMessage() {}
+
+func (x *ListWorkbenchSnapshotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_workbench_service_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWorkbenchSnapshotsRequest.ProtoReflect.Descriptor instead.
+func (*ListWorkbenchSnapshotsRequest) Descriptor() ([]byte, []int) {
+	return file_workbench_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListWorkbenchSnapshotsRequest) GetWorkspaceId() uint64 {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return 0
+}
+
+type ListWorkbenchSnapshotsReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result []*WorkbenchSnapshot `protobuf:"bytes,1,rep,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *ListWorkbenchSnapshotsReply) Reset() {
+	*x = ListWorkbenchSnapshotsReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_workbench_service_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListWorkbenchSnapshotsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWorkbenchSnapshotsReply) ProtoMessage() {}
+
+func (x *ListWorkbenchSnapshotsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_workbench_service_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWorkbenchSnapshotsReply.ProtoReflect.Descriptor instead.
+func (*ListWorkbenchSnapshotsReply) Descriptor() ([]byte, []int) {
+	return file_workbench_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListWorkbenchSnapshotsReply) GetResult() []*WorkbenchSnapshot {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type DeleteWorkbenchSnapshotRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteWorkbenchSnapshotRequest) Reset() {
+	*x = DeleteWorkbenchSnapshotRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_workbench_service_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteWorkbenchSnapshotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWorkbenchSnapshotRequest) ProtoMessage() {}
+
+func (x *DeleteWorkbenchSnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_workbench_service_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWorkbenchSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWorkbenchSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_workbench_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *DeleteWorkbenchSnapshotRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteWorkbenchSnapshotResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteWorkbenchSnapshotResult) Reset() {
+	*x = DeleteWorkbenchSnapshotResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_workbench_service_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteWorkbenchSnapshotResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWorkbenchSnapshotResult) ProtoMessage() {}
+
+func (x *DeleteWorkbenchSnapshotResult) ProtoReflect() protoreflect.Message {
+	mi := &file_workbench_service_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWorkbenchSnapshotResult.ProtoReflect.Descriptor instead.
+func (*DeleteWorkbenchSnapshotResult) Descriptor() ([]byte, []int) {
+	return file_workbench_service_proto_rawDescGZIP(), []int{32}
+}
+
+type DeleteWorkbenchSnapshotReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result *DeleteWorkbenchSnapshotResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *DeleteWorkbenchSnapshotReply) Reset() {
+	*x = DeleteWorkbenchSnapshotReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_workbench_service_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteWorkbenchSnapshotReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWorkbenchSnapshotReply) ProtoMessage() {}
+
+func (x *DeleteWorkbenchSnapshotReply) ProtoReflect() protoreflect.Message {
+	mi := &file_workbench_service_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWorkbenchSnapshotReply.ProtoReflect.Descriptor instead.
+func (*DeleteWorkbenchSnapshotReply) Descriptor() ([]byte, []int) {
+	return file_workbench_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *DeleteWorkbenchSnapshotReply) GetResult() *DeleteWorkbenchSnapshotResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type StreamWorkbenchLogsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Keep streaming the logs as they are written
+	Follow bool `protobuf:"varint,2,opt,name=follow,proto3" json:"follow,omitempty"`
+	// Only return this number of lines from the end of the logs (at most 10000)
+	TailLines int64 `protobuf:"varint,3,opt,name=tailLines,proto3" json:"tailLines,omitempty"`
+	// Only return the logs written after this time
+	SinceTime *timestamp.Timestamp `protobuf:"bytes,4,opt,name=sinceTime,proto3" json:"sinceTime,omitempty"`
+}
+
+func (x *StreamWorkbenchLogsRequest) Reset() {
+	*x = StreamWorkbenchLogsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_workbench_service_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamWorkbenchLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamWorkbenchLogsRequest) ProtoMessage() {}
+
+func (x *StreamWorkbenchLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_workbench_service_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamWorkbenchLogsRequest.ProtoReflect.Descriptor instead.
+func (*StreamWorkbenchLogsRequest) Descriptor() ([]byte, []int) {
+	return file_workbench_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *StreamWorkbenchLogsRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *StreamWorkbenchLogsRequest) GetFollow() bool {
+	if x != nil {
+		return x.Follow
+	}
+	return false
+}
+
+func (x *StreamWorkbenchLogsRequest) GetTailLines() int64 {
+	if x != nil {
+		return x.TailLines
+	}
+	return 0
+}
+
+func (x *StreamWorkbenchLogsRequest) GetSinceTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.SinceTime
+	}
+	return nil
+}
+
+var File_workbench_service_proto protoreflect.FileDescriptor
+
+var file_workbench_service_proto_rawDesc = []byte{
+	0x0a, 0x17, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x2d, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x63, 0x68, 0x6f, 0x72, 0x75,
+	0x73, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e,
+	0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f, 0x70, 0x65, 0x6e,
+	0x61, 0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x6e,
+	0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0c, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0c, 0x63, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0f, 0x77, 0x6f, 0x72, 0x6b, 0x62,
+	0x65, 0x6e, 0x63, 0x68, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0d, 0x72, 0x75, 0x6e, 0x74,
+	0x69, 0x6d, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb4, 0x01, 0x0a, 0x15, 0x4c, 0x69,
+	0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x12, 0x2f, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x57, 0x6f, 0x72, 0x6b,
+	0x62, 0x65, 0x6e, 0x63, 0x68, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x12, 0x29, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x62,
+	0x65, 0x6e, 0x63, 0x68, 0x53, 0x6f, 0x72, 0x74, 0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x4a, 0x04,
+	0x08, 0x01, 0x10, 0x02, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x43, 0x0a, 0x0f, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6f,
+	0x77, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6f, 0x77,
+	0x6e, 0x65, 0x72, 0x49, 0x64, 0x22, 0x39, 0x0a, 0x0d, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e,
+	0x63, 0x68, 0x53, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x22, 0x90, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e,
+	0x63, 0x68, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x29, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75,
+	0x73, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x06, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x12, 0x2e, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x52, 0x06, 0x63, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x49, 0x74, 0x65, 0x6d,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x49, 0x74,
+	0x65, 0x6d, 0x73, 0x22, 0x4d, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65,
+	0x6e, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x69, 0x6e,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x52, 0x75, 0x6e, 0x74, 0x69,
+	0x6d, 0x65, 0x22, 0x77, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e,
+	0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2f, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b,
+	0x62, 0x65, 0x6e, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x68,
+	0x6f, 0x72, 0x75, 0x73, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x09,
+	0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x12, 0x30, 0x0a, 0x07, 0x72, 0x75, 0x6e,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x68, 0x6f,
+	0x72, 0x75, 0x73, 0x2e, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x73, 0x52, 0x07, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x47, 0x0a, 0x11, 0x47,
+	0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x32, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72,
+	0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x22, 0x4d, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x6f,
+	0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x35, 0x0a, 0x06,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63,
+	0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b,
+	0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x22, 0x27, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72,
+	0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x49, 0x0a, 0x16,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65,
+	0x6e, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x68, 0x6f, 0x72,
+	0x75, 0x73, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x09, 0x77, 0x6f,
+	0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x22, 0x17, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x22, 0x4d, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65,
+	0x6e, 0x63, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x35, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75,
+	0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63,
+	0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22,
+	0x28, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e,
+	0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x17, 0x0a, 0x15, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x22, 0x4d, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b,
+	0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x35, 0x0a, 0x06, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x68, 0x6f,
+	0x72, 0x75, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65,
+	0x6e, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x22, 0x29, 0x0a, 0x17, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x57, 0x6f, 0x72, 0x6b,
+	0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x18, 0x0a, 0x16,
+	0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x4f, 0x0a, 0x15, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x36, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52,
+	0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x2a, 0x0a, 0x18, 0x44, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0x7c, 0x0a, 0x17, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x57,
+	0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2f,
+	0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x62,
+	0x65, 0x6e, 0x63, 0x68, 0x52, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x12,
+	0x30, 0x0a, 0x07, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d,
+	0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x07, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d,
+	0x65, 0x22, 0x51, 0x0a, 0x16, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x57, 0x6f, 0x72,
+	0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x37, 0x0a, 0x06, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x68,
+	0x6f, 0x72, 0x75, 0x73, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x57, 0x6f, 0x72,
+	0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x22, 0x2d, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b,
+	0x62, 0x65, 0x6e, 0x63, 0x68, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0x43, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x62,
+	0x65, 0x6e, 0x63, 0x68, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x26, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x60, 0x0a, 0x18, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x29, 0x0a, 0x17, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x51, 0x0a, 0x16, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x37, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x31, 0x0a, 0x1f, 0x52, 0x65, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x42, 0x0a, 0x1e, 0x52,
+	0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x53,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x20, 0x0a,
+	0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x49, 0x64, 0x22,
+	0x5f, 0x0a, 0x1d, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65,
+	0x6e, 0x63, 0x68, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x3e, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x26, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x22, 0x41, 0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63,
+	0x68, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x20, 0x0a, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x49, 0x64, 0x22, 0x50, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x62,
+	0x65, 0x6e, 0x63, 0x68, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x31, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x57, 0x6f, 0x72, 0x6b,
+	0x62, 0x65, 0x6e, 0x63, 0x68, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x06, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x30, 0x0a, 0x1e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57,
+	0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x1f, 0x0a, 0x1d, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x5d, 0x0a, 0x1c, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3d, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75,
+	0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63,
+	0x68, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52,
+	0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x9c, 0x01, 0x0a, 0x1a, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x4c, 0x6f, 0x67, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x12, 0x1c,
+	0x0a, 0x09, 0x74, 0x61, 0x69, 0x6c, 0x4c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x74, 0x61, 0x69, 0x6c, 0x4c, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x09,
+	0x73, 0x69, 0x6e, 0x63, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x69, 0x6e,
+	0x63, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x32, 0xc7, 0x1d, 0x0a, 0x10, 0x57, 0x6f, 0x72, 0x6b, 0x62,
+	0x65, 0x6e, 0x63, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0xb5, 0x01, 0x0a, 0x0c,
+	0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x12, 0x1b, 0x2e, 0x63,
+	0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e,
+	0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x63, 0x68, 0x6f, 0x72,
+	0x75, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x6d, 0x92, 0x41, 0x46, 0x0a, 0x10, 0x57, 0x6f, 0x72, 0x6b, 0x62,
+	0x65, 0x6e, 0x63, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x0f, 0x47, 0x65, 0x74,
+	0x20, 0x61, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x1a, 0x21, 0x54, 0x68,
+	0x69, 0x73, 0x20, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x20, 0x72, 0x65, 0x74, 0x75,
+	0x72, 0x6e, 0x73, 0x20, 0x61, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x74,
+	0x2f, 0x76, 0x31, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x73, 0x2f, 0x7b,
+	0x69, 0x64, 0x7d, 0x12, 0xbf, 0x01, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b,
+	0x62, 0x65, 0x6e, 0x63, 0x68, 0x73, 0x12, 0x1d, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x73, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x71, 0x92, 0x41, 0x4f, 0x0a, 0x10, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e,
+	0x63, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x20,
+	0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x73, 0x1a, 0x2a, 0x54, 0x68, 0x69, 0x73,
+	0x20, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e,
+	0x73, 0x20, 0x61, 0x20, 0x6c, 0x69, 0x73, 0x74, 0x20, 0x6f, 0x66, 0x20, 0x77, 0x6f, 0x72, 0x6b,
+	0x62, 0x65, 0x6e, 0x63, 0x68, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12, 0x17, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x62,
+	0x65, 0x6e, 0x63, 0x68, 0x73, 0x12, 0xb2, 0x01, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x12, 0x11, 0x2e, 0x63, 0x68, 0x6f, 0x72,
+	0x75, 0x73, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x1a, 0x1c, 0x2e, 0x63,
+	0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b,
+	0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x6e, 0x92, 0x41, 0x49, 0x0a,
+	0x10, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x20, 0x61, 0x20, 0x77, 0x6f, 0x72, 0x6b,
+	0x62, 0x65, 0x6e, 0x63, 0x68, 0x1a, 0x21, 0x54, 0x68, 0x69, 0x73, 0x20, 0x65, 0x6e, 0x64, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x20, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x73, 0x20, 0x61, 0x20, 0x77,
+	0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x3a, 0x01,
+	0x2a, 0x22, 0x17, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f,
+	0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x73, 0x12, 0xbf, 0x01, 0x0a, 0x0f, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x12, 0x1e,
+	0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x6f,
+	0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c,
+	0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x6f,
+	0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x6e, 0x92, 0x41,
+	0x49, 0x0a, 0x10, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x20, 0x61, 0x20, 0x77, 0x6f,
+	0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x1a, 0x21, 0x54, 0x68, 0x69, 0x73, 0x20, 0x65, 0x6e,
+	0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x20, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x20, 0x61,
+	0x20, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c,
+	0x3a, 0x01, 0x2a, 0x1a, 0x17, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x2f, 0x76,
+	0x31, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x73, 0x12, 0xc1, 0x01, 0x0a,
+	0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68,
+	0x12, 0x1e, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1c, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x70,
+	0x92, 0x41, 0x49, 0x0a, 0x10, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x20, 0x61, 0x20,
+	0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x1a, 0x21, 0x54, 0x68, 0x69, 0x73, 0x20,
+	0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x20, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x73,
+	0x20, 0x61, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x1e, 0x2a, 0x1c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x2f, 0x76, 0x31,
+	0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d,
+	0x12, 0xba, 0x02, 0x0a, 0x10, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x57, 0x6f, 0x72, 0x6b,
+	0x62, 0x65, 0x6e, 0x63, 0x68, 0x12, 0x1f, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x52,
+	0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e,
+	0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0xe5, 0x01, 0x92, 0x41, 0xb2, 0x01, 0x0a, 0x10, 0x57, 0x6f,
+	0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x13,
+	0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x20, 0x61, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65,
+	0x6e, 0x63, 0x68, 0x1a, 0x88, 0x01, 0x54, 0x68, 0x69, 0x73, 0x20, 0x65, 0x6e, 0x64, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x20, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x20, 0x61, 0x20, 0x64,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68,
+	0x2c, 0x20, 0x61, 0x6c, 0x6f, 0x6e, 0x67, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x74, 0x68, 0x65,
+	0x20, 0x61, 0x70, 0x70, 0x20, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x20, 0x64,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x69, 0x74, 0x2c, 0x20,
+	0x64, 0x75, 0x72, 0x69, 0x6e, 0x67, 0x20, 0x74, 0x68, 0x65, 0x20, 0x67, 0x72, 0x61, 0x63, 0x65,
+	0x20, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x20, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e,
+	0x67, 0x20, 0x69, 0x74, 0x73, 0x20, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x29, 0x3a, 0x01, 0x2a, 0x22, 0x24, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65,
+	0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x73,
+	0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x12, 0xcf, 0x02,
+	0x0a, 0x11, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65,
+	0x6e, 0x63, 0x68, 0x12, 0x20, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x44, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x44,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0xf7, 0x01, 0x92, 0x41, 0xc6, 0x01, 0x0a, 0x10, 0x57, 0x6f,
+	0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x14,
+	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x20, 0x61, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x62,
+	0x65, 0x6e, 0x63, 0x68, 0x1a, 0x9b, 0x01, 0x54, 0x68, 0x69, 0x73, 0x20, 0x65, 0x6e, 0x64, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x61, 0x20, 0x77,
+	0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x20, 0x61, 0x6c, 0x6f, 0x6e, 0x67, 0x20, 0x77,
+	0x69, 0x74, 0x68, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6c, 0x69, 0x76, 0x65, 0x20, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x69, 0x74, 0x73, 0x20, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x3a, 0x20, 0x70, 0x6f, 0x64, 0x20, 0x70, 0x68, 0x61, 0x73, 0x65, 0x2c, 0x20, 0x63, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x20, 0x73, 0x74, 0x61, 0x74, 0x65, 0x73, 0x2c, 0x20, 0x72,
+	0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x20, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2c, 0x20, 0x69,
+	0x6d, 0x61, 0x67, 0x65, 0x20, 0x70, 0x75, 0x6c, 0x6c, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73,
+	0x20, 0x61, 0x6e, 0x64, 0x20, 0x72, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x20, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x12, 0x25, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72,
+	0x65, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68,
+	0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12,
+	0xf6, 0x02, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63,
+	0x68, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x12, 0x23, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75,
+	0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x56,
+	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e,
+	0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x62,
+	0x65, 0x6e, 0x63, 0x68, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x95, 0x02, 0x92, 0x41, 0xe5, 0x01, 0x0a, 0x10, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e,
+	0x63, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x1f, 0x4c, 0x69, 0x73, 0x74, 0x20,
+	0x74, 0x68, 0x65, 0x20, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x61,
+	0x20, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x1a, 0xaf, 0x01, 0x54, 0x68, 0x69,
+	0x73, 0x20, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72,
+	0x6e, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e,
+	0x74, 0x20, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x20, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x65,
+	0x64, 0x20, 0x69, 0x6e, 0x74, 0x6f, 0x20, 0x65, 0x76, 0x65, 0x72, 0x79, 0x20, 0x61, 0x70, 0x70,
+	0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x2c,
+	0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x20, 0x76, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x69, 0x74, 0x73, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x74, 0x68, 0x65, 0x20, 0x68, 0x6f, 0x6d, 0x65,
+	0x20, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x69, 0x74, 0x73, 0x20, 0x6f,
+	0x77, 0x6e, 0x65, 0x72, 0x2c, 0x20, 0x61, 0x6c, 0x6f, 0x6e, 0x67, 0x20, 0x77, 0x69, 0x74, 0x68,
+	0x20, 0x74, 0x68, 0x65, 0x69, 0x72, 0x20, 0x75, 0x73, 0x61, 0x67, 0x65, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x26, 0x12, 0x24, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x2f, 0x76, 0x31,
+	0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d,
+	0x2f, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x12, 0xdf, 0x02, 0x0a, 0x11, 0x53, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x12, 0x20,
+	0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1e, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x87, 0x02, 0x92, 0x41, 0xd2, 0x01, 0x0a, 0x10, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e,
+	0x63, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x14, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x20, 0x61, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x1a,
+	0xa7, 0x01, 0x54, 0x68, 0x69, 0x73, 0x20, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x20,
+	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x61, 0x70, 0x70, 0x73,
+	0x20, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x20, 0x69, 0x6e, 0x20, 0x61, 0x20, 0x77, 0x6f,
+	0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x74, 0x61, 0x6b, 0x65,
+	0x73, 0x20, 0x61, 0x20, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x20, 0x6f, 0x66, 0x20,
+	0x74, 0x68, 0x65, 0x20, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x20, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x65, 0x64, 0x20, 0x69, 0x6e, 0x74, 0x6f, 0x20, 0x74, 0x68, 0x65, 0x6d, 0x2e, 0x20, 0x54,
+	0x68, 0x65, 0x20, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x20, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x73, 0x20, 0x74, 0x6f, 0x77, 0x61, 0x72, 0x64, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x71,
+	0x75, 0x6f, 0x74, 0x61, 0x73, 0x20, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x20, 0x69, 0x74, 0x20, 0x69,
+	0x73, 0x20, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x3a,
+	0x01, 0x2a, 0x22, 0x26, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x2f, 0x76, 0x31,
+	0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d,
+	0x2f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x12, 0x84, 0x03, 0x0a, 0x18, 0x52,
+	0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x53,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x27, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73,
+	0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63,
+	0x68, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x25, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x97, 0x02, 0x92, 0x41, 0xdb, 0x01, 0x0a, 0x10,
+	0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x1c, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x20, 0x61, 0x20, 0x77, 0x6f, 0x72, 0x6b,
+	0x62, 0x65, 0x6e, 0x63, 0x68, 0x20, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x1a, 0xa8,
+	0x01, 0x54, 0x68, 0x69, 0x73, 0x20, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x20, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x73, 0x20, 0x61, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e,
+	0x63, 0x68, 0x20, 0x6f, 0x77, 0x6e, 0x65, 0x64, 0x20, 0x62, 0x79, 0x20, 0x74, 0x68, 0x65, 0x20,
+	0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x61, 0x20, 0x73, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x2c, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x63, 0x6f, 0x70,
+	0x69, 0x65, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x74, 0x65, 0x64, 0x20, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x20, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x65, 0x64, 0x20, 0x61, 0x74, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x61,
+	0x6d, 0x65, 0x20, 0x70, 0x61, 0x74, 0x68, 0x73, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x74, 0x68, 0x65,
+	0x20, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x74, 0x65, 0x64, 0x20, 0x61, 0x70, 0x70,
+	0x73, 0x20, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x32, 0x3a,
+	0x01, 0x2a, 0x22, 0x2d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x2f, 0x76, 0x31,
+	0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x2d, 0x73, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x12, 0xc9, 0x02, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65,
+	0x6e, 0x63, 0x68, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x12, 0x25, 0x2e, 0x63,
+	0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65,
+	0x6e, 0x63, 0x68, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0xe2, 0x01, 0x92, 0x41, 0x9d, 0x01, 0x0a,
+	0x10, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x2b, 0x4c, 0x69, 0x73, 0x74, 0x20, 0x74, 0x68, 0x65, 0x20, 0x77, 0x6f, 0x72, 0x6b,
+	0x62, 0x65, 0x6e, 0x63, 0x68, 0x20, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x20,
+	0x6f, 0x66, 0x20, 0x61, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x1a, 0x5c,
+	0x54, 0x68, 0x69, 0x73, 0x20, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x20, 0x72, 0x65,
+	0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x62,
+	0x65, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x77, 0x6f, 0x72, 0x6b,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x2c, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6d, 0x6f, 0x73, 0x74, 0x20,
+	0x72, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x20, 0x66, 0x69, 0x72, 0x73, 0x74, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x3b, 0x12, 0x39, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x2f, 0x76, 0x31,
+	0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x77, 0x6f, 0x72,
+	0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x7d, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65,
+	0x6e, 0x63, 0x68, 0x2d, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x12, 0xc9, 0x02,
+	0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63,
+	0x68, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x26, 0x2e, 0x63, 0x68, 0x6f, 0x72,
+	0x75, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e,
+	0x63, 0x68, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x24, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0xdf, 0x01, 0x92, 0x41, 0xae, 0x01, 0x0a, 0x10,
+	0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x1b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x20, 0x61, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x62,
+	0x65, 0x6e, 0x63, 0x68, 0x20, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x1a, 0x7d, 0x54,
+	0x68, 0x69, 0x73, 0x20, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x20, 0x64, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x73, 0x20, 0x61, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68,
+	0x20, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x20, 0x61, 0x6c, 0x6f, 0x6e, 0x67, 0x20,
+	0x77, 0x69, 0x74, 0x68, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x69, 0x74, 0x73, 0x20, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x73, 0x2e, 0x20, 0x54, 0x68, 0x65, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68,
+	0x65, 0x73, 0x20, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x20, 0x66, 0x72, 0x6f, 0x6d,
+	0x20, 0x69, 0x74, 0x20, 0x61, 0x72, 0x65, 0x20, 0x6b, 0x65, 0x70, 0x74, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x27, 0x2a, 0x25, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x2f, 0x76, 0x31,
+	0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x2d, 0x73, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0xf4, 0x02, 0x0a, 0x13, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x4c, 0x6f, 0x67,
+	0x73, 0x12, 0x22, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x4c,
+	0x6f, 0x67, 0x4c, 0x69, 0x6e, 0x65, 0x22, 0xa5, 0x02, 0x92, 0x41, 0xf8, 0x01, 0x0a, 0x10, 0x57,
+	0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
+	0x2c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6c, 0x6f, 0x67, 0x73,
+	0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x20, 0x6f,
+	0x66, 0x20, 0x61, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x1a, 0xb5, 0x01,
+	0x54, 0x68, 0x69, 0x73, 0x20, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x20, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6c, 0x6f, 0x67, 0x73, 0x20, 0x6f,
+	0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x20,
+	0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x20, 0x6f, 0x66,
+	0x20, 0x61, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x2c, 0x20, 0x74, 0x6f,
+	0x20, 0x74, 0x68, 0x65, 0x20, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x20, 0x6f, 0x66, 0x20,
+	0x69, 0x74, 0x73, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x20, 0x53,
+	0x65, 0x6e, 0x64, 0x20, 0x27, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x3a, 0x20, 0x74, 0x65, 0x78,
+	0x74, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2d, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x27, 0x20,
+	0x74, 0x6f, 0x20, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x20, 0x74, 0x68, 0x65, 0x6d, 0x20,
+	0x61, 0x73, 0x20, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2d, 0x73, 0x65, 0x6e, 0x74, 0x20, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x12, 0x21, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65,
+	0x6e, 0x63, 0x68, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x6c, 0x6f, 0x67, 0x73, 0x30, 0x01,
+	0x42, 0xb3, 0x01, 0x92, 0x41, 0xa5, 0x01, 0x12, 0x7c, 0x0a, 0x18, 0x63, 0x68, 0x6f, 0x72, 0x75,
+	0x73, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x20, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x22, 0x5b, 0x0a, 0x18, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x20, 0x77, 0x6f,
+	0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
+	0x2c, 0x68, 0x74, 0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x43, 0x48, 0x4f, 0x52, 0x55, 0x53, 0x2d, 0x54, 0x52, 0x45, 0x2f, 0x63,
+	0x68, 0x6f, 0x72, 0x75, 0x73, 0x2d, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x1a, 0x11, 0x64,
+	0x65, 0x76, 0x40, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2d, 0x74, 0x72, 0x65, 0x2e, 0x63, 0x68,
+	0x32, 0x03, 0x31, 0x2e, 0x30, 0x2a, 0x01, 0x01, 0x32, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x10, 0x61, 0x70, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x5a, 0x08, 0x2e, 0x3b,
+	0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_workbench_service_proto_rawDescOnce sync.Once
+	file_workbench_service_proto_rawDescData = file_workbench_service_proto_rawDesc
+)
+
+func file_workbench_service_proto_rawDescGZIP() []byte {
+	file_workbench_service_proto_rawDescOnce.Do(func() {
+		file_workbench_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_workbench_service_proto_rawDescData)
+	})
+	return file_workbench_service_proto_rawDescData
+}
+
+var file_workbench_service_proto_msgTypes = make([]protoimpl.MessageInfo, 35)
+var file_workbench_service_proto_goTypes = []interface{}{
+	(*ListWorkbenchsRequest)(nil),           // 0: chorus.ListWorkbenchsRequest
+	(*WorkbenchFilter)(nil),                 // 1: chorus.WorkbenchFilter
+	(*WorkbenchSort)(nil),                   // 2: chorus.WorkbenchSort
+	(*ListWorkbenchsReply)(nil),             // 3: chorus.ListWorkbenchsReply
+	(*GetWorkbenchRequest)(nil),             // 4: chorus.GetWorkbenchRequest
+	(*GetWorkbenchResult)(nil),              // 5: chorus.GetWorkbenchResult
+	(*GetWorkbenchReply)(nil),               // 6: chorus.GetWorkbenchReply
+	(*CreateWorkbenchReply)(nil),            // 7: chorus.CreateWorkbenchReply
+	(*CreateWorkbenchResult)(nil),           // 8: chorus.CreateWorkbenchResult
+	(*UpdateWorkbenchRequest)(nil),          // 9: chorus.UpdateWorkbenchRequest
+	(*UpdateWorkbenchResult)(nil),           // 10: chorus.UpdateWorkbenchResult
+	(*UpdateWorkbenchReply)(nil),            // 11: chorus.UpdateWorkbenchReply
+	(*DeleteWorkbenchRequest)(nil),          // 12: chorus.DeleteWorkbenchRequest
+	(*DeleteWorkbenchResult)(nil),           // 13: chorus.DeleteWorkbenchResult
+	(*DeleteWorkbenchReply)(nil),            // 14: chorus.DeleteWorkbenchReply
+	(*RestoreWorkbenchRequest)(nil),         // 15: chorus.RestoreWorkbenchRequest
+	(*RestoreWorkbenchResult)(nil),          // 16: chorus.RestoreWorkbenchResult
+	(*RestoreWorkbenchReply)(nil),           // 17: chorus.RestoreWorkbenchReply
+	(*DescribeWorkbenchRequest)(nil),        // 18: chorus.DescribeWorkbenchRequest
+	(*DescribeWorkbenchResult)(nil),         // 19: chorus.DescribeWorkbenchResult
+	(*DescribeWorkbenchReply)(nil),          // 20: chorus.DescribeWorkbenchReply
+	(*ListWorkbenchVolumesRequest)(nil),     // 21: chorus.ListWorkbenchVolumesRequest
+	(*ListWorkbenchVolumesReply)(nil),       // 22: chorus.ListWorkbenchVolumesReply
+	(*SnapshotWorkbenchRequest)(nil),        // 23: chorus.SnapshotWorkbenchRequest
+	(*SnapshotWorkbenchResult)(nil),         // 24: chorus.SnapshotWorkbenchResult
+	(*SnapshotWorkbenchReply)(nil),          // 25: chorus.SnapshotWorkbenchReply
+	(*RestoreWorkbenchSnapshotRequest)(nil), // 26: chorus.RestoreWorkbenchSnapshotRequest
+	(*RestoreWorkbenchSnapshotResult)(nil),  // 27: chorus.RestoreWorkbenchSnapshotResult
+	(*RestoreWorkbenchSnapshotReply)(nil),   // 28: chorus.RestoreWorkbenchSnapshotReply
+	(*ListWorkbenchSnapshotsRequest)(nil),   // 29: chorus.ListWorkbenchSnapshotsRequest
+	(*ListWorkbenchSnapshotsReply)(nil),     // 30: chorus.ListWorkbenchSnapshotsReply
+	(*DeleteWorkbenchSnapshotRequest)(nil),  // 31: chorus.DeleteWorkbenchSnapshotRequest
+	(*DeleteWorkbenchSnapshotResult)(nil),   // 32: chorus.DeleteWorkbenchSnapshotResult
+	(*DeleteWorkbenchSnapshotReply)(nil),    // 33: chorus.DeleteWorkbenchSnapshotReply
+	(*StreamWorkbenchLogsRequest)(nil),      // 34: chorus.StreamWorkbenchLogsRequest
+	(*RequestCursor)(nil),                   // 35: chorus.RequestCursor
+	(*Workbench)(nil),                       // 36: chorus.Workbench
+	(*ResponseCursor)(nil),                  // 37: chorus.ResponseCursor
+	(*RuntimeDetails)(nil),                  // 38: chorus.RuntimeDetails
+	(*Volume)(nil),                          // 39: chorus.Volume
+	(*WorkbenchSnapshot)(nil),               // 40: chorus.WorkbenchSnapshot
+	(*timestamp.Timestamp)(nil),             // 41: google.protobuf.Timestamp
+	(*LogLine)(nil),                         // 42: chorus.LogLine
+}
+var file_workbench_service_proto_depIdxs = []int32{
+	35, // 0: chorus.ListWorkbenchsRequest.cursor:type_name -> chorus.RequestCursor
+	1,  // 1: chorus.ListWorkbenchsRequest.filter:type_name -> chorus.WorkbenchFilter
+	2,  // 2: chorus.ListWorkbenchsRequest.sort:type_name -> chorus.WorkbenchSort
+	36, // 3: chorus.ListWorkbenchsReply.result:type_name -> chorus.Workbench
+	37, // 4: chorus.ListWorkbenchsReply.cursor:type_name -> chorus.ResponseCursor
+	36, // 5: chorus.GetWorkbenchResult.workbench:type_name -> chorus.Workbench
+	38, // 6: chorus.GetWorkbenchResult.runtime:type_name -> chorus.RuntimeDetails
+	5,  // 7: chorus.GetWorkbenchReply.result:type_name -> chorus.GetWorkbenchResult
+	8,  // 8: chorus.CreateWorkbenchReply.result:type_name -> chorus.CreateWorkbenchResult
+	36, // 9: chorus.UpdateWorkbenchRequest.workbench:type_name -> chorus.Workbench
+	10, // 10: chorus.UpdateWorkbenchReply.result:type_name -> chorus.UpdateWorkbenchResult
+	13, // 11: chorus.DeleteWorkbenchReply.result:type_name -> chorus.DeleteWorkbenchResult
+	16, // 12: chorus.RestoreWorkbenchReply.result:type_name -> chorus.RestoreWorkbenchResult
+	36, // 13: chorus.DescribeWorkbenchResult.workbench:type_name -> chorus.Workbench
+	38, // 14: chorus.DescribeWorkbenchResult.runtime:type_name -> chorus.RuntimeDetails
+	19, // 15: chorus.DescribeWorkbenchReply.result:type_name -> chorus.DescribeWorkbenchResult
+	39, // 16: chorus.ListWorkbenchVolumesReply.result:type_name -> chorus.Volume
+	24, // 17: chorus.SnapshotWorkbenchReply.result:type_name -> chorus.SnapshotWorkbenchResult
+	27, // 18: chorus.RestoreWorkbenchSnapshotReply.result:type_name -> chorus.RestoreWorkbenchSnapshotResult
+	40, // 19: chorus.ListWorkbenchSnapshotsReply.result:type_name -> chorus.WorkbenchSnapshot
+	32, // 20: chorus.DeleteWorkbenchSnapshotReply.result:type_name -> chorus.DeleteWorkbenchSnapshotResult
+	41, // 21: chorus.StreamWorkbenchLogsRequest.sinceTime:type_name -> google.protobuf.Timestamp
+	4,  // 22: chorus.WorkbenchService.GetWorkbench:input_type -> chorus.GetWorkbenchRequest
+	0,  // 23: chorus.WorkbenchService.ListWorkbenchs:input_type -> chorus.ListWorkbenchsRequest
+	36, // 24: chorus.WorkbenchService.CreateWorkbench:input_type -> chorus.Workbench
+	9,  // 25: chorus.WorkbenchService.UpdateWorkbench:input_type -> chorus.UpdateWorkbenchRequest
+	12, // 26: chorus.WorkbenchService.DeleteWorkbench:input_type -> chorus.DeleteWorkbenchRequest
+	15, // 27: chorus.WorkbenchService.RestoreWorkbench:input_type -> chorus.RestoreWorkbenchRequest
+	18, // 28: chorus.WorkbenchService.DescribeWorkbench:input_type -> chorus.DescribeWorkbenchRequest
+	21, // 29: chorus.WorkbenchService.ListWorkbenchVolumes:input_type -> chorus.ListWorkbenchVolumesRequest
+	23, // 30: chorus.WorkbenchService.SnapshotWorkbench:input_type -> chorus.SnapshotWorkbenchRequest
+	26, // 31: chorus.WorkbenchService.RestoreWorkbenchSnapshot:input_type -> chorus.RestoreWorkbenchSnapshotRequest
+	29, // 32: chorus.WorkbenchService.ListWorkbenchSnapshots:input_type -> chorus.ListWorkbenchSnapshotsRequest
+	31, // 33: chorus.WorkbenchService.DeleteWorkbenchSnapshot:input_type -> chorus.DeleteWorkbenchSnapshotRequest
+	34, // 34: chorus.WorkbenchService.StreamWorkbenchLogs:input_type -> chorus.StreamWorkbenchLogsRequest
+	6,  // 35: chorus.WorkbenchService.GetWorkbench:output_type -> chorus.GetWorkbenchReply
+	3,  // 36: chorus.WorkbenchService.ListWorkbenchs:output_type -> chorus.ListWorkbenchsReply
+	7,  // 37: chorus.WorkbenchService.CreateWorkbench:output_type -> chorus.CreateWorkbenchReply
+	11, // 38: chorus.WorkbenchService.UpdateWorkbench:output_type -> chorus.UpdateWorkbenchReply
+	14, // 39: chorus.WorkbenchService.DeleteWorkbench:output_type -> chorus.DeleteWorkbenchReply
+	17, // 40: chorus.WorkbenchService.RestoreWorkbench:output_type -> chorus.RestoreWorkbenchReply
+	20, // 41: chorus.WorkbenchService.DescribeWorkbench:output_type -> chorus.DescribeWorkbenchReply
+	22, // 42: chorus.WorkbenchService.ListWorkbenchVolumes:output_type -> chorus.ListWorkbenchVolumesReply
+	25, // 43: chorus.WorkbenchService.SnapshotWorkbench:output_type -> chorus.SnapshotWorkbenchReply
+	28, // 44: chorus.WorkbenchService.RestoreWorkbenchSnapshot:output_type -> chorus.RestoreWorkbenchSnapshotReply
+	30, // 45: chorus.WorkbenchService.ListWorkbenchSnapshots:output_type -> chorus.ListWorkbenchSnapshotsReply
+	33, // 46: chorus.WorkbenchService.DeleteWorkbenchSnapshot:output_type -> chorus.DeleteWorkbenchSnapshotReply
+	42, // 47: chorus.WorkbenchService.StreamWorkbenchLogs:output_type -> chorus.LogLine
+	35, // [35:48] is the sub-list for method output_type
+	22, // [22:35] is the sub-list for method input_type
+	22, // [22:22] is the sub-list for extension type_name
+	22, // [22:22] is the sub-list for extension extendee
+	0,  // [0:22] is the sub-list for field type_name
+}
+
+func init() { file_workbench_service_proto_init() }
+func file_workbench_service_proto_init() {
+	if File_workbench_service_proto != nil {
+		return
+	}
+	file_common_proto_init()
+	file_cursor_proto_init()
+	file_workbench_proto_init()
+	file_runtime_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_workbench_service_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWorkbenchsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workbench_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WorkbenchFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workbench_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WorkbenchSort); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workbench_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWorkbenchsReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workbench_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetWorkbenchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workbench_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetWorkbenchResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workbench_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetWorkbenchReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workbench_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateWorkbenchReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workbench_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateWorkbenchResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workbench_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateWorkbenchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workbench_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateWorkbenchResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workbench_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateWorkbenchReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workbench_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteWorkbenchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workbench_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteWorkbenchResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workbench_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteWorkbenchReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workbench_service_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreWorkbenchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workbench_service_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreWorkbenchResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workbench_service_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreWorkbenchReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workbench_service_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DescribeWorkbenchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
 			case 2:
 				return &v.unknownFields
 			default:
 				return nil
 			}
 		}
-		file_workbench_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetWorkbenchRequest); i {
+		file_workbench_service_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DescribeWorkbenchResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -857,8 +2557,8 @@ func file_workbench_service_proto_init() {
 				return nil
 			}
 		}
-		file_workbench_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetWorkbenchResult); i {
+		file_workbench_service_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DescribeWorkbenchReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -869,8 +2569,8 @@ func file_workbench_service_proto_init() {
 				return nil
 			}
 		}
-		file_workbench_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetWorkbenchReply); i {
+		file_workbench_service_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWorkbenchVolumesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -881,8 +2581,8 @@ func file_workbench_service_proto_init() {
 				return nil
 			}
 		}
-		file_workbench_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateWorkbenchReply); i {
+		file_workbench_service_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWorkbenchVolumesReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -893,8 +2593,8 @@ func file_workbench_service_proto_init() {
 				return nil
 			}
 		}
-		file_workbench_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateWorkbenchResult); i {
+		file_workbench_service_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SnapshotWorkbenchRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -905,8 +2605,8 @@ func file_workbench_service_proto_init() {
 				return nil
 			}
 		}
-		file_workbench_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateWorkbenchRequest); i {
+		file_workbench_service_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SnapshotWorkbenchResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -917,8 +2617,8 @@ func file_workbench_service_proto_init() {
 				return nil
 			}
 		}
-		file_workbench_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateWorkbenchResult); i {
+		file_workbench_service_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SnapshotWorkbenchReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -929,8 +2629,8 @@ func file_workbench_service_proto_init() {
 				return nil
 			}
 		}
-		file_workbench_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateWorkbenchReply); i {
+		file_workbench_service_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreWorkbenchSnapshotRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -941,8 +2641,8 @@ func file_workbench_service_proto_init() {
 				return nil
 			}
 		}
-		file_workbench_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteWorkbenchRequest); i {
+		file_workbench_service_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreWorkbenchSnapshotResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -953,8 +2653,8 @@ func file_workbench_service_proto_init() {
 				return nil
 			}
 		}
-		file_workbench_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteWorkbenchResult); i {
+		file_workbench_service_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreWorkbenchSnapshotReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -965,8 +2665,68 @@ func file_workbench_service_proto_init() {
 				return nil
 			}
 		}
-		file_workbench_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteWorkbenchReply); i {
+		file_workbench_service_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWorkbenchSnapshotsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workbench_service_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWorkbenchSnapshotsReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workbench_service_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteWorkbenchSnapshotRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workbench_service_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteWorkbenchSnapshotResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workbench_service_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteWorkbenchSnapshotReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workbench_service_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamWorkbenchLogsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -984,7 +2744,7 @@ func file_workbench_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_workbench_service_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   13,
+			NumMessages:   35,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -1015,6 +2775,14 @@ type WorkbenchServiceClient interface {
 	CreateWorkbench(ctx context.Context, in *Workbench, opts ...grpc.CallOption) (*CreateWorkbenchReply, error)
 	UpdateWorkbench(ctx context.Context, in *UpdateWorkbenchRequest, opts ...grpc.CallOption) (*UpdateWorkbenchReply, error)
 	DeleteWorkbench(ctx context.Context, in *DeleteWorkbenchRequest, opts ...grpc.CallOption) (*DeleteWorkbenchReply, error)
+	RestoreWorkbench(ctx context.Context, in *RestoreWorkbenchRequest, opts ...grpc.CallOption) (*RestoreWorkbenchReply, error)
+	DescribeWorkbench(ctx context.Context, in *DescribeWorkbenchRequest, opts ...grpc.CallOption) (*DescribeWorkbenchReply, error)
+	ListWorkbenchVolumes(ctx context.Context, in *ListWorkbenchVolumesRequest, opts ...grpc.CallOption) (*ListWorkbenchVolumesReply, error)
+	SnapshotWorkbench(ctx context.Context, in *SnapshotWorkbenchRequest, opts ...grpc.CallOption) (*SnapshotWorkbenchReply, error)
+	RestoreWorkbenchSnapshot(ctx context.Context, in *RestoreWorkbenchSnapshotRequest, opts ...grpc.CallOption) (*RestoreWorkbenchSnapshotReply, error)
+	ListWorkbenchSnapshots(ctx context.Context, in *ListWorkbenchSnapshotsRequest, opts ...grpc.CallOption) (*ListWorkbenchSnapshotsReply, error)
+	DeleteWorkbenchSnapshot(ctx context.Context, in *DeleteWorkbenchSnapshotRequest, opts ...grpc.CallOption) (*DeleteWorkbenchSnapshotReply, error)
+	StreamWorkbenchLogs(ctx context.Context, in *StreamWorkbenchLogsRequest, opts ...grpc.CallOption) (WorkbenchService_StreamWorkbenchLogsClient, error)
 }
 
 type workbenchServiceClient struct {
@@ -1070,6 +2838,101 @@ func (c *workbenchServiceClient) DeleteWorkbench(ctx context.Context, in *Delete
 	return out, nil
 }
 
+func (c *workbenchServiceClient) RestoreWorkbench(ctx context.Context, in *RestoreWorkbenchRequest, opts ...grpc.CallOption) (*RestoreWorkbenchReply, error) {
+	out := new(RestoreWorkbenchReply)
+	err := c.cc.Invoke(ctx, "/chorus.WorkbenchService/RestoreWorkbench", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workbenchServiceClient) DescribeWorkbench(ctx context.Context, in *DescribeWorkbenchRequest, opts ...grpc.CallOption) (*DescribeWorkbenchReply, error) {
+	out := new(DescribeWorkbenchReply)
+	err := c.cc.Invoke(ctx, "/chorus.WorkbenchService/DescribeWorkbench", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workbenchServiceClient) ListWorkbenchVolumes(ctx context.Context, in *ListWorkbenchVolumesRequest, opts ...grpc.CallOption) (*ListWorkbenchVolumesReply, error) {
+	out := new(ListWorkbenchVolumesReply)
+	err := c.cc.Invoke(ctx, "/chorus.WorkbenchService/ListWorkbenchVolumes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workbenchServiceClient) SnapshotWorkbench(ctx context.Context, in *SnapshotWorkbenchRequest, opts ...grpc.CallOption) (*SnapshotWorkbenchReply, error) {
+	out := new(SnapshotWorkbenchReply)
+	err := c.cc.Invoke(ctx, "/chorus.WorkbenchService/SnapshotWorkbench", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workbenchServiceClient) RestoreWorkbenchSnapshot(ctx context.Context, in *RestoreWorkbenchSnapshotRequest, opts ...grpc.CallOption) (*RestoreWorkbenchSnapshotReply, error) {
+	out := new(RestoreWorkbenchSnapshotReply)
+	err := c.cc.Invoke(ctx, "/chorus.WorkbenchService/RestoreWorkbenchSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workbenchServiceClient) ListWorkbenchSnapshots(ctx context.Context, in *ListWorkbenchSnapshotsRequest, opts ...grpc.CallOption) (*ListWorkbenchSnapshotsReply, error) {
+	out := new(ListWorkbenchSnapshotsReply)
+	err := c.cc.Invoke(ctx, "/chorus.WorkbenchService/ListWorkbenchSnapshots", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workbenchServiceClient) DeleteWorkbenchSnapshot(ctx context.Context, in *DeleteWorkbenchSnapshotRequest, opts ...grpc.CallOption) (*DeleteWorkbenchSnapshotReply, error) {
+	out := new(DeleteWorkbenchSnapshotReply)
+	err := c.cc.Invoke(ctx, "/chorus.WorkbenchService/DeleteWorkbenchSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workbenchServiceClient) StreamWorkbenchLogs(ctx context.Context, in *StreamWorkbenchLogsRequest, opts ...grpc.CallOption) (WorkbenchService_StreamWorkbenchLogsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_WorkbenchService_serviceDesc.Streams[0], "/chorus.WorkbenchService/StreamWorkbenchLogs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &workbenchServiceStreamWorkbenchLogsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type WorkbenchService_StreamWorkbenchLogsClient interface {
+	Recv() (*LogLine, error)
+	grpc.ClientStream
+}
+
+type workbenchServiceStreamWorkbenchLogsClient struct {
+	grpc.ClientStream
+}
+
+func (x *workbenchServiceStreamWorkbenchLogsClient) Recv() (*LogLine, error) {
+	m := new(LogLine)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // WorkbenchServiceServer is the server API for WorkbenchService service.
 type WorkbenchServiceServer interface {
 	GetWorkbench(context.Context, *GetWorkbenchRequest) (*GetWorkbenchReply, error)
@@ -1077,6 +2940,14 @@ type WorkbenchServiceServer interface {
 	CreateWorkbench(context.Context, *Workbench) (*CreateWorkbenchReply, error)
 	UpdateWorkbench(context.Context, *UpdateWorkbenchRequest) (*UpdateWorkbenchReply, error)
 	DeleteWorkbench(context.Context, *DeleteWorkbenchRequest) (*DeleteWorkbenchReply, error)
+	RestoreWorkbench(context.Context, *RestoreWorkbenchRequest) (*RestoreWorkbenchReply, error)
+	DescribeWorkbench(context.Context, *DescribeWorkbenchRequest) (*DescribeWorkbenchReply, error)
+	ListWorkbenchVolumes(context.Context, *ListWorkbenchVolumesRequest) (*ListWorkbenchVolumesReply, error)
+	SnapshotWorkbench(context.Context, *SnapshotWorkbenchRequest) (*SnapshotWorkbenchReply, error)
+	RestoreWorkbenchSnapshot(context.Context, *RestoreWorkbenchSnapshotRequest) (*RestoreWorkbenchSnapshotReply, error)
+	ListWorkbenchSnapshots(context.Context, *ListWorkbenchSnapshotsRequest) (*ListWorkbenchSnapshotsReply, error)
+	DeleteWorkbenchSnapshot(context.Context, *DeleteWorkbenchSnapshotRequest) (*DeleteWorkbenchSnapshotReply, error)
+	StreamWorkbenchLogs(*StreamWorkbenchLogsRequest, WorkbenchService_StreamWorkbenchLogsServer) error
 }
 
 // UnimplementedWorkbenchServiceServer can be embedded to have forward compatible implementations.
@@ -1098,6 +2969,30 @@ func (*UnimplementedWorkbenchServiceServer) UpdateWorkbench(context.Context, *Up
 func (*UnimplementedWorkbenchServiceServer) DeleteWorkbench(context.Context, *DeleteWorkbenchRequest) (*DeleteWorkbenchReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteWorkbench not implemented")
 }
+func (*UnimplementedWorkbenchServiceServer) RestoreWorkbench(context.Context, *RestoreWorkbenchRequest) (*RestoreWorkbenchReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreWorkbench not implemented")
+}
+func (*UnimplementedWorkbenchServiceServer) DescribeWorkbench(context.Context, *DescribeWorkbenchRequest) (*DescribeWorkbenchReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeWorkbench not implemented")
+}
+func (*UnimplementedWorkbenchServiceServer) ListWorkbenchVolumes(context.Context, *ListWorkbenchVolumesRequest) (*ListWorkbenchVolumesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWorkbenchVolumes not implemented")
+}
+func (*UnimplementedWorkbenchServiceServer) SnapshotWorkbench(context.Context, *SnapshotWorkbenchRequest) (*SnapshotWorkbenchReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SnapshotWorkbench not implemented")
+}
+func (*UnimplementedWorkbenchServiceServer) RestoreWorkbenchSnapshot(context.Context, *RestoreWorkbenchSnapshotRequest) (*RestoreWorkbenchSnapshotReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreWorkbenchSnapshot not implemented")
+}
+func (*UnimplementedWorkbenchServiceServer) ListWorkbenchSnapshots(context.Context, *ListWorkbenchSnapshotsRequest) (*ListWorkbenchSnapshotsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWorkbenchSnapshots not implemented")
+}
+func (*UnimplementedWorkbenchServiceServer) DeleteWorkbenchSnapshot(context.Context, *DeleteWorkbenchSnapshotRequest) (*DeleteWorkbenchSnapshotReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWorkbenchSnapshot not implemented")
+}
+func (*UnimplementedWorkbenchServiceServer) StreamWorkbenchLogs(*StreamWorkbenchLogsRequest, WorkbenchService_StreamWorkbenchLogsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamWorkbenchLogs not implemented")
+}
 
 func RegisterWorkbenchServiceServer(s *grpc.Server, srv WorkbenchServiceServer) {
 	s.RegisterService(&_WorkbenchService_serviceDesc, srv)
@@ -1193,6 +3088,153 @@ func _WorkbenchService_DeleteWorkbench_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WorkbenchService_RestoreWorkbench_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreWorkbenchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkbenchServiceServer).RestoreWorkbench(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chorus.WorkbenchService/RestoreWorkbench",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkbenchServiceServer).RestoreWorkbench(ctx, req.(*RestoreWorkbenchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkbenchService_DescribeWorkbench_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeWorkbenchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkbenchServiceServer).DescribeWorkbench(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chorus.WorkbenchService/DescribeWorkbench",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkbenchServiceServer).DescribeWorkbench(ctx, req.(*DescribeWorkbenchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkbenchService_ListWorkbenchVolumes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWorkbenchVolumesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkbenchServiceServer).ListWorkbenchVolumes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chorus.WorkbenchService/ListWorkbenchVolumes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkbenchServiceServer).ListWorkbenchVolumes(ctx, req.(*ListWorkbenchVolumesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkbenchService_SnapshotWorkbench_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SnapshotWorkbenchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkbenchServiceServer).SnapshotWorkbench(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chorus.WorkbenchService/SnapshotWorkbench",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkbenchServiceServer).SnapshotWorkbench(ctx, req.(*SnapshotWorkbenchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkbenchService_RestoreWorkbenchSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreWorkbenchSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkbenchServiceServer).RestoreWorkbenchSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chorus.WorkbenchService/RestoreWorkbenchSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkbenchServiceServer).RestoreWorkbenchSnapshot(ctx, req.(*RestoreWorkbenchSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkbenchService_ListWorkbenchSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWorkbenchSnapshotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkbenchServiceServer).ListWorkbenchSnapshots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chorus.WorkbenchService/ListWorkbenchSnapshots",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkbenchServiceServer).ListWorkbenchSnapshots(ctx, req.(*ListWorkbenchSnapshotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkbenchService_DeleteWorkbenchSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWorkbenchSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkbenchServiceServer).DeleteWorkbenchSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chorus.WorkbenchService/DeleteWorkbenchSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkbenchServiceServer).DeleteWorkbenchSnapshot(ctx, req.(*DeleteWorkbenchSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkbenchService_StreamWorkbenchLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamWorkbenchLogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WorkbenchServiceServer).StreamWorkbenchLogs(m, &workbenchServiceStreamWorkbenchLogsServer{stream})
+}
+
+type WorkbenchService_StreamWorkbenchLogsServer interface {
+	Send(*LogLine) error
+	grpc.ServerStream
+}
+
+type workbenchServiceStreamWorkbenchLogsServer struct {
+	grpc.ServerStream
+}
+
+func (x *workbenchServiceStreamWorkbenchLogsServer) Send(m *LogLine) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _WorkbenchService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "chorus.WorkbenchService",
 	HandlerType: (*WorkbenchServiceServer)(nil),
@@ -1217,7 +3259,41 @@ var _WorkbenchService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteWorkbench",
 			Handler:    _WorkbenchService_DeleteWorkbench_Handler,
 		},
+		{
+			MethodName: "RestoreWorkbench",
+			Handler:    _WorkbenchService_RestoreWorkbench_Handler,
+		},
+		{
+			MethodName: "DescribeWorkbench",
+			Handler:    _WorkbenchService_DescribeWorkbench_Handler,
+		},
+		{
+			MethodName: "ListWorkbenchVolumes",
+			Handler:    _WorkbenchService_ListWorkbenchVolumes_Handler,
+		},
+		{
+			MethodName: "SnapshotWorkbench",
+			Handler:    _WorkbenchService_SnapshotWorkbench_Handler,
+		},
+		{
+			MethodName: "RestoreWorkbenchSnapshot",
+			Handler:    _WorkbenchService_RestoreWorkbenchSnapshot_Handler,
+		},
+		{
+			MethodName: "ListWorkbenchSnapshots",
+			Handler:    _WorkbenchService_ListWorkbenchSnapshots_Handler,
+		},
+		{
+			MethodName: "DeleteWorkbenchSnapshot",
+			Handler:    _WorkbenchService_DeleteWorkbenchSnapshot_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamWorkbenchLogs",
+			Handler:       _WorkbenchService_StreamWorkbenchLogs_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "workbench-service.proto",
 }
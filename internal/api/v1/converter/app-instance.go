@@ -24,11 +24,12 @@ func AppInstanceToBusiness(appInstance *chorus.AppInstance) (*model.AppInstance,
 	return &model.AppInstance{
 		ID: appInstance.Id,
 
-		TenantID:    appInstance.TenantId,
-		UserID:      appInstance.UserId,
-		AppID:       appInstance.AppId,
-		WorkspaceID: appInstance.WorkspaceId,
-		WorkbenchID: appInstance.WorkbenchId,
+		TenantID:     appInstance.TenantId,
+		UserID:       appInstance.UserId,
+		AppID:        appInstance.AppId,
+		WorkspaceID:  appInstance.WorkspaceId,
+		WorkbenchID:  appInstance.WorkbenchId,
+		AppVersionID: appInstance.AppVersionId,
 
 		Status: status,
 
@@ -46,19 +47,25 @@ func AppInstanceFromBusiness(appInstance *model.AppInstance) (*chorus.AppInstanc
 	if err != nil {
 		return nil, fmt.Errorf("unable to convert updatedAt timestamp: %w", err)
 	}
+	da, err := PointerToProtoTimestamp(appInstance.DeletedAt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert deletedAt timestamp: %w", err)
+	}
 
 	return &chorus.AppInstance{
 		Id: appInstance.ID,
 
-		TenantId:    appInstance.TenantID,
-		UserId:      appInstance.UserID,
-		AppId:       appInstance.AppID,
-		WorkspaceId: appInstance.WorkspaceID,
-		WorkbenchId: appInstance.WorkbenchID,
+		TenantId:     appInstance.TenantID,
+		UserId:       appInstance.UserID,
+		AppId:        appInstance.AppID,
+		WorkspaceId:  appInstance.WorkspaceID,
+		WorkbenchId:  appInstance.WorkbenchID,
+		AppVersionId: appInstance.AppVersionID,
 
 		Status: appInstance.Status.String(),
 
 		CreatedAt: ca,
 		UpdatedAt: ua,
+		DeletedAt: da,
 	}, nil
 }
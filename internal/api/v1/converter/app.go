@@ -35,6 +35,9 @@ func AppToBusiness(app *chorus.App) (*model.App, error) {
 		DockerImageName: app.DockerImageName,
 		DockerImageTag:  app.DockerImageTag,
 
+		CPURequest:    app.CpuRequest,
+		MemoryRequest: app.MemoryRequest,
+
 		CreatedAt: ca,
 		UpdatedAt: ua,
 	}, nil
@@ -49,6 +52,14 @@ func AppFromBusiness(app *model.App) (*chorus.App, error) {
 	if err != nil {
 		return nil, fmt.Errorf("unable to convert updatedAt timestamp: %w", err)
 	}
+	da, err := PointerToProtoTimestamp(app.DeletedAt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert deletedAt timestamp: %w", err)
+	}
+	var defaultVersionID uint64
+	if app.DefaultVersionID != nil {
+		defaultVersionID = *app.DefaultVersionID
+	}
 
 	return &chorus.App{
 		Id: app.ID,
@@ -61,8 +72,44 @@ func AppFromBusiness(app *model.App) (*chorus.App, error) {
 
 		Status: app.Status.String(),
 
-		DockerImageName: app.DockerImageName,
-		DockerImageTag:  app.DockerImageTag,
+		DockerImageName:   app.DockerImageName,
+		DockerImageTag:    app.DockerImageTag,
+		DockerImageDigest: app.DockerImageDigest,
+		DefaultVersionId:  defaultVersionID,
+
+		CpuRequest:    app.CPURequest,
+		MemoryRequest: app.MemoryRequest,
+
+		CreatedAt: ca,
+		UpdatedAt: ua,
+		DeletedAt: da,
+	}, nil
+}
+
+func AppVersionFromBusiness(version *model.AppVersion) (*chorus.AppVersion, error) {
+	ca, err := ToProtoTimestamp(version.CreatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert createdAt timestamp: %w", err)
+	}
+	ua, err := ToProtoTimestamp(version.UpdatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert updatedAt timestamp: %w", err)
+	}
+
+	return &chorus.AppVersion{
+		Id: version.ID,
+
+		TenantId: version.TenantID,
+		UserId:   version.UserID,
+		AppId:    version.AppID,
+
+		Version:    version.Version,
+		Changelog:  version.Changelog,
+		Deprecated: version.Deprecated,
+
+		DockerImageName:   version.DockerImageName,
+		DockerImageTag:    version.DockerImageTag,
+		DockerImageDigest: version.DockerImageDigest,
 
 		CreatedAt: ca,
 		UpdatedAt: ua,
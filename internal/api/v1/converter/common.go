@@ -27,6 +27,20 @@ func FromProtoBoolValue(b *wrappers.BoolValue) *bool {
 	return &b.Value
 }
 
+func FromProtoUInt64Value(v *wrappers.UInt64Value) *uint64 {
+	if v == nil {
+		return nil
+	}
+	return &v.Value
+}
+
+func ToProtoUInt64Value(v *uint64) *wrappers.UInt64Value {
+	if v == nil {
+		return nil
+	}
+	return &wrappers.UInt64Value{Value: *v}
+}
+
 func PointerToProtoTimestamp(t *time.Time) (*timestamp.Timestamp, error) {
 	if t == nil || t.IsZero() {
 		return nil, nil
@@ -0,0 +1,81 @@
+package converter
+
+import (
+	"fmt"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/api/v1/chorus"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/quota/model"
+)
+
+func QuotaToBusiness(quota *chorus.Quota) (*model.Quota, error) {
+	scope, err := model.ToQuotaScope(quota.Scope)
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert quota scope: %w", err)
+	}
+
+	return &model.Quota{
+		ID: quota.Id,
+
+		TenantID: quota.TenantId,
+		Scope:    scope,
+		ScopeID:  quota.ScopeId,
+
+		MaxWorkbenches:  FromProtoUInt64Value(quota.MaxWorkbenches),
+		MaxAppInstances: FromProtoUInt64Value(quota.MaxAppInstances),
+		MaxCPU:          FromProtoUInt64Value(quota.MaxCpu),
+		MaxMemory:       FromProtoUInt64Value(quota.MaxMemory),
+		MaxSnapshots:    FromProtoUInt64Value(quota.MaxSnapshots),
+	}, nil
+}
+
+func QuotaFromBusiness(quota *model.Quota) (*chorus.Quota, error) {
+	ca, err := ToProtoTimestamp(quota.CreatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert createdAt timestamp: %w", err)
+	}
+	ua, err := ToProtoTimestamp(quota.UpdatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert updatedAt timestamp: %w", err)
+	}
+
+	return &chorus.Quota{
+		Id: quota.ID,
+
+		TenantId: quota.TenantID,
+		Scope:    quota.Scope.String(),
+		ScopeId:  quota.ScopeID,
+
+		MaxWorkbenches:  ToProtoUInt64Value(quota.MaxWorkbenches),
+		MaxAppInstances: ToProtoUInt64Value(quota.MaxAppInstances),
+		MaxCpu:          ToProtoUInt64Value(quota.MaxCPU),
+		MaxMemory:       ToProtoUInt64Value(quota.MaxMemory),
+		MaxSnapshots:    ToProtoUInt64Value(quota.MaxSnapshots),
+
+		CreatedAt: ca,
+		UpdatedAt: ua,
+	}, nil
+}
+
+func QuotaUsageFromBusiness(usage *model.QuotaUsage) (*chorus.QuotaUsage, error) {
+	res := &chorus.QuotaUsage{
+		Scope:   usage.Scope.String(),
+		ScopeId: usage.ScopeID,
+		Usage: &chorus.Usage{
+			Workbenches:  usage.Usage.Workbenches,
+			AppInstances: usage.Usage.AppInstances,
+			Cpu:          usage.Usage.CPU,
+			Memory:       usage.Usage.Memory,
+			Snapshots:    usage.Usage.Snapshots,
+		},
+	}
+
+	if usage.Quota != nil {
+		quota, err := QuotaFromBusiness(usage.Quota)
+		if err != nil {
+			return nil, err
+		}
+		res.Quota = quota
+	}
+
+	return res, nil
+}
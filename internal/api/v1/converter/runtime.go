@@ -0,0 +1,90 @@
+package converter
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/api/v1/chorus"
+	"github.com/CHORUS-TRE/chorus-backend/internal/client/runtime"
+)
+
+func RuntimeDetailsFromBusiness(details *runtime.Details) (*chorus.RuntimeDetails, error) {
+	res := &chorus.RuntimeDetails{}
+
+	for _, pod := range details.Pods {
+		tgPod := &chorus.PodDetails{Name: pod.Name, Phase: pod.Phase}
+		for _, container := range pod.Containers {
+			tgContainer, err := containerDetailsFromBusiness(container)
+			if err != nil {
+				return nil, fmt.Errorf("unable to convert container %v: %w", container.Name, err)
+			}
+			tgPod.Containers = append(tgPod.Containers, tgContainer)
+		}
+		res.Pods = append(res.Pods, tgPod)
+	}
+
+	for _, event := range details.Events {
+		ls, err := ToProtoTimestamp(event.LastSeen)
+		if err != nil {
+			return nil, fmt.Errorf("unable to convert lastSeen timestamp: %w", err)
+		}
+		res.Events = append(res.Events, &chorus.RuntimeEvent{
+			Type:     event.Type,
+			Reason:   event.Reason,
+			Message:  event.Message,
+			Count:    event.Count,
+			LastSeen: ls,
+		})
+	}
+
+	return res, nil
+}
+
+func containerDetailsFromBusiness(container runtime.ContainerDetails) (*chorus.ContainerDetails, error) {
+	res := &chorus.ContainerDetails{
+		Name:           container.Name,
+		Image:          container.Image,
+		Ready:          container.Ready,
+		State:          container.State,
+		Reason:         container.Reason,
+		Message:        container.Message,
+		RestartCount:   container.RestartCount,
+		ImagePullError: container.ImagePullError,
+	}
+
+	if t := container.LastTermination; t != nil {
+		fa, err := ToProtoTimestamp(t.FinishedAt)
+		if err != nil {
+			return nil, fmt.Errorf("unable to convert finishedAt timestamp: %w", err)
+		}
+		res.LastTermination = &chorus.ContainerTermination{
+			Reason:     t.Reason,
+			Message:    t.Message,
+			ExitCode:   t.ExitCode,
+			FinishedAt: fa,
+		}
+	}
+
+	return res, nil
+}
+
+func VolumeFromBusiness(volume runtime.VolumeStatus, mountPath string) (*chorus.Volume, error) {
+	res := &chorus.Volume{
+		Name:          volume.Name,
+		Kind:          volume.Labels[runtime.LabelVolumeKind],
+		MountPath:     mountPath,
+		Phase:         volume.Phase,
+		CapacityBytes: volume.CapacityBytes,
+		UsedBytes:     ToProtoUInt64Value(volume.UsedBytes),
+	}
+
+	if userID, ok := volume.Labels[runtime.LabelUserID]; ok {
+		id, err := strconv.ParseUint(userID, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("unable to convert user id %q: %w", userID, err)
+		}
+		res.UserId = id
+	}
+
+	return res, nil
+}
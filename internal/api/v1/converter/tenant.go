@@ -0,0 +1,85 @@
+package converter
+
+import (
+	"fmt"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/api/v1/chorus"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/tenant/model"
+)
+
+func TenantFromBusiness(tenant *model.Tenant) (*chorus.Tenant, error) {
+	ca, err := ToProtoTimestamp(tenant.CreationDate)
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert createdAt timestamp: %w", err)
+	}
+	ua, err := ToProtoTimestamp(tenant.UpdateDate)
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert updatedAt timestamp: %w", err)
+	}
+
+	res := &chorus.Tenant{
+		Id:     tenant.ID,
+		Name:   tenant.Name,
+		Status: tenant.Status.String(),
+
+		CreatedAt: ca,
+		UpdatedAt: ua,
+	}
+
+	if s := tenant.Settings; s != nil {
+		res.Settings = &chorus.TenantSettings{
+			IpWhitelist: &chorus.TenantIPWhitelistSettings{
+				Enabled:     s.IPWhitelist.Enabled,
+				Subnetworks: s.IPWhitelist.Subnetworks,
+			},
+			Mailing: &chorus.TenantMailingSettings{
+				FromEmail: s.Mailing.FromEmail,
+				FromName:  s.Mailing.FromName,
+			},
+			Scim: &chorus.TenantSCIMSettings{
+				Enabled:  s.SCIM.Enabled,
+				Source:   s.SCIM.Source,
+				HasToken: s.SCIM.TokenHash != "",
+			},
+			Notifications: &chorus.TenantNotificationsSettings{
+				RetentionDays: uint32(s.Notifications.RetentionDays),
+			},
+			Registries: &chorus.TenantRegistriesSettings{
+				Allowed: s.Registries.Allowed,
+			},
+		}
+	}
+
+	return res, nil
+}
+
+// TenantSettingsToBusiness converts the settings of a tenant into an update
+// of the sections that are set, returning the SCIM token separately as only
+// its hash is stored.
+func TenantSettingsToBusiness(settings *chorus.TenantSettings) (model.TenantSettingsUpdate, string) {
+	var res model.TenantSettingsUpdate
+	if settings == nil {
+		return res, ""
+	}
+
+	if s := settings.IpWhitelist; s != nil {
+		res.IPWhitelist = &model.IPWhitelistSettings{Enabled: s.Enabled, Subnetworks: s.Subnetworks}
+	}
+	if s := settings.Mailing; s != nil {
+		res.Mailing = &model.MailingSettings{FromEmail: s.FromEmail, FromName: s.FromName}
+	}
+	if s := settings.Notifications; s != nil {
+		res.Notifications = &model.NotificationsSettings{RetentionDays: int(s.RetentionDays)}
+	}
+	if s := settings.Registries; s != nil {
+		res.Registries = &model.RegistriesSettings{Allowed: s.Allowed}
+	}
+
+	var token string
+	if s := settings.Scim; s != nil {
+		res.SCIM = &model.SCIMSettings{Enabled: s.Enabled, Source: s.Source}
+		token = s.Token
+	}
+
+	return res, token
+}
@@ -37,3 +37,29 @@ func UserFromBusiness(user *model.User) (*chorus.User, error) {
 		UpdatedAt:       ua,
 	}, nil
 }
+
+func RoleFromBusiness(role *model.Role) (*chorus.Role, error) {
+	ca, err := ToProtoTimestamp(role.CreatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert createdAt timestamp: %w", err)
+	}
+	ua, err := ToProtoTimestamp(role.UpdatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert updatedAt timestamp: %w", err)
+	}
+
+	var permissions []string
+	for _, p := range role.Permissions {
+		permissions = append(permissions, p.String())
+	}
+
+	return &chorus.Role{
+		Id:          role.ID,
+		Name:        role.Name,
+		Description: role.Description,
+		Permissions: permissions,
+		BuiltIn:     role.IsBuiltIn(),
+		CreatedAt:   ca,
+		UpdatedAt:   ua,
+	}, nil
+}
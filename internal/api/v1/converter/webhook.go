@@ -0,0 +1,87 @@
+package converter
+
+import (
+	"fmt"
+
+	"github.com/golang/protobuf/ptypes/wrappers"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/api/v1/chorus"
+	"github.com/CHORUS-TRE/chorus-backend/internal/utils"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/webhook/model"
+)
+
+func WebhookToBusiness(webhook *chorus.Webhook) (*model.Webhook, error) {
+	eventTypes, err := model.ToEventTypes(webhook.EventTypes)
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert webhook event types: %w", err)
+	}
+
+	return &model.Webhook{
+		ID: webhook.Id,
+
+		TenantID:   webhook.TenantId,
+		URL:        webhook.Url,
+		EventTypes: eventTypes,
+		Enabled:    webhook.Enabled,
+	}, nil
+}
+
+func WebhookFromBusiness(webhook *model.Webhook) (*chorus.Webhook, error) {
+	ca, err := ToProtoTimestamp(webhook.CreatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert createdAt timestamp: %w", err)
+	}
+	ua, err := ToProtoTimestamp(webhook.UpdatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert updatedAt timestamp: %w", err)
+	}
+
+	return &chorus.Webhook{
+		Id: webhook.ID,
+
+		TenantId:   webhook.TenantID,
+		Url:        webhook.URL,
+		EventTypes: webhook.EventTypes.Strings(),
+		Enabled:    webhook.Enabled,
+
+		CreatedAt: ca,
+		UpdatedAt: ua,
+	}, nil
+}
+
+func WebhookDeliveryFromBusiness(delivery *model.WebhookDelivery) (*chorus.WebhookDelivery, error) {
+	naa, err := ToProtoTimestamp(delivery.NextAttemptAt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert nextAttemptAt timestamp: %w", err)
+	}
+	da, err := PointerToProtoTimestamp(delivery.DeliveredAt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert deliveredAt timestamp: %w", err)
+	}
+	ca, err := ToProtoTimestamp(delivery.CreatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert createdAt timestamp: %w", err)
+	}
+
+	res := &chorus.WebhookDelivery{
+		Id:        delivery.ID,
+		WebhookId: delivery.WebhookID,
+
+		EventId:   delivery.EventID,
+		EventType: delivery.EventType.String(),
+		Payload:   delivery.Payload,
+
+		Status:        delivery.Status.String(),
+		Attempts:      uint32(delivery.Attempts),
+		LastError:     utils.ToString(delivery.LastError),
+		NextAttemptAt: naa,
+		DeliveredAt:   da,
+
+		CreatedAt: ca,
+	}
+	if delivery.ResponseCode != nil {
+		res.ResponseCode = &wrappers.UInt32Value{Value: uint32(*delivery.ResponseCode)}
+	}
+
+	return res, nil
+}
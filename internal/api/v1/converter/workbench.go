@@ -48,6 +48,10 @@ func WorkbenchFromBusiness(workbench *model.Workbench) (*chorus.Workbench, error
 	if err != nil {
 		return nil, fmt.Errorf("unable to convert updatedAt timestamp: %w", err)
 	}
+	da, err := PointerToProtoTimestamp(workbench.DeletedAt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert deletedAt timestamp: %w", err)
+	}
 
 	return &chorus.Workbench{
 		Id: workbench.ID,
@@ -62,6 +66,46 @@ func WorkbenchFromBusiness(workbench *model.Workbench) (*chorus.Workbench, error
 
 		Status: workbench.Status.String(),
 
+		CreatedAt: ca,
+		UpdatedAt: ua,
+		DeletedAt: da,
+	}, nil
+}
+
+func WorkbenchSnapshotFromBusiness(snapshot *model.WorkbenchSnapshot) (*chorus.WorkbenchSnapshot, error) {
+	ca, err := ToProtoTimestamp(snapshot.CreatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert createdAt timestamp: %w", err)
+	}
+	ua, err := ToProtoTimestamp(snapshot.UpdatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert updatedAt timestamp: %w", err)
+	}
+
+	apps := make([]*chorus.WorkbenchSnapshotApp, 0, len(snapshot.Content.Apps))
+	for _, app := range snapshot.Content.Apps {
+		apps = append(apps, &chorus.WorkbenchSnapshotApp{AppId: app.AppID, Name: app.Name, AppVersionId: app.AppVersionID})
+	}
+	volumes := make([]*chorus.WorkbenchSnapshotVolume, 0, len(snapshot.Content.Volumes))
+	for _, v := range snapshot.Content.Volumes {
+		volumes = append(volumes, &chorus.WorkbenchSnapshotVolume{Name: v.Name, MountPath: v.MountPath, Size: v.Size})
+	}
+
+	return &chorus.WorkbenchSnapshot{
+		Id: snapshot.ID,
+
+		TenantId:    snapshot.TenantID,
+		UserId:      snapshot.UserID,
+		WorkspaceId: snapshot.WorkspaceID,
+		WorkbenchId: snapshot.WorkbenchID,
+
+		Name:        snapshot.Name,
+		Description: snapshot.Description,
+		Status:      snapshot.Status.String(),
+
+		Apps:    apps,
+		Volumes: volumes,
+
 		CreatedAt: ca,
 		UpdatedAt: ua,
 	}, nil
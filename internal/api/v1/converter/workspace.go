@@ -47,6 +47,10 @@ func WorkspaceFromBusiness(workspace *model.Workspace) (*chorus.Workspace, error
 	if err != nil {
 		return nil, fmt.Errorf("unable to convert updatedAt timestamp: %w", err)
 	}
+	da, err := PointerToProtoTimestamp(workspace.DeletedAt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert deletedAt timestamp: %w", err)
+	}
 
 	return &chorus.Workspace{
 		Id: workspace.ID,
@@ -62,5 +66,21 @@ func WorkspaceFromBusiness(workspace *model.Workspace) (*chorus.Workspace, error
 
 		CreatedAt: ca,
 		UpdatedAt: ua,
+		DeletedAt: da,
 	}, nil
 }
+
+func WorkspaceTeardownFromBusiness(teardown *model.WorkspaceTeardown) *chorus.DeleteWorkspaceResult {
+	workbenchs := make([]*chorus.WorkbenchTeardown, 0, len(teardown.Workbenchs))
+	for _, wt := range teardown.Workbenchs {
+		workbenchs = append(workbenchs, &chorus.WorkbenchTeardown{
+			WorkbenchId: wt.WorkbenchID,
+			Uninstalled: wt.Uninstalled,
+			Error:       wt.Error,
+		})
+	}
+
+	return &chorus.DeleteWorkspaceResult{
+		Workbenchs: workbenchs,
+	}
+}
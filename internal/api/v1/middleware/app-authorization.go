@@ -5,6 +5,7 @@ import (
 
 	"github.com/CHORUS-TRE/chorus-backend/internal/api/v1/chorus"
 	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/user/model"
 )
 
 type appControllerAuthorization struct {
@@ -12,12 +13,12 @@ type appControllerAuthorization struct {
 	next chorus.AppServiceServer
 }
 
-func AppAuthorizing(logger *logger.ContextLogger, authorizedRoles []string) func(chorus.AppServiceServer) chorus.AppServiceServer {
+func AppAuthorizing(logger *logger.ContextLogger, resolver PermissionResolver) func(chorus.AppServiceServer) chorus.AppServiceServer {
 	return func(next chorus.AppServiceServer) chorus.AppServiceServer {
 		return &appControllerAuthorization{
 			authorization: authorization{
-				logger:          logger,
-				authorizedRoles: authorizedRoles,
+				logger:   logger,
+				resolver: resolver,
 			},
 			next: next,
 		}
@@ -27,7 +28,7 @@ func AppAuthorizing(logger *logger.ContextLogger, authorizedRoles []string) func
 func (c appControllerAuthorization) ListApps(ctx context.Context, req *chorus.ListAppsRequest) (*chorus.ListAppsReply, error) {
 	// TODO check for permission
 
-	err := c.IsAuthenticatedAndAuthorized(ctx)
+	err := c.IsAuthenticatedAndAuthorized(ctx, model.PermissionAppsRead)
 	if err != nil {
 		return nil, err
 	}
@@ -38,7 +39,7 @@ func (c appControllerAuthorization) ListApps(ctx context.Context, req *chorus.Li
 func (c appControllerAuthorization) GetApp(ctx context.Context, req *chorus.GetAppRequest) (*chorus.GetAppReply, error) {
 	// TODO check for permission
 
-	err := c.IsAuthenticatedAndAuthorized(ctx)
+	err := c.IsAuthenticatedAndAuthorized(ctx, model.PermissionAppsRead)
 	if err != nil {
 		return nil, err
 	}
@@ -49,7 +50,7 @@ func (c appControllerAuthorization) GetApp(ctx context.Context, req *chorus.GetA
 func (c appControllerAuthorization) CreateApp(ctx context.Context, req *chorus.App) (*chorus.CreateAppReply, error) {
 	// TODO check for permission
 
-	err := c.IsAuthenticatedAndAuthorized(ctx)
+	err := c.IsAuthenticatedAndAuthorized(ctx, model.PermissionAppsWrite)
 	if err != nil {
 		return nil, err
 	}
@@ -60,7 +61,7 @@ func (c appControllerAuthorization) CreateApp(ctx context.Context, req *chorus.A
 func (c appControllerAuthorization) UpdateApp(ctx context.Context, req *chorus.UpdateAppRequest) (*chorus.UpdateAppReply, error) {
 	// TODO check for permission
 
-	err := c.IsAuthenticatedAndAuthorized(ctx)
+	err := c.IsAuthenticatedAndAuthorized(ctx, model.PermissionAppsWrite)
 	if err != nil {
 		return nil, err
 	}
@@ -71,10 +72,50 @@ func (c appControllerAuthorization) UpdateApp(ctx context.Context, req *chorus.U
 func (c appControllerAuthorization) DeleteApp(ctx context.Context, req *chorus.DeleteAppRequest) (*chorus.DeleteAppReply, error) {
 	// TODO check for permission
 
-	err := c.IsAuthenticatedAndAuthorized(ctx)
+	err := c.IsAuthenticatedAndAuthorized(ctx, model.PermissionAppsWrite)
 	if err != nil {
 		return nil, err
 	}
 	//nolint: staticcheck
 	return c.next.DeleteApp(ctx, req)
 }
+
+func (c appControllerAuthorization) RestoreApp(ctx context.Context, req *chorus.RestoreAppRequest) (*chorus.RestoreAppReply, error) {
+	err := c.IsAuthenticatedAndAuthorized(ctx, model.PermissionAppsWrite)
+	if err != nil {
+		return nil, err
+	}
+	return c.next.RestoreApp(ctx, req)
+}
+
+func (c appControllerAuthorization) ResolveAppImage(ctx context.Context, req *chorus.ResolveAppImageRequest) (*chorus.ResolveAppImageReply, error) {
+	err := c.IsAuthenticatedAndAuthorized(ctx, model.PermissionAppsResolve)
+	if err != nil {
+		return nil, err
+	}
+	return c.next.ResolveAppImage(ctx, req)
+}
+
+func (c appControllerAuthorization) ListAppVersions(ctx context.Context, req *chorus.ListAppVersionsRequest) (*chorus.ListAppVersionsReply, error) {
+	err := c.IsAuthenticatedAndAuthorized(ctx, model.PermissionAppsRead)
+	if err != nil {
+		return nil, err
+	}
+	return c.next.ListAppVersions(ctx, req)
+}
+
+func (c appControllerAuthorization) CreateAppVersion(ctx context.Context, req *chorus.CreateAppVersionRequest) (*chorus.CreateAppVersionReply, error) {
+	err := c.IsAuthenticatedAndAuthorized(ctx, model.PermissionAppsWrite)
+	if err != nil {
+		return nil, err
+	}
+	return c.next.CreateAppVersion(ctx, req)
+}
+
+func (c appControllerAuthorization) UpdateAppVersion(ctx context.Context, req *chorus.UpdateAppVersionRequest) (*chorus.UpdateAppVersionReply, error) {
+	err := c.IsAuthenticatedAndAuthorized(ctx, model.PermissionAppsWrite)
+	if err != nil {
+		return nil, err
+	}
+	return c.next.UpdateAppVersion(ctx, req)
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/CHORUS-TRE/chorus-backend/internal/api/v1/chorus"
 	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/user/model"
 )
 
 type appInstanceControllerAuthorization struct {
@@ -12,12 +13,12 @@ type appInstanceControllerAuthorization struct {
 	next chorus.AppInstanceServiceServer
 }
 
-func AppInstanceAuthorizing(logger *logger.ContextLogger, authorizedRoles []string) func(chorus.AppInstanceServiceServer) chorus.AppInstanceServiceServer {
+func AppInstanceAuthorizing(logger *logger.ContextLogger, resolver PermissionResolver) func(chorus.AppInstanceServiceServer) chorus.AppInstanceServiceServer {
 	return func(next chorus.AppInstanceServiceServer) chorus.AppInstanceServiceServer {
 		return &appInstanceControllerAuthorization{
 			authorization: authorization{
-				logger:          logger,
-				authorizedRoles: authorizedRoles,
+				logger:   logger,
+				resolver: resolver,
 			},
 			next: next,
 		}
@@ -27,7 +28,7 @@ func AppInstanceAuthorizing(logger *logger.ContextLogger, authorizedRoles []stri
 func (c appInstanceControllerAuthorization) ListAppInstances(ctx context.Context, req *chorus.ListAppInstancesRequest) (*chorus.ListAppInstancesReply, error) {
 	// TODO check for permission
 
-	err := c.IsAuthenticatedAndAuthorized(ctx)
+	err := c.IsAuthenticatedAndAuthorized(ctx, model.PermissionAppInstancesRead)
 	if err != nil {
 		return nil, err
 	}
@@ -38,7 +39,7 @@ func (c appInstanceControllerAuthorization) ListAppInstances(ctx context.Context
 func (c appInstanceControllerAuthorization) GetAppInstance(ctx context.Context, req *chorus.GetAppInstanceRequest) (*chorus.GetAppInstanceReply, error) {
 	// TODO check for permission
 
-	err := c.IsAuthenticatedAndAuthorized(ctx)
+	err := c.IsAuthenticatedAndAuthorized(ctx, model.PermissionAppInstancesRead)
 	if err != nil {
 		return nil, err
 	}
@@ -49,7 +50,7 @@ func (c appInstanceControllerAuthorization) GetAppInstance(ctx context.Context,
 func (c appInstanceControllerAuthorization) CreateAppInstance(ctx context.Context, req *chorus.AppInstance) (*chorus.CreateAppInstanceReply, error) {
 	// TODO check for permission
 
-	err := c.IsAuthenticatedAndAuthorized(ctx)
+	err := c.IsAuthenticatedAndAuthorized(ctx, model.PermissionAppInstancesWrite)
 	if err != nil {
 		return nil, err
 	}
@@ -60,7 +61,7 @@ func (c appInstanceControllerAuthorization) CreateAppInstance(ctx context.Contex
 func (c appInstanceControllerAuthorization) UpdateAppInstance(ctx context.Context, req *chorus.UpdateAppInstanceRequest) (*chorus.UpdateAppInstanceReply, error) {
 	// TODO check for permission
 
-	err := c.IsAuthenticatedAndAuthorized(ctx)
+	err := c.IsAuthenticatedAndAuthorized(ctx, model.PermissionAppInstancesWrite)
 	if err != nil {
 		return nil, err
 	}
@@ -71,10 +72,34 @@ func (c appInstanceControllerAuthorization) UpdateAppInstance(ctx context.Contex
 func (c appInstanceControllerAuthorization) DeleteAppInstance(ctx context.Context, req *chorus.DeleteAppInstanceRequest) (*chorus.DeleteAppInstanceReply, error) {
 	// TODO check for permission
 
-	err := c.IsAuthenticatedAndAuthorized(ctx)
+	err := c.IsAuthenticatedAndAuthorized(ctx, model.PermissionAppInstancesWrite)
 	if err != nil {
 		return nil, err
 	}
 	//nolint: staticcheck
 	return c.next.DeleteAppInstance(ctx, req)
 }
+
+func (c appInstanceControllerAuthorization) RestoreAppInstance(ctx context.Context, req *chorus.RestoreAppInstanceRequest) (*chorus.RestoreAppInstanceReply, error) {
+	err := c.IsAuthenticatedAndAuthorized(ctx, model.PermissionAppInstancesWrite)
+	if err != nil {
+		return nil, err
+	}
+	return c.next.RestoreAppInstance(ctx, req)
+}
+
+func (c appInstanceControllerAuthorization) UpgradeAppInstance(ctx context.Context, req *chorus.UpgradeAppInstanceRequest) (*chorus.UpgradeAppInstanceReply, error) {
+	err := c.IsAuthenticatedAndAuthorized(ctx, model.PermissionAppInstancesWrite)
+	if err != nil {
+		return nil, err
+	}
+	return c.next.UpgradeAppInstance(ctx, req)
+}
+
+func (c appInstanceControllerAuthorization) StreamAppInstanceLogs(req *chorus.StreamAppInstanceLogsRequest, stream chorus.AppInstanceService_StreamAppInstanceLogsServer) error {
+	err := c.IsAuthenticatedAndAuthorized(stream.Context(), model.PermissionAppInstancesRead)
+	if err != nil {
+		return err
+	}
+	return c.next.StreamAppInstanceLogs(req, stream)
+}
@@ -5,79 +5,64 @@ import (
 
 	jwt_model "github.com/CHORUS-TRE/chorus-backend/internal/jwt/model"
 	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/user/model"
 
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
+// PermissionResolver resolves the permissions granted by the roles of a
+// tenant.
+type PermissionResolver interface {
+	GetRolesPermissions(ctx context.Context, tenantID uint64, roles []string) ([]model.Permission, error)
+}
+
 type authorization struct {
-	logger          *logger.ContextLogger
-	authorizedRoles []string
+	logger   *logger.ContextLogger
+	resolver PermissionResolver
 }
 
-func NewAuthorization(logger *logger.ContextLogger, authorizedRoles []string) authorization {
+func NewAuthorization(logger *logger.ContextLogger, resolver PermissionResolver) authorization {
 	return authorization{
 		logger,
-		authorizedRoles,
+		resolver,
 	}
 }
 
-func (c authorization) IsAuthenticatedAndAuthorized(ctx context.Context) error {
+// IsAuthenticatedAndAuthorized checks that the request is authenticated and
+// that one of the roles of the user grants the permission.
+func (c authorization) IsAuthenticatedAndAuthorized(ctx context.Context, permission model.Permission) error {
 	claims, ok := ctx.Value(jwt_model.JWTClaimsContextKey).(*jwt_model.JWTClaims)
 	if !ok {
 		c.logger.Warn(ctx, "malformed JWT token")
 		return status.Error(codes.Unauthenticated, "malformed jwt-token")
 	}
-	if !c.isAuthorized(claims.Roles) {
-		return c.permissionDenied(ctx, claims)
-	}
-	return nil
-}
 
-func (c authorization) IsAuthenticatedAndAuthorizedWithRoles(ctx context.Context, roles []string) error {
-	claims, ok := ctx.Value(jwt_model.JWTClaimsContextKey).(*jwt_model.JWTClaims)
-	if !ok {
-		c.logger.Warn(ctx, "malformed JWT token")
-		return status.Error(codes.Unauthenticated, "malformed jwt-token")
+	permissions, err := c.resolver.GetRolesPermissions(ctx, claims.TenantID, claims.Roles)
+	if err != nil {
+		c.logger.Error(ctx, "unable to resolve permissions", zap.Error(err))
+		return status.Error(codes.Internal, "unable to resolve permissions")
 	}
-	if !c.isAuthorized(claims.Roles) && !hasAnyOfRoles(claims.Roles, roles) {
-		return c.permissionDenied(ctx, claims)
+
+	if !hasPermission(permission, permissions) {
+		return c.permissionDenied(ctx, claims, permission)
 	}
 	return nil
 }
 
-func (c authorization) permissionDenied(ctx context.Context, claims *jwt_model.JWTClaims) error {
+func (c authorization) permissionDenied(ctx context.Context, claims *jwt_model.JWTClaims, permission model.Permission) error {
 	c.logger.Warn(ctx, "permission denied",
 		zap.Uint64("id", claims.ID),
 		zap.Uint64("tenant_id", claims.TenantID),
-		zap.Strings("roles", claims.Roles))
-	return status.Errorf(codes.PermissionDenied, "authorized roles: %v", c.authorizedRoles)
-}
-
-func (c authorization) isAuthorized(roles []string) bool {
-	for _, r := range roles {
-		for _, authorizedRole := range c.authorizedRoles {
-			if r == authorizedRole {
-				return true
-			}
-		}
-	}
-	return false
-}
-
-func hasRole(role string, roles []string) bool {
-	for _, r := range roles {
-		if r == role {
-			return true
-		}
-	}
-	return false
+		zap.Strings("roles", claims.Roles),
+		zap.String("permission", permission.String()))
+	return status.Errorf(codes.PermissionDenied, "missing permission: %v", permission)
 }
 
-func hasAnyOfRoles(roles []string, wantedRoles []string) bool {
-	for _, r := range roles {
-		if hasRole(r, wantedRoles) {
+func hasPermission(permission model.Permission, permissions []model.Permission) bool {
+	for _, p := range permissions {
+		if p == permission {
 			return true
 		}
 	}
@@ -1,27 +1,96 @@
 package middleware
 
 import (
+	"context"
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	jwt_model "github.com/CHORUS-TRE/chorus-backend/internal/jwt/model"
+	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/user/model"
 )
 
-func buildAuthorization(roles ...string) authorization {
-	return authorization{authorizedRoles: roles}
+type resolverMock map[string][]model.Permission
+
+func (r resolverMock) GetRolesPermissions(ctx context.Context, tenantID uint64, roles []string) ([]model.Permission, error) {
+	if tenantID == 0 {
+		return nil, errors.New("unknown tenant")
+	}
+	var permissions []model.Permission
+	for _, role := range roles {
+		permissions = append(permissions, r[role]...)
+	}
+	return permissions, nil
 }
 
-func TestIsAuthorized(t *testing.T) {
-	require.False(t, buildAuthorization("").isAuthorized([]string{"client"}))
-	require.False(t, buildAuthorization("admin").isAuthorized([]string{"client"}))
-	require.False(t, buildAuthorization("admin").isAuthorized([]string{""}))
-	require.True(t, buildAuthorization("admin").isAuthorized([]string{"admin"}))
-	require.True(t, buildAuthorization("admin").isAuthorized([]string{"admin", "role1"}))
-	require.True(t, buildAuthorization("admin", "role1").isAuthorized([]string{"admin"}))
-	require.True(t, buildAuthorization("admin", "role1").isAuthorized([]string{"admin", "role2"}))
+func TestIsAuthenticatedAndAuthorized(t *testing.T) {
+	auth := NewAuthorization(logger.NewContextLogger(zap.NewNop()), resolverMock{
+		"admin":         {model.PermissionUsersRead, model.PermissionUsersWrite},
+		"authenticated": {model.PermissionUsersSelf},
+	})
+
+	tests := []struct {
+		name       string
+		claims     *jwt_model.JWTClaims
+		permission model.Permission
+		expected   codes.Code
+	}{
+		{
+			name:       "Without claims, is unauthenticated",
+			permission: model.PermissionUsersSelf,
+			expected:   codes.Unauthenticated,
+		},
+		{
+			name:       "With a role granting the permission, is authorized",
+			claims:     &jwt_model.JWTClaims{TenantID: 1, Roles: []string{"authenticated"}},
+			permission: model.PermissionUsersSelf,
+			expected:   codes.OK,
+		},
+		{
+			name:       "With one of the roles granting the permission, is authorized",
+			claims:     &jwt_model.JWTClaims{TenantID: 1, Roles: []string{"authenticated", "admin"}},
+			permission: model.PermissionUsersWrite,
+			expected:   codes.OK,
+		},
+		{
+			name:       "Without a role granting the permission, is denied",
+			claims:     &jwt_model.JWTClaims{TenantID: 1, Roles: []string{"authenticated"}},
+			permission: model.PermissionUsersRead,
+			expected:   codes.PermissionDenied,
+		},
+		{
+			name:       "With an unknown role, is denied",
+			claims:     &jwt_model.JWTClaims{TenantID: 1, Roles: []string{"client"}},
+			permission: model.PermissionUsersSelf,
+			expected:   codes.PermissionDenied,
+		},
+		{
+			name:       "With unresolvable permissions, fails",
+			claims:     &jwt_model.JWTClaims{Roles: []string{"admin"}},
+			permission: model.PermissionUsersRead,
+			expected:   codes.Internal,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := context.Background()
+			if test.claims != nil {
+				ctx = context.WithValue(ctx, jwt_model.JWTClaimsContextKey, test.claims)
+			}
+			err := auth.IsAuthenticatedAndAuthorized(ctx, test.permission)
+			require.Equal(t, test.expected, status.Code(err))
+		})
+	}
 }
 
-func TestHasRole(t *testing.T) {
-	require.False(t, hasRole("", []string{"client"}))
-	require.False(t, hasRole("admin", []string{"client"}))
-	require.True(t, hasRole("admin", []string{"admin", "client"}))
+func TestHasPermission(t *testing.T) {
+	require.False(t, hasPermission(model.PermissionUsersRead, nil))
+	require.False(t, hasPermission(model.PermissionUsersRead, []model.Permission{model.PermissionUsersWrite}))
+	require.True(t, hasPermission(model.PermissionUsersRead, []model.Permission{model.PermissionUsersWrite, model.PermissionUsersRead}))
 }
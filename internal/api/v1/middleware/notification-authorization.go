@@ -5,6 +5,7 @@ import (
 
 	"github.com/CHORUS-TRE/chorus-backend/internal/api/v1/chorus"
 	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/user/model"
 	"github.com/golang/protobuf/ptypes/empty"
 )
 
@@ -13,12 +14,12 @@ type notificationControllerAuthorization struct {
 	next chorus.NotificationServiceServer
 }
 
-func NotificationAuthorizing(logger *logger.ContextLogger, authorizedRoles []string) func(chorus.NotificationServiceServer) chorus.NotificationServiceServer {
+func NotificationAuthorizing(logger *logger.ContextLogger, resolver PermissionResolver) func(chorus.NotificationServiceServer) chorus.NotificationServiceServer {
 	return func(next chorus.NotificationServiceServer) chorus.NotificationServiceServer {
 		return &notificationControllerAuthorization{
 			authorization: authorization{
-				logger:          logger,
-				authorizedRoles: authorizedRoles,
+				logger:   logger,
+				resolver: resolver,
 			},
 			next: next,
 		}
@@ -26,7 +27,7 @@ func NotificationAuthorizing(logger *logger.ContextLogger, authorizedRoles []str
 }
 
 func (c notificationControllerAuthorization) CountUnreadNotifications(ctx context.Context, empty *empty.Empty) (*chorus.CountUnreadNotificationsReply, error) {
-	err := c.IsAuthenticatedAndAuthorized(ctx)
+	err := c.IsAuthenticatedAndAuthorized(ctx, model.PermissionNotificationsRead)
 	if err != nil {
 		return nil, err
 	}
@@ -34,7 +35,7 @@ func (c notificationControllerAuthorization) CountUnreadNotifications(ctx contex
 	return c.next.CountUnreadNotifications(ctx, empty)
 }
 func (c notificationControllerAuthorization) MarkNotificationsAsRead(ctx context.Context, req *chorus.MarkNotificationsAsReadRequest) (*empty.Empty, error) {
-	err := c.IsAuthenticatedAndAuthorized(ctx)
+	err := c.IsAuthenticatedAndAuthorized(ctx, model.PermissionNotificationsWrite)
 	if err != nil {
 		return nil, err
 	}
@@ -42,10 +43,43 @@ func (c notificationControllerAuthorization) MarkNotificationsAsRead(ctx context
 	return c.next.MarkNotificationsAsRead(ctx, req)
 }
 func (c notificationControllerAuthorization) GetNotifications(ctx context.Context, req *chorus.GetNotificationsRequest) (*chorus.GetNotificationsReply, error) {
-	err := c.IsAuthenticatedAndAuthorized(ctx)
+	err := c.IsAuthenticatedAndAuthorized(ctx, model.PermissionNotificationsRead)
 	if err != nil {
 		return nil, err
 	}
 
 	return c.next.GetNotifications(ctx, req)
 }
+func (c notificationControllerAuthorization) StreamNotifications(empty *empty.Empty, stream chorus.NotificationService_StreamNotificationsServer) error {
+	err := c.IsAuthenticatedAndAuthorized(stream.Context(), model.PermissionNotificationsRead)
+	if err != nil {
+		return err
+	}
+
+	return c.next.StreamNotifications(empty, stream)
+}
+func (c notificationControllerAuthorization) GetNotificationPreferences(ctx context.Context, empty *empty.Empty) (*chorus.GetNotificationPreferencesReply, error) {
+	err := c.IsAuthenticatedAndAuthorized(ctx, model.PermissionNotificationsRead)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.next.GetNotificationPreferences(ctx, empty)
+}
+func (c notificationControllerAuthorization) UpdateNotificationPreferences(ctx context.Context, req *chorus.UpdateNotificationPreferencesRequest) (*empty.Empty, error) {
+	err := c.IsAuthenticatedAndAuthorized(ctx, model.PermissionNotificationsWrite)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.next.UpdateNotificationPreferences(ctx, req)
+}
+
+func (c notificationControllerAuthorization) CreateAnnouncement(ctx context.Context, req *chorus.CreateAnnouncementRequest) (*chorus.CreateAnnouncementReply, error) {
+	err := c.IsAuthenticatedAndAuthorized(ctx, model.PermissionNotificationsAnnounce)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.next.CreateAnnouncement(ctx, req)
+}
@@ -0,0 +1,60 @@
+package middleware
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes/empty"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/api/v1/chorus"
+	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/user/model"
+)
+
+type quotaControllerAuthorization struct {
+	authorization
+	next chorus.QuotaServiceServer
+}
+
+func QuotaAuthorizing(logger *logger.ContextLogger, resolver PermissionResolver) func(chorus.QuotaServiceServer) chorus.QuotaServiceServer {
+	return func(next chorus.QuotaServiceServer) chorus.QuotaServiceServer {
+		return &quotaControllerAuthorization{
+			authorization: authorization{
+				logger:   logger,
+				resolver: resolver,
+			},
+			next: next,
+		}
+	}
+}
+
+func (c quotaControllerAuthorization) GetUsage(ctx context.Context, req *chorus.GetUsageRequest) (*chorus.GetUsageReply, error) {
+	err := c.IsAuthenticatedAndAuthorized(ctx, model.PermissionQuotasRead)
+	if err != nil {
+		return nil, err
+	}
+	return c.next.GetUsage(ctx, req)
+}
+
+func (c quotaControllerAuthorization) ListQuotas(ctx context.Context, empty *empty.Empty) (*chorus.ListQuotasReply, error) {
+	err := c.IsAuthenticatedAndAuthorized(ctx, model.PermissionQuotasRead)
+	if err != nil {
+		return nil, err
+	}
+	return c.next.ListQuotas(ctx, empty)
+}
+
+func (c quotaControllerAuthorization) SetQuota(ctx context.Context, req *chorus.Quota) (*chorus.SetQuotaReply, error) {
+	err := c.IsAuthenticatedAndAuthorized(ctx, model.PermissionQuotasWrite)
+	if err != nil {
+		return nil, err
+	}
+	return c.next.SetQuota(ctx, req)
+}
+
+func (c quotaControllerAuthorization) DeleteQuota(ctx context.Context, req *chorus.DeleteQuotaRequest) (*chorus.DeleteQuotaReply, error) {
+	err := c.IsAuthenticatedAndAuthorized(ctx, model.PermissionQuotasWrite)
+	if err != nil {
+		return nil, err
+	}
+	return c.next.DeleteQuota(ctx, req)
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/CHORUS-TRE/chorus-backend/internal/api/v1/chorus"
 	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/user/model"
 )
 
 type stewardControllerAuthorization struct {
@@ -14,12 +15,12 @@ type stewardControllerAuthorization struct {
 	next chorus.StewardServiceServer
 }
 
-func StewardAuthorizing(logger *logger.ContextLogger, authorizedRoles []string) func(chorus.StewardServiceServer) chorus.StewardServiceServer {
+func StewardAuthorizing(logger *logger.ContextLogger, resolver PermissionResolver) func(chorus.StewardServiceServer) chorus.StewardServiceServer {
 	return func(next chorus.StewardServiceServer) chorus.StewardServiceServer {
 		return &stewardControllerAuthorization{
 			authorization: authorization{
-				logger:          logger,
-				authorizedRoles: authorizedRoles,
+				logger:   logger,
+				resolver: resolver,
 			},
 			next: next,
 		}
@@ -27,7 +28,7 @@ func StewardAuthorizing(logger *logger.ContextLogger, authorizedRoles []string)
 }
 
 func (c stewardControllerAuthorization) InitializeTenant(ctx context.Context, request *chorus.InitializeTenantRequest) (*empty.Empty, error) {
-	err := c.IsAuthenticatedAndAuthorized(ctx)
+	err := c.IsAuthenticatedAndAuthorized(ctx, model.PermissionTenantsInitialize)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,76 @@
+package middleware
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes/empty"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/api/v1/chorus"
+	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/user/model"
+)
+
+type tenantControllerAuthorization struct {
+	authorization
+	next chorus.TenantServiceServer
+}
+
+func TenantAuthorizing(logger *logger.ContextLogger, resolver PermissionResolver) func(chorus.TenantServiceServer) chorus.TenantServiceServer {
+	return func(next chorus.TenantServiceServer) chorus.TenantServiceServer {
+		return &tenantControllerAuthorization{
+			authorization: authorization{
+				logger:   logger,
+				resolver: resolver,
+			},
+			next: next,
+		}
+	}
+}
+
+func (c tenantControllerAuthorization) ListTenants(ctx context.Context, empty *empty.Empty) (*chorus.ListTenantsReply, error) {
+	err := c.IsAuthenticatedAndAuthorized(ctx, model.PermissionTenantsRead)
+	if err != nil {
+		return nil, err
+	}
+	return c.next.ListTenants(ctx, empty)
+}
+
+func (c tenantControllerAuthorization) GetTenant(ctx context.Context, req *chorus.GetTenantRequest) (*chorus.GetTenantReply, error) {
+	err := c.IsAuthenticatedAndAuthorized(ctx, model.PermissionTenantsRead)
+	if err != nil {
+		return nil, err
+	}
+	return c.next.GetTenant(ctx, req)
+}
+
+func (c tenantControllerAuthorization) UpdateTenant(ctx context.Context, req *chorus.UpdateTenantRequest) (*chorus.UpdateTenantReply, error) {
+	err := c.IsAuthenticatedAndAuthorized(ctx, model.PermissionTenantsWrite)
+	if err != nil {
+		return nil, err
+	}
+	return c.next.UpdateTenant(ctx, req)
+}
+
+func (c tenantControllerAuthorization) SuspendTenant(ctx context.Context, req *chorus.SuspendTenantRequest) (*chorus.SuspendTenantReply, error) {
+	err := c.IsAuthenticatedAndAuthorized(ctx, model.PermissionTenantsWrite)
+	if err != nil {
+		return nil, err
+	}
+	return c.next.SuspendTenant(ctx, req)
+}
+
+func (c tenantControllerAuthorization) ResumeTenant(ctx context.Context, req *chorus.ResumeTenantRequest) (*chorus.ResumeTenantReply, error) {
+	err := c.IsAuthenticatedAndAuthorized(ctx, model.PermissionTenantsWrite)
+	if err != nil {
+		return nil, err
+	}
+	return c.next.ResumeTenant(ctx, req)
+}
+
+func (c tenantControllerAuthorization) DeleteTenant(ctx context.Context, req *chorus.DeleteTenantRequest) (*chorus.DeleteTenantReply, error) {
+	err := c.IsAuthenticatedAndAuthorized(ctx, model.PermissionTenantsWrite)
+	if err != nil {
+		return nil, err
+	}
+	return c.next.DeleteTenant(ctx, req)
+}
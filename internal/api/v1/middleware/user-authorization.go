@@ -14,12 +14,12 @@ type userControllerAuthorization struct {
 	next chorus.UserServiceServer
 }
 
-func UserAuthorizing(logger *logger.ContextLogger, authorizedRoles []string) func(chorus.UserServiceServer) chorus.UserServiceServer {
+func UserAuthorizing(logger *logger.ContextLogger, resolver PermissionResolver) func(chorus.UserServiceServer) chorus.UserServiceServer {
 	return func(next chorus.UserServiceServer) chorus.UserServiceServer {
 		return &userControllerAuthorization{
 			authorization: authorization{
-				logger:          logger,
-				authorizedRoles: authorizedRoles,
+				logger:   logger,
+				resolver: resolver,
 			},
 			next: next,
 		}
@@ -27,7 +27,7 @@ func UserAuthorizing(logger *logger.ContextLogger, authorizedRoles []string) fun
 }
 
 func (c userControllerAuthorization) GetUsers(ctx context.Context, req *chorus.GetUsersRequest) (*chorus.GetUsersReply, error) {
-	err := c.IsAuthenticatedAndAuthorized(ctx)
+	err := c.IsAuthenticatedAndAuthorized(ctx, model.PermissionUsersRead)
 	if err != nil {
 		return nil, err
 	}
@@ -36,7 +36,7 @@ func (c userControllerAuthorization) GetUsers(ctx context.Context, req *chorus.G
 }
 
 func (c userControllerAuthorization) GetUser(ctx context.Context, req *chorus.GetUserRequest) (*chorus.GetUserReply, error) {
-	err := c.IsAuthenticatedAndAuthorized(ctx)
+	err := c.IsAuthenticatedAndAuthorized(ctx, model.PermissionUsersRead)
 	if err != nil {
 		return nil, err
 	}
@@ -54,7 +54,7 @@ func (c userControllerAuthorization) CreateUser(ctx context.Context, req *chorus
 }
 
 func (c userControllerAuthorization) GetUserMe(ctx context.Context, empty *empty.Empty) (*chorus.GetUserMeReply, error) {
-	err := c.IsAuthenticatedAndAuthorizedWithRoles(ctx, []string{model.RoleAuthenticated.String()})
+	err := c.IsAuthenticatedAndAuthorized(ctx, model.PermissionUsersSelf)
 	if err != nil {
 		return nil, err
 	}
@@ -63,7 +63,7 @@ func (c userControllerAuthorization) GetUserMe(ctx context.Context, empty *empty
 }
 
 func (c userControllerAuthorization) UpdatePassword(ctx context.Context, req *chorus.UpdatePasswordRequest) (*chorus.UpdatePasswordReply, error) {
-	err := c.IsAuthenticatedAndAuthorizedWithRoles(ctx, []string{model.RoleAuthenticated.String()})
+	err := c.IsAuthenticatedAndAuthorized(ctx, model.PermissionUsersSelf)
 	if err != nil {
 		return nil, err
 	}
@@ -72,7 +72,7 @@ func (c userControllerAuthorization) UpdatePassword(ctx context.Context, req *ch
 }
 
 func (c userControllerAuthorization) UpdateUser(ctx context.Context, req *chorus.UpdateUserRequest) (*chorus.UpdateUserReply, error) {
-	err := c.IsAuthenticatedAndAuthorized(ctx)
+	err := c.IsAuthenticatedAndAuthorized(ctx, model.PermissionUsersWrite)
 	if err != nil {
 		return nil, err
 	}
@@ -81,7 +81,7 @@ func (c userControllerAuthorization) UpdateUser(ctx context.Context, req *chorus
 }
 
 func (c userControllerAuthorization) DeleteUser(ctx context.Context, req *chorus.DeleteUserRequest) (*chorus.DeleteUserReply, error) {
-	err := c.IsAuthenticatedAndAuthorized(ctx)
+	err := c.IsAuthenticatedAndAuthorized(ctx, model.PermissionUsersWrite)
 	if err != nil {
 		return nil, err
 	}
@@ -90,7 +90,7 @@ func (c userControllerAuthorization) DeleteUser(ctx context.Context, req *chorus
 }
 
 func (c userControllerAuthorization) EnableTotp(ctx context.Context, req *chorus.EnableTotpRequest) (*chorus.EnableTotpReply, error) {
-	err := c.IsAuthenticatedAndAuthorizedWithRoles(ctx, []string{model.RoleAuthenticated.String()})
+	err := c.IsAuthenticatedAndAuthorized(ctx, model.PermissionUsersSelf)
 	if err != nil {
 		return nil, err
 	}
@@ -99,7 +99,7 @@ func (c userControllerAuthorization) EnableTotp(ctx context.Context, req *chorus
 }
 
 func (c userControllerAuthorization) ResetTotp(ctx context.Context, req *chorus.ResetTotpRequest) (*chorus.ResetTotpReply, error) {
-	err := c.IsAuthenticatedAndAuthorizedWithRoles(ctx, []string{model.RoleAuthenticated.String()})
+	err := c.IsAuthenticatedAndAuthorized(ctx, model.PermissionUsersSelf)
 	if err != nil {
 		return nil, err
 	}
@@ -108,10 +108,58 @@ func (c userControllerAuthorization) ResetTotp(ctx context.Context, req *chorus.
 }
 
 func (c userControllerAuthorization) ResetPassword(ctx context.Context, req *chorus.ResetPasswordRequest) (*chorus.ResetPasswordReply, error) {
-	err := c.IsAuthenticatedAndAuthorized(ctx)
+	err := c.IsAuthenticatedAndAuthorized(ctx, model.PermissionUsersWrite)
 	if err != nil {
 		return nil, err
 	}
 	//nolint: staticcheck
 	return c.next.ResetPassword(ctx, req)
 }
+
+func (c userControllerAuthorization) ListRoles(ctx context.Context, empty *empty.Empty) (*chorus.ListRolesReply, error) {
+	err := c.IsAuthenticatedAndAuthorized(ctx, model.PermissionRolesRead)
+	if err != nil {
+		return nil, err
+	}
+	return c.next.ListRoles(ctx, empty)
+}
+
+func (c userControllerAuthorization) GetRole(ctx context.Context, req *chorus.GetRoleRequest) (*chorus.GetRoleReply, error) {
+	err := c.IsAuthenticatedAndAuthorized(ctx, model.PermissionRolesRead)
+	if err != nil {
+		return nil, err
+	}
+	return c.next.GetRole(ctx, req)
+}
+
+func (c userControllerAuthorization) CreateRole(ctx context.Context, req *chorus.Role) (*chorus.CreateRoleReply, error) {
+	err := c.IsAuthenticatedAndAuthorized(ctx, model.PermissionRolesWrite)
+	if err != nil {
+		return nil, err
+	}
+	return c.next.CreateRole(ctx, req)
+}
+
+func (c userControllerAuthorization) UpdateRole(ctx context.Context, req *chorus.UpdateRoleRequest) (*chorus.UpdateRoleReply, error) {
+	err := c.IsAuthenticatedAndAuthorized(ctx, model.PermissionRolesWrite)
+	if err != nil {
+		return nil, err
+	}
+	return c.next.UpdateRole(ctx, req)
+}
+
+func (c userControllerAuthorization) DeleteRole(ctx context.Context, req *chorus.DeleteRoleRequest) (*chorus.DeleteRoleReply, error) {
+	err := c.IsAuthenticatedAndAuthorized(ctx, model.PermissionRolesWrite)
+	if err != nil {
+		return nil, err
+	}
+	return c.next.DeleteRole(ctx, req)
+}
+
+func (c userControllerAuthorization) ListPermissions(ctx context.Context, empty *empty.Empty) (*chorus.ListPermissionsReply, error) {
+	err := c.IsAuthenticatedAndAuthorized(ctx, model.PermissionRolesRead)
+	if err != nil {
+		return nil, err
+	}
+	return c.next.ListPermissions(ctx, empty)
+}
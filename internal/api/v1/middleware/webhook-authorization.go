@@ -0,0 +1,84 @@
+package middleware
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes/empty"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/api/v1/chorus"
+	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/user/model"
+)
+
+type webhookControllerAuthorization struct {
+	authorization
+	next chorus.WebhookServiceServer
+}
+
+func WebhookAuthorizing(logger *logger.ContextLogger, resolver PermissionResolver) func(chorus.WebhookServiceServer) chorus.WebhookServiceServer {
+	return func(next chorus.WebhookServiceServer) chorus.WebhookServiceServer {
+		return &webhookControllerAuthorization{
+			authorization: authorization{
+				logger:   logger,
+				resolver: resolver,
+			},
+			next: next,
+		}
+	}
+}
+
+func (c webhookControllerAuthorization) ListWebhooks(ctx context.Context, empty *empty.Empty) (*chorus.ListWebhooksReply, error) {
+	err := c.IsAuthenticatedAndAuthorized(ctx, model.PermissionWebhooksRead)
+	if err != nil {
+		return nil, err
+	}
+	return c.next.ListWebhooks(ctx, empty)
+}
+
+func (c webhookControllerAuthorization) GetWebhook(ctx context.Context, req *chorus.GetWebhookRequest) (*chorus.GetWebhookReply, error) {
+	err := c.IsAuthenticatedAndAuthorized(ctx, model.PermissionWebhooksRead)
+	if err != nil {
+		return nil, err
+	}
+	return c.next.GetWebhook(ctx, req)
+}
+
+func (c webhookControllerAuthorization) CreateWebhook(ctx context.Context, req *chorus.Webhook) (*chorus.CreateWebhookReply, error) {
+	err := c.IsAuthenticatedAndAuthorized(ctx, model.PermissionWebhooksWrite)
+	if err != nil {
+		return nil, err
+	}
+	return c.next.CreateWebhook(ctx, req)
+}
+
+func (c webhookControllerAuthorization) UpdateWebhook(ctx context.Context, req *chorus.Webhook) (*chorus.UpdateWebhookReply, error) {
+	err := c.IsAuthenticatedAndAuthorized(ctx, model.PermissionWebhooksWrite)
+	if err != nil {
+		return nil, err
+	}
+	return c.next.UpdateWebhook(ctx, req)
+}
+
+func (c webhookControllerAuthorization) DeleteWebhook(ctx context.Context, req *chorus.DeleteWebhookRequest) (*chorus.DeleteWebhookReply, error) {
+	err := c.IsAuthenticatedAndAuthorized(ctx, model.PermissionWebhooksWrite)
+	if err != nil {
+		return nil, err
+	}
+	return c.next.DeleteWebhook(ctx, req)
+}
+
+func (c webhookControllerAuthorization) ListWebhookDeliveries(ctx context.Context, req *chorus.ListWebhookDeliveriesRequest) (*chorus.ListWebhookDeliveriesReply, error) {
+	err := c.IsAuthenticatedAndAuthorized(ctx, model.PermissionWebhooksRead)
+	if err != nil {
+		return nil, err
+	}
+	return c.next.ListWebhookDeliveries(ctx, req)
+}
+
+func (c webhookControllerAuthorization) ReplayWebhookDelivery(ctx context.Context, req *chorus.ReplayWebhookDeliveryRequest) (*chorus.ReplayWebhookDeliveryReply, error) {
+	err := c.IsAuthenticatedAndAuthorized(ctx, model.PermissionWebhooksWrite)
+	if err != nil {
+		return nil, err
+	}
+	return c.next.ReplayWebhookDelivery(ctx, req)
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/CHORUS-TRE/chorus-backend/internal/api/v1/chorus"
 	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/user/model"
 )
 
 type workbenchControllerAuthorization struct {
@@ -12,12 +13,12 @@ type workbenchControllerAuthorization struct {
 	next chorus.WorkbenchServiceServer
 }
 
-func WorkbenchAuthorizing(logger *logger.ContextLogger, authorizedRoles []string) func(chorus.WorkbenchServiceServer) chorus.WorkbenchServiceServer {
+func WorkbenchAuthorizing(logger *logger.ContextLogger, resolver PermissionResolver) func(chorus.WorkbenchServiceServer) chorus.WorkbenchServiceServer {
 	return func(next chorus.WorkbenchServiceServer) chorus.WorkbenchServiceServer {
 		return &workbenchControllerAuthorization{
 			authorization: authorization{
-				logger:          logger,
-				authorizedRoles: authorizedRoles,
+				logger:   logger,
+				resolver: resolver,
 			},
 			next: next,
 		}
@@ -27,7 +28,7 @@ func WorkbenchAuthorizing(logger *logger.ContextLogger, authorizedRoles []string
 func (c workbenchControllerAuthorization) ListWorkbenchs(ctx context.Context, req *chorus.ListWorkbenchsRequest) (*chorus.ListWorkbenchsReply, error) {
 	// TODO check for permission
 
-	err := c.IsAuthenticatedAndAuthorized(ctx)
+	err := c.IsAuthenticatedAndAuthorized(ctx, model.PermissionWorkbenchesRead)
 	if err != nil {
 		return nil, err
 	}
@@ -38,7 +39,7 @@ func (c workbenchControllerAuthorization) ListWorkbenchs(ctx context.Context, re
 func (c workbenchControllerAuthorization) GetWorkbench(ctx context.Context, req *chorus.GetWorkbenchRequest) (*chorus.GetWorkbenchReply, error) {
 	// TODO check for permission
 
-	err := c.IsAuthenticatedAndAuthorized(ctx)
+	err := c.IsAuthenticatedAndAuthorized(ctx, model.PermissionWorkbenchesRead)
 	if err != nil {
 		return nil, err
 	}
@@ -49,7 +50,7 @@ func (c workbenchControllerAuthorization) GetWorkbench(ctx context.Context, req
 func (c workbenchControllerAuthorization) CreateWorkbench(ctx context.Context, req *chorus.Workbench) (*chorus.CreateWorkbenchReply, error) {
 	// TODO check for permission
 
-	err := c.IsAuthenticatedAndAuthorized(ctx)
+	err := c.IsAuthenticatedAndAuthorized(ctx, model.PermissionWorkbenchesWrite)
 	if err != nil {
 		return nil, err
 	}
@@ -60,7 +61,7 @@ func (c workbenchControllerAuthorization) CreateWorkbench(ctx context.Context, r
 func (c workbenchControllerAuthorization) UpdateWorkbench(ctx context.Context, req *chorus.UpdateWorkbenchRequest) (*chorus.UpdateWorkbenchReply, error) {
 	// TODO check for permission
 
-	err := c.IsAuthenticatedAndAuthorized(ctx)
+	err := c.IsAuthenticatedAndAuthorized(ctx, model.PermissionWorkbenchesWrite)
 	if err != nil {
 		return nil, err
 	}
@@ -71,10 +72,74 @@ func (c workbenchControllerAuthorization) UpdateWorkbench(ctx context.Context, r
 func (c workbenchControllerAuthorization) DeleteWorkbench(ctx context.Context, req *chorus.DeleteWorkbenchRequest) (*chorus.DeleteWorkbenchReply, error) {
 	// TODO check for permission
 
-	err := c.IsAuthenticatedAndAuthorized(ctx)
+	err := c.IsAuthenticatedAndAuthorized(ctx, model.PermissionWorkbenchesWrite)
 	if err != nil {
 		return nil, err
 	}
 	//nolint: staticcheck
 	return c.next.DeleteWorkbench(ctx, req)
 }
+
+func (c workbenchControllerAuthorization) RestoreWorkbench(ctx context.Context, req *chorus.RestoreWorkbenchRequest) (*chorus.RestoreWorkbenchReply, error) {
+	err := c.IsAuthenticatedAndAuthorized(ctx, model.PermissionWorkbenchesWrite)
+	if err != nil {
+		return nil, err
+	}
+	return c.next.RestoreWorkbench(ctx, req)
+}
+
+func (c workbenchControllerAuthorization) DescribeWorkbench(ctx context.Context, req *chorus.DescribeWorkbenchRequest) (*chorus.DescribeWorkbenchReply, error) {
+	err := c.IsAuthenticatedAndAuthorized(ctx, model.PermissionWorkbenchesRead)
+	if err != nil {
+		return nil, err
+	}
+	return c.next.DescribeWorkbench(ctx, req)
+}
+
+func (c workbenchControllerAuthorization) ListWorkbenchVolumes(ctx context.Context, req *chorus.ListWorkbenchVolumesRequest) (*chorus.ListWorkbenchVolumesReply, error) {
+	err := c.IsAuthenticatedAndAuthorized(ctx, model.PermissionWorkbenchesRead)
+	if err != nil {
+		return nil, err
+	}
+	return c.next.ListWorkbenchVolumes(ctx, req)
+}
+
+func (c workbenchControllerAuthorization) StreamWorkbenchLogs(req *chorus.StreamWorkbenchLogsRequest, stream chorus.WorkbenchService_StreamWorkbenchLogsServer) error {
+	err := c.IsAuthenticatedAndAuthorized(stream.Context(), model.PermissionWorkbenchesRead)
+	if err != nil {
+		return err
+	}
+	return c.next.StreamWorkbenchLogs(req, stream)
+}
+
+func (c workbenchControllerAuthorization) SnapshotWorkbench(ctx context.Context, req *chorus.SnapshotWorkbenchRequest) (*chorus.SnapshotWorkbenchReply, error) {
+	err := c.IsAuthenticatedAndAuthorized(ctx, model.PermissionWorkbenchesWrite)
+	if err != nil {
+		return nil, err
+	}
+	return c.next.SnapshotWorkbench(ctx, req)
+}
+
+func (c workbenchControllerAuthorization) RestoreWorkbenchSnapshot(ctx context.Context, req *chorus.RestoreWorkbenchSnapshotRequest) (*chorus.RestoreWorkbenchSnapshotReply, error) {
+	err := c.IsAuthenticatedAndAuthorized(ctx, model.PermissionWorkbenchesWrite)
+	if err != nil {
+		return nil, err
+	}
+	return c.next.RestoreWorkbenchSnapshot(ctx, req)
+}
+
+func (c workbenchControllerAuthorization) ListWorkbenchSnapshots(ctx context.Context, req *chorus.ListWorkbenchSnapshotsRequest) (*chorus.ListWorkbenchSnapshotsReply, error) {
+	err := c.IsAuthenticatedAndAuthorized(ctx, model.PermissionWorkbenchesRead)
+	if err != nil {
+		return nil, err
+	}
+	return c.next.ListWorkbenchSnapshots(ctx, req)
+}
+
+func (c workbenchControllerAuthorization) DeleteWorkbenchSnapshot(ctx context.Context, req *chorus.DeleteWorkbenchSnapshotRequest) (*chorus.DeleteWorkbenchSnapshotReply, error) {
+	err := c.IsAuthenticatedAndAuthorized(ctx, model.PermissionWorkbenchesWrite)
+	if err != nil {
+		return nil, err
+	}
+	return c.next.DeleteWorkbenchSnapshot(ctx, req)
+}
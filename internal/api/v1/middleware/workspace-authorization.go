@@ -5,6 +5,7 @@ import (
 
 	"github.com/CHORUS-TRE/chorus-backend/internal/api/v1/chorus"
 	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/user/model"
 )
 
 type workspaceControllerAuthorization struct {
@@ -12,12 +13,12 @@ type workspaceControllerAuthorization struct {
 	next chorus.WorkspaceServiceServer
 }
 
-func WorkspaceAuthorizing(logger *logger.ContextLogger, authorizedRoles []string) func(chorus.WorkspaceServiceServer) chorus.WorkspaceServiceServer {
+func WorkspaceAuthorizing(logger *logger.ContextLogger, resolver PermissionResolver) func(chorus.WorkspaceServiceServer) chorus.WorkspaceServiceServer {
 	return func(next chorus.WorkspaceServiceServer) chorus.WorkspaceServiceServer {
 		return &workspaceControllerAuthorization{
 			authorization: authorization{
-				logger:          logger,
-				authorizedRoles: authorizedRoles,
+				logger:   logger,
+				resolver: resolver,
 			},
 			next: next,
 		}
@@ -27,7 +28,7 @@ func WorkspaceAuthorizing(logger *logger.ContextLogger, authorizedRoles []string
 func (c workspaceControllerAuthorization) ListWorkspaces(ctx context.Context, req *chorus.ListWorkspacesRequest) (*chorus.ListWorkspacesReply, error) {
 	// TODO check for permission
 
-	err := c.IsAuthenticatedAndAuthorized(ctx)
+	err := c.IsAuthenticatedAndAuthorized(ctx, model.PermissionWorkspacesRead)
 	if err != nil {
 		return nil, err
 	}
@@ -38,7 +39,7 @@ func (c workspaceControllerAuthorization) ListWorkspaces(ctx context.Context, re
 func (c workspaceControllerAuthorization) GetWorkspace(ctx context.Context, req *chorus.GetWorkspaceRequest) (*chorus.GetWorkspaceReply, error) {
 	// TODO check for permission
 
-	err := c.IsAuthenticatedAndAuthorized(ctx)
+	err := c.IsAuthenticatedAndAuthorized(ctx, model.PermissionWorkspacesRead)
 	if err != nil {
 		return nil, err
 	}
@@ -46,10 +47,18 @@ func (c workspaceControllerAuthorization) GetWorkspace(ctx context.Context, req
 	return c.next.GetWorkspace(ctx, req)
 }
 
+func (c workspaceControllerAuthorization) ListWorkspaceVolumes(ctx context.Context, req *chorus.ListWorkspaceVolumesRequest) (*chorus.ListWorkspaceVolumesReply, error) {
+	err := c.IsAuthenticatedAndAuthorized(ctx, model.PermissionWorkspacesRead)
+	if err != nil {
+		return nil, err
+	}
+	return c.next.ListWorkspaceVolumes(ctx, req)
+}
+
 func (c workspaceControllerAuthorization) CreateWorkspace(ctx context.Context, req *chorus.Workspace) (*chorus.CreateWorkspaceReply, error) {
 	// TODO check for permission
 
-	err := c.IsAuthenticatedAndAuthorized(ctx)
+	err := c.IsAuthenticatedAndAuthorized(ctx, model.PermissionWorkspacesWrite)
 	if err != nil {
 		return nil, err
 	}
@@ -60,7 +69,7 @@ func (c workspaceControllerAuthorization) CreateWorkspace(ctx context.Context, r
 func (c workspaceControllerAuthorization) UpdateWorkspace(ctx context.Context, req *chorus.UpdateWorkspaceRequest) (*chorus.UpdateWorkspaceReply, error) {
 	// TODO check for permission
 
-	err := c.IsAuthenticatedAndAuthorized(ctx)
+	err := c.IsAuthenticatedAndAuthorized(ctx, model.PermissionWorkspacesWrite)
 	if err != nil {
 		return nil, err
 	}
@@ -71,10 +80,18 @@ func (c workspaceControllerAuthorization) UpdateWorkspace(ctx context.Context, r
 func (c workspaceControllerAuthorization) DeleteWorkspace(ctx context.Context, req *chorus.DeleteWorkspaceRequest) (*chorus.DeleteWorkspaceReply, error) {
 	// TODO check for permission
 
-	err := c.IsAuthenticatedAndAuthorized(ctx)
+	err := c.IsAuthenticatedAndAuthorized(ctx, model.PermissionWorkspacesWrite)
 	if err != nil {
 		return nil, err
 	}
 	//nolint: staticcheck
 	return c.next.DeleteWorkspace(ctx, req)
 }
+
+func (c workspaceControllerAuthorization) RestoreWorkspace(ctx context.Context, req *chorus.RestoreWorkspaceRequest) (*chorus.RestoreWorkspaceReply, error) {
+	err := c.IsAuthenticatedAndAuthorized(ctx, model.PermissionWorkspacesWrite)
+	if err != nil {
+		return nil, err
+	}
+	return c.next.RestoreWorkspace(ctx, req)
+}
@@ -12,7 +12,9 @@ import (
 	"github.com/CHORUS-TRE/chorus-backend/internal/api/v1/chorus"
 	"github.com/CHORUS-TRE/chorus-backend/internal/api/v1/converter"
 	jwt_model "github.com/CHORUS-TRE/chorus-backend/internal/jwt/model"
+	"github.com/CHORUS-TRE/chorus-backend/internal/notification"
 	"github.com/CHORUS-TRE/chorus-backend/internal/utils"
+	"github.com/CHORUS-TRE/chorus-backend/internal/utils/grpc"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/notification/model"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/notification/service"
 )
@@ -82,9 +84,10 @@ func (c NotificationController) GetNotifications(ctx context.Context, req *choru
 		return nil, status.Errorf(codes.Internal, "unable to call 'GetNotifications': %v", err.Error())
 	}
 
+	locale, _ := notification.LocaleFromContext(ctx)
 	var notifications []*chorus.Notification
 	for _, r := range res {
-		notification, err := notificationFromBusiness(r)
+		notification, err := notificationFromBusiness(r, locale)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "conversion error: %v", err.Error())
 		}
@@ -94,6 +97,134 @@ func (c NotificationController) GetNotifications(ctx context.Context, req *choru
 	return &chorus.GetNotificationsReply{Result: notifications, TotalItems: count}, nil
 }
 
+func (c NotificationController) StreamNotifications(empty *empty.Empty, stream chorus.NotificationService_StreamNotificationsServer) error {
+	ctx := stream.Context()
+
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, "could not extract tenant id from jwt-token")
+	}
+
+	userID, err := jwt_model.ExtractUserID(ctx)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, "could not extract user id from jwt-token")
+	}
+
+	locale, _ := notification.LocaleFromContext(ctx)
+	err = c.notification.StreamNotifications(ctx, service.StreamNotificationsRequest{TenantID: tenantID, UserID: userID}, func(n *model.Notification) error {
+		notification, err := notificationFromBusiness(n, locale)
+		if err != nil {
+			return fmt.Errorf("conversion error: %w", err)
+		}
+		return stream.Send(notification)
+	})
+	if err != nil {
+		return status.Errorf(grpc.ErrorCode(err), "unable to call 'StreamNotifications': %v", err.Error())
+	}
+
+	return nil
+}
+
+func (c NotificationController) GetNotificationPreferences(ctx context.Context, empty *empty.Empty) (*chorus.GetNotificationPreferencesReply, error) {
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "could not extract tenant id from jwt-token")
+	}
+
+	userID, err := jwt_model.ExtractUserID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "could not extract user id from jwt-token")
+	}
+
+	res, err := c.notification.GetNotificationPreferences(ctx, service.GetNotificationPreferencesRequest{TenantID: tenantID, UserID: userID})
+	if err != nil {
+		return nil, status.Errorf(grpc.ErrorCode(err), "unable to call 'GetNotificationPreferences': %v", err.Error())
+	}
+
+	var preferences []*chorus.NotificationPreference
+	for _, p := range res {
+		preferences = append(preferences, &chorus.NotificationPreference{
+			Type:           p.Type.String(),
+			EmailFrequency: p.EmailFrequency.String(),
+		})
+	}
+
+	return &chorus.GetNotificationPreferencesReply{Result: preferences}, nil
+}
+
+func (c NotificationController) UpdateNotificationPreferences(ctx context.Context, req *chorus.UpdateNotificationPreferencesRequest) (*empty.Empty, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "could not extract tenant id from jwt-token")
+	}
+
+	userID, err := jwt_model.ExtractUserID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "could not extract user id from jwt-token")
+	}
+
+	var preferences []*model.NotificationPreference
+	for _, p := range req.Preferences {
+		preferences = append(preferences, &model.NotificationPreference{
+			TenantID:       tenantID,
+			UserID:         userID,
+			Type:           model.NotificationType(p.Type),
+			EmailFrequency: model.EmailFrequency(p.EmailFrequency),
+		})
+	}
+
+	err = c.notification.UpdateNotificationPreferences(ctx, service.UpdateNotificationPreferencesRequest{
+		TenantID:    tenantID,
+		UserID:      userID,
+		Preferences: preferences,
+	})
+	if err != nil {
+		return nil, status.Errorf(grpc.ErrorCode(err), "unable to call 'UpdateNotificationPreferences': %v", err.Error())
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (c NotificationController) CreateAnnouncement(ctx context.Context, req *chorus.CreateAnnouncementRequest) (*chorus.CreateAnnouncementReply, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "could not extract tenant id from jwt-token")
+	}
+
+	startsAt, err := converter.FromProtoTimestamp(req.StartsAt)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "unable to convert startsAt timestamp: %v", err.Error())
+	}
+	endsAt, err := converter.FromProtoTimestamp(req.EndsAt)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "unable to convert endsAt timestamp: %v", err.Error())
+	}
+
+	id, recipients, err := c.notification.CreateAnnouncement(ctx, service.CreateAnnouncementRequest{
+		TenantID:     tenantID,
+		Message:      req.Message,
+		Link:         req.Link,
+		Pinned:       req.Pinned,
+		StartsAt:     utils.FromNullableTime(startsAt),
+		EndsAt:       utils.FromNullableTime(endsAt),
+		Roles:        req.Roles,
+		WorkspaceIDs: req.WorkspaceIds,
+	})
+	if err != nil {
+		return nil, status.Errorf(grpc.ErrorCode(err), "unable to call 'CreateAnnouncement': %v", err.Error())
+	}
+
+	return &chorus.CreateAnnouncementReply{Result: &chorus.CreateAnnouncementResult{Id: id, Recipients: uint32(recipients)}}, nil
+}
+
 func (c NotificationController) getNotificationToServiceRequest(tenantID, userID uint64, r *chorus.GetNotificationsRequest) service.GetNotificationsRequest {
 	if r.Pagination == nil {
 		r.Pagination = &chorus.PaginationQuery{}
@@ -119,7 +250,9 @@ func (c NotificationController) getNotificationToServiceRequest(tenantID, userID
 	}
 }
 
-func notificationFromBusiness(r *model.Notification) (*chorus.Notification, error) {
+// notificationFromBusiness converts a notification, rendering its message in
+// the locale of the user reading it.
+func notificationFromBusiness(r *model.Notification, locale model.Locale) (*chorus.Notification, error) {
 	ca, err := converter.ToProtoTimestamp(r.CreatedAt)
 	if err != nil {
 		return nil, fmt.Errorf("unable to convert createdAt timestamp: %w", err)
@@ -128,11 +261,25 @@ func notificationFromBusiness(r *model.Notification) (*chorus.Notification, erro
 	if err != nil {
 		return nil, fmt.Errorf("unable to convert createdAt timestamp: %w", err)
 	}
+	sa, err := converter.PointerToProtoTimestamp(r.StartsAt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert startsAt timestamp: %w", err)
+	}
+	ea, err := converter.PointerToProtoTimestamp(r.EndsAt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert endsAt timestamp: %w", err)
+	}
 	return &chorus.Notification{
 		Id:        r.ID,
 		TenantId:  r.TenantID,
-		Message:   r.Message,
+		Message:   r.LocalizedMessage(locale),
 		CreatedAt: ca,
 		ReadAt:    ra,
+		Type:      r.Type.String(),
+		Link:      r.Link,
+		Params:    r.Params,
+		Pinned:    r.Pinned,
+		StartsAt:  sa,
+		EndsAt:    ea,
 	}, nil
 }
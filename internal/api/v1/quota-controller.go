@@ -0,0 +1,119 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes/empty"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/api/v1/chorus"
+	"github.com/CHORUS-TRE/chorus-backend/internal/api/v1/converter"
+	jwt_model "github.com/CHORUS-TRE/chorus-backend/internal/jwt/model"
+	"github.com/CHORUS-TRE/chorus-backend/internal/utils/grpc"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/quota/service"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// QuotaController is the quota service controller handler.
+type QuotaController struct {
+	quota service.Quotaer
+}
+
+func NewQuotaController(quota service.Quotaer) QuotaController {
+	return QuotaController{quota: quota}
+}
+
+// GetUsage returns the usage of the tenant, of the requested workspace and of
+// the caller.
+func (c QuotaController) GetUsage(ctx context.Context, req *chorus.GetUsageRequest) (*chorus.GetUsageReply, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "could not extract tenant id from jwt-token")
+	}
+
+	userID, err := jwt_model.ExtractUserID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "could not extract user id from jwt-token")
+	}
+
+	res, err := c.quota.GetUsage(ctx, tenantID, req.WorkspaceId, userID)
+	if err != nil {
+		return nil, status.Errorf(grpc.ErrorCode(err), "unable to call 'GetUsage': %v", err.Error())
+	}
+
+	var usages []*chorus.QuotaUsage
+	for _, u := range res {
+		usage, err := converter.QuotaUsageFromBusiness(u)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "conversion error: %v", err.Error())
+		}
+		usages = append(usages, usage)
+	}
+	return &chorus.GetUsageReply{Result: usages}, nil
+}
+
+func (c QuotaController) ListQuotas(ctx context.Context, empty *empty.Empty) (*chorus.ListQuotasReply, error) {
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "could not extract tenant id from jwt-token")
+	}
+
+	res, err := c.quota.ListQuotas(ctx, tenantID)
+	if err != nil {
+		return nil, status.Errorf(grpc.ErrorCode(err), "unable to call 'ListQuotas': %v", err.Error())
+	}
+
+	var quotas []*chorus.Quota
+	for _, q := range res {
+		quota, err := converter.QuotaFromBusiness(q)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "conversion error: %v", err.Error())
+		}
+		quotas = append(quotas, quota)
+	}
+	return &chorus.ListQuotasReply{Result: quotas}, nil
+}
+
+func (c QuotaController) SetQuota(ctx context.Context, req *chorus.Quota) (*chorus.SetQuotaReply, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "could not extract tenant id from jwt-token")
+	}
+
+	quota, err := converter.QuotaToBusiness(req)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "conversion error: %v", err.Error())
+	}
+	quota.TenantID = tenantID
+
+	id, err := c.quota.SetQuota(ctx, quota)
+	if err != nil {
+		return nil, status.Errorf(grpc.ErrorCode(err), "unable to call 'SetQuota': %v", err.Error())
+	}
+	return &chorus.SetQuotaReply{Result: &chorus.SetQuotaResult{Id: id}}, nil
+}
+
+func (c QuotaController) DeleteQuota(ctx context.Context, req *chorus.DeleteQuotaRequest) (*chorus.DeleteQuotaReply, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "could not extract tenant id from jwt-token")
+	}
+
+	if err := c.quota.DeleteQuota(ctx, tenantID, req.Id); err != nil {
+		return nil, status.Errorf(grpc.ErrorCode(err), "unable to call 'DeleteQuota': %v", err.Error())
+	}
+	return &chorus.DeleteQuotaReply{Result: &chorus.DeleteQuotaResult{}}, nil
+}
@@ -0,0 +1,110 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes/empty"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/api/v1/chorus"
+	"github.com/CHORUS-TRE/chorus-backend/internal/api/v1/converter"
+	"github.com/CHORUS-TRE/chorus-backend/internal/utils/grpc"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/tenant/service"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TenantController is the tenant service controller handler.
+type TenantController struct {
+	tenant service.Tenanter
+}
+
+func NewTenantController(tenant service.Tenanter) TenantController {
+	return TenantController{tenant: tenant}
+}
+
+func (c TenantController) ListTenants(ctx context.Context, empty *empty.Empty) (*chorus.ListTenantsReply, error) {
+	res, err := c.tenant.ListTenants(ctx)
+	if err != nil {
+		return nil, status.Errorf(grpc.ErrorCode(err), "unable to call 'ListTenants': %v", err.Error())
+	}
+
+	var tenants []*chorus.Tenant
+	for _, t := range res {
+		tenant, err := converter.TenantFromBusiness(t)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "conversion error: %v", err.Error())
+		}
+		tenants = append(tenants, tenant)
+	}
+	return &chorus.ListTenantsReply{Result: tenants}, nil
+}
+
+func (c TenantController) GetTenant(ctx context.Context, req *chorus.GetTenantRequest) (*chorus.GetTenantReply, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	res, err := c.tenant.GetTenant(ctx, req.Id)
+	if err != nil {
+		return nil, status.Errorf(grpc.ErrorCode(err), "unable to call 'GetTenant': %v", err.Error())
+	}
+
+	tenant, err := converter.TenantFromBusiness(res)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "conversion error: %v", err.Error())
+	}
+	return &chorus.GetTenantReply{Result: &chorus.GetTenantResult{Tenant: tenant}}, nil
+}
+
+func (c TenantController) UpdateTenant(ctx context.Context, req *chorus.UpdateTenantRequest) (*chorus.UpdateTenantReply, error) {
+	if req == nil || req.Tenant == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	settings, scimToken := converter.TenantSettingsToBusiness(req.Tenant.Settings)
+
+	err := c.tenant.UpdateTenant(ctx, service.UpdateTenantReq{
+		ID:        req.Tenant.Id,
+		Name:      req.Tenant.Name,
+		Settings:  settings,
+		SCIMToken: scimToken,
+	})
+	if err != nil {
+		return nil, status.Errorf(grpc.ErrorCode(err), "unable to call 'UpdateTenant': %v", err.Error())
+	}
+	return &chorus.UpdateTenantReply{Result: &chorus.UpdateTenantResult{}}, nil
+}
+
+func (c TenantController) SuspendTenant(ctx context.Context, req *chorus.SuspendTenantRequest) (*chorus.SuspendTenantReply, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := c.tenant.SuspendTenant(ctx, req.Id); err != nil {
+		return nil, status.Errorf(grpc.ErrorCode(err), "unable to call 'SuspendTenant': %v", err.Error())
+	}
+	return &chorus.SuspendTenantReply{Result: &chorus.SuspendTenantResult{}}, nil
+}
+
+func (c TenantController) ResumeTenant(ctx context.Context, req *chorus.ResumeTenantRequest) (*chorus.ResumeTenantReply, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := c.tenant.ResumeTenant(ctx, req.Id); err != nil {
+		return nil, status.Errorf(grpc.ErrorCode(err), "unable to call 'ResumeTenant': %v", err.Error())
+	}
+	return &chorus.ResumeTenantReply{Result: &chorus.ResumeTenantResult{}}, nil
+}
+
+func (c TenantController) DeleteTenant(ctx context.Context, req *chorus.DeleteTenantRequest) (*chorus.DeleteTenantReply, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := c.tenant.DeleteTenant(ctx, req.Id); err != nil {
+		return nil, status.Errorf(grpc.ErrorCode(err), "unable to call 'DeleteTenant': %v", err.Error())
+	}
+	return &chorus.DeleteTenantReply{Result: &chorus.DeleteTenantResult{}}, nil
+}
@@ -3,6 +3,8 @@ package v1
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/golang/protobuf/ptypes/empty"
 	"google.golang.org/grpc/codes"
@@ -12,6 +14,7 @@ import (
 	"github.com/CHORUS-TRE/chorus-backend/internal/api/v1/converter"
 	jwt_model "github.com/CHORUS-TRE/chorus-backend/internal/jwt/model"
 	"github.com/CHORUS-TRE/chorus-backend/internal/utils/grpc"
+	"github.com/CHORUS-TRE/chorus-backend/internal/utils/pagination"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/user/model"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/user/service"
 )
@@ -148,6 +151,8 @@ func NewUserController(user service.Userer) UserController {
 }
 
 // GetUsers extracts the retrieved users from the service and inserts them into a reply object.
+// The users are paginated when a cursor is provided, and the total number of users
+// matching the filter is returned alongside.
 // Note that an admin role is required to call this procedure.
 func (c UserController) GetUsers(ctx context.Context, req *chorus.GetUsersRequest) (*chorus.GetUsersReply, error) {
 	if req == nil {
@@ -159,11 +164,47 @@ func (c UserController) GetUsers(ctx context.Context, req *chorus.GetUsersReques
 		return nil, status.Error(codes.InvalidArgument, "could not extract tenant id from jwt-token")
 	}
 
-	res, err := c.user.GetUsers(ctx, service.GetUsersReq{TenantID: tenantID})
+	var cursor *pagination.RequestCursor[pagination.KeysetCursor]
+	if req.Cursor != nil {
+		cursor, err = pagination.RequestCursorFromPb[pagination.KeysetCursor](req.Cursor)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid cursor: %v", err.Error())
+		}
+		if cursor.PageSize == 0 {
+			cursor.PageSize = 20
+		}
+	}
+
+	if req.Sort == nil {
+		req.Sort = &chorus.UserSort{Type: "ID", Order: "ASC"}
+	}
+	if req.Filter == nil {
+		req.Filter = &chorus.UserFilter{}
+	}
+
+	res, resCursor, totalItems, err := c.user.GetUsers(ctx, service.GetUsersReq{
+		TenantID: tenantID,
+		Cursor:   cursor,
+		Filter: service.UserFilter{
+			Status: strings.ToLower(req.Filter.Status),
+			Role:   strings.ToLower(req.Filter.Role),
+			Source: req.Filter.Source,
+			Search: req.Filter.Search,
+		},
+		Sort: service.Sort{
+			SortOrder: strings.ToUpper(req.Sort.Order),
+			SortType:  strings.ToUpper(req.Sort.Type),
+		},
+	})
 	if err != nil {
 		return nil, status.Errorf(grpc.ErrorCode(err), "unable to call 'GetUsers': %v", err.Error())
 	}
 
+	resCursorPb, err := resCursor.ToPb()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "conversion error: %v", err.Error())
+	}
+
 	var users []*chorus.User
 	for _, r := range res {
 		user, err := converter.UserFromBusiness(r)
@@ -172,7 +213,7 @@ func (c UserController) GetUsers(ctx context.Context, req *chorus.GetUsersReques
 		}
 		users = append(users, user)
 	}
-	return &chorus.GetUsersReply{Result: users}, nil
+	return &chorus.GetUsersReply{Result: users, Cursor: resCursorPb, TotalItems: totalItems}, nil
 }
 
 // CreateUser extracts the user from the request and passes it to the user service.
@@ -287,6 +328,119 @@ func (c UserController) ResetPassword(ctx context.Context, req *chorus.ResetPass
 	return &chorus.ResetPasswordReply{Result: &chorus.ResetPasswordResult{}}, nil
 }
 
+func (c UserController) ListRoles(ctx context.Context, empty *empty.Empty) (*chorus.ListRolesReply, error) {
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "could not extract tenant id from jwt-token")
+	}
+
+	res, err := c.user.ListRoles(ctx, tenantID)
+	if err != nil {
+		return nil, status.Errorf(grpc.ErrorCode(err), "unable to call 'ListRoles': %v", err.Error())
+	}
+
+	var roles []*chorus.Role
+	for _, r := range res {
+		role, err := converter.RoleFromBusiness(r)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "conversion error: %v", err.Error())
+		}
+		roles = append(roles, role)
+	}
+	return &chorus.ListRolesReply{Result: roles}, nil
+}
+
+func (c UserController) GetRole(ctx context.Context, req *chorus.GetRoleRequest) (*chorus.GetRoleReply, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "could not extract tenant id from jwt-token")
+	}
+
+	res, err := c.user.GetRole(ctx, tenantID, req.Id)
+	if err != nil {
+		return nil, status.Errorf(grpc.ErrorCode(err), "unable to call 'GetRole': %v", err.Error())
+	}
+
+	role, err := converter.RoleFromBusiness(res)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "conversion error: %v", err.Error())
+	}
+	return &chorus.GetRoleReply{Result: &chorus.GetRoleResult{Role: role}}, nil
+}
+
+func (c UserController) CreateRole(ctx context.Context, req *chorus.Role) (*chorus.CreateRoleReply, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "could not extract tenant id from jwt-token")
+	}
+
+	role, err := roleToServiceRequest(req)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "conversion error: %v", err.Error())
+	}
+
+	res, err := c.user.CreateRole(ctx, service.CreateRoleReq{TenantID: tenantID, Role: role})
+	if err != nil {
+		return nil, status.Errorf(grpc.ErrorCode(err), "unable to call 'CreateRole': %v", err.Error())
+	}
+	return &chorus.CreateRoleReply{Result: &chorus.CreateRoleResult{Id: res}}, nil
+}
+
+func (c UserController) UpdateRole(ctx context.Context, req *chorus.UpdateRoleRequest) (*chorus.UpdateRoleReply, error) {
+	if req == nil || req.Role == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "could not extract tenant id from jwt-token")
+	}
+
+	role, err := roleToServiceRequest(req.Role)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "conversion error: %v", err.Error())
+	}
+
+	if err := c.user.UpdateRole(ctx, service.UpdateRoleReq{TenantID: tenantID, Role: role}); err != nil {
+		return nil, status.Errorf(grpc.ErrorCode(err), "unable to call 'UpdateRole': %v", err.Error())
+	}
+	return &chorus.UpdateRoleReply{Result: &chorus.UpdateRoleResult{}}, nil
+}
+
+func (c UserController) DeleteRole(ctx context.Context, req *chorus.DeleteRoleRequest) (*chorus.DeleteRoleReply, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "could not extract tenant id from jwt-token")
+	}
+
+	if err := c.user.DeleteRole(ctx, tenantID, req.Id); err != nil {
+		return nil, status.Errorf(grpc.ErrorCode(err), "unable to call 'DeleteRole': %v", err.Error())
+	}
+	return &chorus.DeleteRoleReply{Result: &chorus.DeleteRoleResult{}}, nil
+}
+
+func (c UserController) ListPermissions(ctx context.Context, empty *empty.Empty) (*chorus.ListPermissionsReply, error) {
+	var permissions []*chorus.Permission
+	for p, description := range model.Permissions {
+		permissions = append(permissions, &chorus.Permission{Name: p.String(), Description: description})
+	}
+	sort.Slice(permissions, func(i, j int) bool { return permissions[i].Name < permissions[j].Name })
+
+	return &chorus.ListPermissionsReply{Result: permissions}, nil
+}
+
 // userToServiceRequest converts a chorus.User to a model.User.
 func userToServiceRequest(user *chorus.User) (*service.UserReq, error) {
 	ca, err := converter.FromProtoTimestamp(user.CreatedAt)
@@ -341,3 +495,17 @@ func userToUpdateServiceRequest(user *chorus.User) (*service.UserUpdateReq, erro
 		Roles:     roles,
 	}, nil
 }
+
+func roleToServiceRequest(role *chorus.Role) (*service.RoleReq, error) {
+	permissions, err := model.ToPermissions(role.Permissions)
+	if err != nil {
+		return nil, err
+	}
+
+	return &service.RoleReq{
+		ID:          role.Id,
+		Name:        strings.ToLower(role.Name),
+		Description: role.Description,
+		Permissions: permissions,
+	}, nil
+}
@@ -0,0 +1,179 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes/empty"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/api/v1/chorus"
+	"github.com/CHORUS-TRE/chorus-backend/internal/api/v1/converter"
+	jwt_model "github.com/CHORUS-TRE/chorus-backend/internal/jwt/model"
+	"github.com/CHORUS-TRE/chorus-backend/internal/utils/grpc"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/webhook/service"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// WebhookController is the webhook service controller handler.
+type WebhookController struct {
+	webhook service.Webhooker
+}
+
+func NewWebhookController(webhook service.Webhooker) WebhookController {
+	return WebhookController{webhook: webhook}
+}
+
+func (c WebhookController) ListWebhooks(ctx context.Context, empty *empty.Empty) (*chorus.ListWebhooksReply, error) {
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "could not extract tenant id from jwt-token")
+	}
+
+	res, err := c.webhook.ListWebhooks(ctx, tenantID)
+	if err != nil {
+		return nil, status.Errorf(grpc.ErrorCode(err), "unable to call 'ListWebhooks': %v", err.Error())
+	}
+
+	var webhooks []*chorus.Webhook
+	for _, w := range res {
+		webhook, err := converter.WebhookFromBusiness(w)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "conversion error: %v", err.Error())
+		}
+		webhooks = append(webhooks, webhook)
+	}
+	return &chorus.ListWebhooksReply{Result: webhooks}, nil
+}
+
+func (c WebhookController) GetWebhook(ctx context.Context, req *chorus.GetWebhookRequest) (*chorus.GetWebhookReply, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "could not extract tenant id from jwt-token")
+	}
+
+	res, err := c.webhook.GetWebhook(ctx, tenantID, req.Id)
+	if err != nil {
+		return nil, status.Errorf(grpc.ErrorCode(err), "unable to call 'GetWebhook': %v", err.Error())
+	}
+
+	webhook, err := converter.WebhookFromBusiness(res)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "conversion error: %v", err.Error())
+	}
+	return &chorus.GetWebhookReply{Result: &chorus.GetWebhookResult{Webhook: webhook}}, nil
+}
+
+// CreateWebhook creates a webhook and returns the secret signing its
+// deliveries, which cannot be read afterwards.
+func (c WebhookController) CreateWebhook(ctx context.Context, req *chorus.Webhook) (*chorus.CreateWebhookReply, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "could not extract tenant id from jwt-token")
+	}
+
+	webhook, err := converter.WebhookToBusiness(req)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "conversion error: %v", err.Error())
+	}
+	webhook.TenantID = tenantID
+
+	id, secret, err := c.webhook.CreateWebhook(ctx, webhook)
+	if err != nil {
+		return nil, status.Errorf(grpc.ErrorCode(err), "unable to call 'CreateWebhook': %v", err.Error())
+	}
+	return &chorus.CreateWebhookReply{Result: &chorus.CreateWebhookResult{Id: id, Secret: secret}}, nil
+}
+
+func (c WebhookController) UpdateWebhook(ctx context.Context, req *chorus.Webhook) (*chorus.UpdateWebhookReply, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "could not extract tenant id from jwt-token")
+	}
+
+	webhook, err := converter.WebhookToBusiness(req)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "conversion error: %v", err.Error())
+	}
+	webhook.TenantID = tenantID
+
+	if err := c.webhook.UpdateWebhook(ctx, webhook); err != nil {
+		return nil, status.Errorf(grpc.ErrorCode(err), "unable to call 'UpdateWebhook': %v", err.Error())
+	}
+	return &chorus.UpdateWebhookReply{Result: &chorus.UpdateWebhookResult{}}, nil
+}
+
+func (c WebhookController) DeleteWebhook(ctx context.Context, req *chorus.DeleteWebhookRequest) (*chorus.DeleteWebhookReply, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "could not extract tenant id from jwt-token")
+	}
+
+	if err := c.webhook.DeleteWebhook(ctx, tenantID, req.Id); err != nil {
+		return nil, status.Errorf(grpc.ErrorCode(err), "unable to call 'DeleteWebhook': %v", err.Error())
+	}
+	return &chorus.DeleteWebhookReply{Result: &chorus.DeleteWebhookResult{}}, nil
+}
+
+func (c WebhookController) ListWebhookDeliveries(ctx context.Context, req *chorus.ListWebhookDeliveriesRequest) (*chorus.ListWebhookDeliveriesReply, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "could not extract tenant id from jwt-token")
+	}
+
+	pagination := converter.PaginationToBusiness(req.Pagination)
+
+	res, err := c.webhook.ListWebhookDeliveries(ctx, tenantID, req.WebhookId, pagination)
+	if err != nil {
+		return nil, status.Errorf(grpc.ErrorCode(err), "unable to call 'ListWebhookDeliveries': %v", err.Error())
+	}
+
+	var deliveries []*chorus.WebhookDelivery
+	for _, d := range res {
+		delivery, err := converter.WebhookDeliveryFromBusiness(d)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "conversion error: %v", err.Error())
+		}
+		deliveries = append(deliveries, delivery)
+	}
+	return &chorus.ListWebhookDeliveriesReply{Result: deliveries}, nil
+}
+
+// ReplayWebhookDelivery posts the event of a logged delivery to the webhook
+// again, as a new delivery.
+func (c WebhookController) ReplayWebhookDelivery(ctx context.Context, req *chorus.ReplayWebhookDeliveryRequest) (*chorus.ReplayWebhookDeliveryReply, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "could not extract tenant id from jwt-token")
+	}
+
+	id, err := c.webhook.ReplayWebhookDelivery(ctx, tenantID, req.WebhookId, req.Id)
+	if err != nil {
+		return nil, status.Errorf(grpc.ErrorCode(err), "unable to call 'ReplayWebhookDelivery': %v", err.Error())
+	}
+	return &chorus.ReplayWebhookDeliveryReply{Result: &chorus.ReplayWebhookDeliveryResult{Id: id}}, nil
+}
@@ -2,11 +2,13 @@ package v1
 
 import (
 	"context"
+	"strings"
 
 	"github.com/CHORUS-TRE/chorus-backend/internal/api/v1/chorus"
 	"github.com/CHORUS-TRE/chorus-backend/internal/api/v1/converter"
 	jwt_model "github.com/CHORUS-TRE/chorus-backend/internal/jwt/model"
 	"github.com/CHORUS-TRE/chorus-backend/internal/utils/grpc"
+	"github.com/CHORUS-TRE/chorus-backend/internal/utils/pagination"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/workbench/service"
 
 	"google.golang.org/grpc/codes"
@@ -38,7 +40,65 @@ func (c WorkbenchController) GetWorkbench(ctx context.Context, req *chorus.GetWo
 		return nil, status.Errorf(codes.Internal, "conversion error: %v", err.Error())
 	}
 
-	return &chorus.GetWorkbenchReply{Result: &chorus.GetWorkbenchResult{Workbench: tgWorkbench}}, nil
+	result := &chorus.GetWorkbenchResult{Workbench: tgWorkbench}
+	if req.IncludeRuntime {
+		result.Runtime, err = c.getWorkbenchRuntime(ctx, tenantID, req.Id)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &chorus.GetWorkbenchReply{Result: result}, nil
+}
+
+// DescribeWorkbench returns the workbench along with the live state of its
+// server in the runtime.
+func (c WorkbenchController) DescribeWorkbench(ctx context.Context, req *chorus.DescribeWorkbenchRequest) (*chorus.DescribeWorkbenchReply, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "could not extract tenant id from jwt-token")
+	}
+
+	workbench, err := c.workbench.GetWorkbench(ctx, tenantID, req.Id)
+	if err != nil {
+		return nil, status.Errorf(grpc.ErrorCode(err), "unable to call 'GetWorkbench': %v", err.Error())
+	}
+
+	tgWorkbench, err := converter.WorkbenchFromBusiness(workbench)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "conversion error: %v", err.Error())
+	}
+
+	tgRuntime, err := c.getWorkbenchRuntime(ctx, tenantID, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &chorus.DescribeWorkbenchReply{Result: &chorus.DescribeWorkbenchResult{Workbench: tgWorkbench, Runtime: tgRuntime}}, nil
+}
+
+// getWorkbenchRuntime returns the live state of the server of a workbench,
+// which only the members of its workspace see.
+func (c WorkbenchController) getWorkbenchRuntime(ctx context.Context, tenantID, workbenchID uint64) (*chorus.RuntimeDetails, error) {
+	userID, err := jwt_model.ExtractUserID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "could not extract user id from jwt-token")
+	}
+
+	details, err := c.workbench.GetWorkbenchRuntime(ctx, tenantID, userID, workbenchID)
+	if err != nil {
+		return nil, status.Errorf(grpc.ErrorCode(err), "unable to call 'GetWorkbenchRuntime': %v", err.Error())
+	}
+
+	tgRuntime, err := converter.RuntimeDetailsFromBusiness(details)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "conversion error: %v", err.Error())
+	}
+	return tgRuntime, nil
 }
 
 func (c WorkbenchController) UpdateWorkbench(ctx context.Context, req *chorus.UpdateWorkbenchRequest) (*chorus.UpdateWorkbenchReply, error) {
@@ -82,6 +142,202 @@ func (c WorkbenchController) DeleteWorkbench(ctx context.Context, req *chorus.De
 	return &chorus.DeleteWorkbenchReply{Result: &chorus.DeleteWorkbenchResult{}}, nil
 }
 
+func (c WorkbenchController) RestoreWorkbench(ctx context.Context, req *chorus.RestoreWorkbenchRequest) (*chorus.RestoreWorkbenchReply, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "could not extract tenant id from jwt-token")
+	}
+
+	err = c.workbench.RestoreWorkbench(ctx, tenantID, req.Id)
+	if err != nil {
+		return nil, status.Errorf(grpc.ErrorCode(err), "unable to call 'RestoreWorkbench': %v", err.Error())
+	}
+	return &chorus.RestoreWorkbenchReply{Result: &chorus.RestoreWorkbenchResult{}}, nil
+}
+
+// ListWorkbenchVolumes returns the volumes mounted into the apps of the
+// workbench along with their usage.
+func (c WorkbenchController) ListWorkbenchVolumes(ctx context.Context, req *chorus.ListWorkbenchVolumesRequest) (*chorus.ListWorkbenchVolumesReply, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "could not extract tenant id from jwt-token")
+	}
+
+	volumes, err := c.workbench.ListWorkbenchVolumes(ctx, tenantID, req.Id)
+	if err != nil {
+		return nil, status.Errorf(grpc.ErrorCode(err), "unable to call 'ListWorkbenchVolumes': %v", err.Error())
+	}
+
+	result := make([]*chorus.Volume, 0, len(volumes))
+	for _, volume := range volumes {
+		tgVolume, err := converter.VolumeFromBusiness(volume.VolumeStatus, volume.MountPath)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "conversion error: %v", err.Error())
+		}
+		result = append(result, tgVolume)
+	}
+
+	return &chorus.ListWorkbenchVolumesReply{Result: result}, nil
+}
+
+// StreamWorkbenchLogs streams the logs of the server of the workbench, the
+// membership of its workspace being checked by the service.
+func (c WorkbenchController) StreamWorkbenchLogs(req *chorus.StreamWorkbenchLogsRequest, stream chorus.WorkbenchService_StreamWorkbenchLogsServer) error {
+	if req == nil {
+		return status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := stream.Context()
+
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, "could not extract tenant id from jwt-token")
+	}
+
+	userID, err := jwt_model.ExtractUserID(ctx)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, "could not extract user id from jwt-token")
+	}
+
+	sinceTime, err := converter.FromProtoTimestamp(req.SinceTime)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid since time: %v", err.Error())
+	}
+
+	err = c.workbench.StreamWorkbenchLogs(ctx, service.StreamWorkbenchLogsReq{
+		TenantID:    tenantID,
+		UserID:      userID,
+		WorkbenchID: req.Id,
+		Follow:      req.Follow,
+		TailLines:   req.TailLines,
+		SinceTime:   sinceTime,
+	}, func(line string) error {
+		return stream.Send(&chorus.LogLine{Content: line})
+	})
+	if err != nil {
+		return status.Errorf(grpc.ErrorCode(err), "unable to call 'StreamWorkbenchLogs': %v", err.Error())
+	}
+	return nil
+}
+
+// SnapshotWorkbench records the apps of a workbench of the caller and takes
+// a snapshot of its volumes, the ownership being checked by the service.
+func (c WorkbenchController) SnapshotWorkbench(ctx context.Context, req *chorus.SnapshotWorkbenchRequest) (*chorus.SnapshotWorkbenchReply, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "could not extract tenant id from jwt-token")
+	}
+
+	userID, err := jwt_model.ExtractUserID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "could not extract user id from jwt-token")
+	}
+
+	id, err := c.workbench.SnapshotWorkbench(ctx, service.SnapshotWorkbenchReq{
+		TenantID:    tenantID,
+		UserID:      userID,
+		WorkbenchID: req.Id,
+		Name:        req.Name,
+		Description: req.Description,
+	})
+	if err != nil {
+		return nil, status.Errorf(grpc.ErrorCode(err), "unable to call 'SnapshotWorkbench': %v", err.Error())
+	}
+	return &chorus.SnapshotWorkbenchReply{Result: &chorus.SnapshotWorkbenchResult{Id: id}}, nil
+}
+
+// RestoreWorkbenchSnapshot creates a workbench owned by the caller from a
+// snapshot of the caller.
+func (c WorkbenchController) RestoreWorkbenchSnapshot(ctx context.Context, req *chorus.RestoreWorkbenchSnapshotRequest) (*chorus.RestoreWorkbenchSnapshotReply, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "could not extract tenant id from jwt-token")
+	}
+
+	userID, err := jwt_model.ExtractUserID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "could not extract user id from jwt-token")
+	}
+
+	workbenchID, err := c.workbench.RestoreWorkbenchSnapshot(ctx, tenantID, userID, req.Id)
+	if err != nil {
+		return nil, status.Errorf(grpc.ErrorCode(err), "unable to call 'RestoreWorkbenchSnapshot': %v", err.Error())
+	}
+	return &chorus.RestoreWorkbenchSnapshotReply{Result: &chorus.RestoreWorkbenchSnapshotResult{WorkbenchId: workbenchID}}, nil
+}
+
+// ListWorkbenchSnapshots returns the snapshots the caller took in the
+// workspace, the membership of the workspace being checked by the service.
+func (c WorkbenchController) ListWorkbenchSnapshots(ctx context.Context, req *chorus.ListWorkbenchSnapshotsRequest) (*chorus.ListWorkbenchSnapshotsReply, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "could not extract tenant id from jwt-token")
+	}
+
+	userID, err := jwt_model.ExtractUserID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "could not extract user id from jwt-token")
+	}
+
+	snapshots, err := c.workbench.ListWorkbenchSnapshots(ctx, tenantID, userID, req.WorkspaceId)
+	if err != nil {
+		return nil, status.Errorf(grpc.ErrorCode(err), "unable to call 'ListWorkbenchSnapshots': %v", err.Error())
+	}
+
+	result := make([]*chorus.WorkbenchSnapshot, 0, len(snapshots))
+	for _, snapshot := range snapshots {
+		tgSnapshot, err := converter.WorkbenchSnapshotFromBusiness(snapshot)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "conversion error: %v", err.Error())
+		}
+		result = append(result, tgSnapshot)
+	}
+
+	return &chorus.ListWorkbenchSnapshotsReply{Result: result}, nil
+}
+
+// DeleteWorkbenchSnapshot deletes a snapshot of the caller.
+func (c WorkbenchController) DeleteWorkbenchSnapshot(ctx context.Context, req *chorus.DeleteWorkbenchSnapshotRequest) (*chorus.DeleteWorkbenchSnapshotReply, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "could not extract tenant id from jwt-token")
+	}
+
+	userID, err := jwt_model.ExtractUserID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "could not extract user id from jwt-token")
+	}
+
+	err = c.workbench.DeleteWorkbenchSnapshot(ctx, tenantID, userID, req.Id)
+	if err != nil {
+		return nil, status.Errorf(grpc.ErrorCode(err), "unable to call 'DeleteWorkbenchSnapshot': %v", err.Error())
+	}
+	return &chorus.DeleteWorkbenchSnapshotReply{Result: &chorus.DeleteWorkbenchSnapshotResult{}}, nil
+}
+
 // NewWorkbenchController returns a fresh admin service controller instance.
 func NewWorkbenchController(workbench service.Workbencher) WorkbenchController {
 	return WorkbenchController{workbench: workbench}
@@ -98,13 +354,45 @@ func (c WorkbenchController) ListWorkbenchs(ctx context.Context, req *chorus.Lis
 		return nil, status.Error(codes.InvalidArgument, "could not extract tenant id from jwt-token")
 	}
 
-	pagination := converter.PaginationToBusiness(req.Pagination)
+	var cursor *pagination.RequestCursor[pagination.KeysetCursor]
+	if req.Cursor != nil {
+		cursor, err = pagination.RequestCursorFromPb[pagination.KeysetCursor](req.Cursor)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid cursor: %v", err.Error())
+		}
+		if cursor.PageSize == 0 {
+			cursor.PageSize = 20
+		}
+	}
+
+	if req.Sort == nil {
+		req.Sort = &chorus.WorkbenchSort{Type: "ID", Order: "ASC"}
+	}
+	if req.Filter == nil {
+		req.Filter = &chorus.WorkbenchFilter{}
+	}
 
-	res, err := c.workbench.ListWorkbenchs(ctx, tenantID, pagination)
+	res, resCursor, totalItems, err := c.workbench.ListWorkbenchs(ctx, service.ListWorkbenchsReq{
+		TenantID: tenantID,
+		Cursor:   cursor,
+		Filter: service.WorkbenchFilter{
+			Status:  strings.ToLower(req.Filter.Status),
+			OwnerID: req.Filter.OwnerId,
+		},
+		Sort: service.Sort{
+			SortOrder: strings.ToUpper(req.Sort.Order),
+			SortType:  strings.ToUpper(req.Sort.Type),
+		},
+	})
 	if err != nil {
 		return nil, status.Errorf(grpc.ErrorCode(err), "unable to call 'ListWorkbenchs': %v", err.Error())
 	}
 
+	resCursorPb, err := resCursor.ToPb()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "conversion error: %v", err.Error())
+	}
+
 	var workbenchs []*chorus.Workbench
 	for _, r := range res {
 		workbench, err := converter.WorkbenchFromBusiness(r)
@@ -113,7 +401,7 @@ func (c WorkbenchController) ListWorkbenchs(ctx context.Context, req *chorus.Lis
 		}
 		workbenchs = append(workbenchs, workbench)
 	}
-	return &chorus.ListWorkbenchsReply{Result: workbenchs}, nil
+	return &chorus.ListWorkbenchsReply{Result: workbenchs, Cursor: resCursorPb, TotalItems: totalItems}, nil
 }
 
 // CreateWorkbench extracts the workbench from the request and passes it to the workbench service.
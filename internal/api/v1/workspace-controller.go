@@ -2,11 +2,13 @@ package v1
 
 import (
 	"context"
+	"strings"
 
 	"github.com/CHORUS-TRE/chorus-backend/internal/api/v1/chorus"
 	"github.com/CHORUS-TRE/chorus-backend/internal/api/v1/converter"
 	jwt_model "github.com/CHORUS-TRE/chorus-backend/internal/jwt/model"
 	"github.com/CHORUS-TRE/chorus-backend/internal/utils/grpc"
+	"github.com/CHORUS-TRE/chorus-backend/internal/utils/pagination"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/workspace/service"
 
 	"google.golang.org/grpc/codes"
@@ -41,6 +43,35 @@ func (c WorkspaceController) GetWorkspace(ctx context.Context, req *chorus.GetWo
 	return &chorus.GetWorkspaceReply{Result: &chorus.GetWorkspaceResult{Workspace: tgWorkspace}}, nil
 }
 
+// ListWorkspaceVolumes returns the volumes of the workspace along with their
+// usage.
+func (c WorkspaceController) ListWorkspaceVolumes(ctx context.Context, req *chorus.ListWorkspaceVolumesRequest) (*chorus.ListWorkspaceVolumesReply, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "could not extract tenant id from jwt-token")
+	}
+
+	volumes, err := c.workspace.ListWorkspaceVolumes(ctx, tenantID, req.Id)
+	if err != nil {
+		return nil, status.Errorf(grpc.ErrorCode(err), "unable to call 'ListWorkspaceVolumes': %v", err.Error())
+	}
+
+	result := make([]*chorus.Volume, 0, len(volumes))
+	for _, volume := range volumes {
+		tgVolume, err := converter.VolumeFromBusiness(volume, "")
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "conversion error: %v", err.Error())
+		}
+		result = append(result, tgVolume)
+	}
+
+	return &chorus.ListWorkspaceVolumesReply{Result: result}, nil
+}
+
 func (c WorkspaceController) UpdateWorkspace(ctx context.Context, req *chorus.UpdateWorkspaceRequest) (*chorus.UpdateWorkspaceReply, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
@@ -75,11 +106,28 @@ func (c WorkspaceController) DeleteWorkspace(ctx context.Context, req *chorus.De
 		return nil, status.Error(codes.InvalidArgument, "could not extract tenant id from jwt-token")
 	}
 
-	err = c.workspace.DeleteWorkspace(ctx, tenantID, req.Id)
+	teardown, err := c.workspace.DeleteWorkspace(ctx, tenantID, req.Id, req.Force)
 	if err != nil {
 		return nil, status.Errorf(grpc.ErrorCode(err), "unable to call 'DeleteWorkspace': %v", err.Error())
 	}
-	return &chorus.DeleteWorkspaceReply{Result: &chorus.DeleteWorkspaceResult{}}, nil
+	return &chorus.DeleteWorkspaceReply{Result: converter.WorkspaceTeardownFromBusiness(teardown)}, nil
+}
+
+func (c WorkspaceController) RestoreWorkspace(ctx context.Context, req *chorus.RestoreWorkspaceRequest) (*chorus.RestoreWorkspaceReply, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "could not extract tenant id from jwt-token")
+	}
+
+	err = c.workspace.RestoreWorkspace(ctx, tenantID, req.Id)
+	if err != nil {
+		return nil, status.Errorf(grpc.ErrorCode(err), "unable to call 'RestoreWorkspace': %v", err.Error())
+	}
+	return &chorus.RestoreWorkspaceReply{Result: &chorus.RestoreWorkspaceResult{}}, nil
 }
 
 // NewWorkspaceController returns a fresh admin service controller instance.
@@ -98,13 +146,45 @@ func (c WorkspaceController) ListWorkspaces(ctx context.Context, req *chorus.Lis
 		return nil, status.Error(codes.InvalidArgument, "could not extract tenant id from jwt-token")
 	}
 
-	pagination := converter.PaginationToBusiness(req.Pagination)
+	var cursor *pagination.RequestCursor[pagination.KeysetCursor]
+	if req.Cursor != nil {
+		cursor, err = pagination.RequestCursorFromPb[pagination.KeysetCursor](req.Cursor)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid cursor: %v", err.Error())
+		}
+		if cursor.PageSize == 0 {
+			cursor.PageSize = 20
+		}
+	}
+
+	if req.Sort == nil {
+		req.Sort = &chorus.WorkspaceSort{Type: "ID", Order: "ASC"}
+	}
+	if req.Filter == nil {
+		req.Filter = &chorus.WorkspaceFilter{}
+	}
 
-	res, err := c.workspace.ListWorkspaces(ctx, tenantID, pagination)
+	res, resCursor, totalItems, err := c.workspace.ListWorkspaces(ctx, service.ListWorkspacesReq{
+		TenantID: tenantID,
+		Cursor:   cursor,
+		Filter: service.WorkspaceFilter{
+			Status:  strings.ToLower(req.Filter.Status),
+			OwnerID: req.Filter.OwnerId,
+		},
+		Sort: service.Sort{
+			SortOrder: strings.ToUpper(req.Sort.Order),
+			SortType:  strings.ToUpper(req.Sort.Type),
+		},
+	})
 	if err != nil {
 		return nil, status.Errorf(grpc.ErrorCode(err), "unable to call 'ListWorkspaces': %v", err.Error())
 	}
 
+	resCursorPb, err := resCursor.ToPb()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "conversion error: %v", err.Error())
+	}
+
 	var workspaces []*chorus.Workspace
 	for _, r := range res {
 		workspace, err := converter.WorkspaceFromBusiness(r)
@@ -113,7 +193,7 @@ func (c WorkspaceController) ListWorkspaces(ctx context.Context, req *chorus.Lis
 		}
 		workspaces = append(workspaces, workspace)
 	}
-	return &chorus.ListWorkspacesReply{Result: workspaces}, nil
+	return &chorus.ListWorkspacesReply{Result: workspaces, Cursor: resCursorPb, TotalItems: totalItems}, nil
 }
 
 // CreateWorkspace extracts the workspace from the request and passes it to the workspace service.
@@ -8,33 +8,36 @@ import (
 	"fmt"
 	"io"
 	"net/http"
-	"os"
 
+	"github.com/CHORUS-TRE/chorus-backend/internal/client/k8s"
+	"github.com/CHORUS-TRE/chorus-backend/internal/client/runtime"
 	"github.com/CHORUS-TRE/chorus-backend/internal/config"
 	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
 	"go.uber.org/zap"
 	helmaction "helm.sh/helm/v3/pkg/action"
 	helmchart "helm.sh/helm/v3/pkg/chart"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/cli-runtime/pkg/genericclioptions"
-	"k8s.io/client-go/kubernetes"
-	"k8s.io/client-go/rest"
-	"k8s.io/client-go/tools/clientcmd"
 	"k8s.io/client-go/tools/portforward"
 	"k8s.io/client-go/transport/spdy"
 )
 
-type HelmClienter interface {
-	CreateWorkbench(namespace, workbenchName string) error
-	CreatePortForward(namespace, serviceName string) (uint16, chan struct{}, error)
-	CreateAppInstance(namespace, workbenchName, appName, appImage string) error
-	DeleteApp(namespace, workbenchName, appName string) error
-	DeleteWorkbench(namespace, workbenchName string) error
-}
+var _ runtime.WorkbenchRuntime = &client{}
+
+const (
+	// serverSelector and appSelector select the pods that the workbench
+	// operator runs for the server and for the apps of a workbench.
+	serverSelector = "workbench=%s"
+	appSelector    = "workbench=%s,app=%s"
+)
 
 type client struct {
-	cfg   config.Config
-	chart *helmchart.Chart
+	cfg        config.Config
+	chart      *helmchart.Chart
+	pool       *k8s.ClientPool
+	workbenchs k8s.WorkbenchClient
+	snapshots  k8s.VolumeSnapshotClient
 }
 
 func debug(format string, v ...interface{}) {
@@ -47,101 +50,56 @@ func NewClient(cfg config.Config) (*client, error) {
 		return nil, fmt.Errorf("Error loading Helm chart: %w", err)
 	}
 
-	c := &client{
-		chart: chart,
-		cfg:   cfg,
-	}
-	return c, nil
-}
-
-func (c *client) getConfig(namespace string) (*helmaction.Configuration, error) {
-	if c.cfg.Clients.HelmClient.KubeConfig != "" {
-		return c.getConfigFromKubeConfig(namespace)
-	}
-	if c.cfg.Clients.HelmClient.Token != "" {
-		return c.getConfigFromServiceAccount(namespace)
-	}
-
-	return nil, errors.New("no config for helm client found")
-}
-
-func (c *client) getConfigFromKubeConfig(namespace string) (*helmaction.Configuration, error) {
-	config, err := clientcmd.Load(([]byte)(c.cfg.Clients.HelmClient.KubeConfig))
+	workbenchs, err := k8s.NewWorkbenchClient(cfg)
 	if err != nil {
-		return nil, fmt.Errorf("error loading kubeconfig: %w", err)
-	}
-
-	clientConfig := clientcmd.NewDefaultClientConfig(*config, &clientcmd.ConfigOverrides{})
-
-	configFlags := &genericclioptions.ConfigFlags{
-		Namespace: &namespace,
+		return nil, fmt.Errorf("Error creating workbench client: %w", err)
 	}
 
-	configFlags.WrapConfigFn = func(cfg *rest.Config) *rest.Config {
-		clientConfig, err := clientConfig.ClientConfig()
-		if err != nil {
-			fmt.Printf("Error getting client config: %v\n", err)
-			os.Exit(1)
-		}
-		return clientConfig
+	snapshots, err := k8s.NewVolumeSnapshotClient(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("Error creating volume snapshot client: %w", err)
 	}
 
-	actionConfig := new(helmaction.Configuration)
-	if err := actionConfig.Init(configFlags, namespace, "secret", debug); err != nil {
-		return nil, fmt.Errorf("error initializing Helm configuration: %w", err)
+	c := &client{
+		chart:      chart,
+		cfg:        cfg,
+		pool:       k8s.NewClientPool(cfg),
+		workbenchs: workbenchs,
+		snapshots:  snapshots,
 	}
-
-	return actionConfig, nil
-
+	return c, nil
 }
 
-func (c *client) getConfigFromServiceAccount(namespace string) (*helmaction.Configuration, error) {
-	token := c.cfg.Clients.HelmClient.Token
-	caCert := c.cfg.Clients.HelmClient.CA
-	apiServer := c.cfg.Clients.HelmClient.APIServer
-
-	restConfig := &rest.Config{
-		Host:        apiServer,
-		BearerToken: token,
-		TLSClientConfig: rest.TLSClientConfig{
-			CAData: []byte(caCert),
-		},
-	}
-
-	configFlags := &genericclioptions.ConfigFlags{
-		Namespace: &namespace,
-	}
-
-	configFlags.WrapConfigFn = func(cfg *rest.Config) *rest.Config {
-		return restConfig
+// getConfig returns a helm configuration of a namespace, built from the
+// pooled clients of the namespace. A configuration holds the state of the
+// action it is used for, so one is built for every action.
+func (c *client) getConfig(namespace string) (*helmaction.Configuration, error) {
+	clients, err := c.pool.Get(namespace)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get clients: %w", err)
 	}
 
 	actionConfig := new(helmaction.Configuration)
-	if err := actionConfig.Init(configFlags, namespace, "secret", debug); err != nil {
+	if err := actionConfig.Init(clients, namespace, "secret", debug); err != nil {
 		return nil, fmt.Errorf("error initializing Helm configuration: %w", err)
 	}
-
 	return actionConfig, nil
 }
 
 func (c *client) CreatePortForward(namespace, serviceName string) (uint16, chan struct{}, error) {
-	helmConfig, err := c.getConfig(namespace)
+	clients, err := c.pool.Get(namespace)
 	if err != nil {
-		return 0, nil, fmt.Errorf("unable to get config: %w", err)
+		return 0, nil, fmt.Errorf("unable to get clients: %w", err)
 	}
 
-	config, err := helmConfig.RESTClientGetter.ToRESTConfig()
+	config, err := clients.ToRESTConfig()
 	if err != nil {
-		return 0, nil, fmt.Errorf("unable to convert to rest config: %w", err)
-	}
-
-	clientset, err := kubernetes.NewForConfig(config)
-	if err != nil {
-		return 0, nil, fmt.Errorf("unable to get clienset: %w", err)
+		return 0, nil, fmt.Errorf("unable to get rest config: %w", err)
 	}
+	clientset := clients.Clientset()
 
 	pods, err := clientset.CoreV1().Pods(namespace).List(context.Background(), v1.ListOptions{
-		LabelSelector: fmt.Sprintf("workbench=%s", serviceName),
+		LabelSelector: fmt.Sprintf(serverSelector, serviceName),
 	})
 	if err != nil {
 		return 0, nil, fmt.Errorf("unable to get pods: %w", err)
@@ -221,7 +179,7 @@ func EncodeRegistriesToDockerJSON(entries []config.ImagePullSecret) (string, err
 	return string(jsonData), nil
 }
 
-func (c *client) CreateWorkbench(namespace, workbenchName string) error {
+func (c *client) CreateWorkbench(namespace, workbenchName string, mounts []runtime.VolumeMount) error {
 	actionConfig, err := c.getConfig(namespace)
 	if err != nil {
 		return fmt.Errorf("Unable to get config: %w", err)
@@ -232,9 +190,16 @@ func (c *client) CreateWorkbench(namespace, workbenchName string) error {
 	install.Namespace = namespace
 	install.ReleaseName = workbenchName
 
+	volumes := make([]map[string]string, 0, len(mounts))
+	for _, m := range mounts {
+		volumes = append(volumes, map[string]string{"name": m.Name, "mountPath": m.MountPath})
+	}
+
+	// The apps are left out of the values: they are applied to the Workbench
+	// resource by the workbench client, which owns them.
 	vals := map[string]interface{}{
-		"name": workbenchName,
-		"apps": []map[string]string{},
+		"name":    workbenchName,
+		"volumes": volumes,
 	}
 	if len(c.cfg.Clients.HelmClient.ImagePullSecrets) != 0 {
 		dockerConfig, err := EncodeRegistriesToDockerJSON(c.cfg.Clients.HelmClient.ImagePullSecrets)
@@ -255,78 +220,113 @@ func (c *client) CreateWorkbench(namespace, workbenchName string) error {
 	return nil
 }
 
+// CreateAppInstance adds an app to the Workbench resource installed by the
+// release of the workbench, which deploys it.
 func (c *client) CreateAppInstance(namespace, workbenchName, appName, appImage string) error {
-	actionConfig, err := c.getConfig(namespace)
-	if err != nil {
-		return fmt.Errorf("Unable to get config: %w", err)
-	}
-
-	get := helmaction.NewGet(actionConfig)
-	release, err := get.Run(workbenchName)
-	if err != nil {
-		return fmt.Errorf("Failed to get release: %w", err)
-	}
-
-	app := map[string]string{
-		"app":  appName,
-		"name": appName,
+	app := k8s.WorkbenchApp{Name: appName, Image: appImage}
+	if err := c.workbenchs.AddApp(context.Background(), namespace, workbenchName, app); err != nil {
+		return fmt.Errorf("Failed to add app to workbench: %w", err)
 	}
 
-	vals := release.Config
-	vals["apps"] = append(vals["apps"].([]interface{}), app)
+	return nil
+}
 
-	upgrade := helmaction.NewUpgrade(actionConfig)
-	upgrade.Namespace = namespace
-	_, err = upgrade.Run(workbenchName, c.chart, vals)
-	if err != nil {
-		return fmt.Errorf("Failed to add app to workbench: %w", err)
+func (c *client) DeleteApp(namespace, workbenchName, appName string) error {
+	if err := c.workbenchs.RemoveApp(context.Background(), namespace, workbenchName, appName); err != nil {
+		return fmt.Errorf("Failed to delete app from workbench: %w", err)
 	}
 
 	return nil
 }
 
-func (c *client) DeleteApp(namespace, workbenchName, appName string) error {
+func (c *client) DeleteWorkbench(namespace, workbenchName string) error {
 	actionConfig, err := c.getConfig(namespace)
 	if err != nil {
 		return fmt.Errorf("Unable to get config: %w", err)
 	}
 
-	get := helmaction.NewGet(actionConfig)
-	release, err := get.Run(workbenchName)
+	// The workbenches that are not running have no release to uninstall.
+	uninstall := helmaction.NewUninstall(actionConfig)
+	uninstall.IgnoreNotFound = true
+	_, err = uninstall.Run(workbenchName)
 	if err != nil {
-		return fmt.Errorf("Failed to get release: %w", err)
+		return fmt.Errorf("Failed to delete workbench: %w", err)
 	}
 
-	vals := release.Config
-	apps := vals["apps"].([]interface{})
-	for i, app := range apps {
-		if app.(map[string]interface{})["name"] == appName {
-			vals["apps"] = append(apps[:i], apps[i+1:]...)
-			break
-		}
-	}
+	return nil
+}
 
-	upgrade := helmaction.NewUpgrade(actionConfig)
-	upgrade.Namespace = namespace
-	_, err = upgrade.Run(workbenchName, c.chart, vals)
+// DeleteNamespace deletes a namespace along with everything left in it. A
+// namespace that does not exist is considered deleted.
+func (c *client) DeleteNamespace(namespace string) error {
+	clients, err := c.pool.Get(namespace)
 	if err != nil {
-		return fmt.Errorf("Failed to delete app from workbench: %w", err)
+		return fmt.Errorf("Unable to get clients: %w", err)
+	}
+
+	err = clients.Clientset().CoreV1().Namespaces().Delete(context.Background(), namespace, v1.DeleteOptions{})
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return fmt.Errorf("Failed to delete namespace: %w", err)
 	}
 
 	return nil
 }
 
-func (c *client) DeleteWorkbench(namespace, workbenchName string) error {
-	actionConfig, err := c.getConfig(namespace)
+// WorkbenchLogs returns the logs of the pod of the server of a workbench.
+func (c *client) WorkbenchLogs(ctx context.Context, namespace, workbenchName string, opts runtime.LogOptions) (io.ReadCloser, error) {
+	logs, err := c.podLogs(ctx, namespace, fmt.Sprintf(serverSelector, workbenchName), opts)
+	if errors.Is(err, errNoPod) {
+		return nil, fmt.Errorf("%v/%v: %w", namespace, workbenchName, runtime.ErrWorkbenchNotFound)
+	}
+	return logs, err
+}
+
+// AppLogs returns the logs of the pod of an app of a workbench.
+func (c *client) AppLogs(ctx context.Context, namespace, workbenchName, appName string, opts runtime.LogOptions) (io.ReadCloser, error) {
+	logs, err := c.podLogs(ctx, namespace, fmt.Sprintf(appSelector, workbenchName, appName), opts)
+	if errors.Is(err, errNoPod) {
+		return nil, fmt.Errorf("%v/%v/%v: %w", namespace, workbenchName, appName, runtime.ErrAppNotFound)
+	}
+	return logs, err
+}
+
+var errNoPod = errors.New("no pod found")
+
+// podLogs streams the logs of the most recent pod matching the selector, the
+// previous ones being replaced by it.
+func (c *client) podLogs(ctx context.Context, namespace, selector string, opts runtime.LogOptions) (io.ReadCloser, error) {
+	clients, err := c.pool.Get(namespace)
 	if err != nil {
-		return fmt.Errorf("Unable to get config: %w", err)
+		return nil, fmt.Errorf("Unable to get clients: %w", err)
 	}
 
-	uninstall := helmaction.NewUninstall(actionConfig)
-	_, err = uninstall.Run(workbenchName)
+	pods, err := clients.Clientset().CoreV1().Pods(namespace).List(ctx, v1.ListOptions{LabelSelector: selector})
 	if err != nil {
-		return fmt.Errorf("Failed to delete workbench: %w", err)
+		return nil, fmt.Errorf("Unable to get pods: %w", err)
+	}
+	if len(pods.Items) == 0 {
+		return nil, errNoPod
 	}
 
-	return nil
+	pod := pods.Items[0]
+	for _, p := range pods.Items[1:] {
+		if pod.CreationTimestamp.Before(&p.CreationTimestamp) {
+			pod = p
+		}
+	}
+
+	logOptions := &corev1.PodLogOptions{Follow: opts.Follow}
+	if opts.TailLines > 0 {
+		logOptions.TailLines = &opts.TailLines
+	}
+	if !opts.SinceTime.IsZero() {
+		sinceTime := v1.NewTime(opts.SinceTime)
+		logOptions.SinceTime = &sinceTime
+	}
+
+	logs, err := clients.Clientset().CoreV1().Pods(namespace).GetLogs(pod.Name, logOptions).Stream(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to stream the logs of pod %v: %w", pod.Name, err)
+	}
+	return logs, nil
 }
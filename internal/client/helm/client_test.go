@@ -0,0 +1,24 @@
+package helm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/client/k8s"
+	"github.com/CHORUS-TRE/chorus-backend/internal/config"
+)
+
+func TestGetConfig(t *testing.T) {
+	cfg := config.Config{}
+	cfg.Clients.HelmClient.Token = "token"
+	cfg.Clients.HelmClient.APIServer = "https://127.0.0.1:6443"
+	c := &client{cfg: cfg, pool: k8s.NewClientPool(cfg)}
+
+	c1, err := c.getConfig("workspace1")
+	require.NoError(t, err)
+	c2, err := c.getConfig("workspace1")
+	require.NoError(t, err)
+	require.NotSame(t, c1, c2, "the concurrent actions do not share a configuration")
+	require.Equal(t, "Secret", c1.Releases.Name(), "the releases are stored in secrets")
+}
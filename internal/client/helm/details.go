@@ -0,0 +1,143 @@
+package helm
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/client/runtime"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/kubernetes"
+)
+
+// WorkbenchDetails returns the state of the pods of the server of a workbench
+// along with their recent events.
+func (c *client) WorkbenchDetails(ctx context.Context, namespace, workbenchName string) (*runtime.Details, error) {
+	clients, err := c.pool.Get(namespace)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to get clients: %w", err)
+	}
+	return podsDetails(ctx, clients.Clientset(), namespace, fmt.Sprintf(serverSelector, workbenchName))
+}
+
+// AppDetails returns the state of the pods of an app along with their recent
+// events.
+func (c *client) AppDetails(ctx context.Context, namespace, workbenchName, appName string) (*runtime.Details, error) {
+	clients, err := c.pool.Get(namespace)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to get clients: %w", err)
+	}
+	return podsDetails(ctx, clients.Clientset(), namespace, fmt.Sprintf(appSelector, workbenchName, appName))
+}
+
+func podsDetails(ctx context.Context, clientset kubernetes.Interface, namespace, selector string) (*runtime.Details, error) {
+	pods, err := clientset.CoreV1().Pods(namespace).List(ctx, v1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return nil, fmt.Errorf("Unable to get pods: %w", err)
+	}
+
+	details := &runtime.Details{}
+	for _, pod := range pods.Items {
+		details.Pods = append(details.Pods, podDetails(pod))
+
+		events, err := clientset.CoreV1().Events(namespace).List(ctx, v1.ListOptions{
+			FieldSelector: fields.Set{
+				"involvedObject.kind": "Pod",
+				"involvedObject.name": pod.Name,
+			}.String(),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("Unable to get the events of pod %v: %w", pod.Name, err)
+		}
+		for _, e := range events.Items {
+			details.Events = append(details.Events, eventDetails(e))
+		}
+	}
+
+	sort.SliceStable(details.Events, func(i, j int) bool {
+		return details.Events[i].LastSeen.After(details.Events[j].LastSeen)
+	})
+	if len(details.Events) > runtime.MaxEvents {
+		details.Events = details.Events[:runtime.MaxEvents]
+	}
+
+	return details, nil
+}
+
+func podDetails(pod corev1.Pod) runtime.PodDetails {
+	res := runtime.PodDetails{Name: pod.Name, Phase: string(pod.Status.Phase)}
+
+	images := make(map[string]string, len(pod.Spec.Containers))
+	for _, c := range pod.Spec.Containers {
+		images[c.Name] = c.Image
+	}
+
+	for _, status := range pod.Status.ContainerStatuses {
+		container := runtime.ContainerDetails{
+			Name:         status.Name,
+			Image:        images[status.Name],
+			Ready:        status.Ready,
+			RestartCount: status.RestartCount,
+		}
+
+		switch {
+		case status.State.Waiting != nil:
+			container.State = runtime.ContainerWaiting
+			container.Reason = status.State.Waiting.Reason
+			container.Message = status.State.Waiting.Message
+			if isImagePullError(container.Reason) {
+				container.ImagePullError = container.Message
+				if container.ImagePullError == "" {
+					container.ImagePullError = container.Reason
+				}
+			}
+		case status.State.Running != nil:
+			container.State = runtime.ContainerRunning
+		case status.State.Terminated != nil:
+			container.State = runtime.ContainerTerminated
+			container.Reason = status.State.Terminated.Reason
+			container.Message = status.State.Terminated.Message
+		}
+
+		if t := status.LastTerminationState.Terminated; t != nil {
+			container.LastTermination = &runtime.Termination{
+				Reason:     t.Reason,
+				Message:    t.Message,
+				ExitCode:   t.ExitCode,
+				FinishedAt: t.FinishedAt.Time,
+			}
+		}
+
+		res.Containers = append(res.Containers, container)
+	}
+
+	return res
+}
+
+func isImagePullError(reason string) bool {
+	switch reason {
+	case "ErrImagePull", "ImagePullBackOff", "InvalidImageName", "ErrImageNeverPull":
+		return true
+	}
+	return false
+}
+
+func eventDetails(e corev1.Event) runtime.Event {
+	lastSeen := e.LastTimestamp.Time
+	if lastSeen.IsZero() {
+		lastSeen = e.EventTime.Time
+	}
+	count := e.Count
+	if e.Series != nil {
+		count = e.Series.Count
+	}
+	return runtime.Event{
+		Type:     e.Type,
+		Reason:   e.Reason,
+		Message:  e.Message,
+		Count:    count,
+		LastSeen: lastSeen,
+	}
+}
@@ -0,0 +1,99 @@
+package helm
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/client/runtime"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestPodsDetails(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	pod := &corev1.Pod{
+		ObjectMeta: v1.ObjectMeta{Name: "workbench1-abc", Namespace: "workspace1", Labels: map[string]string{"workbench": "workbench1"}},
+		Spec: corev1.PodSpec{Containers: []corev1.Container{
+			{Name: "server", Image: "registry/server:1.0"},
+			{Name: "sidecar", Image: "registry/sidecar:1.0"},
+		}},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodPending,
+			ContainerStatuses: []corev1.ContainerStatus{
+				{
+					Name:         "server",
+					RestartCount: 2,
+					State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{
+						Reason:  "ImagePullBackOff",
+						Message: "Back-off pulling image \"registry/server:1.0\"",
+					}},
+					LastTerminationState: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{
+						Reason:     "OOMKilled",
+						ExitCode:   137,
+						FinishedAt: v1.NewTime(now),
+					}},
+				},
+				{
+					Name:  "sidecar",
+					Ready: true,
+					State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}},
+				},
+			},
+		},
+	}
+	other := &corev1.Pod{ObjectMeta: v1.ObjectMeta{Name: "workbench2-abc", Namespace: "workspace1", Labels: map[string]string{"workbench": "workbench2"}}}
+
+	event := func(name, reason string, lastSeen time.Time) *corev1.Event {
+		return &corev1.Event{
+			ObjectMeta:     v1.ObjectMeta{Name: name, Namespace: "workspace1"},
+			InvolvedObject: corev1.ObjectReference{Kind: "Pod", Name: "workbench1-abc"},
+			Type:           corev1.EventTypeWarning,
+			Reason:         reason,
+			Count:          1,
+			LastTimestamp:  v1.NewTime(lastSeen),
+		}
+	}
+
+	clientset := fake.NewSimpleClientset(pod, other,
+		event("e1", "Scheduled", now.Add(-time.Minute)),
+		event("e2", "Failed", now),
+	)
+
+	details, err := podsDetails(context.Background(), clientset, "workspace1", "workbench=workbench1")
+	require.NoError(t, err)
+
+	require.Len(t, details.Pods, 1)
+	require.Equal(t, "Pending", details.Pods[0].Phase)
+	require.Equal(t, []runtime.ContainerDetails{
+		{
+			Name:           "server",
+			Image:          "registry/server:1.0",
+			State:          runtime.ContainerWaiting,
+			Reason:         "ImagePullBackOff",
+			Message:        "Back-off pulling image \"registry/server:1.0\"",
+			RestartCount:   2,
+			ImagePullError: "Back-off pulling image \"registry/server:1.0\"",
+			LastTermination: &runtime.Termination{
+				Reason:     "OOMKilled",
+				ExitCode:   137,
+				FinishedAt: now,
+			},
+		},
+		{
+			Name:  "sidecar",
+			Image: "registry/sidecar:1.0",
+			Ready: true,
+			State: runtime.ContainerRunning,
+		},
+	}, details.Pods[0].Containers)
+
+	// The fake clientset does not filter the events by field, so both are
+	// returned, the most recent first.
+	require.Len(t, details.Events, 2)
+	require.Equal(t, "Failed", details.Events[0].Reason)
+	require.Equal(t, "Scheduled", details.Events[1].Reason)
+}
@@ -0,0 +1,205 @@
+package helm
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/client/k8s"
+	"github.com/CHORUS-TRE/chorus-backend/internal/client/runtime"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// CreateVolume creates a persistent volume claim. The claims are mounted by
+// the pods of every app of a workbench, so they are ReadWriteMany and their
+// storage class must support it. A claim that exists is left as is.
+func (c *client) CreateVolume(ctx context.Context, namespace string, volume runtime.Volume) error {
+	clients, err := c.pool.Get(namespace)
+	if err != nil {
+		return fmt.Errorf("Unable to get clients: %w", err)
+	}
+
+	pvc, err := volumeClaim(namespace, volume)
+	if err != nil {
+		return err
+	}
+
+	_, err = clients.Clientset().CoreV1().PersistentVolumeClaims(namespace).Create(ctx, pvc, v1.CreateOptions{})
+	if err != nil && !k8serrors.IsAlreadyExists(err) {
+		return fmt.Errorf("Failed to create volume %v: %w", volume.Name, err)
+	}
+	return nil
+}
+
+// RestoreVolume creates a persistent volume claim provisioned from a volume
+// snapshot. The claim stays pending until the snapshot is ready to use, and
+// its size must not be below the size of the snapshotted claim.
+func (c *client) RestoreVolume(ctx context.Context, namespace string, volume runtime.Volume, snapshotName string) error {
+	clients, err := c.pool.Get(namespace)
+	if err != nil {
+		return fmt.Errorf("Unable to get clients: %w", err)
+	}
+
+	pvc, err := volumeClaim(namespace, volume)
+	if err != nil {
+		return err
+	}
+	pvc.Spec.DataSource = &corev1.TypedLocalObjectReference{
+		APIGroup: &k8s.VolumeSnapshotGVR.Group,
+		Kind:     "VolumeSnapshot",
+		Name:     snapshotName,
+	}
+
+	_, err = clients.Clientset().CoreV1().PersistentVolumeClaims(namespace).Create(ctx, pvc, v1.CreateOptions{})
+	if err != nil && !k8serrors.IsAlreadyExists(err) {
+		return fmt.Errorf("Failed to restore volume %v: %w", volume.Name, err)
+	}
+	return nil
+}
+
+// CreateVolumeSnapshot takes a CSI snapshot of a persistent volume claim,
+// which requires the snapshot controller in the cluster and a CSI driver
+// supporting snapshots. The snapshot is cut asynchronously.
+func (c *client) CreateVolumeSnapshot(ctx context.Context, namespace, volumeName, snapshotName string) error {
+	clients, err := c.pool.Get(namespace)
+	if err != nil {
+		return fmt.Errorf("Unable to get clients: %w", err)
+	}
+
+	_, err = clients.Clientset().CoreV1().PersistentVolumeClaims(namespace).Get(ctx, volumeName, v1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		return fmt.Errorf("%v/%v: %w", namespace, volumeName, runtime.ErrVolumeNotFound)
+	}
+	if err != nil {
+		return fmt.Errorf("Unable to get volume %v: %w", volumeName, err)
+	}
+
+	snapshotClass := c.cfg.Clients.WorkbenchRuntime.Volumes.SnapshotClass
+	if err := c.snapshots.CreateVolumeSnapshot(ctx, namespace, snapshotName, volumeName, snapshotClass); err != nil {
+		return fmt.Errorf("Failed to snapshot volume %v: %w", volumeName, err)
+	}
+	return nil
+}
+
+// VolumeSnapshotReady returns whether the CSI snapshot is ready to use. A
+// snapshot in error may still be cut on a retry of the snapshot controller,
+// but is reported as failed.
+func (c *client) VolumeSnapshotReady(ctx context.Context, namespace, snapshotName string) (bool, error) {
+	status, err := c.snapshots.GetVolumeSnapshotStatus(ctx, namespace, snapshotName)
+	if k8serrors.IsNotFound(err) {
+		return false, fmt.Errorf("%v/%v: %w", namespace, snapshotName, runtime.ErrSnapshotNotFound)
+	}
+	if err != nil {
+		return false, fmt.Errorf("Unable to get snapshot %v: %w", snapshotName, err)
+	}
+	if status.Error != "" {
+		return false, fmt.Errorf("%v/%v: %v: %w", namespace, snapshotName, status.Error, runtime.ErrSnapshotFailed)
+	}
+	return status.ReadyToUse, nil
+}
+
+func (c *client) DeleteVolumeSnapshot(ctx context.Context, namespace, snapshotName string) error {
+	if err := c.snapshots.DeleteVolumeSnapshot(ctx, namespace, snapshotName); err != nil {
+		return fmt.Errorf("Failed to delete snapshot %v: %w", snapshotName, err)
+	}
+	return nil
+}
+
+// DeleteWorkbenchVolumes deletes the persistent volume claims of a
+// workbench.
+func (c *client) DeleteWorkbenchVolumes(ctx context.Context, namespace, workbenchName string) error {
+	clients, err := c.pool.Get(namespace)
+	if err != nil {
+		return fmt.Errorf("Unable to get clients: %w", err)
+	}
+
+	selector := runtime.LabelWorkbench + "=" + workbenchName
+	err = clients.Clientset().CoreV1().PersistentVolumeClaims(namespace).DeleteCollection(ctx, v1.DeleteOptions{}, v1.ListOptions{LabelSelector: selector})
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return fmt.Errorf("Failed to delete the volumes of workbench %v: %w", workbenchName, err)
+	}
+	return nil
+}
+
+// Mounts returns the volumes of the Workbench resource installed by the
+// release of a workbench.
+func (c *client) Mounts(ctx context.Context, namespace, workbenchName string) ([]runtime.VolumeMount, error) {
+	workbench, err := c.workbenchs.GetWorkbench(ctx, namespace, workbenchName)
+	if k8serrors.IsNotFound(err) {
+		return nil, fmt.Errorf("%v/%v: %w", namespace, workbenchName, runtime.ErrWorkbenchNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Unable to get workbench %v: %w", workbenchName, err)
+	}
+
+	mounts := make([]runtime.VolumeMount, 0, len(workbench.Spec.Volumes))
+	for _, v := range workbench.Spec.Volumes {
+		mounts = append(mounts, runtime.VolumeMount{Name: v.Name, MountPath: v.MountPath})
+	}
+	return mounts, nil
+}
+
+// volumeClaim returns the ReadWriteMany claim of a volume.
+func volumeClaim(namespace string, volume runtime.Volume) (*corev1.PersistentVolumeClaim, error) {
+	size, err := resource.ParseQuantity(volume.Size)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid size %q of volume %v: %w", volume.Size, volume.Name, err)
+	}
+
+	pvc := &corev1.PersistentVolumeClaim{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      volume.Name,
+			Namespace: namespace,
+			Labels:    volume.Labels,
+		},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteMany},
+			Resources: corev1.VolumeResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceStorage: size},
+			},
+		},
+	}
+	if volume.StorageClass != "" {
+		pvc.Spec.StorageClassName = &volume.StorageClass
+	}
+	return pvc, nil
+}
+
+// Volumes returns the persistent volume claims of the workspace along with
+// their capacity. The usage of the claims is only reported by the kubelets
+// through nodes/proxy, which the backend is not granted, so it is left
+// unknown.
+func (c *client) Volumes(ctx context.Context, namespace string) ([]runtime.VolumeStatus, error) {
+	clients, err := c.pool.Get(namespace)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to get clients: %w", err)
+	}
+
+	pvcs, err := clients.Clientset().CoreV1().PersistentVolumeClaims(namespace).List(ctx, v1.ListOptions{LabelSelector: runtime.LabelVolumeKind})
+	if err != nil {
+		return nil, fmt.Errorf("Unable to get volumes: %w", err)
+	}
+
+	return volumeStatuses(pvcs.Items), nil
+}
+
+func volumeStatuses(pvcs []corev1.PersistentVolumeClaim) []runtime.VolumeStatus {
+	res := make([]runtime.VolumeStatus, 0, len(pvcs))
+	for _, pvc := range pvcs {
+		status := runtime.VolumeStatus{
+			Name:   pvc.Name,
+			Labels: pvc.Labels,
+			Phase:  string(pvc.Status.Phase),
+		}
+		if capacity, ok := pvc.Status.Capacity[corev1.ResourceStorage]; ok {
+			status.CapacityBytes = uint64(capacity.Value())
+		}
+		res = append(res, status)
+	}
+
+	sort.Slice(res, func(i, j int) bool { return res[i].Name < res[j].Name })
+	return res
+}
@@ -0,0 +1,56 @@
+package helm
+
+import (
+	"testing"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/client/runtime"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestVolumeStatuses(t *testing.T) {
+	pvc := func(name string, phase corev1.PersistentVolumeClaimPhase, capacity string) corev1.PersistentVolumeClaim {
+		p := corev1.PersistentVolumeClaim{
+			ObjectMeta: v1.ObjectMeta{Name: name, Labels: map[string]string{"chorus-tre.ch/volume-kind": "shared"}},
+			Status:     corev1.PersistentVolumeClaimStatus{Phase: phase},
+		}
+		if capacity != "" {
+			p.Status.Capacity = corev1.ResourceList{corev1.ResourceStorage: resource.MustParse(capacity)}
+		}
+		return p
+	}
+
+	statuses := volumeStatuses([]corev1.PersistentVolumeClaim{
+		pvc("workspace-shared", corev1.ClaimBound, "1Gi"),
+		pvc("home-user1", corev1.ClaimPending, ""),
+	})
+
+	require.Len(t, statuses, 2)
+	require.Equal(t, "home-user1", statuses[0].Name)
+	require.Equal(t, "Pending", statuses[0].Phase)
+	require.Zero(t, statuses[0].CapacityBytes)
+	require.Nil(t, statuses[0].UsedBytes)
+	require.Equal(t, "workspace-shared", statuses[1].Name)
+	require.Equal(t, "Bound", statuses[1].Phase)
+	require.Equal(t, uint64(1024*1024*1024), statuses[1].CapacityBytes)
+	require.Nil(t, statuses[1].UsedBytes)
+}
+
+func TestVolumeClaim(t *testing.T) {
+	pvc, err := volumeClaim("workspace1", runtime.Volume{Name: "home-user1", StorageClass: "cephfs", Size: "5Gi"})
+	require.NoError(t, err)
+	require.Equal(t, "home-user1", pvc.Name)
+	require.Equal(t, "workspace1", pvc.Namespace)
+	require.Equal(t, []corev1.PersistentVolumeAccessMode{corev1.ReadWriteMany}, pvc.Spec.AccessModes)
+	require.Equal(t, "cephfs", *pvc.Spec.StorageClassName)
+	require.Equal(t, resource.MustParse("5Gi"), pvc.Spec.Resources.Requests[corev1.ResourceStorage])
+
+	pvc, err = volumeClaim("workspace1", runtime.Volume{Name: "workspace-shared", Size: "10Gi"})
+	require.NoError(t, err)
+	require.Nil(t, pvc.Spec.StorageClassName)
+
+	_, err = volumeClaim("workspace1", runtime.Volume{Name: "workspace-shared", Size: "ten"})
+	require.Error(t, err)
+}
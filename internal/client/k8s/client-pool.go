@@ -0,0 +1,179 @@
+package k8s
+
+import (
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/config"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/restmapper"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+var _ genericclioptions.RESTClientGetter = &NamespaceClients{}
+
+// ClientPool caches the clients reaching the cluster of the helm client, per
+// namespace. The clients are built again when the service account token or
+// ca files change on disk.
+type ClientPool struct {
+	cfg config.Config
+
+	mu         sync.Mutex
+	modTimes   map[string]time.Time
+	cluster    *clusterClients
+	namespaces map[string]*NamespaceClients
+}
+
+// clusterClients are the clients shared by all the namespaces.
+type clusterClients struct {
+	config    *rest.Config
+	clientset kubernetes.Interface
+	discovery discovery.CachedDiscoveryInterface
+	mapper    meta.RESTMapper
+}
+
+// NamespaceClients are the clients of a namespace. They implement the
+// RESTClientGetter of the kubectl and helm clients.
+type NamespaceClients struct {
+	*clusterClients
+	namespace string
+}
+
+func NewClientPool(cfg config.Config) *ClientPool {
+	return &ClientPool{
+		cfg:        cfg,
+		namespaces: make(map[string]*NamespaceClients),
+	}
+}
+
+// Get returns the clients of a namespace. The returned clients stay usable
+// after a refresh, but are no longer the ones returned for the namespace.
+func (p *ClientPool) Get(namespace string) (*NamespaceClients, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	modTimes, err := p.readModTimes()
+	if err != nil {
+		return nil, err
+	}
+	if !sameModTimes(modTimes, p.modTimes) {
+		p.cluster = nil
+		p.namespaces = make(map[string]*NamespaceClients)
+	}
+
+	if c, ok := p.namespaces[namespace]; ok {
+		return c, nil
+	}
+
+	if p.cluster == nil {
+		cluster, err := newClusterClients(p.cfg)
+		if err != nil {
+			return nil, err
+		}
+		p.cluster = cluster
+		p.modTimes = modTimes
+	}
+
+	c := &NamespaceClients{clusterClients: p.cluster, namespace: namespace}
+	p.namespaces[namespace] = c
+	return c, nil
+}
+
+// readModTimes returns the modification time of the credential files, which
+// are replaced when they are rotated.
+func (p *ClientPool) readModTimes() (map[string]time.Time, error) {
+	res := make(map[string]time.Time)
+	for _, f := range []string{p.cfg.Clients.HelmClient.TokenFile, p.cfg.Clients.HelmClient.CAFile} {
+		if f == "" {
+			continue
+		}
+		info, err := os.Stat(f)
+		if err != nil {
+			return nil, fmt.Errorf("unable to stat %v: %w", f, err)
+		}
+		res[f] = info.ModTime()
+	}
+	return res, nil
+}
+
+func sameModTimes(a, b map[string]time.Time) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for f, t := range a {
+		if !t.Equal(b[f]) {
+			return false
+		}
+	}
+	return true
+}
+
+func newClusterClients(cfg config.Config) (*clusterClients, error) {
+	restConfig, err := RESTConfig(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get rest config: %w", err)
+	}
+
+	// The ca file is only read when the transport is built, so it is read
+	// here to be read again upon the next refresh.
+	if restConfig.TLSClientConfig.CAFile != "" {
+		ca, err := os.ReadFile(restConfig.TLSClientConfig.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read ca file: %w", err)
+		}
+		restConfig.TLSClientConfig.CAData = ca
+		restConfig.TLSClientConfig.CAFile = ""
+	}
+
+	clientset, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get clientset: %w", err)
+	}
+
+	cachedDiscovery := memory.NewMemCacheClient(clientset.Discovery())
+
+	return &clusterClients{
+		config:    restConfig,
+		clientset: clientset,
+		discovery: cachedDiscovery,
+		mapper:    restmapper.NewDeferredDiscoveryRESTMapper(cachedDiscovery),
+	}, nil
+}
+
+func (c *NamespaceClients) Namespace() string {
+	return c.namespace
+}
+
+func (c *NamespaceClients) Clientset() kubernetes.Interface {
+	return c.clientset
+}
+
+// ToRESTConfig returns a copy of the shared configuration, which the callers
+// are free to modify.
+func (c *NamespaceClients) ToRESTConfig() (*rest.Config, error) {
+	return rest.CopyConfig(c.config), nil
+}
+
+func (c *NamespaceClients) ToDiscoveryClient() (discovery.CachedDiscoveryInterface, error) {
+	return c.discovery, nil
+}
+
+func (c *NamespaceClients) ToRESTMapper() (meta.RESTMapper, error) {
+	return c.mapper, nil
+}
+
+// ToRawKubeConfigLoader only provides the namespace of the clients, their
+// configuration being built from the config of the helm client.
+func (c *NamespaceClients) ToRawKubeConfigLoader() clientcmd.ClientConfig {
+	return clientcmd.NewDefaultClientConfig(*clientcmdapi.NewConfig(), &clientcmd.ConfigOverrides{
+		Context: clientcmdapi.Context{Namespace: c.namespace},
+	})
+}
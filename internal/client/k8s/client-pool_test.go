@@ -0,0 +1,69 @@
+package k8s
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientPoolCachesClients(t *testing.T) {
+	cfg := config.Config{}
+	cfg.Clients.HelmClient.Token = "token"
+	cfg.Clients.HelmClient.APIServer = "https://127.0.0.1:6443"
+	p := NewClientPool(cfg)
+
+	c1, err := p.Get("workspace1")
+	require.NoError(t, err)
+	require.Equal(t, "workspace1", c1.Namespace())
+
+	again, err := p.Get("workspace1")
+	require.NoError(t, err)
+	require.Same(t, c1, again)
+
+	// The namespaces share the clients of the cluster.
+	c2, err := p.Get("workspace2")
+	require.NoError(t, err)
+	require.NotSame(t, c1, c2)
+	require.Same(t, c1.clusterClients, c2.clusterClients)
+
+	ns, _, err := c2.ToRawKubeConfigLoader().Namespace()
+	require.NoError(t, err)
+	require.Equal(t, "workspace2", ns)
+}
+
+func TestClientPoolRefreshesRotatedToken(t *testing.T) {
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(tokenFile, []byte("token1"), 0o600))
+
+	cfg := config.Config{}
+	cfg.Clients.HelmClient.TokenFile = tokenFile
+	cfg.Clients.HelmClient.APIServer = "https://127.0.0.1:6443"
+	p := NewClientPool(cfg)
+
+	c1, err := p.Get("workspace1")
+	require.NoError(t, err)
+	restConfig, err := c1.ToRESTConfig()
+	require.NoError(t, err)
+	require.Equal(t, tokenFile, restConfig.BearerTokenFile)
+
+	require.NoError(t, os.WriteFile(tokenFile, []byte("token2"), 0o600))
+	require.NoError(t, os.Chtimes(tokenFile, time.Now(), time.Now().Add(time.Minute)))
+
+	c2, err := p.Get("workspace1")
+	require.NoError(t, err)
+	require.NotSame(t, c1.clusterClients, c2.clusterClients)
+
+	// A missing token file is an error rather than stale clients.
+	require.NoError(t, os.Remove(tokenFile))
+	_, err = p.Get("workspace1")
+	require.Error(t, err)
+}
+
+func TestClientPoolWithoutConfig(t *testing.T) {
+	_, err := NewClientPool(config.Config{}).Get("workspace1")
+	require.Error(t, err)
+}
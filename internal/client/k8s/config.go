@@ -0,0 +1,56 @@
+package k8s
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/config"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+// IsConfigured returns whether the cluster of the helm client is configured.
+func IsConfigured(cfg config.Config) bool {
+	helmCfg := cfg.Clients.HelmClient
+	return helmCfg.KubeConfig != "" || helmCfg.Token != "" || helmCfg.TokenFile != ""
+}
+
+// RESTConfig returns the configuration reaching the cluster of the helm
+// client, either through its kubeconfig or through its service account.
+func RESTConfig(cfg config.Config) (*rest.Config, error) {
+	helmCfg := cfg.Clients.HelmClient
+
+	if helmCfg.KubeConfig != "" {
+		restConfig, err := clientcmd.RESTConfigFromKubeConfig([]byte(helmCfg.KubeConfig))
+		if err != nil {
+			return nil, fmt.Errorf("error loading kubeconfig: %w", err)
+		}
+		return restConfig, nil
+	}
+	if helmCfg.Token != "" {
+		return &rest.Config{
+			Host:        helmCfg.APIServer,
+			BearerToken: helmCfg.Token,
+			TLSClientConfig: rest.TLSClientConfig{
+				CAData: []byte(helmCfg.CA),
+			},
+		}, nil
+	}
+	if helmCfg.TokenFile != "" {
+		// The clients read the token file again periodically, so that they
+		// follow its rotation.
+		restConfig := &rest.Config{
+			Host:            helmCfg.APIServer,
+			BearerTokenFile: helmCfg.TokenFile,
+			TLSClientConfig: rest.TLSClientConfig{
+				CAFile: helmCfg.CAFile,
+			},
+		}
+		if helmCfg.CAFile == "" {
+			restConfig.TLSClientConfig.CAData = []byte(helmCfg.CA)
+		}
+		return restConfig, nil
+	}
+
+	return nil, errors.New("no config for kubernetes client found")
+}
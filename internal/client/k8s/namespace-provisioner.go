@@ -0,0 +1,244 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/config"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	corev1ac "k8s.io/client-go/applyconfigurations/core/v1"
+	metav1ac "k8s.io/client-go/applyconfigurations/meta/v1"
+	networkingv1ac "k8s.io/client-go/applyconfigurations/networking/v1"
+	rbacv1ac "k8s.io/client-go/applyconfigurations/rbac/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	// fieldManager owns the fields applied by the provisioner, which are
+	// reverted when they are changed by anyone else.
+	fieldManager = "chorus-backend"
+
+	defaultDenyPolicyName = "default-deny"
+	allowPolicyName       = "allow-approved"
+	quotaName             = "workspace-quota"
+	limitRangeName        = "workspace-limits"
+	serviceAccountName    = "workspace"
+
+	namespaceNameLabel = "kubernetes.io/metadata.name"
+)
+
+// NamespaceProvisioner isolates the namespace of a workspace.
+type NamespaceProvisioner interface {
+	ProvisionNamespace(ctx context.Context, spec NamespaceSpec) error
+}
+
+// NamespaceSpec describes the namespace of a workspace.
+type NamespaceSpec struct {
+	Name   string
+	Labels map[string]string
+	// MaxCPU and MaxMemory bound the resources requested in the namespace,
+	// in millicores and MiB. A nil limit is unlimited.
+	MaxCPU    *uint64
+	MaxMemory *uint64
+}
+
+type namespaceProvisioner struct {
+	cfg       config.NamespaceProvisioner
+	clientset kubernetes.Interface
+}
+
+func NewNamespaceProvisioner(cfg config.Config) (*namespaceProvisioner, error) {
+	restConfig, err := RESTConfig(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get rest config: %w", err)
+	}
+
+	clientset, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get clientset: %w", err)
+	}
+
+	return &namespaceProvisioner{
+		cfg:       cfg.Clients.NamespaceProvisioner,
+		clientset: clientset,
+	}, nil
+}
+
+// ProvisionNamespace applies the namespace along with its network policies,
+// resource quota, limit range and service account. It is idempotent, so that
+// it reconciles a namespace that drifted from its spec.
+func (p *namespaceProvisioner) ProvisionNamespace(ctx context.Context, spec NamespaceSpec) error {
+	opts := metav1.ApplyOptions{FieldManager: fieldManager, Force: true}
+	ns := spec.Name
+
+	if _, err := p.clientset.CoreV1().Namespaces().Apply(ctx, corev1ac.Namespace(ns).WithLabels(spec.Labels), opts); err != nil {
+		return fmt.Errorf("unable to apply namespace %v: %w", ns, err)
+	}
+
+	for _, policy := range p.networkPolicies(ns) {
+		if _, err := p.clientset.NetworkingV1().NetworkPolicies(ns).Apply(ctx, policy, opts); err != nil {
+			return fmt.Errorf("unable to apply network policy %v: %w", *policy.Name, err)
+		}
+	}
+
+	if err := p.applyQuota(ctx, spec, opts); err != nil {
+		return err
+	}
+
+	limitRange, err := p.limitRange(ns)
+	if err != nil {
+		return err
+	}
+	if _, err := p.clientset.CoreV1().LimitRanges(ns).Apply(ctx, limitRange, opts); err != nil {
+		return fmt.Errorf("unable to apply limit range: %w", err)
+	}
+
+	if err := p.applyServiceAccount(ctx, ns, opts); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// networkPolicies denies all the traffic of the namespace, then allows the
+// traffic within the namespace, the DNS lookups, the approved egress and the
+// ingress from the approved namespaces.
+func (p *namespaceProvisioner) networkPolicies(ns string) []*networkingv1ac.NetworkPolicyApplyConfiguration {
+	allPods := metav1ac.LabelSelector()
+	sameNamespace := networkingv1ac.NetworkPolicyPeer().WithPodSelector(metav1ac.LabelSelector())
+
+	deny := networkingv1ac.NetworkPolicy(defaultDenyPolicyName, ns).
+		WithSpec(networkingv1ac.NetworkPolicySpec().
+			WithPodSelector(allPods).
+			WithPolicyTypes(networkingv1.PolicyTypeIngress, networkingv1.PolicyTypeEgress))
+
+	dns := networkingv1ac.NetworkPolicyEgressRule().
+		WithTo(networkingv1ac.NetworkPolicyPeer().
+			WithNamespaceSelector(metav1ac.LabelSelector().WithMatchLabels(map[string]string{namespaceNameLabel: "kube-system"}))).
+		WithPorts(
+			networkingv1ac.NetworkPolicyPort().WithProtocol(corev1.ProtocolUDP).WithPort(intstr.FromInt32(53)),
+			networkingv1ac.NetworkPolicyPort().WithProtocol(corev1.ProtocolTCP).WithPort(intstr.FromInt32(53)),
+		)
+
+	egress := []*networkingv1ac.NetworkPolicyEgressRuleApplyConfiguration{
+		networkingv1ac.NetworkPolicyEgressRule().WithTo(sameNamespace),
+		dns,
+	}
+	for _, rule := range p.cfg.AllowedEgress {
+		r := networkingv1ac.NetworkPolicyEgressRule().
+			WithTo(networkingv1ac.NetworkPolicyPeer().WithIPBlock(networkingv1ac.IPBlock().WithCIDR(rule.CIDR)))
+		for _, port := range rule.Ports {
+			r.WithPorts(networkingv1ac.NetworkPolicyPort().WithProtocol(corev1.ProtocolTCP).WithPort(intstr.FromInt32(port)))
+		}
+		egress = append(egress, r)
+	}
+
+	ingress := []*networkingv1ac.NetworkPolicyIngressRuleApplyConfiguration{
+		networkingv1ac.NetworkPolicyIngressRule().WithFrom(sameNamespace),
+	}
+	for _, from := range p.cfg.IngressNamespaces {
+		ingress = append(ingress, networkingv1ac.NetworkPolicyIngressRule().
+			WithFrom(networkingv1ac.NetworkPolicyPeer().
+				WithNamespaceSelector(metav1ac.LabelSelector().WithMatchLabels(map[string]string{namespaceNameLabel: from}))))
+	}
+
+	allow := networkingv1ac.NetworkPolicy(allowPolicyName, ns).
+		WithSpec(networkingv1ac.NetworkPolicySpec().
+			WithPodSelector(allPods).
+			WithPolicyTypes(networkingv1.PolicyTypeIngress, networkingv1.PolicyTypeEgress).
+			WithIngress(ingress...).
+			WithEgress(egress...))
+
+	return []*networkingv1ac.NetworkPolicyApplyConfiguration{deny, allow}
+}
+
+// applyQuota applies the resource quota of the namespace, which is removed
+// when the workspace is unlimited.
+func (p *namespaceProvisioner) applyQuota(ctx context.Context, spec NamespaceSpec, opts metav1.ApplyOptions) error {
+	hard := corev1.ResourceList{}
+	if spec.MaxCPU != nil {
+		hard[corev1.ResourceRequestsCPU] = *resource.NewMilliQuantity(int64(*spec.MaxCPU), resource.DecimalSI)
+	}
+	if spec.MaxMemory != nil {
+		hard[corev1.ResourceRequestsMemory] = *resource.NewQuantity(int64(*spec.MaxMemory)*1024*1024, resource.BinarySI)
+	}
+
+	if len(hard) == 0 {
+		err := p.clientset.CoreV1().ResourceQuotas(spec.Name).Delete(ctx, quotaName, metav1.DeleteOptions{})
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return fmt.Errorf("unable to delete resource quota: %w", err)
+		}
+		return nil
+	}
+
+	quota := corev1ac.ResourceQuota(quotaName, spec.Name).WithSpec(corev1ac.ResourceQuotaSpec().WithHard(hard))
+	if _, err := p.clientset.CoreV1().ResourceQuotas(spec.Name).Apply(ctx, quota, opts); err != nil {
+		return fmt.Errorf("unable to apply resource quota: %w", err)
+	}
+	return nil
+}
+
+// limitRange sets the requests and limits of the containers that set none,
+// which the resource quota otherwise rejects.
+func (p *namespaceProvisioner) limitRange(ns string) (*corev1ac.LimitRangeApplyConfiguration, error) {
+	defaults := corev1.ResourceList{}
+	if p.cfg.DefaultCPU != "" {
+		q, err := resource.ParseQuantity(p.cfg.DefaultCPU)
+		if err != nil {
+			return nil, fmt.Errorf("invalid default cpu %q: %w", p.cfg.DefaultCPU, err)
+		}
+		defaults[corev1.ResourceCPU] = q
+	}
+	if p.cfg.DefaultMemory != "" {
+		q, err := resource.ParseQuantity(p.cfg.DefaultMemory)
+		if err != nil {
+			return nil, fmt.Errorf("invalid default memory %q: %w", p.cfg.DefaultMemory, err)
+		}
+		defaults[corev1.ResourceMemory] = q
+	}
+
+	return corev1ac.LimitRange(limitRangeName, ns).
+		WithSpec(corev1ac.LimitRangeSpec().
+			WithLimits(corev1ac.LimitRangeItem().
+				WithType(corev1.LimitTypeContainer).
+				WithDefault(defaults).
+				WithDefaultRequest(defaults))), nil
+}
+
+// applyServiceAccount applies the service account of the workspace, which can
+// only read the pods, services and config maps of its namespace.
+func (p *namespaceProvisioner) applyServiceAccount(ctx context.Context, ns string, opts metav1.ApplyOptions) error {
+	sa := corev1ac.ServiceAccount(serviceAccountName, ns).WithAutomountServiceAccountToken(false)
+	if _, err := p.clientset.CoreV1().ServiceAccounts(ns).Apply(ctx, sa, opts); err != nil {
+		return fmt.Errorf("unable to apply service account: %w", err)
+	}
+
+	role := rbacv1ac.Role(serviceAccountName, ns).
+		WithRules(rbacv1ac.PolicyRule().
+			WithAPIGroups("").
+			WithResources("pods", "services", "configmaps").
+			WithVerbs("get", "list", "watch"))
+	if _, err := p.clientset.RbacV1().Roles(ns).Apply(ctx, role, opts); err != nil {
+		return fmt.Errorf("unable to apply role: %w", err)
+	}
+
+	binding := rbacv1ac.RoleBinding(serviceAccountName, ns).
+		WithRoleRef(rbacv1ac.RoleRef().
+			WithAPIGroup("rbac.authorization.k8s.io").
+			WithKind("Role").
+			WithName(serviceAccountName)).
+		WithSubjects(rbacv1ac.Subject().
+			WithKind("ServiceAccount").
+			WithName(serviceAccountName).
+			WithNamespace(ns))
+	if _, err := p.clientset.RbacV1().RoleBindings(ns).Apply(ctx, binding, opts); err != nil {
+		return fmt.Errorf("unable to apply role binding: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package k8s
+
+import (
+	"testing"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/config"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestNetworkPolicies(t *testing.T) {
+	p := &namespaceProvisioner{cfg: config.NamespaceProvisioner{
+		AllowedEgress:     []config.EgressRule{{CIDR: "10.0.0.0/8", Ports: []int32{443}}, {CIDR: "192.168.1.1/32"}},
+		IngressNamespaces: []string{"chorus"},
+	}}
+
+	policies := p.networkPolicies("workspace1")
+	require.Len(t, policies, 2)
+
+	deny := policies[0]
+	require.Equal(t, defaultDenyPolicyName, *deny.Name)
+	require.Equal(t, "workspace1", *deny.Namespace)
+	require.Empty(t, deny.Spec.Ingress)
+	require.Empty(t, deny.Spec.Egress)
+	require.Len(t, deny.Spec.PolicyTypes, 2)
+
+	allow := policies[1]
+	// The namespace itself, the DNS and the two approved endpoints.
+	require.Len(t, allow.Spec.Egress, 4)
+	require.Equal(t, "10.0.0.0/8", *allow.Spec.Egress[2].To[0].IPBlock.CIDR)
+	require.Equal(t, int32(443), allow.Spec.Egress[2].Ports[0].Port.IntVal)
+	require.Empty(t, allow.Spec.Egress[3].Ports)
+	// The namespace itself and the backend namespace.
+	require.Len(t, allow.Spec.Ingress, 2)
+	require.Equal(t, "chorus", allow.Spec.Ingress[1].From[0].NamespaceSelector.MatchLabels[namespaceNameLabel])
+}
+
+func TestLimitRange(t *testing.T) {
+	p := &namespaceProvisioner{cfg: config.NamespaceProvisioner{DefaultCPU: "500m", DefaultMemory: "512Mi"}}
+
+	lr, err := p.limitRange("workspace1")
+	require.NoError(t, err)
+	item := lr.Spec.Limits[0]
+	require.Equal(t, corev1.LimitTypeContainer, *item.Type)
+	require.Equal(t, "500m", item.Default.Cpu().String())
+	require.Equal(t, "512Mi", item.DefaultRequest.Memory().String())
+
+	p.cfg.DefaultCPU = "lots"
+	_, err = p.limitRange("workspace1")
+	require.Error(t, err)
+}
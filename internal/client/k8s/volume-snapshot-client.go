@@ -0,0 +1,116 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/config"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+)
+
+// VolumeSnapshotGVR is the resource of the CSI volume snapshots, whose CRDs
+// are installed along with the snapshot controller of the cluster.
+var VolumeSnapshotGVR = schema.GroupVersionResource{
+	Group:    "snapshot.storage.k8s.io",
+	Version:  "v1",
+	Resource: "volumesnapshots",
+}
+
+const volumeSnapshotKind = "VolumeSnapshot"
+
+// VolumeSnapshotClient creates, watches and deletes the snapshots of the
+// persistent volume claims.
+type VolumeSnapshotClient interface {
+	// CreateVolumeSnapshot takes a snapshot of a claim with the snapshot
+	// class, the default one of its CSI driver when empty. A snapshot that
+	// exists is left as is.
+	CreateVolumeSnapshot(ctx context.Context, namespace, name, claimName, snapshotClass string) error
+	// GetVolumeSnapshotStatus returns whether a snapshot is ready to use, or
+	// the error reported by the snapshot controller when it cannot be cut.
+	GetVolumeSnapshotStatus(ctx context.Context, namespace, name string) (*VolumeSnapshotStatus, error)
+	// DeleteVolumeSnapshot deletes a snapshot, unless it does not exist.
+	DeleteVolumeSnapshot(ctx context.Context, namespace, name string) error
+}
+
+// VolumeSnapshotStatus is the status of a volume snapshot.
+type VolumeSnapshotStatus struct {
+	ReadyToUse bool
+	// Error is the message of the error cutting the snapshot, empty when
+	// there is none.
+	Error string
+}
+
+type volumeSnapshotClient struct {
+	client dynamic.Interface
+}
+
+func NewVolumeSnapshotClient(cfg config.Config) (*volumeSnapshotClient, error) {
+	restConfig, err := RESTConfig(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get rest config: %w", err)
+	}
+
+	client, err := dynamic.NewForConfig(restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get dynamic client: %w", err)
+	}
+
+	return newVolumeSnapshotClient(client), nil
+}
+
+func newVolumeSnapshotClient(client dynamic.Interface) *volumeSnapshotClient {
+	return &volumeSnapshotClient{client: client}
+}
+
+func (c *volumeSnapshotClient) CreateVolumeSnapshot(ctx context.Context, namespace, name, claimName, snapshotClass string) error {
+	spec := map[string]interface{}{
+		"source": map[string]interface{}{"persistentVolumeClaimName": claimName},
+	}
+	if snapshotClass != "" {
+		spec["volumeSnapshotClassName"] = snapshotClass
+	}
+
+	snapshot := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": VolumeSnapshotGVR.GroupVersion().String(),
+		"kind":       volumeSnapshotKind,
+		"metadata": map[string]interface{}{
+			"name":      name,
+			"namespace": namespace,
+		},
+		"spec": spec,
+	}}
+
+	_, err := c.client.Resource(VolumeSnapshotGVR).Namespace(namespace).Create(ctx, snapshot, metav1.CreateOptions{})
+	if err != nil && !k8serrors.IsAlreadyExists(err) {
+		return fmt.Errorf("unable to create volume snapshot %v: %w", name, err)
+	}
+	return nil
+}
+
+func (c *volumeSnapshotClient) GetVolumeSnapshotStatus(ctx context.Context, namespace, name string) (*VolumeSnapshotStatus, error) {
+	snapshot, err := c.client.Resource(VolumeSnapshotGVR).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("unable to get volume snapshot %v: %w", name, err)
+	}
+
+	var status VolumeSnapshotStatus
+	if status.ReadyToUse, _, err = unstructured.NestedBool(snapshot.Object, "status", "readyToUse"); err != nil {
+		return nil, fmt.Errorf("unable to read the status of volume snapshot %v: %w", name, err)
+	}
+	if status.Error, _, err = unstructured.NestedString(snapshot.Object, "status", "error", "message"); err != nil {
+		return nil, fmt.Errorf("unable to read the status of volume snapshot %v: %w", name, err)
+	}
+	return &status, nil
+}
+
+func (c *volumeSnapshotClient) DeleteVolumeSnapshot(ctx context.Context, namespace, name string) error {
+	err := c.client.Resource(VolumeSnapshotGVR).Namespace(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return fmt.Errorf("unable to delete volume snapshot %v: %w", name, err)
+	}
+	return nil
+}
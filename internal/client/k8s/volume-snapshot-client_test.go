@@ -0,0 +1,66 @@
+package k8s
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+)
+
+func TestVolumeSnapshotClient(t *testing.T) {
+	ctx := context.Background()
+	client := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		VolumeSnapshotGVR: "VolumeSnapshotList",
+	})
+	c := newVolumeSnapshotClient(client)
+
+	require.NoError(t, c.CreateVolumeSnapshot(ctx, "workspace1", "snapshot1-home-user1", "home-user1", "csi-snapclass"))
+	require.NoError(t, c.CreateVolumeSnapshot(ctx, "workspace1", "snapshot1-home-user1", "home-user1", "csi-snapclass"))
+	require.NoError(t, c.CreateVolumeSnapshot(ctx, "workspace1", "snapshot1-workspace-shared", "workspace-shared", ""))
+
+	obj, err := client.Tracker().Get(VolumeSnapshotGVR, "workspace1", "snapshot1-home-user1")
+	require.NoError(t, err)
+	snapshot := obj.(*unstructured.Unstructured)
+	require.Equal(t, volumeSnapshotKind, snapshot.GetKind())
+	claim, _, err := unstructured.NestedString(snapshot.Object, "spec", "source", "persistentVolumeClaimName")
+	require.NoError(t, err)
+	require.Equal(t, "home-user1", claim)
+	class, _, err := unstructured.NestedString(snapshot.Object, "spec", "volumeSnapshotClassName")
+	require.NoError(t, err)
+	require.Equal(t, "csi-snapclass", class)
+
+	obj, err = client.Tracker().Get(VolumeSnapshotGVR, "workspace1", "snapshot1-workspace-shared")
+	require.NoError(t, err)
+	_, found, err := unstructured.NestedString(obj.(*unstructured.Unstructured).Object, "spec", "volumeSnapshotClassName")
+	require.NoError(t, err)
+	require.False(t, found)
+
+	status, err := c.GetVolumeSnapshotStatus(ctx, "workspace1", "snapshot1-home-user1")
+	require.NoError(t, err)
+	require.Equal(t, &VolumeSnapshotStatus{}, status)
+
+	require.NoError(t, unstructured.SetNestedField(snapshot.Object, true, "status", "readyToUse"))
+	require.NoError(t, client.Tracker().Update(VolumeSnapshotGVR, snapshot, "workspace1"))
+	status, err = c.GetVolumeSnapshotStatus(ctx, "workspace1", "snapshot1-home-user1")
+	require.NoError(t, err)
+	require.True(t, status.ReadyToUse)
+
+	require.NoError(t, unstructured.SetNestedField(snapshot.Object, "no space left", "status", "error", "message"))
+	require.NoError(t, client.Tracker().Update(VolumeSnapshotGVR, snapshot, "workspace1"))
+	status, err = c.GetVolumeSnapshotStatus(ctx, "workspace1", "snapshot1-home-user1")
+	require.NoError(t, err)
+	require.Equal(t, "no space left", status.Error)
+
+	_, err = c.GetVolumeSnapshotStatus(ctx, "workspace1", "snapshot2-home-user1")
+	require.True(t, k8serrors.IsNotFound(err))
+
+	require.NoError(t, c.DeleteVolumeSnapshot(ctx, "workspace1", "snapshot1-home-user1"))
+	require.NoError(t, c.DeleteVolumeSnapshot(ctx, "workspace1", "snapshot1-home-user1"))
+	_, err = client.Tracker().Get(VolumeSnapshotGVR, "workspace1", "snapshot1-home-user1")
+	require.Error(t, err)
+}
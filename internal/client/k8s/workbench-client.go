@@ -0,0 +1,175 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/config"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/util/retry"
+)
+
+// WorkbenchGVR is the resource of the Workbench CRD, whose objects are
+// installed by the chart of the workbenches.
+var WorkbenchGVR = schema.GroupVersionResource{
+	Group:    "default.chorus-tre.ch",
+	Version:  "v1alpha1",
+	Resource: "workbenches",
+}
+
+const workbenchKind = "Workbench"
+
+// Workbench maps the Workbench custom resource.
+type Workbench struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   WorkbenchSpec   `json:"spec,omitempty"`
+	Status WorkbenchStatus `json:"status,omitempty"`
+}
+
+type WorkbenchSpec struct {
+	Server           WorkbenchServer   `json:"server,omitempty"`
+	Apps             []WorkbenchApp    `json:"apps,omitempty"`
+	ImagePullSecrets []string          `json:"imagePullSecrets,omitempty"`
+	Volumes          []WorkbenchVolume `json:"volumes,omitempty"`
+}
+
+type WorkbenchServer struct {
+	Version string `json:"version,omitempty"`
+}
+
+// WorkbenchApp is an application running in a workbench.
+type WorkbenchApp struct {
+	Name    string `json:"name"`
+	Version string `json:"version,omitempty"`
+	State   string `json:"state,omitempty"`
+	Image   string `json:"image,omitempty"`
+}
+
+// WorkbenchVolume is a persistent volume claim mounted into every app of a
+// workbench.
+type WorkbenchVolume struct {
+	Name      string `json:"name"`
+	MountPath string `json:"mountPath"`
+}
+
+type WorkbenchStatus struct {
+	Server WorkbenchStatusItem   `json:"server,omitempty"`
+	Apps   []WorkbenchStatusItem `json:"apps,omitempty"`
+}
+
+// WorkbenchStatusItem is the observed state of the server or of an app of a
+// workbench.
+type WorkbenchStatusItem struct {
+	Revision int    `json:"revision"`
+	Status   string `json:"status"`
+}
+
+// WorkbenchClient reads the workbenches and adds or removes their apps.
+type WorkbenchClient interface {
+	GetWorkbench(ctx context.Context, namespace, name string) (*Workbench, error)
+	AddApp(ctx context.Context, namespace, workbenchName string, app WorkbenchApp) error
+	RemoveApp(ctx context.Context, namespace, workbenchName, appName string) error
+}
+
+type workbenchClient struct {
+	client dynamic.Interface
+}
+
+func NewWorkbenchClient(cfg config.Config) (*workbenchClient, error) {
+	restConfig, err := RESTConfig(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get rest config: %w", err)
+	}
+
+	client, err := dynamic.NewForConfig(restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get dynamic client: %w", err)
+	}
+
+	return newWorkbenchClient(client), nil
+}
+
+func newWorkbenchClient(client dynamic.Interface) *workbenchClient {
+	return &workbenchClient{client: client}
+}
+
+func (c *workbenchClient) GetWorkbench(ctx context.Context, namespace, name string) (*Workbench, error) {
+	u, err := c.client.Resource(WorkbenchGVR).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("unable to get workbench %v: %w", name, err)
+	}
+
+	workbench := &Workbench{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, workbench); err != nil {
+		return nil, fmt.Errorf("unable to decode workbench %v: %w", name, err)
+	}
+	return workbench, nil
+}
+
+// AddApp adds an app to a workbench, replacing the app of the same name if
+// any.
+func (c *workbenchClient) AddApp(ctx context.Context, namespace, workbenchName string, app WorkbenchApp) error {
+	return c.updateApps(ctx, namespace, workbenchName, func(apps []WorkbenchApp) []WorkbenchApp {
+		return append(withoutApp(apps, app.Name), app)
+	})
+}
+
+// RemoveApp removes an app from a workbench. Removing an app that is not in
+// the workbench is not an error.
+func (c *workbenchClient) RemoveApp(ctx context.Context, namespace, workbenchName, appName string) error {
+	return c.updateApps(ctx, namespace, workbenchName, func(apps []WorkbenchApp) []WorkbenchApp {
+		return withoutApp(apps, appName)
+	})
+}
+
+// updateApps applies the apps of a workbench computed from its current ones.
+// The list of apps is replaced as a whole, so the applied configuration holds
+// the resource version it was computed from: a concurrent update makes the
+// apply fail with a conflict, upon which the apps are computed again.
+func (c *workbenchClient) updateApps(ctx context.Context, namespace, workbenchName string, update func([]WorkbenchApp) []WorkbenchApp) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		workbench, err := c.GetWorkbench(ctx, namespace, workbenchName)
+		if err != nil {
+			return err
+		}
+
+		spec, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&struct {
+			Apps []WorkbenchApp `json:"apps"`
+		}{Apps: update(workbench.Spec.Apps)})
+		if err != nil {
+			return fmt.Errorf("unable to encode the apps of workbench %v: %w", workbenchName, err)
+		}
+
+		apply := &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": WorkbenchGVR.GroupVersion().String(),
+			"kind":       workbenchKind,
+			"metadata": map[string]interface{}{
+				"name":            workbenchName,
+				"namespace":       namespace,
+				"resourceVersion": workbench.ResourceVersion,
+			},
+			"spec": spec,
+		}}
+
+		_, err = c.client.Resource(WorkbenchGVR).Namespace(namespace).Apply(ctx, workbenchName, apply, metav1.ApplyOptions{FieldManager: fieldManager, Force: true})
+		return err
+	})
+}
+
+// withoutApp returns the apps other than the named one, never nil so that
+// removing the last app applies an empty list.
+func withoutApp(apps []WorkbenchApp, name string) []WorkbenchApp {
+	res := make([]WorkbenchApp, 0, len(apps))
+	for _, app := range apps {
+		if app.Name != name {
+			res = append(res, app)
+		}
+	}
+	return res
+}
@@ -0,0 +1,118 @@
+package k8s
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func bumpResourceVersion(u *unstructured.Unstructured) {
+	rv, _ := strconv.Atoi(u.GetResourceVersion())
+	u.SetResourceVersion(strconv.Itoa(rv + 1))
+}
+
+// newFakeDynamicClient returns a fake dynamic client holding a workbench. The
+// fake client does not implement server-side apply, so a reactor applies the
+// patches as merge patches, rejecting those from a stale resource version as
+// the API server does.
+func newFakeDynamicClient(t *testing.T, apps ...interface{}) *dynamicfake.FakeDynamicClient {
+	client := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		WorkbenchGVR: "WorkbenchList",
+	})
+
+	workbench := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": WorkbenchGVR.GroupVersion().String(),
+		"kind":       workbenchKind,
+		"metadata":   map[string]interface{}{"name": "workbench1", "namespace": "workspace1", "resourceVersion": "1"},
+		"spec":       map[string]interface{}{"apps": apps},
+	}}
+	require.NoError(t, client.Tracker().Create(WorkbenchGVR, workbench, "workspace1"))
+
+	client.PrependReactor("patch", "workbenches", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		patch := action.(k8stesting.PatchAction)
+		current, err := client.Tracker().Get(WorkbenchGVR, patch.GetNamespace(), patch.GetName())
+		if err != nil {
+			return true, nil, err
+		}
+		res := current.(*unstructured.Unstructured).DeepCopy()
+
+		applied := &unstructured.Unstructured{}
+		require.NoError(t, json.Unmarshal(patch.GetPatch(), &applied.Object))
+		if applied.GetResourceVersion() != res.GetResourceVersion() {
+			return true, nil, k8serrors.NewConflict(WorkbenchGVR.GroupResource(), patch.GetName(), nil)
+		}
+
+		apps, _, err := unstructured.NestedSlice(applied.Object, "spec", "apps")
+		require.NoError(t, err)
+		require.NoError(t, unstructured.SetNestedSlice(res.Object, apps, "spec", "apps"))
+		bumpResourceVersion(res)
+		return true, res, client.Tracker().Update(WorkbenchGVR, res, patch.GetNamespace())
+	})
+
+	return client
+}
+
+func TestWorkbenchClientAddApp(t *testing.T) {
+	ctx := context.Background()
+	c := newWorkbenchClient(newFakeDynamicClient(t, map[string]interface{}{"name": "firefox"}))
+
+	require.NoError(t, c.AddApp(ctx, "workspace1", "workbench1", WorkbenchApp{Name: "vscode", Image: "registry/vscode:1.0"}))
+	// Adding an app again replaces it.
+	require.NoError(t, c.AddApp(ctx, "workspace1", "workbench1", WorkbenchApp{Name: "vscode", Image: "registry/vscode:1.1"}))
+
+	workbench, err := c.GetWorkbench(ctx, "workspace1", "workbench1")
+	require.NoError(t, err)
+	require.Equal(t, []WorkbenchApp{{Name: "firefox"}, {Name: "vscode", Image: "registry/vscode:1.1"}}, workbench.Spec.Apps)
+	require.Equal(t, "3", workbench.ResourceVersion)
+}
+
+func TestWorkbenchClientRemoveApp(t *testing.T) {
+	ctx := context.Background()
+	c := newWorkbenchClient(newFakeDynamicClient(t, map[string]interface{}{"name": "firefox"}, map[string]interface{}{"name": "vscode"}))
+
+	require.NoError(t, c.RemoveApp(ctx, "workspace1", "workbench1", "firefox"))
+	require.NoError(t, c.RemoveApp(ctx, "workspace1", "workbench1", "unknown"))
+	require.NoError(t, c.RemoveApp(ctx, "workspace1", "workbench1", "vscode"))
+
+	workbench, err := c.GetWorkbench(ctx, "workspace1", "workbench1")
+	require.NoError(t, err)
+	require.Empty(t, workbench.Spec.Apps)
+}
+
+func TestWorkbenchClientConflict(t *testing.T) {
+	ctx := context.Background()
+	client := newFakeDynamicClient(t)
+	c := newWorkbenchClient(client)
+
+	// An app is added concurrently between the first read of the workbench
+	// and its apply, which is then retried from the new resource version.
+	concurrent := true
+	client.PrependReactor("patch", "workbenches", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		if !concurrent {
+			return false, nil, nil
+		}
+		concurrent = false
+		current, err := client.Tracker().Get(WorkbenchGVR, "workspace1", "workbench1")
+		require.NoError(t, err)
+		u := current.(*unstructured.Unstructured).DeepCopy()
+		require.NoError(t, unstructured.SetNestedSlice(u.Object, []interface{}{map[string]interface{}{"name": "firefox"}}, "spec", "apps"))
+		bumpResourceVersion(u)
+		require.NoError(t, client.Tracker().Update(WorkbenchGVR, u, "workspace1"))
+		return false, nil, nil
+	})
+
+	require.NoError(t, c.AddApp(ctx, "workspace1", "workbench1", WorkbenchApp{Name: "vscode"}))
+
+	workbench, err := c.GetWorkbench(ctx, "workspace1", "workbench1")
+	require.NoError(t, err)
+	require.Equal(t, []WorkbenchApp{{Name: "firefox"}, {Name: "vscode"}}, workbench.Spec.Apps)
+}
@@ -0,0 +1,290 @@
+package registry
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/config"
+)
+
+const (
+	// DefaultRegistry is the registry of the images whose name has none.
+	DefaultRegistry = "docker.io"
+	// defaultRegistryHost serves the API of the default registry.
+	defaultRegistryHost = "registry-1.docker.io"
+
+	defaultTimeout = 30 * time.Second
+)
+
+// manifestMediaTypes are the manifests accepted when resolving a tag, the
+// digest of an index covering all the platforms of a multi-arch image.
+var manifestMediaTypes = []string{
+	"application/vnd.oci.image.index.v1+json",
+	"application/vnd.docker.distribution.manifest.list.v2+json",
+	"application/vnd.oci.image.manifest.v1+json",
+	"application/vnd.docker.distribution.manifest.v2+json",
+}
+
+var (
+	ErrImageNotFound = errors.New("image not found")
+	ErrUnauthorized  = errors.New("unauthorized")
+)
+
+// Reference is the name of an image split into its registry and its
+// repository, such as "registry.example.com" and "team/app".
+type Reference struct {
+	Registry   string
+	Repository string
+}
+
+// ParseReference splits the name of an image, without tag nor digest. As
+// with Docker, the first component is the registry when it looks like a
+// host, and the images of the default registry without a namespace are in
+// "library".
+func ParseReference(name string) (Reference, error) {
+	if name == "" || strings.ContainsAny(name, "@ ") {
+		return Reference{}, fmt.Errorf("invalid image name %q", name)
+	}
+
+	registry, repository := DefaultRegistry, name
+	if i := strings.IndexByte(name, '/'); i != -1 {
+		first := name[:i]
+		if strings.ContainsAny(first, ".:") || first == "localhost" {
+			registry, repository = first, name[i+1:]
+		}
+	}
+	if registry == DefaultRegistry && !strings.Contains(repository, "/") {
+		repository = "library/" + repository
+	}
+	if repository == "" {
+		return Reference{}, fmt.Errorf("invalid image name %q", name)
+	}
+
+	return Reference{Registry: registry, Repository: repository}, nil
+}
+
+// String returns the full name of the image.
+func (r Reference) String() string {
+	return r.Registry + "/" + r.Repository
+}
+
+// Allowed reports whether the image is in one of the allowed registries. An
+// entry is either a registry, such as "registry.example.com", or a prefix of
+// repositories in a registry, such as "registry.example.com/chorus".
+func (r Reference) Allowed(allowed []string) bool {
+	name := r.String()
+	for _, a := range allowed {
+		a = strings.TrimSuffix(a, "/")
+		if a != "" && (name == a || strings.HasPrefix(name, a+"/")) {
+			return true
+		}
+	}
+	return false
+}
+
+// Client queries the registries of the images, authenticating with the image
+// pull secrets of the helm client.
+type Client interface {
+	// ResolveDigest returns the digest the tag of an image points to.
+	ResolveDigest(ctx context.Context, ref Reference, tag string) (string, error)
+}
+
+type client struct {
+	cfg    config.Config
+	client *http.Client
+	scheme string
+}
+
+func NewClient(cfg config.Config) *client {
+	return newClient(cfg, &http.Client{Timeout: defaultTimeout}, "https")
+}
+
+func newClient(cfg config.Config, httpClient *http.Client, scheme string) *client {
+	return &client{cfg: cfg, client: httpClient, scheme: scheme}
+}
+
+func (c *client) ResolveDigest(ctx context.Context, ref Reference, tag string) (string, error) {
+	host := ref.Registry
+	if host == DefaultRegistry {
+		host = defaultRegistryHost
+	}
+	u := c.scheme + "://" + host + "/v2/" + ref.Repository + "/manifests/" + url.PathEscape(tag)
+
+	res, err := c.manifest(ctx, ref, u, "")
+	if err != nil {
+		return "", err
+	}
+	defer res.Body.Close()
+
+	// The registry is asked for a token with the scope of the repository
+	// when it requires one.
+	if res.StatusCode == http.StatusUnauthorized {
+		authorization, err := c.authorize(ctx, ref, res.Header.Get("WWW-Authenticate"))
+		if err != nil {
+			return "", fmt.Errorf("unable to authenticate to registry %v: %w", ref.Registry, err)
+		}
+		res.Body.Close()
+
+		res, err = c.manifest(ctx, ref, u, authorization)
+		if err != nil {
+			return "", err
+		}
+		defer res.Body.Close()
+	}
+
+	switch res.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return "", fmt.Errorf("unable to resolve %v:%v: %w", ref, tag, ErrImageNotFound)
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return "", fmt.Errorf("unable to resolve %v:%v: %w", ref, tag, ErrUnauthorized)
+	default:
+		return "", fmt.Errorf("unable to resolve %v:%v: unexpected status %v", ref, tag, res.StatusCode)
+	}
+
+	if digest := res.Header.Get("Docker-Content-Digest"); digest != "" {
+		return digest, nil
+	}
+
+	// Registries that do not return the digest are trusted with the content
+	// of the manifest, whose hash is the digest.
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return "", fmt.Errorf("unable to read manifest of %v:%v: %w", ref, tag, err)
+	}
+	sum := sha256.Sum256(body)
+	return "sha256:" + hex.EncodeToString(sum[:]), nil
+}
+
+func (c *client) manifest(ctx context.Context, ref Reference, u, authorization string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", strings.Join(manifestMediaTypes, ", "))
+	if authorization != "" {
+		req.Header.Set("Authorization", authorization)
+	}
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query registry %v: %w", ref.Registry, err)
+	}
+	return res, nil
+}
+
+// authorize answers the challenge of a registry, with the basic credentials
+// of its image pull secret or with a bearer token obtained with them. The
+// token is requested over https, and anonymously from the token services that
+// are neither hosted by the registry nor listed in the secret.
+func (c *client) authorize(ctx context.Context, ref Reference, challenge string) (string, error) {
+	scheme, params := parseChallenge(challenge)
+	secret, hasSecret := c.secret(ref.Registry)
+
+	switch scheme {
+	case "basic":
+		if !hasSecret {
+			return "", ErrUnauthorized
+		}
+		return "Basic " + base64.StdEncoding.EncodeToString([]byte(secret.Username+":"+secret.Password)), nil
+
+	case "bearer":
+		realm, err := url.Parse(params["realm"])
+		if err != nil || params["realm"] == "" || realm.Scheme != "https" {
+			return "", fmt.Errorf("invalid realm %q", params["realm"])
+		}
+		q := realm.Query()
+		if service := params["service"]; service != "" {
+			q.Set("service", service)
+		}
+		q.Set("scope", "repository:"+ref.Repository+":pull")
+		realm.RawQuery = q.Encode()
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, realm.String(), nil)
+		if err != nil {
+			return "", err
+		}
+		if hasSecret && trustedRealm(ref.Registry, secret, realm.Host) {
+			req.SetBasicAuth(secret.Username, secret.Password)
+		}
+		res, err := c.client.Do(req)
+		if err != nil {
+			return "", fmt.Errorf("unable to get token: %w", err)
+		}
+		defer res.Body.Close()
+		if res.StatusCode != http.StatusOK {
+			return "", fmt.Errorf("unable to get token: %w", ErrUnauthorized)
+		}
+
+		var token struct {
+			Token       string `json:"token"`
+			AccessToken string `json:"access_token"`
+		}
+		if err := json.NewDecoder(res.Body).Decode(&token); err != nil {
+			return "", fmt.Errorf("unable to decode token: %w", err)
+		}
+		if token.Token == "" {
+			token.Token = token.AccessToken
+		}
+		return "Bearer " + token.Token, nil
+
+	default:
+		return "", fmt.Errorf("unsupported authentication %q: %w", scheme, ErrUnauthorized)
+	}
+}
+
+func (c *client) secret(registry string) (config.ImagePullSecret, bool) {
+	for _, secret := range c.cfg.Clients.HelmClient.ImagePullSecrets {
+		if secret.Registry == registry {
+			return secret, true
+		}
+	}
+	return config.ImagePullSecret{}, false
+}
+
+// trustedRealm reports whether the credentials of a registry can be sent to
+// the host of a token service, which is either the registry itself or one of
+// the authentication hosts of its secret.
+func trustedRealm(registry string, secret config.ImagePullSecret, host string) bool {
+	if host == registry || (registry == DefaultRegistry && host == defaultRegistryHost) {
+		return true
+	}
+	return slices.Contains(secret.AuthHosts, host)
+}
+
+// parseChallenge parses a WWW-Authenticate header such as
+// `Bearer realm="https://auth.example.com/token",service="registry"`.
+func parseChallenge(challenge string) (string, map[string]string) {
+	scheme, rest, _ := strings.Cut(strings.TrimSpace(challenge), " ")
+	params := map[string]string{}
+	for rest != "" {
+		var pair string
+		rest = strings.TrimLeft(rest, " ,")
+		key, value, ok := strings.Cut(rest, "=")
+		if !ok {
+			break
+		}
+		if strings.HasPrefix(value, `"`) {
+			end := strings.IndexByte(value[1:], '"')
+			if end == -1 {
+				break
+			}
+			pair, rest = value[1:end+1], value[end+2:]
+		} else {
+			pair, rest, _ = strings.Cut(value, ",")
+		}
+		params[strings.ToLower(strings.TrimSpace(key))] = pair
+	}
+	return strings.ToLower(scheme), params
+}
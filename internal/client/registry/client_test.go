@@ -0,0 +1,155 @@
+package registry
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/config"
+)
+
+func TestParseReference(t *testing.T) {
+	tests := []struct {
+		name string
+		want Reference
+	}{
+		{"ubuntu", Reference{Registry: "docker.io", Repository: "library/ubuntu"}},
+		{"jupyter/base-notebook", Reference{Registry: "docker.io", Repository: "jupyter/base-notebook"}},
+		{"registry.example.com/chorus/vscode", Reference{Registry: "registry.example.com", Repository: "chorus/vscode"}},
+		{"localhost:5000/app", Reference{Registry: "localhost:5000", Repository: "app"}},
+		{"localhost/app", Reference{Registry: "localhost", Repository: "app"}},
+	}
+	for _, tt := range tests {
+		ref, err := ParseReference(tt.name)
+		require.NoError(t, err, tt.name)
+		require.Equal(t, tt.want, ref, tt.name)
+	}
+
+	for _, name := range []string{"", "ubuntu@sha256:abc", "registry.example.com/"} {
+		_, err := ParseReference(name)
+		require.Error(t, err, name)
+	}
+}
+
+func TestReferenceAllowed(t *testing.T) {
+	ref := Reference{Registry: "registry.example.com", Repository: "chorus/vscode"}
+
+	require.True(t, ref.Allowed([]string{"registry.example.com"}))
+	require.True(t, ref.Allowed([]string{"docker.io", "registry.example.com/chorus/"}))
+	require.False(t, ref.Allowed(nil))
+	require.False(t, ref.Allowed([]string{"registry.example"}))
+	require.False(t, ref.Allowed([]string{"registry.example.com/chorus/vs"}))
+}
+
+func TestResolveDigest(t *testing.T) {
+	const digest = "sha256:4a8d1f6e2b4c9d0e7f3a5b6c8d9e0f1a2b3c4d5e6f7a8b9c0d1e2f3a4b5c6d7e"
+
+	var srv *httptest.Server
+	srv = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/token":
+			user, pass, ok := r.BasicAuth()
+			if !ok || user != "robot" || pass != "secret" || r.URL.Query().Get("scope") != "repository:chorus/vscode:pull" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			_, _ = w.Write([]byte(`{"token":"abc"}`))
+		case r.Header.Get("Authorization") != "Bearer abc":
+			w.Header().Set("WWW-Authenticate", `Bearer realm="`+srv.URL+`/token",service="registry"`)
+			w.WriteHeader(http.StatusUnauthorized)
+		case r.URL.Path == "/v2/chorus/vscode/manifests/1.2.0":
+			require.True(t, strings.Contains(r.Header.Get("Accept"), "application/vnd.oci.image.index.v1+json"))
+			w.Header().Set("Docker-Content-Digest", digest)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	registry := strings.TrimPrefix(srv.URL, "https://")
+	cfg := config.Config{}
+	cfg.Clients.HelmClient.ImagePullSecrets = []config.ImagePullSecret{{Registry: registry, Username: "robot", Password: "secret"}}
+	c := newClient(cfg, srv.Client(), "https")
+
+	ref := Reference{Registry: registry, Repository: "chorus/vscode"}
+	got, err := c.ResolveDigest(context.Background(), ref, "1.2.0")
+	require.NoError(t, err)
+	require.Equal(t, digest, got)
+
+	_, err = c.ResolveDigest(context.Background(), ref, "missing")
+	require.ErrorIs(t, err, ErrImageNotFound)
+
+	_, err = newClient(config.Config{}, srv.Client(), "https").ResolveDigest(context.Background(), ref, "1.2.0")
+	require.ErrorIs(t, err, ErrUnauthorized)
+}
+
+func TestResolveDigest_Realm(t *testing.T) {
+	const digest = "sha256:4a8d1f6e2b4c9d0e7f3a5b6c8d9e0f1a2b3c4d5e6f7a8b9c0d1e2f3a4b5c6d7e"
+
+	var credentials bool
+	auth := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _, credentials = r.BasicAuth()
+		if !credentials {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`{"access_token":"abc"}`))
+	}))
+	defer auth.Close()
+
+	realm := auth.URL + "/token"
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer abc" {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="`+realm+`"`)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Docker-Content-Digest", digest)
+	}))
+	defer srv.Close()
+
+	registry := strings.TrimPrefix(srv.URL, "https://")
+	ref := Reference{Registry: registry, Repository: "chorus/vscode"}
+	secret := config.ImagePullSecret{Registry: registry, Username: "robot", Password: "secret"}
+	newTestClient := func(secret config.ImagePullSecret) *client {
+		cfg := config.Config{}
+		cfg.Clients.HelmClient.ImagePullSecrets = []config.ImagePullSecret{secret}
+		return newClient(cfg, srv.Client(), "https")
+	}
+
+	// The credentials are not sent to a token service hosted elsewhere.
+	_, err := newTestClient(secret).ResolveDigest(context.Background(), ref, "1.2.0")
+	require.ErrorIs(t, err, ErrUnauthorized)
+	require.False(t, credentials)
+
+	secret.AuthHosts = []string{strings.TrimPrefix(auth.URL, "https://")}
+	got, err := newTestClient(secret).ResolveDigest(context.Background(), ref, "1.2.0")
+	require.NoError(t, err)
+	require.Equal(t, digest, got)
+	require.True(t, credentials)
+
+	// Nor over plain http.
+	credentials = false
+	realm = "http://" + secret.AuthHosts[0] + "/token"
+	_, err = newTestClient(secret).ResolveDigest(context.Background(), ref, "1.2.0")
+	require.ErrorContains(t, err, "invalid realm")
+	require.False(t, credentials)
+}
+
+func TestParseChallenge(t *testing.T) {
+	scheme, params := parseChallenge(`Bearer realm="https://auth.example.com/token",service="registry.example.com",scope="repository:a:pull"`)
+	require.Equal(t, "bearer", scheme)
+	require.Equal(t, map[string]string{
+		"realm":   "https://auth.example.com/token",
+		"service": "registry.example.com",
+		"scope":   "repository:a:pull",
+	}, params)
+
+	scheme, params = parseChallenge(`Basic realm=registry`)
+	require.Equal(t, "basic", scheme)
+	require.Equal(t, "registry", params["realm"])
+}
@@ -0,0 +1,116 @@
+package runtime
+
+import (
+	"time"
+)
+
+// MaxEvents is the maximum number of events returned in the details, the
+// most recent ones being kept.
+const MaxEvents = 20
+
+// Details is the observed state of the server of a workbench or of an app.
+// A workbench or an app that is not deployed has no pods.
+type Details struct {
+	Pods   []PodDetails
+	Events []Event
+}
+
+// Started tells whether the pods are ready, or failed to start: a pod
+// failed, an image cannot be pulled or a container keeps crashing. Neither is
+// true while the pods are starting.
+func (d *Details) Started() (ready, failed bool) {
+	if len(d.Pods) == 0 {
+		return false, false
+	}
+
+	ready = true
+	for _, pod := range d.Pods {
+		if pod.Phase == PodFailed {
+			return false, true
+		}
+		if len(pod.Containers) == 0 {
+			ready = false
+		}
+		for _, c := range pod.Containers {
+			if c.ImagePullError != "" || c.Reason == ReasonCrashLoopBackOff {
+				return false, true
+			}
+			if !c.Ready {
+				ready = false
+			}
+		}
+	}
+	return ready, false
+}
+
+// Restarts returns the number of times the containers restarted, and whether
+// they are crashing: a pod failed, a container keeps crashing or its previous
+// run ended with an error.
+func (d *Details) Restarts() (restarts int32, crashing bool) {
+	for _, pod := range d.Pods {
+		if pod.Phase == PodFailed {
+			crashing = true
+		}
+		for _, c := range pod.Containers {
+			restarts += c.RestartCount
+			if c.Reason == ReasonCrashLoopBackOff || (c.LastTermination != nil && c.LastTermination.ExitCode != 0) {
+				crashing = true
+			}
+		}
+	}
+	return restarts, crashing
+}
+
+// PodDetails is the state of a pod, or of a container for the runtimes
+// without pods.
+type PodDetails struct {
+	Name       string
+	Phase      string
+	Containers []ContainerDetails
+}
+
+type ContainerDetails struct {
+	Name  string
+	Image string
+	Ready bool
+	// State is one of waiting, running and terminated, along with the reason
+	// and message of the waiting and terminated states.
+	State        string
+	Reason       string
+	Message      string
+	RestartCount int32
+	// LastTermination is the termination of the previous run of the
+	// container, if it restarted.
+	LastTermination *Termination
+	// ImagePullError explains why the image of the container could not be
+	// pulled, if so.
+	ImagePullError string
+}
+
+type Termination struct {
+	Reason     string
+	Message    string
+	ExitCode   int32
+	FinishedAt time.Time
+}
+
+// Event is an event about a pod, such as its scheduling or the pull of its
+// images.
+type Event struct {
+	Type     string
+	Reason   string
+	Message  string
+	Count    int32
+	LastSeen time.Time
+}
+
+const (
+	PodFailed              = "Failed"
+	ReasonCrashLoopBackOff = "CrashLoopBackOff"
+)
+
+const (
+	ContainerWaiting    = "waiting"
+	ContainerRunning    = "running"
+	ContainerTerminated = "terminated"
+)
@@ -0,0 +1,108 @@
+package runtime
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDetails_Started(t *testing.T) {
+	pod := func(phase string, containers ...ContainerDetails) PodDetails {
+		return PodDetails{Name: "pod", Phase: phase, Containers: containers}
+	}
+
+	tests := []struct {
+		name          string
+		details       Details
+		expectsReady  bool
+		expectsFailed bool
+	}{
+		{
+			name:    "Without pods, is starting",
+			details: Details{},
+		},
+		{
+			name:    "With a container creating, is starting",
+			details: Details{Pods: []PodDetails{pod("Pending", ContainerDetails{State: ContainerWaiting, Reason: "ContainerCreating"})}},
+		},
+		{
+			name:         "With all the containers ready, is ready",
+			details:      Details{Pods: []PodDetails{pod("Running", ContainerDetails{State: ContainerRunning, Ready: true})}},
+			expectsReady: true,
+		},
+		{
+			name:          "With an image that cannot be pulled, failed",
+			details:       Details{Pods: []PodDetails{pod("Pending", ContainerDetails{State: ContainerWaiting, ImagePullError: "not found"})}},
+			expectsFailed: true,
+		},
+		{
+			name:          "With a container crashing, failed",
+			details:       Details{Pods: []PodDetails{pod("Running", ContainerDetails{State: ContainerWaiting, Reason: ReasonCrashLoopBackOff})}},
+			expectsFailed: true,
+		},
+		{
+			name:          "With a failed pod, failed",
+			details:       Details{Pods: []PodDetails{pod(PodFailed, ContainerDetails{State: ContainerTerminated})}},
+			expectsFailed: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ready, failed := tt.details.Started()
+			require.Equal(t, tt.expectsReady, ready)
+			require.Equal(t, tt.expectsFailed, failed)
+		})
+	}
+}
+
+func TestDetails_Restarts(t *testing.T) {
+	pod := func(phase string, containers ...ContainerDetails) PodDetails {
+		return PodDetails{Name: "pod", Phase: phase, Containers: containers}
+	}
+
+	tests := []struct {
+		name            string
+		details         Details
+		expectsRestarts int32
+		expectsCrashing bool
+	}{
+		{
+			name:    "Without pods, is not crashing",
+			details: Details{},
+		},
+		{
+			name:            "With a container restarted after completing, is not crashing",
+			details:         Details{Pods: []PodDetails{pod("Running", ContainerDetails{State: ContainerRunning, RestartCount: 1, LastTermination: &Termination{Reason: "Completed"}})}},
+			expectsRestarts: 1,
+		},
+		{
+			name: "With a container restarted after an error, is crashing",
+			details: Details{Pods: []PodDetails{pod("Running",
+				ContainerDetails{State: ContainerRunning, RestartCount: 2, LastTermination: &Termination{Reason: "OOMKilled", ExitCode: 137}},
+				ContainerDetails{State: ContainerRunning, RestartCount: 1},
+			)}},
+			expectsRestarts: 3,
+			expectsCrashing: true,
+		},
+		{
+			name:            "With a container crashing, is crashing",
+			details:         Details{Pods: []PodDetails{pod("Running", ContainerDetails{State: ContainerWaiting, Reason: ReasonCrashLoopBackOff, RestartCount: 5})}},
+			expectsRestarts: 5,
+			expectsCrashing: true,
+		},
+		{
+			name:            "With a failed pod, is crashing",
+			details:         Details{Pods: []PodDetails{pod(PodFailed, ContainerDetails{State: ContainerTerminated})}},
+			expectsCrashing: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			restarts, crashing := tt.details.Restarts()
+			require.Equal(t, tt.expectsRestarts, restarts)
+			require.Equal(t, tt.expectsCrashing, crashing)
+		})
+	}
+}
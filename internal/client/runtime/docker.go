@@ -0,0 +1,831 @@
+package runtime
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/config"
+)
+
+var _ WorkbenchRuntime = &dockerRuntime{}
+
+const (
+	dockerAPIVersion = "v1.41"
+
+	labelNamespace = "chorus-tre.ch/namespace"
+	labelWorkbench = "chorus-tre.ch/workbench"
+	labelApp       = "chorus-tre.ch/app"
+	// labelVolumes keeps the volumes mounted into the apps of a workbench on
+	// the container of its server.
+	labelVolumes = "chorus-tre.ch/volumes"
+	labelVolume  = "chorus-tre.ch/volume"
+	// labelSnapshot marks the volumes holding the snapshot of a volume.
+	labelSnapshot = "chorus-tre.ch/snapshot"
+
+	defaultHelperImage = "busybox"
+
+	// serverPort is the port of the server of a workbench, published on the
+	// loopback interface of the host.
+	serverPort = "8080/tcp"
+
+	// dockerRequestTimeout bounds the calls to the Docker Engine API, but for
+	// the pulls of the images, the copies of the volumes and the logs.
+	dockerRequestTimeout = time.Minute
+	dockerPullTimeout    = 10 * time.Minute
+	dockerCopyTimeout    = 30 * time.Minute
+	dockerDialTimeout    = 10 * time.Second
+)
+
+// dockerRuntime runs the workbenches on a single Docker host through the
+// Docker Engine API. A namespace is a network, the server of a workbench is a
+// container on that network and its apps are containers reaching the server
+// through its display.
+type dockerRuntime struct {
+	cfg     config.Config
+	client  *http.Client
+	baseURL string
+	timeout time.Duration
+}
+
+func NewDockerRuntime(cfg config.Config) (*dockerRuntime, error) {
+	host := cfg.Clients.WorkbenchRuntime.Docker.Host
+	if host == "" {
+		host = "unix:///var/run/docker.sock"
+	}
+
+	u, err := url.Parse(host)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse docker host %v: %w", host, err)
+	}
+
+	// The client has no overall timeout, which would cut the logs that are
+	// followed: the requests are bounded by their context instead.
+	d := &net.Dialer{Timeout: dockerDialTimeout}
+	transport := &http.Transport{
+		DialContext:         d.DialContext,
+		IdleConnTimeout:     90 * time.Second,
+		MaxIdleConnsPerHost: 10,
+	}
+
+	r := &dockerRuntime{cfg: cfg, client: &http.Client{Transport: transport}, timeout: dockerRequestTimeout}
+	switch u.Scheme {
+	case "unix":
+		socket := u.Path
+		transport.DialContext = func(ctx context.Context, _, _ string) (net.Conn, error) {
+			return d.DialContext(ctx, "unix", socket)
+		}
+		r.baseURL = "http://docker/" + dockerAPIVersion
+	case "tcp", "http":
+		r.baseURL = "http://" + u.Host + "/" + dockerAPIVersion
+	default:
+		return nil, fmt.Errorf("unsupported docker host scheme %q", u.Scheme)
+	}
+
+	return r, nil
+}
+
+func serverContainer(namespace, workbenchName string) string {
+	return namespace + "-" + workbenchName
+}
+
+func appContainer(namespace, workbenchName, appName string) string {
+	return namespace + "-" + workbenchName + "-" + appName
+}
+
+// volumeName prefixes the volumes with their namespace, the volumes of a host
+// sharing a single namespace.
+func volumeName(namespace, name string) string {
+	return namespace + "-" + name
+}
+
+func (r *dockerRuntime) CreateWorkbench(namespace, workbenchName string, mounts []VolumeMount) error {
+	ctx := context.Background()
+
+	if err := r.createNetwork(ctx, namespace); err != nil {
+		return err
+	}
+
+	volumes, err := json.Marshal(mounts)
+	if err != nil {
+		return fmt.Errorf("unable to encode the volumes of workbench %v: %w", workbenchName, err)
+	}
+
+	name := serverContainer(namespace, workbenchName)
+	container := map[string]interface{}{
+		"Image": r.cfg.Clients.WorkbenchRuntime.Docker.ServerImage,
+		"Labels": map[string]string{
+			labelNamespace: namespace,
+			labelWorkbench: workbenchName,
+			labelVolumes:   string(volumes),
+		},
+		"ExposedPorts": map[string]interface{}{serverPort: struct{}{}},
+		"HostConfig": map[string]interface{}{
+			"NetworkMode":   namespace,
+			"RestartPolicy": map[string]string{"Name": "unless-stopped"},
+			"PortBindings": map[string]interface{}{
+				serverPort: []map[string]string{{"HostIp": "127.0.0.1", "HostPort": ""}},
+			},
+		},
+	}
+
+	if err := r.runContainer(ctx, name, container); err != nil {
+		if errors.Is(err, ErrWorkbenchExists) {
+			return fmt.Errorf("%v/%v: %w", namespace, workbenchName, ErrWorkbenchExists)
+		}
+		return fmt.Errorf("unable to run workbench %v: %w", workbenchName, err)
+	}
+	return nil
+}
+
+// CreatePortForward returns the port of the host on which the server of the
+// workbench is published. Nothing is forwarded, so no stop channel is
+// returned.
+func (r *dockerRuntime) CreatePortForward(namespace, workbenchName string) (uint16, chan struct{}, error) {
+	ctx := context.Background()
+
+	var inspect struct {
+		NetworkSettings struct {
+			Ports map[string][]struct {
+				HostIP   string `json:"HostIp"`
+				HostPort string `json:"HostPort"`
+			}
+		}
+	}
+	if err := r.do(ctx, http.MethodGet, "/containers/"+serverContainer(namespace, workbenchName)+"/json", nil, nil, &inspect); err != nil {
+		if isStatus(err, http.StatusNotFound) {
+			return 0, nil, fmt.Errorf("%v/%v: %w", namespace, workbenchName, ErrWorkbenchNotFound)
+		}
+		return 0, nil, fmt.Errorf("unable to inspect workbench %v: %w", workbenchName, err)
+	}
+
+	bindings := inspect.NetworkSettings.Ports[serverPort]
+	if len(bindings) == 0 {
+		return 0, nil, fmt.Errorf("workbench %v has no published port", workbenchName)
+	}
+
+	port, err := strconv.ParseUint(bindings[0].HostPort, 10, 16)
+	if err != nil {
+		return 0, nil, fmt.Errorf("unable to parse the port of workbench %v: %w", workbenchName, err)
+	}
+	return uint16(port), nil, nil
+}
+
+// CreateAppInstance runs an app next to the server of a workbench, replacing
+// the app of the same name if any.
+func (r *dockerRuntime) CreateAppInstance(namespace, workbenchName, appName, appImage string) error {
+	ctx := context.Background()
+
+	server := serverContainer(namespace, workbenchName)
+	mounts, err := r.Mounts(ctx, namespace, workbenchName)
+	if err != nil {
+		return err
+	}
+	binds := make([]string, 0, len(mounts))
+	for _, m := range mounts {
+		binds = append(binds, volumeName(namespace, m.Name)+":"+m.MountPath)
+	}
+
+	name := appContainer(namespace, workbenchName, appName)
+	if err := r.removeContainer(ctx, name); err != nil {
+		return err
+	}
+
+	container := map[string]interface{}{
+		"Image": appImage,
+		"Env":   []string{"DISPLAY=" + server + ":80"},
+		"Labels": map[string]string{
+			labelNamespace: namespace,
+			labelWorkbench: workbenchName,
+			labelApp:       appName,
+		},
+		"HostConfig": map[string]interface{}{
+			"NetworkMode":   namespace,
+			"RestartPolicy": map[string]string{"Name": "unless-stopped"},
+			"Binds":         binds,
+		},
+	}
+
+	if err := r.runContainer(ctx, name, container); err != nil {
+		return fmt.Errorf("unable to run app %v: %w", appName, err)
+	}
+	return nil
+}
+
+func (r *dockerRuntime) DeleteApp(namespace, workbenchName, appName string) error {
+	return r.removeContainer(context.Background(), appContainer(namespace, workbenchName, appName))
+}
+
+// DeleteWorkbench removes the server of a workbench along with its apps.
+// Removing a workbench that does not exist is not an error.
+func (r *dockerRuntime) DeleteWorkbench(namespace, workbenchName string) error {
+	return r.removeContainers(context.Background(), map[string][]string{
+		"label": {labelNamespace + "=" + namespace, labelWorkbench + "=" + workbenchName},
+	})
+}
+
+// DeleteNamespace removes the network of a namespace along with all the
+// containers left in it.
+func (r *dockerRuntime) DeleteNamespace(namespace string) error {
+	ctx := context.Background()
+
+	if err := r.removeContainers(ctx, map[string][]string{
+		"label": {labelNamespace + "=" + namespace},
+	}); err != nil {
+		return err
+	}
+
+	if err := r.removeVolumes(ctx, labelNamespace+"="+namespace); err != nil {
+		return err
+	}
+
+	if err := r.do(ctx, http.MethodDelete, "/networks/"+namespace, nil, nil, nil); err != nil && !isStatus(err, http.StatusNotFound) {
+		return fmt.Errorf("unable to remove network %v: %w", namespace, err)
+	}
+	return nil
+}
+
+// CreateVolume creates a volume of the local driver, which is not bounded by
+// the size of the volume. Creating a volume that exists returns it.
+func (r *dockerRuntime) CreateVolume(ctx context.Context, namespace string, volume Volume) error {
+	labels := map[string]string{labelNamespace: namespace, labelVolume: volume.Name}
+	for k, v := range volume.Labels {
+		labels[k] = v
+	}
+
+	body := map[string]interface{}{
+		"Name":   volumeName(namespace, volume.Name),
+		"Labels": labels,
+	}
+	if err := r.do(ctx, http.MethodPost, "/volumes/create", nil, body, nil); err != nil {
+		return fmt.Errorf("unable to create volume %v: %w", volume.Name, err)
+	}
+	return nil
+}
+
+// Volumes returns the volumes of a namespace along with the size of their
+// content. The local driver has no capacity.
+func (r *dockerRuntime) Volumes(ctx context.Context, namespace string) ([]VolumeStatus, error) {
+	var df struct {
+		Volumes []struct {
+			Name      string
+			Labels    map[string]string
+			UsageData *struct {
+				// Size is -1 when it is not computed.
+				Size int64
+			}
+		}
+	}
+	if err := r.do(ctx, http.MethodGet, "/system/df", nil, nil, &df); err != nil {
+		return nil, fmt.Errorf("unable to get the usage of the volumes: %w", err)
+	}
+
+	var res []VolumeStatus
+	for _, v := range df.Volumes {
+		if v.Labels[labelNamespace] != namespace || v.Labels[labelSnapshot] != "" {
+			continue
+		}
+
+		status := VolumeStatus{Name: v.Labels[labelVolume], Labels: map[string]string{}, Phase: "Bound"}
+		for k, l := range v.Labels {
+			if k != labelNamespace && k != labelVolume {
+				status.Labels[k] = l
+			}
+		}
+		if v.UsageData != nil && v.UsageData.Size >= 0 {
+			used := uint64(v.UsageData.Size)
+			status.UsedBytes = &used
+		}
+		res = append(res, status)
+	}
+
+	sort.Slice(res, func(i, j int) bool { return res[i].Name < res[j].Name })
+	return res, nil
+}
+
+// Mounts returns the volumes kept on the container of the server of a
+// workbench. The workbenches created before the volumes have none.
+func (r *dockerRuntime) Mounts(ctx context.Context, namespace, workbenchName string) ([]VolumeMount, error) {
+	var inspect struct {
+		Config struct {
+			Labels map[string]string
+		}
+	}
+	if err := r.do(ctx, http.MethodGet, "/containers/"+serverContainer(namespace, workbenchName)+"/json", nil, nil, &inspect); err != nil {
+		if isStatus(err, http.StatusNotFound) {
+			return nil, fmt.Errorf("%v/%v: %w", namespace, workbenchName, ErrWorkbenchNotFound)
+		}
+		return nil, fmt.Errorf("unable to inspect workbench %v: %w", workbenchName, err)
+	}
+
+	var mounts []VolumeMount
+	if volumes := inspect.Config.Labels[labelVolumes]; volumes != "" {
+		if err := json.Unmarshal([]byte(volumes), &mounts); err != nil {
+			return nil, fmt.Errorf("unable to decode the volumes of workbench %v: %w", workbenchName, err)
+		}
+	}
+	return mounts, nil
+}
+
+// CreateVolumeSnapshot copies the content of a volume to a volume of its
+// own, the local driver having no snapshots. The copy is not atomic: the
+// files written by the apps meanwhile may or may not be in the snapshot.
+func (r *dockerRuntime) CreateVolumeSnapshot(ctx context.Context, namespace, volume, snapshotName string) error {
+	if err := r.do(ctx, http.MethodGet, "/volumes/"+volumeName(namespace, volume), nil, nil, nil); err != nil {
+		if isStatus(err, http.StatusNotFound) {
+			return fmt.Errorf("%v/%v: %w", namespace, volume, ErrVolumeNotFound)
+		}
+		return fmt.Errorf("unable to inspect volume %v: %w", volume, err)
+	}
+
+	body := map[string]interface{}{
+		"Name":   volumeName(namespace, snapshotName),
+		"Labels": map[string]string{labelNamespace: namespace, labelSnapshot: snapshotName},
+	}
+	if err := r.do(ctx, http.MethodPost, "/volumes/create", nil, body, nil); err != nil {
+		return fmt.Errorf("unable to create snapshot %v: %w", snapshotName, err)
+	}
+
+	if err := r.copyVolume(ctx, volumeName(namespace, volume), volumeName(namespace, snapshotName)); err != nil {
+		return fmt.Errorf("unable to snapshot volume %v: %w", volume, err)
+	}
+	return nil
+}
+
+// RestoreVolume creates a volume and copies the content of the snapshot into
+// it.
+func (r *dockerRuntime) RestoreVolume(ctx context.Context, namespace string, volume Volume, snapshotName string) error {
+	if err := r.do(ctx, http.MethodGet, "/volumes/"+volumeName(namespace, snapshotName), nil, nil, nil); err != nil {
+		if isStatus(err, http.StatusNotFound) {
+			return fmt.Errorf("%v/%v: %w", namespace, snapshotName, ErrSnapshotNotFound)
+		}
+		return fmt.Errorf("unable to inspect snapshot %v: %w", snapshotName, err)
+	}
+
+	if err := r.CreateVolume(ctx, namespace, volume); err != nil {
+		return err
+	}
+
+	if err := r.copyVolume(ctx, volumeName(namespace, snapshotName), volumeName(namespace, volume.Name)); err != nil {
+		return fmt.Errorf("unable to restore volume %v: %w", volume.Name, err)
+	}
+	return nil
+}
+
+// VolumeSnapshotReady returns true for the snapshots that exist, whose
+// content is copied before CreateVolumeSnapshot returns.
+func (r *dockerRuntime) VolumeSnapshotReady(ctx context.Context, namespace, snapshotName string) (bool, error) {
+	if err := r.do(ctx, http.MethodGet, "/volumes/"+volumeName(namespace, snapshotName), nil, nil, nil); err != nil {
+		if isStatus(err, http.StatusNotFound) {
+			return false, fmt.Errorf("%v/%v: %w", namespace, snapshotName, ErrSnapshotNotFound)
+		}
+		return false, fmt.Errorf("unable to inspect snapshot %v: %w", snapshotName, err)
+	}
+	return true, nil
+}
+
+func (r *dockerRuntime) DeleteVolumeSnapshot(ctx context.Context, namespace, snapshotName string) error {
+	if err := r.do(ctx, http.MethodDelete, "/volumes/"+volumeName(namespace, snapshotName), nil, nil, nil); err != nil && !isStatus(err, http.StatusNotFound) {
+		return fmt.Errorf("unable to remove snapshot %v: %w", snapshotName, err)
+	}
+	return nil
+}
+
+func (r *dockerRuntime) DeleteWorkbenchVolumes(ctx context.Context, namespace, workbenchName string) error {
+	return r.removeVolumes(ctx, labelNamespace+"="+namespace, LabelWorkbench+"="+workbenchName)
+}
+
+// copyVolume copies the content of a volume into another one with a helper
+// container, waiting for it to exit.
+func (r *dockerRuntime) copyVolume(ctx context.Context, from, to string) error {
+	image := r.cfg.Clients.WorkbenchRuntime.Docker.HelperImage
+	if image == "" {
+		image = defaultHelperImage
+	}
+
+	name := "copy-" + to
+	if err := r.removeContainer(ctx, name); err != nil {
+		return err
+	}
+
+	container := map[string]interface{}{
+		"Image": image,
+		"Cmd":   []string{"cp", "-a", "/from/.", "/to/"},
+		"HostConfig": map[string]interface{}{
+			"Binds": []string{from + ":/from:ro", to + ":/to"},
+		},
+	}
+	if err := r.runContainer(ctx, name, container); err != nil {
+		return err
+	}
+	defer r.removeContainer(context.WithoutCancel(ctx), name)
+
+	var wait struct {
+		StatusCode int
+	}
+	// The copy lasts as long as the content of the volume takes to copy.
+	waitCtx, cancel := context.WithTimeout(ctx, dockerCopyTimeout)
+	defer cancel()
+	if err := r.do(waitCtx, http.MethodPost, "/containers/"+name+"/wait", nil, nil, &wait); err != nil {
+		return fmt.Errorf("unable to wait for container %v: %w", name, err)
+	}
+	if wait.StatusCode != 0 {
+		return fmt.Errorf("container %v exited with code %v", name, wait.StatusCode)
+	}
+	return nil
+}
+
+// removeVolumes removes the volumes matching all the labels.
+func (r *dockerRuntime) removeVolumes(ctx context.Context, labels ...string) error {
+	f, err := json.Marshal(map[string][]string{"label": labels})
+	if err != nil {
+		return fmt.Errorf("unable to encode filters: %w", err)
+	}
+
+	var list struct {
+		Volumes []struct {
+			Name string
+		}
+	}
+	if err := r.do(ctx, http.MethodGet, "/volumes", url.Values{"filters": {string(f)}}, nil, &list); err != nil {
+		return fmt.Errorf("unable to list volumes: %w", err)
+	}
+
+	for _, v := range list.Volumes {
+		if err := r.do(ctx, http.MethodDelete, "/volumes/"+v.Name, nil, nil, nil); err != nil && !isStatus(err, http.StatusNotFound) {
+			return fmt.Errorf("unable to remove volume %v: %w", v.Name, err)
+		}
+	}
+	return nil
+}
+
+// WorkbenchLogs returns the output of the container of the server of a
+// workbench.
+func (r *dockerRuntime) WorkbenchLogs(ctx context.Context, namespace, workbenchName string, opts LogOptions) (io.ReadCloser, error) {
+	logs, err := r.containerLogs(ctx, serverContainer(namespace, workbenchName), opts)
+	if isStatus(err, http.StatusNotFound) {
+		return nil, fmt.Errorf("%v/%v: %w", namespace, workbenchName, ErrWorkbenchNotFound)
+	}
+	return logs, err
+}
+
+// AppLogs returns the output of the container of an app.
+func (r *dockerRuntime) AppLogs(ctx context.Context, namespace, workbenchName, appName string, opts LogOptions) (io.ReadCloser, error) {
+	logs, err := r.containerLogs(ctx, appContainer(namespace, workbenchName, appName), opts)
+	if isStatus(err, http.StatusNotFound) {
+		return nil, fmt.Errorf("%v/%v/%v: %w", namespace, workbenchName, appName, ErrAppNotFound)
+	}
+	return logs, err
+}
+
+// WorkbenchDetails returns the state of the container of the server of a
+// workbench. Docker keeps no events, so none are returned.
+func (r *dockerRuntime) WorkbenchDetails(ctx context.Context, namespace, workbenchName string) (*Details, error) {
+	return r.containerDetails(ctx, serverContainer(namespace, workbenchName))
+}
+
+// AppDetails returns the state of the container of an app.
+func (r *dockerRuntime) AppDetails(ctx context.Context, namespace, workbenchName, appName string) (*Details, error) {
+	return r.containerDetails(ctx, appContainer(namespace, workbenchName, appName))
+}
+
+// containerDetails reports a container as a pod of a single container, with
+// the phase of the pod derived from the status of the container. A container
+// that does not exist is reported without pods.
+func (r *dockerRuntime) containerDetails(ctx context.Context, name string) (*Details, error) {
+	var inspect struct {
+		Config struct {
+			Image string
+		}
+		State struct {
+			Status     string
+			ExitCode   int32
+			Error      string
+			OOMKilled  bool
+			FinishedAt time.Time
+		}
+		RestartCount int32
+	}
+	if err := r.do(ctx, http.MethodGet, "/containers/"+name+"/json", nil, nil, &inspect); err != nil {
+		if isStatus(err, http.StatusNotFound) {
+			return &Details{}, nil
+		}
+		return nil, fmt.Errorf("unable to inspect container %v: %w", name, err)
+	}
+
+	container := ContainerDetails{
+		Name:         name,
+		Image:        inspect.Config.Image,
+		RestartCount: inspect.RestartCount,
+		Message:      inspect.State.Error,
+	}
+	phase := "Running"
+	switch inspect.State.Status {
+	case "created":
+		container.State = ContainerWaiting
+		phase = "Pending"
+	case "restarting":
+		container.State = ContainerWaiting
+		container.Reason = "Restarting"
+	case "exited", "dead":
+		container.State = ContainerTerminated
+		container.Reason = "Error"
+		phase = "Failed"
+		if inspect.State.ExitCode == 0 {
+			container.Reason = "Completed"
+			phase = "Succeeded"
+		}
+	default:
+		container.State = ContainerRunning
+		container.Ready = inspect.State.Status == "running"
+	}
+	if inspect.State.OOMKilled {
+		container.Reason = "OOMKilled"
+	}
+
+	// Docker only keeps the last exit of a container, which is the previous
+	// run of a container that restarted.
+	if inspect.RestartCount > 0 && container.State != ContainerTerminated {
+		container.LastTermination = &Termination{
+			ExitCode:   inspect.State.ExitCode,
+			FinishedAt: inspect.State.FinishedAt,
+		}
+	}
+
+	return &Details{Pods: []PodDetails{{
+		Name:       name,
+		Phase:      phase,
+		Containers: []ContainerDetails{container},
+	}}}, nil
+}
+
+func (r *dockerRuntime) containerLogs(ctx context.Context, name string, opts LogOptions) (io.ReadCloser, error) {
+	query := url.Values{
+		"stdout": {"true"},
+		"stderr": {"true"},
+		"follow": {strconv.FormatBool(opts.Follow)},
+	}
+	if opts.TailLines > 0 {
+		query.Set("tail", strconv.FormatInt(opts.TailLines, 10))
+	}
+	if !opts.SinceTime.IsZero() {
+		query.Set("since", strconv.FormatInt(opts.SinceTime.Unix(), 10))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, r.baseURL+"/containers/"+name+"/logs?"+query.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := r.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get the logs of container %v: %w", name, err)
+	}
+	if err := checkStatus(res); err != nil {
+		res.Body.Close()
+		return nil, err
+	}
+
+	return &demuxReader{body: res.Body}, nil
+}
+
+// demuxReader reads the output of a container without a terminal, which the
+// Docker Engine API sends as frames of stdout and stderr each prefixed by an
+// 8 bytes header ending with the size of the frame.
+type demuxReader struct {
+	body      io.ReadCloser
+	remaining uint32
+}
+
+func (d *demuxReader) Read(p []byte) (int, error) {
+	for d.remaining == 0 {
+		var header [8]byte
+		if _, err := io.ReadFull(d.body, header[:]); err != nil {
+			if err == io.ErrUnexpectedEOF {
+				err = io.EOF
+			}
+			return 0, err
+		}
+		d.remaining = binary.BigEndian.Uint32(header[4:])
+	}
+
+	if uint32(len(p)) > d.remaining {
+		p = p[:d.remaining]
+	}
+	n, err := d.body.Read(p)
+	d.remaining -= uint32(n)
+	return n, err
+}
+
+func (d *demuxReader) Close() error {
+	return d.body.Close()
+}
+
+func (r *dockerRuntime) createNetwork(ctx context.Context, namespace string) error {
+	network := map[string]interface{}{
+		"Name":           namespace,
+		"CheckDuplicate": true,
+		"Labels":         map[string]string{labelNamespace: namespace},
+	}
+	if err := r.do(ctx, http.MethodPost, "/networks/create", nil, network, nil); err != nil && !isStatus(err, http.StatusConflict) {
+		return fmt.Errorf("unable to create network %v: %w", namespace, err)
+	}
+	return nil
+}
+
+// runContainer creates and starts a container, pulling its image when it is
+// not on the host.
+func (r *dockerRuntime) runContainer(ctx context.Context, name string, container map[string]interface{}) error {
+	query := url.Values{"name": {name}}
+
+	err := r.do(ctx, http.MethodPost, "/containers/create", query, container, nil)
+	if isStatus(err, http.StatusNotFound) {
+		if err := r.pullImage(ctx, container["Image"].(string)); err != nil {
+			return err
+		}
+		err = r.do(ctx, http.MethodPost, "/containers/create", query, container, nil)
+	}
+	if isStatus(err, http.StatusConflict) {
+		return ErrWorkbenchExists
+	}
+	if err != nil {
+		return fmt.Errorf("unable to create container %v: %w", name, err)
+	}
+
+	if err := r.do(ctx, http.MethodPost, "/containers/"+name+"/start", nil, nil, nil); err != nil {
+		return fmt.Errorf("unable to start container %v: %w", name, err)
+	}
+	return nil
+}
+
+// pullImage pulls an image, authenticating with the image pull secret of its
+// registry if any.
+func (r *dockerRuntime) pullImage(ctx context.Context, image string) error {
+	ctx, cancel := context.WithTimeout(ctx, dockerPullTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, r.baseURL+"/images/create?"+url.Values{"fromImage": {image}}.Encode(), nil)
+	if err != nil {
+		return err
+	}
+
+	for _, secret := range r.cfg.Clients.HelmClient.ImagePullSecrets {
+		if !strings.HasPrefix(image, secret.Registry+"/") {
+			continue
+		}
+		auth, err := json.Marshal(map[string]string{
+			"username":      secret.Username,
+			"password":      secret.Password,
+			"serveraddress": secret.Registry,
+		})
+		if err != nil {
+			return fmt.Errorf("unable to encode registry auth: %w", err)
+		}
+		req.Header.Set("X-Registry-Auth", base64.URLEncoding.EncodeToString(auth))
+		break
+	}
+
+	res, err := r.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("unable to pull image %v: %w", image, err)
+	}
+	defer res.Body.Close()
+
+	if err := checkStatus(res); err != nil {
+		return fmt.Errorf("unable to pull image %v: %w", image, err)
+	}
+
+	// The pull only completes once its progress is read. A failing pull
+	// still answers 200, with the error in the progress.
+	dec := json.NewDecoder(res.Body)
+	for {
+		var progress struct {
+			Error string `json:"error"`
+		}
+		if err := dec.Decode(&progress); err == io.EOF {
+			return nil
+		} else if err != nil {
+			return fmt.Errorf("unable to read the pull of image %v: %w", image, err)
+		}
+		if progress.Error != "" {
+			return fmt.Errorf("unable to pull image %v: %v", image, progress.Error)
+		}
+	}
+}
+
+func (r *dockerRuntime) removeContainer(ctx context.Context, name string) error {
+	query := url.Values{"force": {"true"}, "v": {"true"}}
+	if err := r.do(ctx, http.MethodDelete, "/containers/"+name, query, nil, nil); err != nil && !isStatus(err, http.StatusNotFound) {
+		return fmt.Errorf("unable to remove container %v: %w", name, err)
+	}
+	return nil
+}
+
+func (r *dockerRuntime) removeContainers(ctx context.Context, filters map[string][]string) error {
+	f, err := json.Marshal(filters)
+	if err != nil {
+		return fmt.Errorf("unable to encode filters: %w", err)
+	}
+
+	var containers []struct {
+		ID string `json:"Id"`
+	}
+	if err := r.do(ctx, http.MethodGet, "/containers/json", url.Values{"all": {"true"}, "filters": {string(f)}}, nil, &containers); err != nil {
+		return fmt.Errorf("unable to list containers: %w", err)
+	}
+
+	for _, c := range containers {
+		if err := r.removeContainer(ctx, c.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// statusError is the error answered by the Docker Engine API.
+type statusError struct {
+	code    int
+	message string
+}
+
+func (e *statusError) Error() string {
+	return fmt.Sprintf("docker engine answered %v: %v", e.code, e.message)
+}
+
+func isStatus(err error, code int) bool {
+	var s *statusError
+	return errors.As(err, &s) && s.code == code
+}
+
+func checkStatus(res *http.Response) error {
+	if res.StatusCode < http.StatusBadRequest {
+		return nil
+	}
+
+	var body struct {
+		Message string `json:"message"`
+	}
+	_ = json.NewDecoder(res.Body).Decode(&body)
+	return &statusError{code: res.StatusCode, message: body.Message}
+}
+
+// do calls the Docker Engine API, bounded by the request timeout unless ctx
+// already has a deadline.
+func (r *dockerRuntime) do(ctx context.Context, method, path string, query url.Values, in, out interface{}) error {
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, r.timeout)
+		defer cancel()
+	}
+
+	var body io.Reader
+	if in != nil {
+		b, err := json.Marshal(in)
+		if err != nil {
+			return fmt.Errorf("unable to encode request: %w", err)
+		}
+		body = bytes.NewReader(b)
+	}
+
+	u := r.baseURL + path
+	if len(query) != 0 {
+		u += "?" + query.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u, body)
+	if err != nil {
+		return err
+	}
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	res, err := r.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if err := checkStatus(res); err != nil {
+		return err
+	}
+
+	if out != nil {
+		if err := json.NewDecoder(res.Body).Decode(out); err != nil {
+			return fmt.Errorf("unable to decode response: %w", err)
+		}
+	}
+	return nil
+}
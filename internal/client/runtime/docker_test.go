@@ -0,0 +1,111 @@
+package runtime
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/config"
+)
+
+func frame(stream byte, payload string) []byte {
+	header := make([]byte, 8)
+	header[0] = stream
+	binary.BigEndian.PutUint32(header[4:], uint32(len(payload)))
+	return append(header, payload...)
+}
+
+func TestDemuxReader(t *testing.T) {
+	var body bytes.Buffer
+	body.Write(frame(1, "starting\n"))
+	body.Write(frame(2, "warning: no display\n"))
+	body.Write(frame(1, ""))
+	body.Write(frame(1, "running\n"))
+
+	out, err := io.ReadAll(&demuxReader{body: io.NopCloser(&body)})
+	require.NoError(t, err)
+	require.Equal(t, "starting\nwarning: no display\nrunning\n", string(out))
+}
+
+// newTestDockerRuntime returns a runtime calling the Docker Engine API served
+// by handler.
+func newTestDockerRuntime(t *testing.T, handler http.Handler) *dockerRuntime {
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+
+	var cfg config.Config
+	cfg.Clients.WorkbenchRuntime.Docker.Host = "tcp://" + strings.TrimPrefix(srv.URL, "http://")
+	r, err := NewDockerRuntime(cfg)
+	require.NoError(t, err)
+	return r
+}
+
+func TestDockerRuntime_CreateAppInstance(t *testing.T) {
+	var calls []string
+	var pulled string
+	r := newTestDockerRuntime(t, http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		path := strings.TrimPrefix(req.URL.Path, "/"+dockerAPIVersion)
+		calls = append(calls, req.Method+" "+path)
+		switch {
+		case path == "/containers/ns-wb/json":
+			w.Write([]byte(`{"Config":{"Labels":{}}}`))
+		case path == "/containers/ns-wb-app" && req.Method == http.MethodDelete:
+			w.WriteHeader(http.StatusNotFound)
+		case path == "/containers/create" && pulled == "":
+			w.WriteHeader(http.StatusNotFound)
+		case path == "/images/create":
+			pulled = req.URL.Query().Get("fromImage")
+			w.Write([]byte(`{"status":"done"}`))
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+
+	require.NoError(t, r.CreateAppInstance("ns", "wb", "app", "app:1"))
+	require.Equal(t, []string{
+		"GET /containers/ns-wb/json",
+		"DELETE /containers/ns-wb-app",
+		"POST /containers/create",
+		"POST /images/create",
+		"POST /containers/create",
+		"POST /containers/ns-wb-app/start",
+	}, calls, "the image is pulled when it is not on the host")
+	require.Equal(t, "app:1", pulled)
+}
+
+func TestDockerRuntime_Timeout(t *testing.T) {
+	block := make(chan struct{})
+	defer close(block)
+	r := newTestDockerRuntime(t, http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		select {
+		case <-block:
+		case <-req.Context().Done():
+		}
+	}))
+	r.timeout = 50 * time.Millisecond
+
+	err := r.DeleteApp("ns", "wb", "app")
+	require.ErrorIs(t, err, context.DeadlineExceeded, "a hanging engine does not block the calls")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = r.Volumes(ctx, "ns")
+	require.ErrorIs(t, err, context.Canceled, "the calls end with their context")
+}
+
+func TestDockerRuntime_PullError(t *testing.T) {
+	r := newTestDockerRuntime(t, http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Write([]byte(`{"status":"pulling"}{"error":"manifest unknown"}`))
+	}))
+
+	err := r.pullImage(context.Background(), "app:1")
+	require.ErrorContains(t, err, "manifest unknown", "a failed pull answers 200 with the error in its progress")
+}
@@ -0,0 +1,408 @@
+package runtime
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+var _ WorkbenchRuntime = &memoryRuntime{}
+
+// WorkbenchState is the state of a workbench or of an app simulated by the
+// memory runtime.
+type WorkbenchState string
+
+const (
+	StateStarting WorkbenchState = "starting"
+	StateRunning  WorkbenchState = "running"
+)
+
+type memoryWorkbench struct {
+	createdAt time.Time
+	mounts    []VolumeMount
+	apps      map[string]memoryApp
+}
+
+type memoryApp struct {
+	image     string
+	createdAt time.Time
+}
+
+// memoryRuntime keeps the workbenches in memory without deploying anything,
+// for the tests and the local development. The workbenches and the apps are
+// starting until startupDelay has elapsed since their creation, then running.
+type memoryRuntime struct {
+	startupDelay time.Duration
+	now          func() time.Time
+
+	mu         sync.Mutex
+	workbenchs map[string]map[string]*memoryWorkbench
+	volumes    map[string]map[string]Volume
+	snapshots  map[string]map[string]Volume
+}
+
+func NewMemoryRuntime(startupDelay time.Duration) *memoryRuntime {
+	return &memoryRuntime{
+		startupDelay: startupDelay,
+		now:          time.Now,
+		workbenchs:   make(map[string]map[string]*memoryWorkbench),
+		volumes:      make(map[string]map[string]Volume),
+		snapshots:    make(map[string]map[string]Volume),
+	}
+}
+
+func (r *memoryRuntime) CreateWorkbench(namespace, workbenchName string, mounts []VolumeMount) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.workbenchs[namespace][workbenchName]; ok {
+		return fmt.Errorf("%v/%v: %w", namespace, workbenchName, ErrWorkbenchExists)
+	}
+	if r.workbenchs[namespace] == nil {
+		r.workbenchs[namespace] = make(map[string]*memoryWorkbench)
+	}
+	r.workbenchs[namespace][workbenchName] = &memoryWorkbench{
+		createdAt: r.now(),
+		mounts:    mounts,
+		apps:      make(map[string]memoryApp),
+	}
+	return nil
+}
+
+func (r *memoryRuntime) CreatePortForward(namespace, workbenchName string) (uint16, chan struct{}, error) {
+	return 0, nil, errors.New("the memory runtime does not serve the workbenches")
+}
+
+func (r *memoryRuntime) CreateAppInstance(namespace, workbenchName, appName, appImage string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	wb, err := r.get(namespace, workbenchName)
+	if err != nil {
+		return err
+	}
+	wb.apps[appName] = memoryApp{image: appImage, createdAt: r.now()}
+	return nil
+}
+
+func (r *memoryRuntime) DeleteApp(namespace, workbenchName, appName string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	wb, err := r.get(namespace, workbenchName)
+	if err != nil {
+		return err
+	}
+	delete(wb.apps, appName)
+	return nil
+}
+
+// DeleteWorkbench removes a workbench. Removing a workbench that does not
+// exist is not an error, as with the helm runtime.
+func (r *memoryRuntime) DeleteWorkbench(namespace, workbenchName string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.workbenchs[namespace], workbenchName)
+	return nil
+}
+
+func (r *memoryRuntime) DeleteNamespace(namespace string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.workbenchs, namespace)
+	delete(r.volumes, namespace)
+	delete(r.snapshots, namespace)
+	return nil
+}
+
+func (r *memoryRuntime) CreateVolume(ctx context.Context, namespace string, volume Volume) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.volumes[namespace] == nil {
+		r.volumes[namespace] = make(map[string]Volume)
+	}
+	if _, ok := r.volumes[namespace][volume.Name]; !ok {
+		r.volumes[namespace][volume.Name] = volume
+	}
+	return nil
+}
+
+// Volumes returns the volumes of a namespace, sorted by name. They are bound
+// as soon as they are created and nothing is ever written to them.
+func (r *memoryRuntime) Volumes(ctx context.Context, namespace string) ([]VolumeStatus, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	res := make([]VolumeStatus, 0, len(r.volumes[namespace]))
+	for _, v := range r.volumes[namespace] {
+		size, err := resource.ParseQuantity(v.Size)
+		if err != nil {
+			return nil, fmt.Errorf("invalid size %q of volume %v: %w", v.Size, v.Name, err)
+		}
+		used := uint64(0)
+		res = append(res, VolumeStatus{
+			Name:          v.Name,
+			Labels:        v.Labels,
+			Phase:         "Bound",
+			CapacityBytes: uint64(size.Value()),
+			UsedBytes:     &used,
+		})
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Name < res[j].Name })
+	return res, nil
+}
+
+func (r *memoryRuntime) Mounts(ctx context.Context, namespace, workbenchName string) ([]VolumeMount, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	wb, err := r.get(namespace, workbenchName)
+	if err != nil {
+		return nil, err
+	}
+	return wb.mounts, nil
+}
+
+// CreateVolumeSnapshot keeps a copy of the volume, its content being empty.
+func (r *memoryRuntime) CreateVolumeSnapshot(ctx context.Context, namespace, volumeName, snapshotName string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	volume, ok := r.volumes[namespace][volumeName]
+	if !ok {
+		return fmt.Errorf("%v/%v: %w", namespace, volumeName, ErrVolumeNotFound)
+	}
+	if r.snapshots[namespace] == nil {
+		r.snapshots[namespace] = make(map[string]Volume)
+	}
+	r.snapshots[namespace][snapshotName] = volume
+	return nil
+}
+
+func (r *memoryRuntime) RestoreVolume(ctx context.Context, namespace string, volume Volume, snapshotName string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.snapshots[namespace][snapshotName]; !ok {
+		return fmt.Errorf("%v/%v: %w", namespace, snapshotName, ErrSnapshotNotFound)
+	}
+	if r.volumes[namespace] == nil {
+		r.volumes[namespace] = make(map[string]Volume)
+	}
+	if _, ok := r.volumes[namespace][volume.Name]; !ok {
+		r.volumes[namespace][volume.Name] = volume
+	}
+	return nil
+}
+
+// VolumeSnapshotReady returns true for the snapshots that exist, which are
+// taken synchronously.
+func (r *memoryRuntime) VolumeSnapshotReady(ctx context.Context, namespace, snapshotName string) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.snapshots[namespace][snapshotName]; !ok {
+		return false, fmt.Errorf("%v/%v: %w", namespace, snapshotName, ErrSnapshotNotFound)
+	}
+	return true, nil
+}
+
+func (r *memoryRuntime) DeleteVolumeSnapshot(ctx context.Context, namespace, snapshotName string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.snapshots[namespace], snapshotName)
+	return nil
+}
+
+func (r *memoryRuntime) DeleteWorkbenchVolumes(ctx context.Context, namespace, workbenchName string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for name, v := range r.volumes[namespace] {
+		if v.Labels[LabelWorkbench] == workbenchName {
+			delete(r.volumes[namespace], name)
+		}
+	}
+	return nil
+}
+
+// Snapshots returns the names of the volume snapshots of a namespace, sorted.
+func (r *memoryRuntime) Snapshots(namespace string) []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	res := make([]string, 0, len(r.snapshots[namespace]))
+	for name := range r.snapshots[namespace] {
+		res = append(res, name)
+	}
+	sort.Strings(res)
+	return res
+}
+
+// WorkbenchLogs returns the simulated logs of the server of a workbench, one
+// line per state it went through. The logs are not followed.
+func (r *memoryRuntime) WorkbenchLogs(ctx context.Context, namespace, workbenchName string, opts LogOptions) (io.ReadCloser, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	wb, err := r.get(namespace, workbenchName)
+	if err != nil {
+		return nil, err
+	}
+	return r.logs("workbench "+workbenchName, wb.createdAt, opts), nil
+}
+
+// AppLogs returns the simulated logs of an app, as WorkbenchLogs.
+func (r *memoryRuntime) AppLogs(ctx context.Context, namespace, workbenchName, appName string, opts LogOptions) (io.ReadCloser, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	wb, err := r.get(namespace, workbenchName)
+	if err != nil {
+		return nil, err
+	}
+	app, ok := wb.apps[appName]
+	if !ok {
+		return nil, fmt.Errorf("%v/%v/%v: %w", namespace, workbenchName, appName, ErrAppNotFound)
+	}
+	return r.logs(fmt.Sprintf("app %v (%v)", appName, app.image), app.createdAt, opts), nil
+}
+
+func (r *memoryRuntime) logs(name string, createdAt time.Time, opts LogOptions) io.ReadCloser {
+	type entry struct {
+		at   time.Time
+		line string
+	}
+	entries := []entry{{createdAt, name + " " + string(StateStarting)}}
+	if r.state(createdAt) == StateRunning {
+		entries = append(entries, entry{createdAt.Add(r.startupDelay), name + " " + string(StateRunning)})
+	}
+
+	var lines []string
+	for _, e := range entries {
+		if !opts.SinceTime.IsZero() && e.at.Before(opts.SinceTime) {
+			continue
+		}
+		lines = append(lines, e.at.UTC().Format(time.RFC3339)+" "+e.line+"\n")
+	}
+	if opts.TailLines > 0 && int64(len(lines)) > opts.TailLines {
+		lines = lines[int64(len(lines))-opts.TailLines:]
+	}
+
+	return io.NopCloser(strings.NewReader(strings.Join(lines, "")))
+}
+
+// WorkbenchDetails returns the simulated state of the server of a workbench,
+// as a pod pending until it is running. A workbench that does not exist has
+// no pods.
+func (r *memoryRuntime) WorkbenchDetails(ctx context.Context, namespace, workbenchName string) (*Details, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	wb, err := r.get(namespace, workbenchName)
+	if err != nil {
+		return &Details{}, nil
+	}
+	return r.details(workbenchName, "", wb.createdAt), nil
+}
+
+// AppDetails returns the simulated state of an app, as WorkbenchDetails.
+func (r *memoryRuntime) AppDetails(ctx context.Context, namespace, workbenchName, appName string) (*Details, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	wb, err := r.get(namespace, workbenchName)
+	if err != nil {
+		return &Details{}, nil
+	}
+	app, ok := wb.apps[appName]
+	if !ok {
+		return &Details{}, nil
+	}
+	return r.details(workbenchName+"-"+appName, app.image, app.createdAt), nil
+}
+
+func (r *memoryRuntime) details(name, image string, createdAt time.Time) *Details {
+	pod := PodDetails{Name: name, Phase: "Pending"}
+	container := ContainerDetails{Name: name, Image: image, State: ContainerWaiting, Reason: "ContainerCreating"}
+	events := []Event{{Type: "Normal", Reason: "Scheduled", Message: "Simulated " + name, Count: 1, LastSeen: createdAt}}
+
+	if r.state(createdAt) == StateRunning {
+		pod.Phase = "Running"
+		container.State = ContainerRunning
+		container.Ready = true
+		container.Reason = ""
+		events = append([]Event{{Type: "Normal", Reason: "Started", Message: "Started " + name, Count: 1, LastSeen: createdAt.Add(r.startupDelay)}}, events...)
+	}
+
+	pod.Containers = []ContainerDetails{container}
+	return &Details{Pods: []PodDetails{pod}, Events: events}
+}
+
+// WorkbenchState returns the simulated state of a workbench.
+func (r *memoryRuntime) WorkbenchState(namespace, workbenchName string) (WorkbenchState, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	wb, err := r.get(namespace, workbenchName)
+	if err != nil {
+		return "", err
+	}
+	return r.state(wb.createdAt), nil
+}
+
+// AppStates returns the simulated state of each app of a workbench.
+func (r *memoryRuntime) AppStates(namespace, workbenchName string) (map[string]WorkbenchState, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	wb, err := r.get(namespace, workbenchName)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(map[string]WorkbenchState, len(wb.apps))
+	for name, app := range wb.apps {
+		res[name] = r.state(app.createdAt)
+	}
+	return res, nil
+}
+
+// Workbenchs returns the names of the workbenches of a namespace, sorted.
+func (r *memoryRuntime) Workbenchs(namespace string) []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	res := make([]string, 0, len(r.workbenchs[namespace]))
+	for name := range r.workbenchs[namespace] {
+		res = append(res, name)
+	}
+	sort.Strings(res)
+	return res
+}
+
+func (r *memoryRuntime) get(namespace, workbenchName string) (*memoryWorkbench, error) {
+	wb, ok := r.workbenchs[namespace][workbenchName]
+	if !ok {
+		return nil, fmt.Errorf("%v/%v: %w", namespace, workbenchName, ErrWorkbenchNotFound)
+	}
+	return wb, nil
+}
+
+func (r *memoryRuntime) state(createdAt time.Time) WorkbenchState {
+	if r.now().Sub(createdAt) < r.startupDelay {
+		return StateStarting
+	}
+	return StateRunning
+}
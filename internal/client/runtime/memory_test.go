@@ -0,0 +1,201 @@
+package runtime
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryRuntimeStates(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	r := NewMemoryRuntime(time.Minute)
+	r.now = func() time.Time { return now }
+
+	require.NoError(t, r.CreateWorkbench("workspace1", "workbench1", nil))
+	require.NoError(t, r.CreateAppInstance("workspace1", "workbench1", "firefox", "registry/firefox:1.0"))
+
+	state, err := r.WorkbenchState("workspace1", "workbench1")
+	require.NoError(t, err)
+	require.Equal(t, StateStarting, state)
+
+	now = now.Add(time.Minute)
+	require.NoError(t, r.CreateAppInstance("workspace1", "workbench1", "vscode", "registry/vscode:1.0"))
+
+	state, err = r.WorkbenchState("workspace1", "workbench1")
+	require.NoError(t, err)
+	require.Equal(t, StateRunning, state)
+
+	apps, err := r.AppStates("workspace1", "workbench1")
+	require.NoError(t, err)
+	require.Equal(t, map[string]WorkbenchState{"firefox": StateRunning, "vscode": StateStarting}, apps)
+}
+
+func TestMemoryRuntimeErrors(t *testing.T) {
+	r := NewMemoryRuntime(0)
+
+	require.NoError(t, r.CreateWorkbench("workspace1", "workbench1", nil))
+	require.ErrorIs(t, r.CreateWorkbench("workspace1", "workbench1", nil), ErrWorkbenchExists)
+	require.ErrorIs(t, r.CreateAppInstance("workspace1", "workbench2", "firefox", "registry/firefox:1.0"), ErrWorkbenchNotFound)
+
+	_, _, err := r.CreatePortForward("workspace1", "workbench1")
+	require.Error(t, err)
+}
+
+func TestMemoryRuntimeDelete(t *testing.T) {
+	r := NewMemoryRuntime(0)
+
+	require.NoError(t, r.CreateWorkbench("workspace1", "workbench1", nil))
+	require.NoError(t, r.CreateWorkbench("workspace1", "workbench2", nil))
+	require.NoError(t, r.CreateAppInstance("workspace1", "workbench1", "firefox", "registry/firefox:1.0"))
+
+	require.NoError(t, r.DeleteApp("workspace1", "workbench1", "firefox"))
+	require.NoError(t, r.DeleteApp("workspace1", "workbench1", "unknown"))
+	apps, err := r.AppStates("workspace1", "workbench1")
+	require.NoError(t, err)
+	require.Empty(t, apps)
+
+	require.NoError(t, r.DeleteWorkbench("workspace1", "workbench1"))
+	require.NoError(t, r.DeleteWorkbench("workspace1", "workbench1"))
+	require.Equal(t, []string{"workbench2"}, r.Workbenchs("workspace1"))
+
+	require.NoError(t, r.DeleteNamespace("workspace1"))
+	require.NoError(t, r.DeleteNamespace("workspace1"))
+	require.Empty(t, r.Workbenchs("workspace1"))
+}
+
+func TestMemoryRuntimeLogs(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	r := NewMemoryRuntime(time.Minute)
+	r.now = func() time.Time { return now }
+
+	require.NoError(t, r.CreateWorkbench("workspace1", "workbench1", nil))
+	now = now.Add(time.Hour)
+
+	readLogs := func(opts LogOptions) string {
+		logs, err := r.WorkbenchLogs(ctx, "workspace1", "workbench1", opts)
+		require.NoError(t, err)
+		defer logs.Close()
+		b, err := io.ReadAll(logs)
+		require.NoError(t, err)
+		return string(b)
+	}
+
+	require.Equal(t, "2024-01-01T00:00:00Z workbench workbench1 starting\n2024-01-01T00:01:00Z workbench workbench1 running\n", readLogs(LogOptions{}))
+	require.Equal(t, "2024-01-01T00:01:00Z workbench workbench1 running\n", readLogs(LogOptions{TailLines: 1}))
+	require.Equal(t, "2024-01-01T00:01:00Z workbench workbench1 running\n", readLogs(LogOptions{SinceTime: now.Add(-time.Hour).Add(time.Second)}))
+
+	_, err := r.AppLogs(ctx, "workspace1", "workbench1", "firefox", LogOptions{})
+	require.ErrorIs(t, err, ErrAppNotFound)
+}
+
+func TestMemoryRuntimeDetails(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	r := NewMemoryRuntime(time.Minute)
+	r.now = func() time.Time { return now }
+
+	details, err := r.WorkbenchDetails(ctx, "workspace1", "workbench1")
+	require.NoError(t, err)
+	require.Empty(t, details.Pods)
+
+	require.NoError(t, r.CreateWorkbench("workspace1", "workbench1", nil))
+	require.NoError(t, r.CreateAppInstance("workspace1", "workbench1", "firefox", "registry/firefox:1.0"))
+
+	details, err = r.WorkbenchDetails(ctx, "workspace1", "workbench1")
+	require.NoError(t, err)
+	require.Len(t, details.Pods, 1)
+	require.Equal(t, "Pending", details.Pods[0].Phase)
+	require.Equal(t, ContainerWaiting, details.Pods[0].Containers[0].State)
+	require.Len(t, details.Events, 1)
+
+	now = now.Add(time.Hour)
+
+	details, err = r.AppDetails(ctx, "workspace1", "workbench1", "firefox")
+	require.NoError(t, err)
+	require.Len(t, details.Pods, 1)
+	require.Equal(t, "Running", details.Pods[0].Phase)
+	require.Equal(t, "registry/firefox:1.0", details.Pods[0].Containers[0].Image)
+	require.True(t, details.Pods[0].Containers[0].Ready)
+	require.Equal(t, "Started", details.Events[0].Reason)
+}
+
+func TestMemoryRuntimeVolumes(t *testing.T) {
+	ctx := context.Background()
+	r := NewMemoryRuntime(0)
+	cfg := config.WorkbenchVolumes{HomeSize: "1Gi"}
+
+	require.NoError(t, r.CreateVolume(ctx, "workspace1", SharedVolume(cfg)))
+	require.NoError(t, r.CreateVolume(ctx, "workspace1", HomeVolume(cfg, 42)))
+	require.NoError(t, r.CreateVolume(ctx, "workspace1", HomeVolume(cfg, 42)))
+
+	volumes, err := r.Volumes(ctx, "workspace1")
+	require.NoError(t, err)
+	require.Len(t, volumes, 2)
+	require.Equal(t, "home-user42", volumes[0].Name)
+	require.Equal(t, uint64(1024*1024*1024), volumes[0].CapacityBytes)
+	require.Equal(t, VolumeKindHome, volumes[0].Labels[LabelVolumeKind])
+	require.Equal(t, "42", volumes[0].Labels[LabelUserID])
+	require.Equal(t, "workspace-shared", volumes[1].Name)
+	require.Equal(t, uint64(10*1024*1024*1024), volumes[1].CapacityBytes)
+
+	mounts := WorkbenchMounts(cfg, 42)
+	require.NoError(t, r.CreateWorkbench("workspace1", "workbench1", mounts))
+	got, err := r.Mounts(ctx, "workspace1", "workbench1")
+	require.NoError(t, err)
+	require.Equal(t, []VolumeMount{
+		{Name: "workspace-shared", MountPath: "/home/shared"},
+		{Name: "home-user42", MountPath: "/home/chorus"},
+	}, got)
+
+	require.NoError(t, r.DeleteNamespace("workspace1"))
+	volumes, err = r.Volumes(ctx, "workspace1")
+	require.NoError(t, err)
+	require.Empty(t, volumes)
+}
+
+func TestMemoryRuntimeSnapshots(t *testing.T) {
+	ctx := context.Background()
+	r := NewMemoryRuntime(0)
+	cfg := config.WorkbenchVolumes{}
+
+	err := r.CreateVolumeSnapshot(ctx, "workspace1", "home-user42", "snapshot1-home-user42")
+	require.ErrorIs(t, err, ErrVolumeNotFound)
+
+	require.NoError(t, r.CreateVolume(ctx, "workspace1", HomeVolume(cfg, 42)))
+	require.NoError(t, r.CreateVolumeSnapshot(ctx, "workspace1", "home-user42", "snapshot1-home-user42"))
+	require.Equal(t, []string{"snapshot1-home-user42"}, r.Snapshots("workspace1"))
+	ready, err := r.VolumeSnapshotReady(ctx, "workspace1", "snapshot1-home-user42")
+	require.NoError(t, err)
+	require.True(t, ready)
+	_, err = r.VolumeSnapshotReady(ctx, "workspace1", "snapshot2-home-user42")
+	require.ErrorIs(t, err, ErrSnapshotNotFound)
+
+	restored := HomeVolume(cfg, 42)
+	restored.Name = "workbench2-home-user42"
+	restored.Labels[LabelWorkbench] = "workbench2"
+	require.NoError(t, r.RestoreVolume(ctx, "workspace1", restored, "snapshot1-home-user42"))
+	err = r.RestoreVolume(ctx, "workspace1", restored, "snapshot2-home-user42")
+	require.ErrorIs(t, err, ErrSnapshotNotFound)
+
+	volumes, err := r.Volumes(ctx, "workspace1")
+	require.NoError(t, err)
+	require.Len(t, volumes, 2)
+	require.Equal(t, "home-user42", volumes[0].Name)
+	require.Equal(t, "workbench2-home-user42", volumes[1].Name)
+	require.Equal(t, VolumeKindHome, volumes[1].Labels[LabelVolumeKind])
+
+	require.NoError(t, r.DeleteWorkbenchVolumes(ctx, "workspace1", "workbench2"))
+	volumes, err = r.Volumes(ctx, "workspace1")
+	require.NoError(t, err)
+	require.Len(t, volumes, 1, "the volumes of other workbenches are kept")
+	require.Equal(t, "home-user42", volumes[0].Name)
+
+	require.NoError(t, r.DeleteVolumeSnapshot(ctx, "workspace1", "snapshot1-home-user42"))
+	require.NoError(t, r.DeleteVolumeSnapshot(ctx, "workspace1", "snapshot1-home-user42"))
+	require.Empty(t, r.Snapshots("workspace1"))
+}
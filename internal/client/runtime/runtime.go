@@ -0,0 +1,113 @@
+package runtime
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"io"
+	"time"
+)
+
+// WorkbenchRuntime deploys the workbenches and their apps. The workbenches of
+// a workspace are grouped in a namespace.
+type WorkbenchRuntime interface {
+	// CreateWorkbench deploys the server of a workbench. The volumes are
+	// mounted into every app of the workbench.
+	CreateWorkbench(namespace, workbenchName string, mounts []VolumeMount) error
+	// CreatePortForward returns a local port reaching the server of a
+	// workbench, along with the channel stopping the forwarding if any.
+	CreatePortForward(namespace, workbenchName string) (uint16, chan struct{}, error)
+	// CreateAppInstance deploys an app in a workbench, replacing the app of
+	// the same name if any, such as when it is upgraded to another image.
+	CreateAppInstance(namespace, workbenchName, appName, appImage string) error
+	DeleteApp(namespace, workbenchName, appName string) error
+	DeleteWorkbench(namespace, workbenchName string) error
+	DeleteNamespace(namespace string) error
+	// WorkbenchLogs and AppLogs return the logs of the container of the
+	// server of a workbench or of one of its apps, one line per log entry.
+	// The logs end when the context is done.
+	WorkbenchLogs(ctx context.Context, namespace, workbenchName string, opts LogOptions) (io.ReadCloser, error)
+	AppLogs(ctx context.Context, namespace, workbenchName, appName string, opts LogOptions) (io.ReadCloser, error)
+	// WorkbenchDetails and AppDetails return the observed state of the
+	// server of a workbench or of one of its apps.
+	WorkbenchDetails(ctx context.Context, namespace, workbenchName string) (*Details, error)
+	AppDetails(ctx context.Context, namespace, workbenchName, appName string) (*Details, error)
+	// CreateVolume creates a persistent volume in a namespace, unless it
+	// already exists.
+	CreateVolume(ctx context.Context, namespace string, volume Volume) error
+	// Volumes returns the persistent volumes of a namespace along with their
+	// usage. They outlive the deletion of the workspace and are only deleted
+	// along with the namespace, when the workspace is purged.
+	Volumes(ctx context.Context, namespace string) ([]VolumeStatus, error)
+	// Mounts returns the volumes mounted into the apps of a workbench.
+	Mounts(ctx context.Context, namespace, workbenchName string) ([]VolumeMount, error)
+	// CreateVolumeSnapshot takes a snapshot of the content of a volume,
+	// which outlives the volume until it is deleted. The snapshot may be
+	// cut asynchronously.
+	CreateVolumeSnapshot(ctx context.Context, namespace, volumeName, snapshotName string) error
+	// VolumeSnapshotReady returns whether a snapshot is cut and can be
+	// restored, and ErrSnapshotFailed when it cannot be cut.
+	VolumeSnapshotReady(ctx context.Context, namespace, snapshotName string) (bool, error)
+	// RestoreVolume creates a volume holding the content of a snapshot.
+	RestoreVolume(ctx context.Context, namespace string, volume Volume, snapshotName string) error
+	// DeleteVolumeSnapshot deletes a snapshot, unless it does not exist.
+	DeleteVolumeSnapshot(ctx context.Context, namespace, snapshotName string) error
+	// DeleteWorkbenchVolumes deletes the volumes of a namespace labelled
+	// with LabelWorkbench as belonging to a workbench, such as the volumes
+	// restored from a snapshot.
+	DeleteWorkbenchVolumes(ctx context.Context, namespace, workbenchName string) error
+}
+
+// LogOptions selects the logs of a container.
+type LogOptions struct {
+	// Follow keeps streaming the logs as they are written.
+	Follow bool
+	// TailLines only returns this number of lines from the end of the logs,
+	// all of them when zero.
+	TailLines int64
+	// SinceTime only returns the logs written after it, all of them when
+	// zero.
+	SinceTime time.Time
+}
+
+var (
+	ErrWorkbenchNotFound = errors.New("workbench not found")
+	ErrWorkbenchExists   = errors.New("workbench already exists")
+	ErrAppNotFound       = errors.New("app not found")
+	ErrVolumeNotFound    = errors.New("volume not found")
+	ErrSnapshotNotFound  = errors.New("volume snapshot not found")
+	ErrSnapshotFailed    = errors.New("volume snapshot failed")
+)
+
+// maxLogLineSize bounds the lines read from the logs, longer lines being
+// split.
+const maxLogLineSize = 1024 * 1024
+
+// SendLines calls send for every line of the logs until they end. Lines
+// longer than 1MiB are split. The end of the context ends the logs without
+// error.
+func SendLines(ctx context.Context, logs io.Reader, send func(line string) error) error {
+	scanner := bufio.NewScanner(logs)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLogLineSize)
+	scanner.Split(scanLines)
+
+	for scanner.Scan() {
+		if err := send(scanner.Text()); err != nil {
+			return err
+		}
+	}
+	if err := scanner.Err(); err != nil && ctx.Err() == nil {
+		return err
+	}
+	return nil
+}
+
+// scanLines splits the lines as bufio.ScanLines, but returns the lines that
+// do not fit the buffer in several parts instead of failing.
+func scanLines(data []byte, atEOF bool) (int, []byte, error) {
+	advance, token, err := bufio.ScanLines(data, atEOF)
+	if advance == 0 && token == nil && err == nil && len(data) >= maxLogLineSize {
+		return len(data), data, nil
+	}
+	return advance, token, err
+}
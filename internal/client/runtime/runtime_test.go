@@ -0,0 +1,22 @@
+package runtime
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSendLines(t *testing.T) {
+	long := strings.Repeat("a", maxLogLineSize+10)
+	logs := "first\r\nsecond\n" + long + "\nlast"
+
+	var lines []string
+	err := SendLines(context.Background(), strings.NewReader(logs), func(line string) error {
+		lines = append(lines, line)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"first", "second", long[:maxLogLineSize], long[maxLogLineSize:], "last"}, lines)
+}
@@ -0,0 +1,118 @@
+package runtime
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/config"
+)
+
+// Volume is a persistent volume of a namespace, which outlives the
+// workbenches mounting it.
+type Volume struct {
+	Name string
+	// StorageClass is the class of the storage backing the volume, the
+	// default one when empty.
+	StorageClass string
+	// Size is the requested size of the volume, such as 10Gi.
+	Size   string
+	Labels map[string]string
+}
+
+// VolumeMount mounts a volume of the namespace into the apps of a workbench.
+type VolumeMount struct {
+	Name      string
+	MountPath string
+}
+
+// VolumeStatus is the observed state of a volume.
+type VolumeStatus struct {
+	Name   string
+	Labels map[string]string
+	// Phase is Pending until the volume is bound to storage, then Bound.
+	Phase         string
+	CapacityBytes uint64
+	// UsedBytes is nil when the runtime does not measure the usage.
+	UsedBytes *uint64
+}
+
+// MountedVolume is a volume along with where it is mounted in the apps of a
+// workbench.
+type MountedVolume struct {
+	VolumeStatus
+	MountPath string
+}
+
+const (
+	VolumeKindShared = "shared"
+	VolumeKindHome   = "home"
+
+	LabelVolumeKind = "chorus-tre.ch/volume-kind"
+	LabelUserID     = "chorus-tre.ch/user-id"
+	// LabelWorkbench marks the volumes that belong to a single workbench,
+	// which are deleted along with it.
+	LabelWorkbench = "chorus-tre.ch/workbench"
+
+	sharedVolumeName = "workspace-shared"
+	homeVolumeName   = "home-user%v"
+
+	defaultSharedSize      = "10Gi"
+	defaultHomeSize        = "5Gi"
+	defaultSharedMountPath = "/home/shared"
+	defaultHomeMountPath   = "/home/chorus"
+)
+
+// SharedVolume returns the volume shared by the members of a workspace.
+func SharedVolume(cfg config.WorkbenchVolumes) Volume {
+	return Volume{
+		Name:         sharedVolumeName,
+		StorageClass: cfg.StorageClass,
+		Size:         orDefault(cfg.SharedSize, defaultSharedSize),
+		Labels:       map[string]string{LabelVolumeKind: VolumeKindShared},
+	}
+}
+
+// HomeVolume returns the home volume of a member of a workspace.
+func HomeVolume(cfg config.WorkbenchVolumes, userID uint64) Volume {
+	return Volume{
+		Name:         fmt.Sprintf(homeVolumeName, userID),
+		StorageClass: cfg.StorageClass,
+		Size:         orDefault(cfg.HomeSize, defaultHomeSize),
+		Labels: map[string]string{
+			LabelVolumeKind: VolumeKindHome,
+			LabelUserID:     strconv.FormatUint(userID, 10),
+		},
+	}
+}
+
+// WorkbenchMounts returns the volumes mounted into the apps of a workbench of
+// a user: the shared volume of the workspace and the home volume of the user.
+func WorkbenchMounts(cfg config.WorkbenchVolumes, userID uint64) []VolumeMount {
+	return []VolumeMount{
+		{Name: sharedVolumeName, MountPath: orDefault(cfg.SharedMountPath, defaultSharedMountPath)},
+		{Name: fmt.Sprintf(homeVolumeName, userID), MountPath: orDefault(cfg.HomeMountPath, defaultHomeMountPath)},
+	}
+}
+
+// MountVolumes returns the status of the mounted volumes. The volumes that
+// do not exist in the namespace only have a name.
+func MountVolumes(mounts []VolumeMount, statuses []VolumeStatus) []MountedVolume {
+	res := make([]MountedVolume, 0, len(mounts))
+	for _, m := range mounts {
+		mounted := MountedVolume{VolumeStatus: VolumeStatus{Name: m.Name}, MountPath: m.MountPath}
+		for _, status := range statuses {
+			if status.Name == m.Name {
+				mounted.VolumeStatus = status
+			}
+		}
+		res = append(res, mounted)
+	}
+	return res
+}
+
+func orDefault(value, def string) string {
+	if value == "" {
+		return def
+	}
+	return value
+}
@@ -0,0 +1,27 @@
+package runtime
+
+import (
+	"testing"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMountVolumes(t *testing.T) {
+	cfg := config.WorkbenchVolumes{SharedMountPath: "/data", HomeSize: "1Gi"}
+	home := HomeVolume(cfg, 7)
+	require.Equal(t, "1Gi", home.Size)
+	require.Equal(t, "10Gi", SharedVolume(cfg).Size)
+
+	used := uint64(10)
+	statuses := []VolumeStatus{
+		{Name: "home-user7", Labels: home.Labels, Phase: "Bound", CapacityBytes: 1024, UsedBytes: &used},
+		{Name: "home-user8", Phase: "Bound"},
+	}
+
+	mounted := MountVolumes(WorkbenchMounts(cfg, 7), statuses)
+	require.Equal(t, []MountedVolume{
+		{VolumeStatus: VolumeStatus{Name: "workspace-shared"}, MountPath: "/data"},
+		{VolumeStatus: statuses[0], MountPath: "/home/chorus"},
+	}, mounted)
+}
@@ -13,7 +13,6 @@ import (
 	service_mw "github.com/CHORUS-TRE/chorus-backend/pkg/app-instance/service/middleware"
 	store_mw "github.com/CHORUS-TRE/chorus-backend/pkg/app-instance/store/middleware"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/app-instance/store/postgres"
-	user_model "github.com/CHORUS-TRE/chorus-backend/pkg/user/model"
 )
 
 var appInstanceOnce sync.Once
@@ -22,13 +21,18 @@ var appInstance service.AppInstanceer
 func ProvideAppInstance() service.AppInstanceer {
 	appInstanceOnce.Do(func() {
 		appInstance = service.NewAppInstanceService(
+			ProvideConfig(),
 			ProvideAppInstanceStore(),
-			ProvideHelmClient(),
+			ProvideWorkbenchRuntime(),
 			ProvideAppService(),
+			ProvideQuota(),
+			ProvideWorkspace(),
+			ProvideNotification(),
+			ProvideWebhook(),
 		)
 		appInstance = service_mw.Logging(logger.BizLog)(appInstance)
 		appInstance = service_mw.Validation(ProvideValidator())(appInstance)
-		appInstance = service_mw.AppInstanceCaching(logger.TechLog)(appInstance)
+		appInstance = service_mw.AppInstanceCaching(logger.TechLog, ProvideCacheBackend("app-instance", largeCacheSize))(appInstance)
 	})
 	return appInstance
 }
@@ -39,7 +43,7 @@ var appInstanceController chorus.AppInstanceServiceServer
 func ProvideAppInstanceController() chorus.AppInstanceServiceServer {
 	appInstanceControllerOnce.Do(func() {
 		appInstanceController = v1.NewAppInstanceController(ProvideAppInstance())
-		appInstanceController = ctrl_mw.AppInstanceAuthorizing(logger.SecLog, []string{user_model.RoleAuthenticated.String()})(appInstanceController)
+		appInstanceController = ctrl_mw.AppInstanceAuthorizing(logger.SecLog, ProvideUser())(appInstanceController)
 	})
 	return appInstanceController
 }
@@ -13,7 +13,6 @@ import (
 	service_mw "github.com/CHORUS-TRE/chorus-backend/pkg/app/service/middleware"
 	store_mw "github.com/CHORUS-TRE/chorus-backend/pkg/app/store/middleware"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/app/store/postgres"
-	user_model "github.com/CHORUS-TRE/chorus-backend/pkg/user/model"
 )
 
 var appOnce sync.Once
@@ -22,11 +21,14 @@ var app service.Apper
 func ProvideAppService() service.Apper {
 	appOnce.Do(func() {
 		app = service.NewAppService(
+			ProvideConfig(),
 			ProvideAppStore(),
+			ProvideTenantStore(),
+			ProvideRegistryClient(),
 		)
 		app = service_mw.Logging(logger.BizLog)(app)
 		app = service_mw.Validation(ProvideValidator())(app)
-		app = service_mw.AppCaching(logger.TechLog)(app)
+		app = service_mw.AppCaching(logger.TechLog, ProvideCacheBackend("app", largeCacheSize))(app)
 	})
 	return app
 }
@@ -37,7 +39,7 @@ var appController chorus.AppServiceServer
 func ProvideAppController() chorus.AppServiceServer {
 	appControllerOnce.Do(func() {
 		appController = v1.NewAppController(ProvideAppService())
-		appController = ctrl_mw.AppAuthorizing(logger.SecLog, []string{user_model.RoleAuthenticated.String()})(appController)
+		appController = ctrl_mw.AppAuthorizing(logger.SecLog, ProvideUser())(appController)
 	})
 	return appController
 }
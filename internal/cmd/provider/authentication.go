@@ -4,8 +4,6 @@ import (
 	"context"
 	"sync"
 
-	"go.uber.org/zap"
-
 	v1 "github.com/CHORUS-TRE/chorus-backend/internal/api/v1"
 	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
 	"github.com/CHORUS-TRE/chorus-backend/internal/migration"
@@ -61,11 +59,7 @@ var clientWhitelister grpc_mw.ClientWhitelister
 
 func ProvideClientWhitelister() grpc_mw.ClientWhitelister {
 	clientWhitelisterOnce.Do(func() {
-		var err error
-		clientWhitelister, err = grpc_mw.NewIPWhitelister(ProvideConfig())
-		if err != nil {
-			logger.TechLog.Logger.Fatal("unable to create client whitelister", zap.Error(err))
-		}
+		clientWhitelister = grpc_mw.NewTenantIPWhitelister(ProvideConfig(), ProvideTenanter())
 	})
 	return clientWhitelister
 }
@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/go-redis/redis"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
+	"github.com/CHORUS-TRE/chorus-backend/internal/utils/cache"
+)
+
+const (
+	largeCacheSize = 100 * 1024 * 1024 // Max 100MiB stored in memory
+	smallCacheSize = 10 * 1024 * 1024  // Max 10MiB stored in memory
+)
+
+// ProvideCacheBackend returns the backend of the cache of a service. The
+// local backends keep up to localSize bytes in memory.
+func ProvideCacheBackend(name string, localSize int) cache.Backend {
+	cfg := ProvideConfig()
+
+	switch cfg.Cache.Type {
+	case "", "local":
+		return cache.NewLocalBackend(localSize)
+	case "redis":
+		return cache.NewRedisBackend(ProvideCacheRedisClient(), cfg.Cache.Redis.Prefix+name+":")
+	default:
+		logger.TechLog.Fatal(context.Background(), "unsupported cache type: "+cfg.Cache.Type)
+	}
+	return nil
+}
+
+var cacheRedisClientOnce sync.Once
+var cacheRedisClient *redis.Client
+
+func ProvideCacheRedisClient() *redis.Client {
+	cacheRedisClientOnce.Do(func() {
+		conf := ProvideConfig().Cache.Redis
+		cacheRedisClient = redis.NewClient(&redis.Options{
+			Addr:     fmt.Sprintf("%s:%s", conf.Host, conf.Port),
+			DB:       conf.Database,
+			Password: conf.Password.PlainText(),
+		})
+	})
+	return cacheRedisClient
+}
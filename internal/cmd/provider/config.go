@@ -91,11 +91,17 @@ func SetDefaultConfig(v *viper.Viper) {
 	v.SetDefault("storage.datastores.chorus.ssl.certificate_file", "/chorus/postgres-certs/client.crt")
 	v.SetDefault("storage.datastores.chorus.ssl.key_file", "/chorus/postgres-certs/client.key")
 
+	// Cache
+	v.SetDefault("cache.type", "local")
+	v.SetDefault("cache.redis.port", "6379")
+	v.SetDefault("cache.redis.prefix", "chorus:cache:")
+
 	// Services
 	v.SetDefault("services.authentication_service.enabled", false)
 	v.SetDefault("services.authentication_service.dev_auth_enabled", false)
 	v.SetDefault("services.workbench_service.stream_proxy_enabled", false)
 	v.SetDefault("services.workbench_service.backend_in_k8s", false)
+	v.SetDefault("services.soft_delete.grace_period", "720h")
 
 	// Loggers
 	v.SetDefault("log.description", "Type can be either 'stdout', 'file' or 'redis'. Level can be either 'debug', 'info', 'warn', or 'error'. Category can be either 'technical', 'business' or 'security'.")
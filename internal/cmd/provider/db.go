@@ -23,6 +23,9 @@ const (
 type Database struct {
 	DB   database.DB
 	Type string
+	// DataSourceName is kept for the clients needing their own connection,
+	// such as postgres listeners.
+	DataSourceName string
 }
 
 func (d *Database) GetSqlxDB() *sqlx.DB {
@@ -138,7 +141,8 @@ func providePostgresDB(ctx context.Context, cfg config.Datastore, opts *options)
 	}
 
 	return &Database{
-		DB:   database.NewDefaultDB(db),
-		Type: POSTGRES,
+		DB:             database.NewDefaultDB(db),
+		Type:           POSTGRES,
+		DataSourceName: dataSourceName,
 	}
 }
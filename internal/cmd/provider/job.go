@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/job"
+)
+
+const (
+	defaultNotificationDeliveryInterval  = time.Minute
+	defaultWebhookDeliveryInterval       = 30 * time.Second
+	defaultNotificationRetentionInterval = 24 * time.Hour
+	defaultResourcePurgeInterval         = 24 * time.Hour
+	defaultNamespaceReconcileInterval    = time.Hour
+	workbenchStartInterval               = 15 * time.Second
+	appInstanceCrashInterval             = time.Minute
+	snapshotReadyInterval                = 30 * time.Second
+)
+
+// InitDaemonJobs starts the jobs run periodically by the daemon until ctx is
+// done.
+func InitDaemonJobs(ctx context.Context) {
+	cfg := ProvideConfig()
+
+	if delivery := cfg.Services.NotificationService.EmailDelivery; delivery.Enabled {
+		interval := delivery.Interval
+		if interval <= 0 {
+			interval = defaultNotificationDeliveryInterval
+		}
+		job.Every(ctx, interval, &job.NotificationDelivery{Deliverer: ProvideNotification()})
+	}
+
+	if retention := cfg.Services.NotificationService.Retention; retention.Enabled {
+		interval := retention.Interval
+		if interval <= 0 {
+			interval = defaultNotificationRetentionInterval
+		}
+		job.Every(ctx, interval, &job.NotificationRetention{
+			Tenants:              ProvideTenanter(),
+			Archiver:             ProvideNotification(),
+			DefaultRetentionDays: retention.Days,
+		})
+	}
+
+	if delivery := cfg.Services.WebhookService.Delivery; delivery.Enabled {
+		interval := delivery.Interval
+		if interval <= 0 {
+			interval = defaultWebhookDeliveryInterval
+		}
+		job.Every(ctx, interval, &job.WebhookDelivery{Deliverer: ProvideWebhook()})
+	}
+
+	if softDelete := cfg.Services.SoftDelete; softDelete.Purge.Enabled {
+		interval := softDelete.Purge.Interval
+		if interval <= 0 {
+			interval = defaultResourcePurgeInterval
+		}
+		// The resources are never purged while they can still be restored.
+		retention := softDelete.Purge.Retention
+		if retention < softDelete.GracePeriod {
+			retention = softDelete.GracePeriod
+		}
+		job.Every(ctx, interval, &job.ResourcePurge{
+			AppInstances: ProvideAppInstance(),
+			Workbenchs:   ProvideWorkbench(),
+			Workspaces:   ProvideWorkspace(),
+			Apps:         ProvideAppService(),
+			Retention:    retention,
+		})
+	}
+
+	// The owners of the workbenches are notified once their server started.
+	job.Every(ctx, workbenchStartInterval, &job.WorkbenchStart{Workbenchs: ProvideWorkbench()})
+	// The owners of the app instances are notified when they crash.
+	job.Every(ctx, appInstanceCrashInterval, &job.AppInstanceCrash{AppInstances: ProvideAppInstance()})
+	// The CSI snapshots of the volumes are cut asynchronously.
+	job.Every(ctx, snapshotReadyInterval, &job.SnapshotReady{Workbenchs: ProvideWorkbench()})
+
+	if provisioner := cfg.Clients.NamespaceProvisioner; provisioner.Enabled {
+		interval := provisioner.ReconcileInterval
+		if interval <= 0 {
+			interval = defaultNamespaceReconcileInterval
+		}
+		job.Every(ctx, interval, &job.NamespaceReconcile{
+			Tenants:    ProvideTenanter(),
+			Workspaces: ProvideWorkspace(),
+		})
+	}
+}
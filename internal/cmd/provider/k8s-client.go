@@ -0,0 +1,29 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/client/k8s"
+	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
+)
+
+var namespaceProvisionerOnce sync.Once
+var namespaceProvisioner k8s.NamespaceProvisioner
+
+func ProvideNamespaceProvisioner() k8s.NamespaceProvisioner {
+	namespaceProvisionerOnce.Do(func() {
+		cfg := ProvideConfig()
+		if !cfg.Clients.NamespaceProvisioner.Enabled {
+			namespaceProvisioner = k8s.NewTestNamespaceProvisioner()
+		} else {
+			var err error
+			namespaceProvisioner, err = k8s.NewNamespaceProvisioner(cfg)
+			if err != nil {
+				logger.TechLog.Fatal(context.Background(), fmt.Sprintf("unable to provide namespace provisioner: '%v'", err))
+			}
+		}
+	})
+	return namespaceProvisioner
+}
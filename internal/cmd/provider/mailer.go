@@ -76,13 +76,13 @@ func ProvideMailer() mailerService.Mailer {
 	return mailer
 }
 
-func ProvideFroms() map[uint64]string {
-
-	froms := make(map[uint64]string)
-	cfg := ProvideConfig()
-
-	for id, tenant := range cfg.Tenants {
-		froms[id] = fmt.Sprintf(`"%s" <%s>`, tenant.Mailing.Sender.FromName, tenant.Mailing.Sender.FromEmail)
+// ProvideFroms returns the senders of the mails from the tenant settings.
+func ProvideFroms() mailerService.FromResolver {
+	return func(ctx context.Context, tenantID uint64) string {
+		tenant, err := ProvideTenanter().GetTenant(ctx, tenantID)
+		if err != nil || tenant.Settings == nil {
+			return ""
+		}
+		return fmt.Sprintf(`"%s" <%s>`, tenant.Settings.Mailing.FromName, tenant.Settings.Mailing.FromEmail)
 	}
-	return froms
 }
@@ -13,7 +13,8 @@ import (
 	service_mw "github.com/CHORUS-TRE/chorus-backend/pkg/notification/service/middleware"
 	store_mw "github.com/CHORUS-TRE/chorus-backend/pkg/notification/store/middleware"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/notification/store/postgres"
-	"github.com/CHORUS-TRE/chorus-backend/pkg/user/model"
+
+	"go.uber.org/zap"
 )
 
 var notificationOnce sync.Once
@@ -21,10 +22,10 @@ var notification service.Notificationer
 
 func ProvideNotification() service.Notificationer {
 	notificationOnce.Do(func() {
-		notification = service.NewNotificationService(ProvideNotificationStore())
+		notification = service.NewNotificationService(ProvideConfig(), ProvideNotificationStore(), ProvideNotificationListener(), ProvideMailer())
 		notification = service_mw.Logging(logger.BizLog)(notification)
 		notification = service_mw.Validation(ProvideValidator())(notification)
-		notification = service_mw.NotificationCaching(logger.TechLog)(notification)
+		notification = service_mw.NotificationCaching(logger.TechLog, ProvideCacheBackend("notification", largeCacheSize))(notification)
 	})
 	return notification
 }
@@ -35,7 +36,7 @@ var notificationController chorus.NotificationServiceServer
 func ProvideNotificationController() chorus.NotificationServiceServer {
 	notificationControllerOnce.Do(func() {
 		notificationController = v1.NewNotificationController(ProvideNotification())
-		notificationController = ctrl_mw.NotificationAuthorizing(logger.SecLog, []string{model.RoleAuthenticated.String()})(notificationController)
+		notificationController = ctrl_mw.NotificationAuthorizing(logger.SecLog, ProvideUser())(notificationController)
 	})
 	return notificationController
 }
@@ -56,3 +57,23 @@ func ProvideNotificationStore() service.NotificationStore {
 	})
 	return notificationStore
 }
+
+var notificationListenerOnce sync.Once
+var notificationListener service.NotificationListener
+
+func ProvideNotificationListener() service.NotificationListener {
+	notificationListenerOnce.Do(func() {
+		db := ProvideMainDB(WithClient("notification-store"), WithMigrations(migration.GetMigration))
+		switch db.Type {
+		case POSTGRES:
+			l, err := postgres.NewNotificationListener(db.DataSourceName)
+			if err != nil {
+				logger.TechLog.Fatal(context.Background(), "unable to create notification listener", zap.Error(err))
+			}
+			notificationListener = l
+		default:
+			logger.TechLog.Fatal(context.Background(), "unsupported database type: "+db.Type)
+		}
+	})
+	return notificationListener
+}
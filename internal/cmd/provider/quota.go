@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"context"
+	"sync"
+
+	v1 "github.com/CHORUS-TRE/chorus-backend/internal/api/v1"
+	"github.com/CHORUS-TRE/chorus-backend/internal/api/v1/chorus"
+	ctrl_mw "github.com/CHORUS-TRE/chorus-backend/internal/api/v1/middleware"
+	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
+	"github.com/CHORUS-TRE/chorus-backend/internal/migration"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/quota/service"
+	service_mw "github.com/CHORUS-TRE/chorus-backend/pkg/quota/service/middleware"
+	store_mw "github.com/CHORUS-TRE/chorus-backend/pkg/quota/store/middleware"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/quota/store/postgres"
+)
+
+var quotaOnce sync.Once
+var quota service.Quotaer
+
+func ProvideQuota() service.Quotaer {
+	quotaOnce.Do(func() {
+		quota = service.NewQuotaService(
+			ProvideQuotaStore(),
+			ProvideNotification(),
+		)
+		quota = service_mw.Logging(logger.BizLog)(quota)
+		quota = service_mw.Validation(ProvideValidator())(quota)
+		quota = service_mw.QuotaCaching(logger.TechLog, ProvideCacheBackend("quota", smallCacheSize))(quota)
+	})
+	return quota
+}
+
+var quotaControllerOnce sync.Once
+var quotaController chorus.QuotaServiceServer
+
+func ProvideQuotaController() chorus.QuotaServiceServer {
+	quotaControllerOnce.Do(func() {
+		quotaController = v1.NewQuotaController(ProvideQuota())
+		quotaController = ctrl_mw.QuotaAuthorizing(logger.SecLog, ProvideUser())(quotaController)
+	})
+	return quotaController
+}
+
+var quotaStoreOnce sync.Once
+var quotaStore service.QuotaStore
+
+func ProvideQuotaStore() service.QuotaStore {
+	quotaStoreOnce.Do(func() {
+		db := ProvideMainDB(WithClient("quota-store"), WithMigrations(migration.GetMigration))
+		switch db.Type {
+		case POSTGRES:
+			quotaStore = postgres.NewQuotaStorage(db.DB.GetSqlxDB())
+		default:
+			logger.TechLog.Fatal(context.Background(), "unsupported database type: "+db.Type)
+		}
+		quotaStore = store_mw.Logging(logger.TechLog)(quotaStore)
+	})
+	return quotaStore
+}
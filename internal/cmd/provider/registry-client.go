@@ -0,0 +1,17 @@
+package provider
+
+import (
+	"sync"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/client/registry"
+)
+
+var registryClientOnce sync.Once
+var registryClient registry.Client
+
+func ProvideRegistryClient() registry.Client {
+	registryClientOnce.Do(func() {
+		registryClient = registry.NewClient(ProvideConfig())
+	})
+	return registryClient
+}
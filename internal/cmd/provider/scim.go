@@ -0,0 +1,17 @@
+package provider
+
+import (
+	"sync"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/api/scim"
+)
+
+var scimHandlerOnce sync.Once
+var scimHandler *scim.Handler
+
+func ProvideSCIMHandler() *scim.Handler {
+	scimHandlerOnce.Do(func() {
+		scimHandler = scim.NewHandler(ProvideTenanter(), ProvideUser(), ProvideWorkspace())
+	})
+	return scimHandler
+}
@@ -4,6 +4,9 @@ import (
 	"context"
 	"sync"
 
+	v1 "github.com/CHORUS-TRE/chorus-backend/internal/api/v1"
+	"github.com/CHORUS-TRE/chorus-backend/internal/api/v1/chorus"
+	ctrl_mw "github.com/CHORUS-TRE/chorus-backend/internal/api/v1/middleware"
 	"github.com/CHORUS-TRE/chorus-backend/internal/migration"
 
 	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
@@ -37,11 +40,23 @@ func ProvideTenanter() service.Tenanter {
 
 		tenanter = service.NewTenantService(
 			ProvideTenantStore(),
+			ProvideWorkbench(),
 			ProvideConfig(),
 		)
 		tenanter = service_mw.Logging(logger.BizLog)(tenanter)
 		tenanter = service_mw.Validation(ProvideValidator())(tenanter)
-		tenanter = service_mw.TenantCaching(logger.TechLog)(tenanter)
+		tenanter = service_mw.TenantCaching(logger.TechLog, ProvideCacheBackend("tenant", largeCacheSize))(tenanter)
 	})
 	return tenanter
 }
+
+var tenantControllerOnce sync.Once
+var tenantController chorus.TenantServiceServer
+
+func ProvideTenantController() chorus.TenantServiceServer {
+	tenantControllerOnce.Do(func() {
+		tenantController = v1.NewTenantController(ProvideTenanter())
+		tenantController = ctrl_mw.TenantAuthorizing(logger.SecLog, ProvideUser())(tenantController)
+	})
+	return tenantController
+}
@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"context"
+	"sync"
+
+	v1 "github.com/CHORUS-TRE/chorus-backend/internal/api/v1"
+	"github.com/CHORUS-TRE/chorus-backend/internal/api/v1/chorus"
+	ctrl_mw "github.com/CHORUS-TRE/chorus-backend/internal/api/v1/middleware"
+	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
+	"github.com/CHORUS-TRE/chorus-backend/internal/migration"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/webhook/service"
+	service_mw "github.com/CHORUS-TRE/chorus-backend/pkg/webhook/service/middleware"
+	store_mw "github.com/CHORUS-TRE/chorus-backend/pkg/webhook/store/middleware"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/webhook/store/postgres"
+)
+
+var webhookOnce sync.Once
+var webhook service.Webhooker
+
+func ProvideWebhook() service.Webhooker {
+	webhookOnce.Do(func() {
+		webhook = service.NewWebhookService(
+			ProvideConfig(),
+			ProvideWebhookStore(),
+			ProvideDaemonEncryptionKey(),
+		)
+		webhook = service_mw.Logging(logger.BizLog)(webhook)
+		webhook = service_mw.Validation(ProvideValidator())(webhook)
+		webhook = service_mw.WebhookCaching(logger.TechLog, ProvideCacheBackend("webhook", smallCacheSize))(webhook)
+	})
+	return webhook
+}
+
+var webhookControllerOnce sync.Once
+var webhookController chorus.WebhookServiceServer
+
+func ProvideWebhookController() chorus.WebhookServiceServer {
+	webhookControllerOnce.Do(func() {
+		webhookController = v1.NewWebhookController(ProvideWebhook())
+		webhookController = ctrl_mw.WebhookAuthorizing(logger.SecLog, ProvideUser())(webhookController)
+	})
+	return webhookController
+}
+
+var webhookStoreOnce sync.Once
+var webhookStore service.WebhookStore
+
+func ProvideWebhookStore() service.WebhookStore {
+	webhookStoreOnce.Do(func() {
+		db := ProvideMainDB(WithClient("webhook-store"), WithMigrations(migration.GetMigration))
+		switch db.Type {
+		case POSTGRES:
+			webhookStore = postgres.NewWebhookStorage(db.DB.GetSqlxDB())
+		default:
+			logger.TechLog.Fatal(context.Background(), "unsupported database type: "+db.Type)
+		}
+		webhookStore = store_mw.Logging(logger.TechLog)(webhookStore)
+	})
+	return webhookStore
+}
@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/client/helm"
+	"github.com/CHORUS-TRE/chorus-backend/internal/client/k8s"
+	"github.com/CHORUS-TRE/chorus-backend/internal/client/runtime"
+	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
+)
+
+var workbenchRuntimeOnce sync.Once
+var workbenchRuntime runtime.WorkbenchRuntime
+
+func ProvideWorkbenchRuntime() runtime.WorkbenchRuntime {
+	workbenchRuntimeOnce.Do(func() {
+		cfg := ProvideConfig()
+
+		runtimeType := cfg.Clients.WorkbenchRuntime.Type
+		if runtimeType == "" {
+			runtimeType = "memory"
+			if k8s.IsConfigured(cfg) {
+				runtimeType = "helm"
+			}
+		}
+
+		var err error
+		switch runtimeType {
+		case "helm":
+			workbenchRuntime, err = helm.NewClient(cfg)
+		case "docker":
+			workbenchRuntime, err = runtime.NewDockerRuntime(cfg)
+		case "memory":
+			workbenchRuntime = runtime.NewMemoryRuntime(cfg.Clients.WorkbenchRuntime.Memory.StartupDelay)
+		default:
+			err = fmt.Errorf("unknown workbench runtime type %q", runtimeType)
+		}
+		if err != nil {
+			logger.TechLog.Fatal(context.Background(), fmt.Sprintf("unable to provide workbench runtime: '%v'", err))
+		}
+	})
+	return workbenchRuntime
+}
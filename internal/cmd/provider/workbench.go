@@ -9,7 +9,6 @@ import (
 	ctrl_mw "github.com/CHORUS-TRE/chorus-backend/internal/api/v1/middleware"
 	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
 	"github.com/CHORUS-TRE/chorus-backend/internal/migration"
-	user_model "github.com/CHORUS-TRE/chorus-backend/pkg/user/model"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/workbench/service"
 	service_mw "github.com/CHORUS-TRE/chorus-backend/pkg/workbench/service/middleware"
 	store_mw "github.com/CHORUS-TRE/chorus-backend/pkg/workbench/store/middleware"
@@ -24,11 +23,17 @@ func ProvideWorkbench() service.Workbencher {
 		workbench = service.NewWorkbenchService(
 			ProvideConfig(),
 			ProvideWorkbenchStore(),
-			ProvideHelmClient(),
+			ProvideWorkbenchRuntime(),
+			ProvideQuota(),
+			ProvideWorkspace(),
+			ProvideAppInstance(),
+			ProvideAppService(),
+			ProvideNotification(),
+			ProvideWebhook(),
 		)
 		workbench = service_mw.Logging(logger.BizLog)(workbench)
 		workbench = service_mw.Validation(ProvideValidator())(workbench)
-		workbench = service_mw.WorkbenchCaching(logger.TechLog)(workbench)
+		workbench = service_mw.WorkbenchCaching(logger.TechLog, ProvideCacheBackend("workbench", largeCacheSize))(workbench)
 	})
 	return workbench
 }
@@ -39,7 +44,7 @@ var workbenchController chorus.WorkbenchServiceServer
 func ProvideWorkbenchController() chorus.WorkbenchServiceServer {
 	workbenchControllerOnce.Do(func() {
 		workbenchController = v1.NewWorkbenchController(ProvideWorkbench())
-		workbenchController = ctrl_mw.WorkbenchAuthorizing(logger.SecLog, []string{user_model.RoleAuthenticated.String()})(workbenchController)
+		workbenchController = ctrl_mw.WorkbenchAuthorizing(logger.SecLog, ProvideUser())(workbenchController)
 	})
 	return workbenchController
 }
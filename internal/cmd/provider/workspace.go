@@ -9,7 +9,6 @@ import (
 	ctrl_mw "github.com/CHORUS-TRE/chorus-backend/internal/api/v1/middleware"
 	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
 	"github.com/CHORUS-TRE/chorus-backend/internal/migration"
-	user_model "github.com/CHORUS-TRE/chorus-backend/pkg/user/model"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/workspace/service"
 	service_mw "github.com/CHORUS-TRE/chorus-backend/pkg/workspace/service/middleware"
 	store_mw "github.com/CHORUS-TRE/chorus-backend/pkg/workspace/store/middleware"
@@ -22,12 +21,16 @@ var workspace service.Workspaceer
 func ProvideWorkspace() service.Workspaceer {
 	workspaceOnce.Do(func() {
 		workspace = service.NewWorkspaceService(
+			ProvideConfig(),
 			ProvideWorkspaceStore(),
-			ProvideHelmClient(),
+			ProvideWorkbenchRuntime(),
+			ProvideNamespaceProvisioner(),
+			ProvideQuota(),
+			ProvideNotification(),
 		)
 		workspace = service_mw.Logging(logger.BizLog)(workspace)
 		workspace = service_mw.Validation(ProvideValidator())(workspace)
-		workspace = service_mw.WorkspaceCaching(logger.TechLog)(workspace)
+		workspace = service_mw.WorkspaceCaching(logger.TechLog, ProvideCacheBackend("workspace", largeCacheSize))(workspace)
 	})
 	return workspace
 }
@@ -38,7 +41,7 @@ var workspaceController chorus.WorkspaceServiceServer
 func ProvideWorkspaceController() chorus.WorkspaceServiceServer {
 	workspaceControllerOnce.Do(func() {
 		workspaceController = v1.NewWorkspaceController(ProvideWorkspace())
-		workspaceController = ctrl_mw.WorkspaceAuthorizing(logger.SecLog, []string{user_model.RoleAuthenticated.String()})(workspaceController)
+		workspaceController = ctrl_mw.WorkspaceAuthorizing(logger.SecLog, ProvideUser())(workspaceController)
 	})
 	return workspaceController
 }
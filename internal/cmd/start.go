@@ -73,7 +73,7 @@ func runServer() error {
 
 	// 1. Init and serve the HTTP server, but it will return 503 errors until
 	// the gRPC server has started.
-	handler, mux, opts := rest.InitServer(ctx, cfg, getVersion(), started, provider.ProvideWorkbench().ProxyWorkbench, provider.ProvideKeyFunc(cfg.Daemon.JWT.Secret.PlainText()), provider.ProvideClaimsFactory())
+	handler, mux, opts := rest.InitServer(ctx, cfg, getVersion(), started, provider.ProvideWorkbench().ProxyWorkbench, provider.ProvideSCIMHandler(), provider.ProvideKeyFunc(cfg.Daemon.JWT.Secret.PlainText()), provider.ProvideClaimsFactory())
 
 	httpSrv := &http.Server{
 		Addr:    httpHostPort,
@@ -92,7 +92,7 @@ func runServer() error {
 	serverMetrics := provider.ProvideServerMetrics()
 
 	// 2. Initialize and run gRPC server.
-	server, err := grpc.InitServer(provider.ProvideClientWhitelister(), provider.ProvideKeyFunc(cfg.Daemon.JWT.Secret.PlainText()), provider.ProvideClaimsFactory(), serverMetrics, cfg)
+	server, err := grpc.InitServer(provider.ProvideClientWhitelister(), provider.ProvideTenanter(), provider.ProvideKeyFunc(cfg.Daemon.JWT.Secret.PlainText()), provider.ProvideClaimsFactory(), serverMetrics, cfg)
 	if err != nil {
 		return err
 	}
@@ -128,7 +128,7 @@ func runServer() error {
 	// services.
 	close(started)
 
-	// provider.InitDaemonJobs()
+	provider.InitDaemonJobs(ctx)
 
 	// 4. Wait for the signal to stop.
 	signal.Notify(c, os.Interrupt)
@@ -150,12 +150,15 @@ func registerGRPCServices(server *google_grpc.Server) {
 	}
 	chorus.RegisterUserServiceServer(server, provider.ProvideUserController())
 	chorus.RegisterStewardServiceServer(server, provider.ProvideStewardController())
+	chorus.RegisterTenantServiceServer(server, provider.ProvideTenantController())
 	chorus.RegisterNotificationServiceServer(server, provider.ProvideNotificationController())
 	chorus.RegisterHealthServiceServer(server, provider.ProvideHealthController())
 	chorus.RegisterAppServiceServer(server, provider.ProvideAppController())
 	chorus.RegisterAppInstanceServiceServer(server, provider.ProvideAppInstanceController())
 	chorus.RegisterWorkspaceServiceServer(server, provider.ProvideWorkspaceController())
 	chorus.RegisterWorkbenchServiceServer(server, provider.ProvideWorkbenchController())
+	chorus.RegisterQuotaServiceServer(server, provider.ProvideQuotaController())
+	chorus.RegisterWebhookServiceServer(server, provider.ProvideWebhookController())
 
 	// Setup a standard health check service to allow a client to poll the
 	// status.
@@ -178,6 +181,9 @@ func registerHTTPEndpoints(ctx context.Context, mux *runtime.ServeMux, grpcHostP
 	if err := chorus.RegisterStewardServiceHandlerFromEndpoint(ctx, mux, grpcHostPort, opts); err != nil {
 		logger.TechLog.Fatal(ctx, "failed to register http steward service handler", logger.WithErrorField(err))
 	}
+	if err := chorus.RegisterTenantServiceHandlerFromEndpoint(ctx, mux, grpcHostPort, opts); err != nil {
+		logger.TechLog.Fatal(ctx, "failed to register http tenant service handler", logger.WithErrorField(err))
+	}
 	if err := chorus.RegisterNotificationServiceHandlerFromEndpoint(ctx, mux, grpcHostPort, opts); err != nil {
 		logger.TechLog.Fatal(ctx, "failed to register http notification service handler", logger.WithErrorField(err))
 	}
@@ -196,4 +202,10 @@ func registerHTTPEndpoints(ctx context.Context, mux *runtime.ServeMux, grpcHostP
 	if err := chorus.RegisterWorkbenchServiceHandlerFromEndpoint(ctx, mux, grpcHostPort, opts); err != nil {
 		logger.TechLog.Fatal(ctx, "failed to register http workspace service handler", logger.WithErrorField(err))
 	}
+	if err := chorus.RegisterQuotaServiceHandlerFromEndpoint(ctx, mux, grpcHostPort, opts); err != nil {
+		logger.TechLog.Fatal(ctx, "failed to register http quota service handler", logger.WithErrorField(err))
+	}
+	if err := chorus.RegisterWebhookServiceHandlerFromEndpoint(ctx, mux, grpcHostPort, opts); err != nil {
+		logger.TechLog.Fatal(ctx, "failed to register http webhook service handler", logger.WithErrorField(err))
+	}
 }
@@ -15,6 +15,22 @@ type (
 		Tenants   map[uint64]Tenant   `yaml:"tenants"`
 		Workflows map[string]Workflow `yaml:"workflows,omitempty"`
 		Services  Services            `yaml:"services"`
+		Cache     Cache               `yaml:"cache"`
+	}
+
+	// Cache configures the backend of the service caches: 'local' keeps them
+	// in the memory of each replica while 'redis' shares them between the
+	// replicas, so that the invalidations apply to all of them.
+	Cache struct {
+		Type  string `yaml:"type"`
+		Redis struct {
+			Host     string    `yaml:"host"`
+			Port     string    `yaml:"port"`
+			Database int       `yaml:"database"`
+			Password Sensitive `yaml:"password"`
+			// Prefix is prepended to the keys of the entries.
+			Prefix string `yaml:"prefix"`
+		} `yaml:"redis"`
 	}
 
 	// Daemon holds the GRPC and HTTP server settings.
@@ -108,7 +124,9 @@ type (
 	}
 
 	Clients struct {
-		HelmClient HelmClient `yaml:"helm_client,omitempty"`
+		HelmClient           HelmClient           `yaml:"helm_client,omitempty"`
+		NamespaceProvisioner NamespaceProvisioner `yaml:"namespace_provisioner,omitempty"`
+		WorkbenchRuntime     WorkbenchRuntime     `yaml:"workbench_runtime,omitempty"`
 	}
 
 	HelmClient struct {
@@ -118,15 +136,105 @@ type (
 		CA        string `yaml:"ca,omitempty"`         // and service account ca
 		APIServer string `yaml:"api_server,omitempty"` // and service account api server
 
+		// TokenFile and CAFile read the service account token and ca from
+		// disk instead, such as the ones mounted in the pod of the backend.
+		// They are read again when they are rotated.
+		TokenFile string `yaml:"token_file,omitempty"`
+		CAFile    string `yaml:"ca_file,omitempty"`
+
 		ImagePullSecrets []ImagePullSecret `yaml:"image_pull_secrets,omitempty"`
 	}
 
+	// NamespaceProvisioner isolates the workspace namespaces, which are
+	// reached through the cluster of the helm client.
+	NamespaceProvisioner struct {
+		Enabled bool `yaml:"enabled"`
+		// AllowedEgress lists the endpoints outside of their namespace that
+		// the workspaces can reach, on top of the cluster DNS.
+		AllowedEgress []EgressRule `yaml:"allowed_egress,omitempty"`
+		// IngressNamespaces lists the namespaces allowed to reach the
+		// workspaces, such as the one of the backend proxying the workbenches.
+		IngressNamespaces []string `yaml:"ingress_namespaces,omitempty"`
+		// DefaultCPU and DefaultMemory are the requests and limits of the
+		// containers that set none, as Kubernetes quantities.
+		DefaultCPU    string `yaml:"default_cpu,omitempty"`
+		DefaultMemory string `yaml:"default_memory,omitempty"`
+		// ReconcileInterval is the interval at which the namespaces of all
+		// the workspaces are provisioned again, reverting their drift and
+		// applying the changes of their quotas.
+		ReconcileInterval time.Duration `yaml:"reconcile_interval,omitempty"`
+	}
+
+	// WorkbenchRuntime selects where the workbenches are deployed: "helm"
+	// installs them in the cluster of the helm client, "docker" runs them on
+	// a single Docker host and "memory" only simulates them. When no type is
+	// given, helm is used if the helm client is configured, memory otherwise.
+	WorkbenchRuntime struct {
+		Type    string                 `yaml:"type,omitempty"`
+		Docker  DockerWorkbenchRuntime `yaml:"docker,omitempty"`
+		Memory  MemoryWorkbenchRuntime `yaml:"memory,omitempty"`
+		Volumes WorkbenchVolumes       `yaml:"volumes,omitempty"`
+	}
+
+	// WorkbenchVolumes configures the persistent volumes of the workspaces:
+	// a volume shared by the members of a workspace and a home volume per
+	// member, both mounted into every app of the workbenches.
+	WorkbenchVolumes struct {
+		// StorageClass is the storage class of the volumes, the default one
+		// of the cluster when empty.
+		StorageClass string `yaml:"storage_class,omitempty"`
+		// SharedSize and HomeSize are the requested sizes of the volumes,
+		// such as 10Gi.
+		SharedSize string `yaml:"shared_size,omitempty"`
+		HomeSize   string `yaml:"home_size,omitempty"`
+		// SharedMountPath and HomeMountPath are where the volumes are
+		// mounted in the apps.
+		SharedMountPath string `yaml:"shared_mount_path,omitempty"`
+		HomeMountPath   string `yaml:"home_mount_path,omitempty"`
+		// SnapshotClass is the volume snapshot class of the snapshots of the
+		// volumes, the default one of the CSI driver when empty.
+		SnapshotClass string `yaml:"snapshot_class,omitempty"`
+	}
+
+	DockerWorkbenchRuntime struct {
+		// Host is the address of the Docker Engine API, such as
+		// unix:///var/run/docker.sock or tcp://127.0.0.1:2375.
+		Host string `yaml:"host,omitempty"`
+		// ServerImage is the image of the server of the workbenches.
+		ServerImage string `yaml:"server_image,omitempty"`
+		// HelperImage is the image copying the content of the volumes to
+		// and from their snapshots, busybox when empty.
+		HelperImage string `yaml:"helper_image,omitempty"`
+	}
+
+	MemoryWorkbenchRuntime struct {
+		// StartupDelay is the time the simulated workbenches and apps take
+		// to be running.
+		StartupDelay time.Duration `yaml:"startup_delay,omitempty"`
+	}
+
+	// EgressRule allows the traffic to a CIDR, on the given TCP ports or on
+	// all the ports when none is given.
+	EgressRule struct {
+		CIDR  string  `yaml:"cidr"`
+		Ports []int32 `yaml:"ports,omitempty"`
+	}
+
+	// ImagePullSecret holds the credentials of a registry. When resolving
+	// the images, they are also sent to the token services of the registry
+	// that are hosted elsewhere, such as "auth.docker.io", when listed in
+	// AuthHosts.
 	ImagePullSecret struct {
-		Registry string `yaml:"registry,omitempty"`
-		Username string `yaml:"username,omitempty"`
-		Password string `yaml:"password,omitempty"`
+		Registry  string   `yaml:"registry,omitempty"`
+		Username  string   `yaml:"username,omitempty"`
+		Password  string   `yaml:"password,omitempty"`
+		AuthHosts []string `yaml:"auth_hosts,omitempty"`
 	}
 
+	// Tenant holds the configuration of a tenant. The IP whitelist, mailing
+	// sender, SCIM, notifications and registries settings are only used until
+	// the settings of the tenant are updated through the tenant service, which
+	// stores them in the database.
 	Tenant struct {
 		Enabled     bool        `yaml:"enabled"`
 		User        string      `yaml:"user"`
@@ -140,6 +248,27 @@ type (
 			EmailAddresses map[string]string `yaml:"email_addresses"`
 		} `yaml:"mailing"`
 		FileStorage TenantFileStorage `yaml:"file_storage"`
+		SCIM        TenantSCIM        `yaml:"scim"`
+
+		Notifications struct {
+			RetentionDays int `yaml:"retention_days"`
+		} `yaml:"notifications"`
+
+		// Registries lists the registries, or repository prefixes within
+		// them, that the images of the apps of the tenant are allowed from. The
+		// settings of the tenant take precedence when they list any.
+		Registries struct {
+			Allowed []string `yaml:"allowed"`
+		} `yaml:"registries"`
+	}
+
+	// TenantSCIM configures the SCIM provisioning of a tenant. Users created
+	// through SCIM are given Source, which should match the ID of the
+	// authentication mode they log in with.
+	TenantSCIM struct {
+		Enabled bool      `yaml:"enabled"`
+		Token   Sensitive `yaml:"token"`
+		Source  string    `yaml:"source"`
 	}
 
 	TenantFileStorage struct {
@@ -216,6 +345,47 @@ type (
 			StreamProxyEnabled bool `yaml:"stream_proxy_enabled"`
 			BackendInK8S       bool `yaml:"backend_in_k8s"`
 		} `yaml:"workbench_service"`
+
+		NotificationService struct {
+			EmailDelivery struct {
+				Enabled     bool          `yaml:"enabled"`
+				Interval    time.Duration `yaml:"interval"`
+				BatchSize   int           `yaml:"batch_size"`
+				MaxAttempts int           `yaml:"max_attempts"`
+				// WebURL is prepended to the links of the notifications sent by email.
+				WebURL string `yaml:"web_url"`
+			} `yaml:"email_delivery"`
+			// Retention archives the read notifications after the retention
+			// period of their tenant, or after Days if the tenant has none.
+			Retention struct {
+				Enabled  bool          `yaml:"enabled"`
+				Interval time.Duration `yaml:"interval"`
+				Days     int           `yaml:"days"`
+			} `yaml:"retention"`
+		} `yaml:"notification_service"`
+
+		WebhookService struct {
+			Delivery struct {
+				Enabled     bool          `yaml:"enabled"`
+				Interval    time.Duration `yaml:"interval"`
+				BatchSize   int           `yaml:"batch_size"`
+				MaxAttempts int           `yaml:"max_attempts"`
+				// Timeout bounds each request posting an event to a webhook.
+				Timeout time.Duration `yaml:"timeout"`
+			} `yaml:"delivery"`
+		} `yaml:"webhook_service"`
+
+		// SoftDelete keeps the deleted workspaces, workbenches, apps and app
+		// instances restorable during GracePeriod, and the purge hard-deletes
+		// them once Retention has elapsed.
+		SoftDelete struct {
+			GracePeriod time.Duration `yaml:"grace_period"`
+			Purge       struct {
+				Enabled   bool          `yaml:"enabled"`
+				Interval  time.Duration `yaml:"interval"`
+				Retention time.Duration `yaml:"retention"`
+			} `yaml:"purge"`
+		} `yaml:"soft_delete"`
 	}
 
 	Mode struct {
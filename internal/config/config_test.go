@@ -112,6 +112,9 @@ func TestDecodeTenants(t *testing.T) {
 	require.Len(t, tenant.IPWhitelist.Subnetworks, 2)
 	require.Equal(t, "127.0.0.1/32", tenant.IPWhitelist.Subnetworks[0])
 	require.Equal(t, "10.1.0.0/16", tenant.IPWhitelist.Subnetworks[1])
+
+	// Registries
+	require.Equal(t, []string{"registry.example.com/chorus"}, tenant.Registries.Allowed)
 }
 
 func config(t *testing.T) Config {
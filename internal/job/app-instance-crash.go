@@ -0,0 +1,34 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
+	"go.uber.org/zap"
+)
+
+// AppInstanceCrashChecker notifies the owners of the app instances that
+// crashed.
+type AppInstanceCrashChecker interface {
+	CheckAppInstancesCrashed(ctx context.Context) error
+}
+
+type AppInstanceCrash struct {
+	AppInstances AppInstanceCrashChecker
+}
+
+func (j *AppInstanceCrash) Do(ctx context.Context, meta interface{}, arg interface{}) (_ interface{}, _ map[string]string, err error) {
+	log := logger.With(logger.TechLog, zap.String("job_name", "app-instance-crash"))
+
+	log.Debug(ctx, "job started", zap.Time("now", time.Now().UTC()))
+
+	err = j.AppInstances.CheckAppInstancesCrashed(ctx)
+	if err != nil {
+		log.Error(ctx, "could not check crashed app instances", zap.Error(err))
+		return nil, map[string]string{"msg": "could not check crashed app instances", "err": err.Error()}, err
+	}
+
+	log.Debug(ctx, "successfully finished")
+	return nil, map[string]string{"msg": "successfully finished"}, nil
+}
@@ -0,0 +1,71 @@
+package job
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
+	"github.com/CHORUS-TRE/chorus-backend/internal/utils/pagination"
+	workspace_model "github.com/CHORUS-TRE/chorus-backend/pkg/workspace/model"
+	workspace_service "github.com/CHORUS-TRE/chorus-backend/pkg/workspace/service"
+	"go.uber.org/zap"
+)
+
+// WorkspaceProvisioner lists the workspaces of a tenant and provisions their
+// namespace.
+type WorkspaceProvisioner interface {
+	ListWorkspaces(ctx context.Context, req workspace_service.ListWorkspacesReq) ([]*workspace_model.Workspace, *pagination.ResponseCursor[pagination.KeysetCursor], uint64, error)
+	ProvisionWorkspaceNamespace(ctx context.Context, tenantID, workspaceID uint64) error
+}
+
+// NamespaceReconcile provisions again the namespaces of the workspaces that
+// are not deleted, reverting their drift and applying their current quota.
+type NamespaceReconcile struct {
+	Tenants    TenantLister
+	Workspaces WorkspaceProvisioner
+}
+
+func (j *NamespaceReconcile) Do(ctx context.Context, meta interface{}, arg interface{}) (_ interface{}, _ map[string]string, err error) {
+	log := logger.With(logger.TechLog, zap.String("job_name", "namespace-reconcile"))
+
+	log.Debug(ctx, "job started", zap.Time("now", time.Now().UTC()))
+
+	tenants, err := j.Tenants.ListTenants(ctx)
+	if err != nil {
+		log.Error(ctx, "could not list tenants", zap.Error(err))
+		return nil, map[string]string{"msg": "could not list tenants", "err": err.Error()}, err
+	}
+
+	var total, failed int
+	for _, t := range tenants {
+		workspaces, _, _, err := j.Workspaces.ListWorkspaces(ctx, workspace_service.ListWorkspacesReq{
+			TenantID: t.ID,
+			Sort:     workspace_service.Sort{SortOrder: "ASC", SortType: "ID"},
+		})
+		if err != nil {
+			failed++
+			log.Error(ctx, "could not list workspaces", zap.Uint64("tenant_id", t.ID), zap.Error(err))
+			continue
+		}
+
+		for _, w := range workspaces {
+			if w.Status == workspace_model.WorkspaceDeleted {
+				continue
+			}
+			total++
+			if err := j.Workspaces.ProvisionWorkspaceNamespace(ctx, t.ID, w.ID); err != nil {
+				failed++
+				log.Error(ctx, "could not provision namespace", zap.Uint64("tenant_id", t.ID), logger.WithWorkspaceIDField(w.ID), zap.Error(err))
+			}
+		}
+	}
+
+	if failed != 0 {
+		err = fmt.Errorf("unable to reconcile %v of %v namespaces", failed, total)
+		return nil, map[string]string{"msg": "could not reconcile namespaces", "err": err.Error()}, err
+	}
+
+	log.Debug(ctx, "successfully finished", zap.Int("namespaces", total))
+	return nil, map[string]string{"msg": "successfully finished"}, nil
+}
@@ -0,0 +1,33 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
+	"go.uber.org/zap"
+)
+
+// NotificationDeliverer sends the notifications that are due by email.
+type NotificationDeliverer interface {
+	DeliverNotifications(ctx context.Context) error
+}
+
+type NotificationDelivery struct {
+	Deliverer NotificationDeliverer
+}
+
+func (j *NotificationDelivery) Do(ctx context.Context, meta interface{}, arg interface{}) (_ interface{}, _ map[string]string, err error) {
+	log := logger.With(logger.TechLog, zap.String("job_name", "notification-delivery"))
+
+	log.Debug(ctx, "job started", zap.Time("now", time.Now().UTC()))
+
+	err = j.Deliverer.DeliverNotifications(ctx)
+	if err != nil {
+		log.Error(ctx, "could not deliver notifications", zap.Error(err))
+		return nil, map[string]string{"msg": "could not deliver notifications", "err": err.Error()}, err
+	}
+
+	log.Debug(ctx, "successfully finished")
+	return nil, map[string]string{"msg": "successfully finished"}, nil
+}
@@ -0,0 +1,74 @@
+package job
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
+	tenant_model "github.com/CHORUS-TRE/chorus-backend/pkg/tenant/model"
+	"go.uber.org/zap"
+)
+
+// TenantLister lists the tenants along with their settings.
+type TenantLister interface {
+	ListTenants(ctx context.Context) ([]*tenant_model.Tenant, error)
+}
+
+// NotificationArchiver archives the read notifications of a tenant older than
+// its retention period.
+type NotificationArchiver interface {
+	ArchiveNotifications(ctx context.Context, tenantID uint64, retentionDays int) (int64, int64, error)
+}
+
+// NotificationRetention applies the retention period of each tenant to its
+// notifications, DefaultRetentionDays applying to the tenants that have none.
+// The notifications of a tenant without retention period are kept.
+type NotificationRetention struct {
+	Tenants              TenantLister
+	Archiver             NotificationArchiver
+	DefaultRetentionDays int
+}
+
+func (j *NotificationRetention) Do(ctx context.Context, meta interface{}, arg interface{}) (_ interface{}, _ map[string]string, err error) {
+	log := logger.With(logger.TechLog, zap.String("job_name", "notification-retention"))
+
+	log.Debug(ctx, "job started", zap.Time("now", time.Now().UTC()))
+
+	tenants, err := j.Tenants.ListTenants(ctx)
+	if err != nil {
+		log.Error(ctx, "could not list tenants", zap.Error(err))
+		return nil, map[string]string{"msg": "could not list tenants", "err": err.Error()}, err
+	}
+
+	var failed int
+	for _, t := range tenants {
+		days := j.retentionDays(t)
+		if days <= 0 {
+			continue
+		}
+
+		archived, purged, err := j.Archiver.ArchiveNotifications(ctx, t.ID, days)
+		if err != nil {
+			failed++
+			log.Error(ctx, "could not archive notifications", zap.Uint64("tenant_id", t.ID), zap.Error(err))
+			continue
+		}
+		log.Debug(ctx, "archived notifications", zap.Uint64("tenant_id", t.ID), zap.Int64("archived", archived), zap.Int64("purged", purged))
+	}
+
+	if failed != 0 {
+		err = fmt.Errorf("unable to archive the notifications of %v of %v tenants", failed, len(tenants))
+		return nil, map[string]string{"msg": "could not archive notifications", "err": err.Error()}, err
+	}
+
+	log.Debug(ctx, "successfully finished")
+	return nil, map[string]string{"msg": "successfully finished"}, nil
+}
+
+func (j *NotificationRetention) retentionDays(t *tenant_model.Tenant) int {
+	if t.Settings != nil && t.Settings.Notifications.RetentionDays > 0 {
+		return t.Settings.Notifications.RetentionDays
+	}
+	return j.DefaultRetentionDays
+}
@@ -0,0 +1,73 @@
+package job
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
+	common_model "github.com/CHORUS-TRE/chorus-backend/pkg/common/model"
+	"go.uber.org/zap"
+)
+
+// AppInstancePurger hard-deletes the app instances deleted before a date.
+type AppInstancePurger interface {
+	PurgeAppInstances(ctx context.Context, deletedBefore time.Time) (common_model.Purged, error)
+}
+
+// WorkbenchPurger hard-deletes the workbenches deleted before a date.
+type WorkbenchPurger interface {
+	PurgeWorkbenchs(ctx context.Context, deletedBefore time.Time) (common_model.Purged, error)
+}
+
+// WorkspacePurger hard-deletes the workspaces deleted before a date.
+type WorkspacePurger interface {
+	PurgeWorkspaces(ctx context.Context, deletedBefore time.Time) (common_model.Purged, error)
+}
+
+// AppPurger hard-deletes the apps deleted before a date.
+type AppPurger interface {
+	PurgeApps(ctx context.Context, deletedBefore time.Time) (common_model.Purged, error)
+}
+
+// ResourcePurge hard-deletes the workspaces, workbenches, apps and app
+// instances deleted for longer than Retention. The app instances are purged
+// first so that the resources they reference can be purged in the same run.
+type ResourcePurge struct {
+	AppInstances AppInstancePurger
+	Workbenchs   WorkbenchPurger
+	Workspaces   WorkspacePurger
+	Apps         AppPurger
+	Retention    time.Duration
+}
+
+func (j *ResourcePurge) Do(ctx context.Context, meta interface{}, arg interface{}) (_ interface{}, _ map[string]string, err error) {
+	log := logger.With(logger.TechLog, zap.String("job_name", "resource-purge"))
+
+	now := time.Now().UTC()
+	log.Debug(ctx, "job started", zap.Time("now", now))
+
+	deletedBefore := now.Add(-j.Retention)
+	purges := []struct {
+		resource string
+		purge    func(ctx context.Context, deletedBefore time.Time) (common_model.Purged, error)
+	}{
+		{"app instances", j.AppInstances.PurgeAppInstances},
+		{"workbenchs", j.Workbenchs.PurgeWorkbenchs},
+		{"workspaces", j.Workspaces.PurgeWorkspaces},
+		{"apps", j.Apps.PurgeApps},
+	}
+
+	for _, p := range purges {
+		purged, err := p.purge(ctx, deletedBefore)
+		if err != nil {
+			log.Error(ctx, "could not purge "+p.resource, zap.Error(err))
+			err = fmt.Errorf("unable to purge %v: %w", p.resource, err)
+			return nil, map[string]string{"msg": "could not purge " + p.resource, "err": err.Error()}, err
+		}
+		log.Debug(ctx, "purged "+p.resource, zap.Int64("purged", purged.Total()))
+	}
+
+	log.Debug(ctx, "successfully finished")
+	return nil, map[string]string{"msg": "successfully finished"}, nil
+}
@@ -0,0 +1,29 @@
+package job
+
+import (
+	"context"
+	"time"
+)
+
+// Job is a task run by the daemon.
+type Job interface {
+	Do(ctx context.Context, meta interface{}, arg interface{}) (interface{}, map[string]string, error)
+}
+
+// Every runs the job every interval until ctx is done. The job logs its own
+// failures, so they only delay it until the next tick.
+func Every(ctx context.Context, interval time.Duration, j Job) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				_, _, _ = j.Do(ctx, nil, nil)
+			}
+		}
+	}()
+}
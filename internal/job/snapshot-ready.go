@@ -0,0 +1,34 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
+	"go.uber.org/zap"
+)
+
+// SnapshotReadyChecker records the workbench snapshots whose volumes were
+// snapshotted or failed to be.
+type SnapshotReadyChecker interface {
+	CheckSnapshotsReady(ctx context.Context) error
+}
+
+type SnapshotReady struct {
+	Workbenchs SnapshotReadyChecker
+}
+
+func (j *SnapshotReady) Do(ctx context.Context, meta interface{}, arg interface{}) (_ interface{}, _ map[string]string, err error) {
+	log := logger.With(logger.TechLog, zap.String("job_name", "snapshot-ready"))
+
+	log.Debug(ctx, "job started", zap.Time("now", time.Now().UTC()))
+
+	err = j.Workbenchs.CheckSnapshotsReady(ctx)
+	if err != nil {
+		log.Error(ctx, "could not check creating snapshots", zap.Error(err))
+		return nil, map[string]string{"msg": "could not check creating snapshots", "err": err.Error()}, err
+	}
+
+	log.Debug(ctx, "successfully finished")
+	return nil, map[string]string{"msg": "successfully finished"}, nil
+}
@@ -0,0 +1,33 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
+	"go.uber.org/zap"
+)
+
+// WebhookDeliverer posts the events that are due to the webhooks.
+type WebhookDeliverer interface {
+	DeliverWebhooks(ctx context.Context) error
+}
+
+type WebhookDelivery struct {
+	Deliverer WebhookDeliverer
+}
+
+func (j *WebhookDelivery) Do(ctx context.Context, meta interface{}, arg interface{}) (_ interface{}, _ map[string]string, err error) {
+	log := logger.With(logger.TechLog, zap.String("job_name", "webhook-delivery"))
+
+	log.Debug(ctx, "job started", zap.Time("now", time.Now().UTC()))
+
+	err = j.Deliverer.DeliverWebhooks(ctx)
+	if err != nil {
+		log.Error(ctx, "could not deliver webhooks", zap.Error(err))
+		return nil, map[string]string{"msg": "could not deliver webhooks", "err": err.Error()}, err
+	}
+
+	log.Debug(ctx, "successfully finished")
+	return nil, map[string]string{"msg": "successfully finished"}, nil
+}
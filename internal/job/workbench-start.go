@@ -0,0 +1,34 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
+	"go.uber.org/zap"
+)
+
+// WorkbenchStartChecker notifies the owners of the workbenches whose server
+// became ready or failed to start.
+type WorkbenchStartChecker interface {
+	CheckWorkbenchsStarted(ctx context.Context) error
+}
+
+type WorkbenchStart struct {
+	Workbenchs WorkbenchStartChecker
+}
+
+func (j *WorkbenchStart) Do(ctx context.Context, meta interface{}, arg interface{}) (_ interface{}, _ map[string]string, err error) {
+	log := logger.With(logger.TechLog, zap.String("job_name", "workbench-start"))
+
+	log.Debug(ctx, "job started", zap.Time("now", time.Now().UTC()))
+
+	err = j.Workbenchs.CheckWorkbenchsStarted(ctx)
+	if err != nil {
+		log.Error(ctx, "could not check starting workbenchs", zap.Error(err))
+		return nil, map[string]string{"msg": "could not check starting workbenchs", "err": err.Error()}, err
+	}
+
+	log.Debug(ctx, "successfully finished")
+	return nil, map[string]string{"msg": "successfully finished"}, nil
+}
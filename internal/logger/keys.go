@@ -14,9 +14,13 @@ const (
 	LoggerKeyUserID        string = "user_id"
 
 	LoggerKeyAppID         string = "app_id"
+	LoggerKeyAppVersionID  string = "app_version_id"
 	LoggerKeyAppInstanceID string = "app_instance_id"
 	LoggerKeyWorkbenchID   string = "workbench_id"
 	LoggerKeyWorkspaceID   string = "workspace_id"
+	LoggerKeyQuotaID       string = "quota_id"
+	LoggerKeyWebhookID     string = "webhook_id"
+	LoggerKeySnapshotID    string = "snapshot_id"
 
 	LoggerKeyMethod     string = "method"
 	LoggerKeyCount      string = "count"
@@ -53,6 +57,10 @@ func WithAppIDField(appID uint64) zap.Field {
 	return zap.Uint64(LoggerKeyAppID, appID)
 }
 
+func WithAppVersionIDField(appVersionID uint64) zap.Field {
+	return zap.Uint64(LoggerKeyAppVersionID, appVersionID)
+}
+
 func WithAppInstanceIDField(appID uint64) zap.Field {
 	return zap.Uint64(LoggerKeyAppInstanceID, appID)
 }
@@ -65,6 +73,18 @@ func WithWorkbenchIDField(appID uint64) zap.Field {
 	return zap.Uint64(LoggerKeyWorkspaceID, appID)
 }
 
+func WithQuotaIDField(quotaID uint64) zap.Field {
+	return zap.Uint64(LoggerKeyQuotaID, quotaID)
+}
+
+func WithWebhookIDField(webhookID uint64) zap.Field {
+	return zap.Uint64(LoggerKeyWebhookID, webhookID)
+}
+
+func WithSnapshotIDField(snapshotID uint64) zap.Field {
+	return zap.Uint64(LoggerKeySnapshotID, snapshotID)
+}
+
 func WithCountField(count int) zap.Field {
 	return zap.Int(LoggerKeyCount, count)
 }
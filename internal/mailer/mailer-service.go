@@ -22,9 +22,19 @@ type Mailer interface {
 	GetTemplate(ctx context.Context, tenantID uint64, tmplKey TemplateKey) *template.Template
 }
 
+// FromResolver returns the sender of the mails of a tenant.
+type FromResolver func(ctx context.Context, tenantID uint64) string
+
+// StaticFrom returns a FromResolver looking up the senders in from.
+func StaticFrom(from map[uint64]string) FromResolver {
+	return func(_ context.Context, tenantID uint64) string {
+		return from[tenantID]
+	}
+}
+
 type MailerService struct {
 	hostPort      string
-	from          map[uint64]string
+	from          FromResolver
 	auth          smtp.Auth
 	emailSubjects map[uint64]map[string]string
 
@@ -33,7 +43,7 @@ type MailerService struct {
 }
 
 // NewMailerService returns a new MailerService.
-func NewMailerService(user, password, authentication string, from map[uint64]string, hostPort string, emailSubjects map[uint64]map[string]string, tlsconfig *tls.Config) (*MailerService, error) {
+func NewMailerService(user, password, authentication string, from FromResolver, hostPort string, emailSubjects map[uint64]map[string]string, tlsconfig *tls.Config) (*MailerService, error) {
 
 	host, port, err := net.SplitHostPort(hostPort)
 	if err != nil {
@@ -107,8 +117,8 @@ func (m *MailerService) Send(ctx context.Context, tenantID uint64, to []string,
 	}
 
 	from := "CHORUS <no-reply@chorus-tre.ch>"
-	if strings.Contains(m.from[tenantID], "@") {
-		from = m.from[tenantID]
+	if f := m.from(ctx, tenantID); strings.Contains(f, "@") {
+		from = f
 	}
 
 	// Send mail
@@ -78,7 +78,7 @@ oyw/fOFJdeGkYEY=
 		KeyLogWriter:                nil,
 	}
 	var emailSubjects = make(map[uint64]map[string]string)
-	m, err := NewMailerService("85f068bf57d1c6", "fab908ed90a71b", "plain", from, "localhost:25", emailSubjects, tlsConfig)
+	m, err := NewMailerService("85f068bf57d1c6", "fab908ed90a71b", "plain", StaticFrom(from), "localhost:25", emailSubjects, tlsConfig)
 	assert.Nil(t, err)
 
 	err = m.SendMessage(context.Background(), 1, []string{"jostoph@localhost"}, "subject", "title", "message")
@@ -4,9 +4,11 @@ package mailer
 type TemplateKey string
 
 const (
-	TemporaryPasswordKey TemplateKey = "temporaryPassword"
-	PasswordRecoveryKey  TemplateKey = "passwordRecovery"
-	TitleTextKey         TemplateKey = "titleText"
+	TemporaryPasswordKey   TemplateKey = "temporaryPassword"
+	PasswordRecoveryKey    TemplateKey = "passwordRecovery"
+	TitleTextKey           TemplateKey = "titleText"
+	NotificationKey        TemplateKey = "notification"
+	NotificationsDigestKey TemplateKey = "notificationsDigest"
 )
 
 func (t TemplateKey) String() string {
@@ -14,9 +16,11 @@ func (t TemplateKey) String() string {
 }
 
 var mailTemplates = map[TemplateKey]string{
-	TitleTextKey:         titleTextTmpl,
-	PasswordRecoveryKey:  passwordRecoveryTmpl,
-	TemporaryPasswordKey: temporaryPasswordTmpl,
+	TitleTextKey:           titleTextTmpl,
+	PasswordRecoveryKey:    passwordRecoveryTmpl,
+	TemporaryPasswordKey:   temporaryPasswordTmpl,
+	NotificationKey:        notificationTmpl,
+	NotificationsDigestKey: notificationsDigestTmpl,
 }
 
 type TitleText struct {
@@ -103,3 +107,60 @@ const temporaryPasswordTmpl = `
 </body>
 </html>
 `
+
+type Notification struct {
+	Message string
+	Link    string
+}
+
+const notificationTmpl = `
+<!doctype html>
+<html>
+<head>
+    <meta http-equiv="Content-Type" content="text/html; charset=utf-8">
+    <meta http-equiv="X-UA-Compatible" content="IE=edge">
+    <link href="https://fonts.googleapis.com/css?family=Lato:400,300" rel="stylesheet" type="text/css">
+    <title>Notification</title>
+    <meta name="viewport" content="width=device-width, initial-scale=1, maximum-scale=1">
+</head>
+
+<body>
+<center>
+    <div style="padding: 55px 0; width: 800px; text-align: left">
+        <p>{{.Message}}</p>
+        {{if .Link}}<p><a href="{{.Link}}">{{.Link}}</a></p>{{end}}
+    </div>
+</center>
+</body>
+</html>
+`
+
+type NotificationsDigest struct {
+	Notifications []Notification
+}
+
+const notificationsDigestTmpl = `
+<!doctype html>
+<html>
+<head>
+    <meta http-equiv="Content-Type" content="text/html; charset=utf-8">
+    <meta http-equiv="X-UA-Compatible" content="IE=edge">
+    <link href="https://fonts.googleapis.com/css?family=Lato:400,300" rel="stylesheet" type="text/css">
+    <title>Your notifications</title>
+    <meta name="viewport" content="width=device-width, initial-scale=1, maximum-scale=1">
+</head>
+
+<body>
+<center>
+    <div style="padding: 55px 0; width: 800px; text-align: left">
+        <h2 style="text-align: left">Your notifications of the day:</h2>
+        <ul>
+        {{range .Notifications}}
+            <li>{{.Message}}{{if .Link}} <a href="{{.Link}}">{{.Link}}</a>{{end}}</li>
+        {{end}}
+        </ul>
+    </div>
+</center>
+</body>
+</html>
+`
@@ -0,0 +1,24 @@
+-- +migrate Up
+
+CREATE SEQUENCE public.workspace_members_seq MINVALUE 1 MAXVALUE 9007199254740991 INCREMENT 1 START 1;
+-- +migrate StatementBegin
+CREATE TABLE public.workspace_members (
+    id BIGINT NOT NULL DEFAULT nextval('public.workspace_members_seq'::REGCLASS),
+
+    tenantid BIGINT NOT NULL,
+    workspaceid BIGINT NOT NULL,
+    userid BIGINT NOT NULL,
+
+    createdat TIMESTAMP NOT NULL,
+
+    CONSTRAINT workspace_members_pkey PRIMARY KEY (id),
+    CONSTRAINT tenantcon FOREIGN KEY (tenantid) REFERENCES tenants(id),
+    CONSTRAINT workspacecon FOREIGN KEY (workspaceid) REFERENCES workspaces(id),
+    CONSTRAINT usercon FOREIGN KEY (userid) REFERENCES users(id),
+    CONSTRAINT workspace_members_workspace_user_unique UNIQUE (workspaceid, userid)
+);
+-- +migrate StatementEnd
+
+-- +migrate StatementBegin
+CREATE INDEX workspace_members_userid_idx ON public.workspace_members (tenantid, userid);
+-- +migrate StatementEnd
@@ -0,0 +1,85 @@
+-- +migrate Up
+
+-- +migrate StatementBegin
+ALTER TABLE public.roles
+    ADD COLUMN tenantid BIGINT NULL,
+    ADD COLUMN description TEXT NOT NULL DEFAULT '',
+    ADD COLUMN createdat TIMESTAMP NOT NULL DEFAULT NOW(),
+    ADD COLUMN updatedat TIMESTAMP NOT NULL DEFAULT NOW(),
+    ADD CONSTRAINT tenantcon FOREIGN KEY (tenantid) REFERENCES tenants(id),
+    DROP CONSTRAINT roles_name_key;
+-- +migrate StatementEnd
+
+-- Built-in roles have no tenant and are shared by all the tenants.
+-- +migrate StatementBegin
+CREATE UNIQUE INDEX roles_builtin_name_unique ON public.roles (name) WHERE tenantid IS NULL;
+-- +migrate StatementEnd
+
+-- +migrate StatementBegin
+CREATE UNIQUE INDEX roles_tenant_name_unique ON public.roles (tenantid, name) WHERE tenantid IS NOT NULL;
+-- +migrate StatementEnd
+
+CREATE SEQUENCE public.role_permissions_seq MINVALUE 1 MAXVALUE 9007199254740991 INCREMENT 1 START 1;
+-- +migrate StatementBegin
+CREATE TABLE public.role_permissions (
+    id BIGINT NOT NULL DEFAULT nextval('public.role_permissions_seq'::REGCLASS),
+
+    roleid BIGINT NOT NULL,
+    permission TEXT NOT NULL,
+
+    CONSTRAINT role_permissions_pkey PRIMARY KEY (id),
+    CONSTRAINT rolecon FOREIGN KEY (roleid) REFERENCES roles(id) ON DELETE CASCADE,
+    CONSTRAINT role_permissions_role_permission_unique UNIQUE (roleid, permission)
+);
+-- +migrate StatementEnd
+
+-- +migrate StatementBegin
+INSERT INTO public.roles (name, description)
+SELECT r.name, r.description
+FROM (VALUES
+    ('authenticated', 'Any authenticated user'),
+    ('admin', 'Administrator of the tenant'),
+    ('chorus', 'Technical user of the chorus services'),
+    ('fileuploader', 'Upload files'),
+    ('filedownloader', 'Download files')
+) AS r (name, description)
+WHERE NOT EXISTS (SELECT 1 FROM public.roles WHERE roles.name = r.name);
+-- +migrate StatementEnd
+
+-- +migrate StatementBegin
+UPDATE public.roles SET description = r.description
+FROM (VALUES
+    ('authenticated', 'Any authenticated user'),
+    ('admin', 'Administrator of the tenant'),
+    ('chorus', 'Technical user of the chorus services'),
+    ('fileuploader', 'Upload files'),
+    ('filedownloader', 'Download files')
+) AS r (name, description)
+WHERE roles.name = r.name AND roles.tenantid IS NULL;
+-- +migrate StatementEnd
+
+-- +migrate StatementBegin
+INSERT INTO public.role_permissions (roleid, permission)
+SELECT roles.id, p.permission
+FROM (VALUES
+    ('authenticated', 'users:self'),
+    ('authenticated', 'workspaces:read'),
+    ('authenticated', 'workspaces:write'),
+    ('authenticated', 'workbenches:read'),
+    ('authenticated', 'workbenches:write'),
+    ('authenticated', 'apps:read'),
+    ('authenticated', 'apps:write'),
+    ('authenticated', 'app-instances:read'),
+    ('authenticated', 'app-instances:write'),
+    ('authenticated', 'notifications:read'),
+    ('authenticated', 'notifications:write'),
+    ('admin', 'users:self'),
+    ('admin', 'users:read'),
+    ('admin', 'users:write'),
+    ('admin', 'roles:read'),
+    ('admin', 'roles:write'),
+    ('chorus', 'tenants:initialize')
+) AS p (role, permission)
+JOIN public.roles ON roles.name = p.role AND roles.tenantid IS NULL
+ON CONFLICT DO NOTHING;
+-- +migrate StatementEnd
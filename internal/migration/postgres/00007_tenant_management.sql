@@ -0,0 +1,20 @@
+-- +migrate Up
+
+-- The settings of a tenant are NULL until they are first updated, the tenant
+-- configuration file being used until then.
+-- +migrate StatementBegin
+ALTER TABLE public.tenants
+    ADD COLUMN status TEXT NOT NULL DEFAULT 'active',
+    ADD COLUMN settings JSONB NULL;
+-- +migrate StatementEnd
+
+-- +migrate StatementBegin
+INSERT INTO public.role_permissions (roleid, permission)
+SELECT roles.id, p.permission
+FROM (VALUES
+    ('chorus', 'tenants:read'),
+    ('chorus', 'tenants:write')
+) AS p (role, permission)
+JOIN public.roles ON roles.name = p.role AND roles.tenantid IS NULL
+ON CONFLICT DO NOTHING;
+-- +migrate StatementEnd
@@ -0,0 +1,46 @@
+-- +migrate Up
+
+-- The resources requested by each instance of an app, in millicores and MiB.
+-- +migrate StatementBegin
+ALTER TABLE public.apps
+    ADD COLUMN cpurequest BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN memoryrequest BIGINT NOT NULL DEFAULT 0;
+-- +migrate StatementEnd
+
+-- A quota applies to a tenant, to a workspace or to a user. A scopeid of 0 is
+-- the default quota of all the workspaces or of all the users of the tenant.
+-- A NULL limit is unlimited.
+CREATE SEQUENCE public.quotas_seq MINVALUE 1 MAXVALUE 9007199254740991 INCREMENT 1 START 1;
+-- +migrate StatementBegin
+CREATE TABLE public.quotas (
+    id BIGINT NOT NULL DEFAULT nextval('public.quotas_seq'::REGCLASS),
+
+    tenantid BIGINT NOT NULL,
+    scope TEXT NOT NULL,
+    scopeid BIGINT NOT NULL,
+
+    maxworkbenches BIGINT NULL,
+    maxappinstances BIGINT NULL,
+    maxcpu BIGINT NULL,
+    maxmemory BIGINT NULL,
+
+    createdat TIMESTAMP NOT NULL,
+    updatedat TIMESTAMP NOT NULL,
+
+    CONSTRAINT quotas_pkey PRIMARY KEY (id),
+    CONSTRAINT tenantcon FOREIGN KEY (tenantid) REFERENCES tenants(id),
+    CONSTRAINT quotas_scope_unique UNIQUE (tenantid, scope, scopeid)
+);
+-- +migrate StatementEnd
+
+-- +migrate StatementBegin
+INSERT INTO public.role_permissions (roleid, permission)
+SELECT roles.id, p.permission
+FROM (VALUES
+    ('authenticated', 'quotas:read'),
+    ('admin', 'quotas:read'),
+    ('admin', 'quotas:write')
+) AS p (role, permission)
+JOIN public.roles ON roles.name = p.role AND roles.tenantid IS NULL
+ON CONFLICT DO NOTHING;
+-- +migrate StatementEnd
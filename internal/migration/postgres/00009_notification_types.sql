@@ -0,0 +1,11 @@
+-- +migrate Up
+
+-- The type and the params of a notification allow the clients to render its
+-- message in their own language, the message being rendered in the default
+-- locale when the notification is created.
+-- +migrate StatementBegin
+ALTER TABLE public.notifications
+    ADD COLUMN type TEXT NOT NULL DEFAULT '',
+    ADD COLUMN link TEXT NOT NULL DEFAULT '',
+    ADD COLUMN params JSONB NOT NULL DEFAULT '{}'::JSONB;
+-- +migrate StatementEnd
@@ -0,0 +1,42 @@
+-- +migrate Up
+
+-- The notification types a user also wants to receive by email. A missing
+-- preference means the notification only lands in the in-app inbox.
+-- +migrate StatementBegin
+CREATE TABLE public.notification_preferences (
+    tenantid BIGINT NOT NULL,
+    userid BIGINT NOT NULL,
+    notificationtype TEXT NOT NULL,
+    emailfrequency TEXT NOT NULL,
+    createdat TIMESTAMP NOT NULL DEFAULT NOW(),
+    updatedat TIMESTAMP NOT NULL DEFAULT NOW(),
+    CONSTRAINT notification_preferences_userid_fkey FOREIGN KEY (userid) REFERENCES public.users(id),
+    CONSTRAINT notification_preferences_pkey PRIMARY KEY (tenantid, userid, notificationtype)
+);
+-- +migrate StatementEnd
+
+-- The emails to send for the notifications, queued along with the
+-- notifications. A delivery is claimed by pushing its nextattemptat, so that
+-- a replica failing while sending it lets another one retry it.
+CREATE SEQUENCE public.notification_deliveries_seq MINVALUE 1 MAXVALUE 9007199254740991 INCREMENT 1 START 1;
+-- +migrate StatementBegin
+CREATE TABLE public.notification_deliveries (
+    id BIGINT NOT NULL DEFAULT nextval('public.notification_deliveries_seq'::REGCLASS),
+    tenantid BIGINT NOT NULL,
+    userid BIGINT NOT NULL,
+    notificationid TEXT NOT NULL,
+    frequency TEXT NOT NULL,
+    status TEXT NOT NULL,
+    attempts INT NOT NULL DEFAULT 0,
+    lasterror TEXT NULL,
+    nextattemptat TIMESTAMP NOT NULL,
+    sentat TIMESTAMP NULL,
+    createdat TIMESTAMP NOT NULL DEFAULT NOW(),
+    updatedat TIMESTAMP NOT NULL DEFAULT NOW(),
+    CONSTRAINT notification_deliveries_pkey PRIMARY KEY (id),
+    CONSTRAINT notification_deliveries_notificationid_fkey FOREIGN KEY (notificationid) REFERENCES public.notifications(id),
+    CONSTRAINT notification_deliveries_userid_fkey FOREIGN KEY (userid) REFERENCES public.users(id)
+);
+-- +migrate StatementEnd
+
+CREATE INDEX notification_deliveries_pending_idx ON public.notification_deliveries (nextattemptat) WHERE status = 'pending';
@@ -0,0 +1,59 @@
+-- +migrate Up
+
+-- The endpoints to which the events of a tenant are posted. The secret signing
+-- the deliveries is encrypted with the daemon key, and eventtypes is the JSON
+-- array of the event types the webhook is subscribed to.
+CREATE SEQUENCE public.webhooks_seq MINVALUE 1 MAXVALUE 9007199254740991 INCREMENT 1 START 1;
+-- +migrate StatementBegin
+CREATE TABLE public.webhooks (
+    id BIGINT NOT NULL DEFAULT nextval('public.webhooks_seq'::REGCLASS),
+    tenantid BIGINT NOT NULL,
+    url TEXT NOT NULL,
+    eventtypes JSONB NOT NULL,
+    secret TEXT NOT NULL,
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    createdat TIMESTAMP NOT NULL DEFAULT NOW(),
+    updatedat TIMESTAMP NOT NULL DEFAULT NOW(),
+    CONSTRAINT webhooks_pkey PRIMARY KEY (id),
+    CONSTRAINT tenantcon FOREIGN KEY (tenantid) REFERENCES tenants(id)
+);
+-- +migrate StatementEnd
+
+-- The delivery log of the webhooks: an event posted to a webhook, queued when
+-- the event is published. As for the notification deliveries, a delivery is
+-- claimed by pushing its nextattemptat.
+CREATE SEQUENCE public.webhook_deliveries_seq MINVALUE 1 MAXVALUE 9007199254740991 INCREMENT 1 START 1;
+-- +migrate StatementBegin
+CREATE TABLE public.webhook_deliveries (
+    id BIGINT NOT NULL DEFAULT nextval('public.webhook_deliveries_seq'::REGCLASS),
+    tenantid BIGINT NOT NULL,
+    webhookid BIGINT NOT NULL,
+    eventid TEXT NOT NULL,
+    eventtype TEXT NOT NULL,
+    payload JSONB NOT NULL,
+    status TEXT NOT NULL,
+    attempts INT NOT NULL DEFAULT 0,
+    responsecode INT NULL,
+    lasterror TEXT NULL,
+    nextattemptat TIMESTAMP NOT NULL,
+    deliveredat TIMESTAMP NULL,
+    createdat TIMESTAMP NOT NULL DEFAULT NOW(),
+    updatedat TIMESTAMP NOT NULL DEFAULT NOW(),
+    CONSTRAINT webhook_deliveries_pkey PRIMARY KEY (id),
+    CONSTRAINT webhook_deliveries_webhookid_fkey FOREIGN KEY (webhookid) REFERENCES public.webhooks(id) ON DELETE CASCADE
+);
+-- +migrate StatementEnd
+
+CREATE INDEX webhook_deliveries_pending_idx ON public.webhook_deliveries (nextattemptat) WHERE status = 'pending';
+CREATE INDEX webhook_deliveries_webhookid_idx ON public.webhook_deliveries (webhookid, createdat DESC);
+
+-- +migrate StatementBegin
+INSERT INTO public.role_permissions (roleid, permission)
+SELECT roles.id, p.permission
+FROM (VALUES
+    ('admin', 'webhooks:read'),
+    ('admin', 'webhooks:write')
+) AS p (role, permission)
+JOIN public.roles ON roles.name = p.role AND roles.tenantid IS NULL
+ON CONFLICT DO NOTHING;
+-- +migrate StatementEnd
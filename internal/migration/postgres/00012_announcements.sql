@@ -0,0 +1,21 @@
+-- +migrate Up
+
+-- An announcement is a notification broadcast by an admin. It is only
+-- displayed between startsat and endsat when they are set, and a pinned
+-- announcement stays on top of the notifications until it ends.
+-- +migrate StatementBegin
+ALTER TABLE public.notifications
+    ADD COLUMN pinned BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN startsat TIMESTAMP NULL,
+    ADD COLUMN endsat TIMESTAMP NULL;
+-- +migrate StatementEnd
+
+-- +migrate StatementBegin
+INSERT INTO public.role_permissions (roleid, permission)
+SELECT roles.id, p.permission
+FROM (VALUES
+    ('admin', 'notifications:announce')
+) AS p (role, permission)
+JOIN public.roles ON roles.name = p.role AND roles.tenantid IS NULL
+ON CONFLICT DO NOTHING;
+-- +migrate StatementEnd
@@ -0,0 +1,8 @@
+-- +migrate Up
+
+-- The read entries are archived with the username of the reader, so that the
+-- archive outlives the notifications once they have no recipient left.
+ALTER TABLE public.notifications_read_by_archive DROP CONSTRAINT notifications_read_by_archive_notificationid_fkey;
+
+CREATE INDEX notifications_read_by_readat_idx ON public.notifications_read_by (tenantid, readat) WHERE readat IS NOT NULL;
+CREATE INDEX notification_deliveries_notificationid_idx ON public.notification_deliveries (notificationid);
@@ -0,0 +1,21 @@
+-- +migrate Up
+
+-- The deleted workspaces, workbenches, apps and app instances are marked with
+-- their deletion date, from which their grace period and their retention are
+-- counted.
+UPDATE public.workspaces SET deletedat = updatedat WHERE status = 'deleted' AND deletedat IS NULL;
+UPDATE public.workbenchs SET deletedat = updatedat WHERE status = 'deleted' AND deletedat IS NULL;
+UPDATE public.apps SET deletedat = updatedat WHERE status = 'deleted' AND deletedat IS NULL;
+UPDATE public.app_instances SET deletedat = updatedat WHERE status = 'deleted' AND deletedat IS NULL;
+
+CREATE INDEX workspaces_deletedat_idx ON public.workspaces (deletedat) WHERE status = 'deleted';
+CREATE INDEX workbenchs_deletedat_idx ON public.workbenchs (deletedat) WHERE status = 'deleted';
+CREATE INDEX apps_deletedat_idx ON public.apps (deletedat) WHERE status = 'deleted';
+CREATE INDEX app_instances_deletedat_idx ON public.app_instances (deletedat) WHERE status = 'deleted';
+
+-- The deletions and the restorations cascade from the workspaces to their
+-- workbenches and from the workbenches to their app instances.
+CREATE INDEX workbenchs_workspaceid_idx ON public.workbenchs (workspaceid);
+CREATE INDEX app_instances_workspaceid_idx ON public.app_instances (workspaceid);
+CREATE INDEX app_instances_workbenchid_idx ON public.app_instances (workbenchid);
+CREATE INDEX app_instances_appid_idx ON public.app_instances (appid);
@@ -0,0 +1,36 @@
+-- +migrate Up
+
+-- A snapshot of a workbench records its apps and the snapshots of its
+-- volumes, from which a new workbench is restored. The snapshots outlive
+-- their workbench, so the workbench is not a foreign key.
+CREATE SEQUENCE public.workbench_snapshots_seq MINVALUE 1 MAXVALUE 9007199254740991 INCREMENT 1 START 1;
+-- +migrate StatementBegin
+CREATE TABLE public.workbench_snapshots (
+    id BIGINT NOT NULL DEFAULT nextval('public.workbench_snapshots_seq'::REGCLASS),
+
+    tenantid BIGINT NOT NULL,
+    userid BIGINT NOT NULL,
+    workspaceid BIGINT NOT NULL,
+    workbenchid BIGINT NOT NULL,
+
+    name TEXT NOT NULL,
+    description TEXT NOT NULL,
+    status TEXT NOT NULL,
+    content JSONB NOT NULL,
+
+    createdat TIMESTAMP NOT NULL,
+    updatedat TIMESTAMP NOT NULL,
+
+    CONSTRAINT workbench_snapshots_pkey PRIMARY KEY (id),
+    CONSTRAINT tenantcon FOREIGN KEY (tenantid) REFERENCES tenants(id),
+    CONSTRAINT usercon FOREIGN KEY (userid) REFERENCES users(id),
+    CONSTRAINT workspacecon FOREIGN KEY (workspaceid) REFERENCES workspaces(id) ON DELETE CASCADE
+);
+-- +migrate StatementEnd
+
+CREATE INDEX workbench_snapshots_workspaceid_idx ON public.workbench_snapshots (workspaceid);
+
+-- +migrate StatementBegin
+ALTER TABLE public.quotas
+    ADD COLUMN maxsnapshots BIGINT NULL;
+-- +migrate StatementEnd
@@ -0,0 +1,19 @@
+-- +migrate Up
+
+-- The apps are deployed by the digest their tag resolved to when they were
+-- registered. The apps registered before are left without one and have to be
+-- resolved again before they are deployed.
+-- +migrate StatementBegin
+ALTER TABLE public.apps
+    ADD COLUMN dockerimagedigest TEXT NOT NULL DEFAULT '';
+-- +migrate StatementEnd
+
+-- +migrate StatementBegin
+INSERT INTO public.role_permissions (roleid, permission)
+SELECT roles.id, p.permission
+FROM (VALUES
+    ('admin', 'apps:resolve')
+) AS p (role, permission)
+JOIN public.roles ON roles.name = p.role AND roles.tenantid IS NULL
+ON CONFLICT DO NOTHING;
+-- +migrate StatementEnd
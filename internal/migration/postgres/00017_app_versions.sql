@@ -0,0 +1,122 @@
+-- +migrate Up
+
+-- A version of an app pins its image to a digest. The image of an app is the
+-- one of its default version, and the app instances record the version they
+-- were launched or upgraded with.
+CREATE SEQUENCE public.app_versions_seq MINVALUE 1 MAXVALUE 9007199254740991 INCREMENT 1 START 1;
+-- +migrate StatementBegin
+CREATE TABLE public.app_versions (
+    id BIGINT NOT NULL DEFAULT nextval('public.app_versions_seq'::REGCLASS),
+
+    tenantid BIGINT NOT NULL,
+    userid BIGINT NOT NULL,
+    appid BIGINT NOT NULL,
+
+    version TEXT NOT NULL,
+    changelog TEXT NOT NULL,
+    deprecated BOOLEAN NOT NULL DEFAULT FALSE,
+
+    dockerimagename TEXT NOT NULL,
+    dockerimagetag TEXT NOT NULL,
+    dockerimagedigest TEXT NOT NULL,
+
+    createdat TIMESTAMP NOT NULL,
+    updatedat TIMESTAMP NOT NULL,
+
+    CONSTRAINT app_versions_pkey PRIMARY KEY (id),
+    CONSTRAINT app_versions_version_key UNIQUE (appid, version),
+    CONSTRAINT tenantcon FOREIGN KEY (tenantid) REFERENCES tenants(id),
+    CONSTRAINT usercon FOREIGN KEY (userid) REFERENCES users(id),
+    CONSTRAINT appcon FOREIGN KEY (appid) REFERENCES apps(id) ON DELETE CASCADE
+);
+-- +migrate StatementEnd
+
+-- The existing apps get a first version named after their tag, which the
+-- existing app instances use.
+-- +migrate StatementBegin
+INSERT INTO public.app_versions (tenantid, userid, appid, version, changelog, dockerimagename, dockerimagetag, dockerimagedigest, createdat, updatedat)
+SELECT tenantid, userid, id, COALESCE(NULLIF(dockerimagetag, ''), 'latest'), '', dockerimagename, dockerimagetag, dockerimagedigest, NOW(), NOW()
+FROM public.apps;
+-- +migrate StatementEnd
+
+-- +migrate StatementBegin
+ALTER TABLE public.apps
+    ADD COLUMN defaultversionid BIGINT NULL,
+    ADD CONSTRAINT defaultversioncon FOREIGN KEY (defaultversionid) REFERENCES app_versions(id);
+-- +migrate StatementEnd
+
+-- +migrate StatementBegin
+UPDATE public.apps a SET defaultversionid = v.id
+FROM public.app_versions v WHERE v.appid = a.id;
+-- +migrate StatementEnd
+
+-- +migrate StatementBegin
+ALTER TABLE public.app_instances
+    ADD COLUMN appversionid BIGINT NULL,
+    ADD CONSTRAINT appversioncon FOREIGN KEY (appversionid) REFERENCES app_versions(id);
+-- +migrate StatementEnd
+
+-- +migrate StatementBegin
+UPDATE public.app_instances ai SET appversionid = a.defaultversionid
+FROM public.apps a WHERE a.id = ai.appid;
+-- +migrate StatementEnd
+
+-- +migrate StatementBegin
+ALTER TABLE public.app_instances
+    ALTER COLUMN appversionid SET NOT NULL;
+-- +migrate StatementEnd
+
+-- Resolving an app again used to register the new digest as a copy of the
+-- app. The copies are folded into versions of the app they were copied from,
+-- the latest one becoming its default version, and their instances and
+-- snapshotted apps are moved over to these versions.
+-- +migrate StatementBegin
+CREATE TABLE public.app_folds AS
+SELECT id, appid, defaultversionid AS versionid, rn
+FROM (
+    SELECT id, defaultversionid, dockerimagedigest,
+        FIRST_VALUE(id) OVER w AS appid,
+        ROW_NUMBER() OVER w AS rn
+    FROM public.apps
+    WHERE status <> 'deleted'
+    WINDOW w AS (PARTITION BY tenantid, name, dockerimagename, dockerimagetag ORDER BY id)
+) a
+WHERE id <> appid AND dockerimagedigest <> '';
+-- +migrate StatementEnd
+
+-- +migrate StatementBegin
+UPDATE public.app_versions v SET appid = f.appid, version = v.version || '-' || f.rn, updatedat = NOW()
+FROM public.app_folds f WHERE v.id = f.versionid;
+-- +migrate StatementEnd
+
+-- +migrate StatementBegin
+UPDATE public.app_instances ai SET appid = f.appid
+FROM public.app_folds f WHERE ai.appid = f.id;
+-- +migrate StatementEnd
+
+-- +migrate StatementBegin
+UPDATE public.apps a
+SET defaultversionid = v.id, dockerimagedigest = v.dockerimagedigest, updatedat = NOW()
+FROM (SELECT DISTINCT ON (appid) appid, versionid FROM public.app_folds ORDER BY appid, id DESC) f
+JOIN public.app_versions v ON v.id = f.versionid
+WHERE a.id = f.appid;
+-- +migrate StatementEnd
+
+-- +migrate StatementBegin
+UPDATE public.workbench_snapshots s SET content = jsonb_set(s.content, '{apps}', (
+    SELECT jsonb_agg(CASE WHEN f.id IS NULL THEN e.app
+        ELSE e.app || jsonb_build_object('appId', f.appid, 'appVersionId', f.versionid) END ORDER BY e.n)
+    FROM jsonb_array_elements(s.content->'apps') WITH ORDINALITY AS e (app, n)
+    LEFT JOIN public.app_folds f ON f.id = (e.app->>'appId')::BIGINT
+))
+WHERE EXISTS (
+    SELECT 1 FROM jsonb_array_elements(s.content->'apps') AS e (app)
+    JOIN public.app_folds f ON f.id = (e.app->>'appId')::BIGINT
+);
+-- +migrate StatementEnd
+
+-- +migrate StatementBegin
+DELETE FROM public.apps a USING public.app_folds f WHERE a.id = f.id;
+-- +migrate StatementEnd
+
+DROP TABLE public.app_folds;
@@ -0,0 +1,8 @@
+-- +migrate Up
+
+-- The tokens issued to the users of a tenant before tokensrevokedat are
+-- rejected, which is set when the tenant is suspended.
+-- +migrate StatementBegin
+ALTER TABLE public.tenants
+    ADD COLUMN tokensrevokedat TIMESTAMP NULL;
+-- +migrate StatementEnd
@@ -0,0 +1,8 @@
+-- +migrate Up
+
+-- startingsince is set while the server of a workbench is starting, until
+-- its owner is notified that it is ready or failed to start.
+-- +migrate StatementBegin
+ALTER TABLE public.workbenchs
+    ADD COLUMN startingsince TIMESTAMP NULL;
+-- +migrate StatementEnd
@@ -0,0 +1,13 @@
+-- +migrate Up
+
+-- deletedstatus keeps the status a workspace or an app had when it was
+-- deleted, so that restoring it brings that status back.
+-- +migrate StatementBegin
+ALTER TABLE public.workspaces
+    ADD COLUMN deletedstatus TEXT NULL;
+-- +migrate StatementEnd
+
+-- +migrate StatementBegin
+ALTER TABLE public.apps
+    ADD COLUMN deletedstatus TEXT NULL;
+-- +migrate StatementEnd
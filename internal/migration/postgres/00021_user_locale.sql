@@ -0,0 +1,8 @@
+-- +migrate Up
+
+-- locale is the language the notifications of the user are emailed in, that
+-- of the last client the user signed in with.
+-- +migrate StatementBegin
+ALTER TABLE public.users
+    ADD COLUMN locale TEXT NOT NULL DEFAULT 'en';
+-- +migrate StatementEnd
@@ -0,0 +1,10 @@
+-- +migrate Up
+
+-- restarts is the number of times the containers of an app instance had
+-- restarted when it was last checked, and crashing whether its owner was
+-- notified that it crashed since.
+-- +migrate StatementBegin
+ALTER TABLE public.app_instances
+    ADD COLUMN restarts INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN crashing BOOLEAN NOT NULL DEFAULT FALSE;
+-- +migrate StatementEnd
@@ -24,6 +24,8 @@ type NotificationBuilder struct {
 	notifType     string
 	prefixMessage string
 	message       string
+	link          string
+	params        model.NotificationParams
 }
 
 func NewNotificationBuilder(stepID string, tenantID uint64) *NotificationBuilder {
@@ -48,6 +50,28 @@ func (nb *NotificationBuilder) WithNotifType(notifType string) *NotificationBuil
 	return nb
 }
 
+// WithType sets the type of the notification, whose message is rendered from
+// the template of the type unless a message is given. The message is rendered
+// in the default locale, and again in the locale of each recipient when the
+// notification is read or emailed.
+func (nb *NotificationBuilder) WithType(notifType model.NotificationType) *NotificationBuilder {
+	nb.notifType = notifType.String()
+	return nb
+}
+
+func (nb *NotificationBuilder) WithLink(link string) *NotificationBuilder {
+	nb.link = link
+	return nb
+}
+
+func (nb *NotificationBuilder) WithParam(key, value string) *NotificationBuilder {
+	if nb.params == nil {
+		nb.params = model.NotificationParams{}
+	}
+	nb.params[key] = value
+	return nb
+}
+
 func (nb *NotificationBuilder) WithTechnicalError(msg string) *NotificationBuilder {
 	if msg != "" {
 		nb.message = fmt.Sprintf("A technical error occurred (%s). Please contact your administrator.", msg)
@@ -60,13 +84,24 @@ func (nb *NotificationBuilder) WithTechnicalError(msg string) *NotificationBuild
 
 func (nb *NotificationBuilder) Build() *model.Notification {
 	message := nb.message
+	notifType := model.NotificationType(nb.notifType)
+	if message == "" && model.HasTemplate(notifType) {
+		rendered, err := model.RenderMessage(model.DefaultLocale, notifType, nb.params)
+		if err != nil {
+			rendered = notifType.String()
+		}
+		message = rendered
+	}
 	if nb.prefixMessage != "" {
-		message = fmt.Sprintf("%s : %s", nb.prefixMessage, nb.message)
+		message = fmt.Sprintf("%s : %s", nb.prefixMessage, message)
 	}
 	return &model.Notification{
 		ID:       fmt.Sprintf("%s-%s", nb.stepID, nb.notifType),
 		TenantID: nb.tenantID,
+		Type:     notifType,
 		Message:  message,
+		Link:     nb.link,
+		Params:   nb.params,
 	}
 }
 
@@ -0,0 +1,23 @@
+package notification
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/CHORUS-TRE/chorus-backend/pkg/notification/model"
+)
+
+func TestNotificationBuilder(t *testing.T) {
+	n := NewNotificationBuilder("step", 1).
+		WithType(model.NotificationTypeWorkbenchReady).
+		WithLink(WorkbenchLink(2, 3)).
+		WithParam("workbench", "analysis").
+		Build()
+
+	require.Equal(t, "step-workbench_ready", n.ID)
+	require.Equal(t, uint64(1), n.TenantID)
+	require.Equal(t, model.NotificationTypeWorkbenchReady, n.Type)
+	require.Equal(t, `Your workbench "analysis" is ready.`, n.Message)
+	require.Equal(t, "/workspaces/2/sessions/3", n.Link)
+}
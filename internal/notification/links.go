@@ -0,0 +1,16 @@
+package notification
+
+import "fmt"
+
+// The links point to the pages of the web application showing the resource
+// the notification is about.
+
+const AccountLink = "/account"
+
+func WorkspaceLink(workspaceID uint64) string {
+	return fmt.Sprintf("/workspaces/%v", workspaceID)
+}
+
+func WorkbenchLink(workspaceID, workbenchID uint64) string {
+	return fmt.Sprintf("/workspaces/%v/sessions/%v", workspaceID, workbenchID)
+}
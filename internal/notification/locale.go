@@ -0,0 +1,29 @@
+package notification
+
+import (
+	"context"
+
+	"google.golang.org/grpc/metadata"
+
+	"github.com/CHORUS-TRE/chorus-backend/pkg/notification/model"
+)
+
+// acceptLanguageKeys are the metadata keys of the Accept-Language header, as
+// forwarded by the gateway and as sent by the gRPC clients.
+var acceptLanguageKeys = []string{"grpcgateway-accept-language", "accept-language"}
+
+// LocaleFromContext returns the locale preferred by the client of the
+// request, and whether the client sent one.
+func LocaleFromContext(ctx context.Context) (model.Locale, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return model.DefaultLocale, false
+	}
+
+	for _, key := range acceptLanguageKeys {
+		if values := md.Get(key); len(values) != 0 && values[0] != "" {
+			return model.ParseLocale(values[0]), true
+		}
+	}
+	return model.DefaultLocale, false
+}
@@ -0,0 +1,32 @@
+package notification
+
+import (
+	"context"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/notification/model"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/notification/service"
+
+	"go.uber.org/zap"
+)
+
+// Notifier creates the notifications of the users.
+type Notifier interface {
+	CreateNotification(ctx context.Context, req service.CreateNotificationRequest) error
+}
+
+// Notify creates the notification for the given users. A failure is only
+// logged, as it must not fail the operation the notification is about.
+func Notify(ctx context.Context, notifier Notifier, notification *model.Notification, userIDs ...uint64) {
+	err := notifier.CreateNotification(ctx, service.CreateNotificationRequest{
+		Notification: notification,
+		UserIDs:      userIDs,
+	})
+	if err != nil {
+		logger.TechLog.Error(ctx, "unable to create notification",
+			zap.String("notification_id", notification.ID),
+			zap.String("type", notification.Type.String()),
+			zap.Error(err),
+		)
+	}
+}
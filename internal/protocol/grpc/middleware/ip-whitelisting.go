@@ -9,6 +9,7 @@ import (
 
 	"github.com/CHORUS-TRE/chorus-backend/internal/config"
 	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
+	tenant_model "github.com/CHORUS-TRE/chorus-backend/pkg/tenant/model"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -67,10 +68,19 @@ func NewIPWhitelistStreamServerInterceptor(cw ClientWhitelister) grpc.StreamServ
 // a gRPC interceptor so that authentication is performed before.
 type IPWhitelister struct {
 	headerKey string
-	tables    map[uint64][]*net.IPNet
+
+	// subnets returns the whitelisted subnetworks of a tenant and false when
+	// IP whitelisting is not enabled for the tenant.
+	subnets func(ctx context.Context, tenantID uint64) ([]*net.IPNet, bool, error)
+}
+
+// Tenanter returns the tenants along with their settings.
+type Tenanter interface {
+	GetTenant(ctx context.Context, tenantID uint64) (*tenant_model.Tenant, error)
 }
 
-// NewIPWhitelister creates a new IP whitelister.
+// NewIPWhitelister creates a new IP whitelister using the whitelists of the
+// tenant configuration.
 func NewIPWhitelister(cfg config.Config) (*IPWhitelister, error) {
 
 	tables := make(map[uint64][]*net.IPNet)
@@ -80,31 +90,69 @@ func NewIPWhitelister(cfg config.Config) (*IPWhitelister, error) {
 			continue
 		}
 
-		subnets := make([]*net.IPNet, len(tenant.IPWhitelist.Subnetworks))
+		subnets, err := parseSubnetworks(tenant.IPWhitelist.Subnetworks)
+		if err != nil {
+			return nil, err
+		}
+
+		tables[tenantID] = subnets
+	}
+
+	w := &IPWhitelister{
+		headerKey: headerKey(cfg),
+		subnets: func(_ context.Context, tenantID uint64) ([]*net.IPNet, bool, error) {
+			subnets, found := tables[tenantID]
+			return subnets, found, nil
+		},
+	}
 
-		for i, str := range tenant.IPWhitelist.Subnetworks {
-			_, subnet, err := net.ParseCIDR(str)
+	return w, nil
+}
+
+// NewTenantIPWhitelister creates a new IP whitelister using the whitelists of
+// the tenant settings, which are looked up on each request.
+func NewTenantIPWhitelister(cfg config.Config, tenanter Tenanter) *IPWhitelister {
+	return &IPWhitelister{
+		headerKey: headerKey(cfg),
+		subnets: func(ctx context.Context, tenantID uint64) ([]*net.IPNet, bool, error) {
+			tenant, err := tenanter.GetTenant(ctx, tenantID)
 			if err != nil {
-				return nil, fmt.Errorf("unable to parse cidr '%s': %v", str, err)
+				return nil, false, fmt.Errorf("unable to get tenant: %w", err)
+			}
+			if tenant.Settings == nil || !tenant.Settings.IPWhitelist.Enabled {
+				return nil, false, nil
 			}
 
-			subnets[i] = subnet
-		}
-
-		tables[tenantID] = subnets
+			subnets, err := parseSubnetworks(tenant.Settings.IPWhitelist.Subnetworks)
+			if err != nil {
+				return nil, false, err
+			}
+			return subnets, true, nil
+		},
 	}
+}
 
+func headerKey(cfg config.Config) string {
 	headerKey := strings.ToLower(cfg.Daemon.HTTP.HeaderClientIP)
 	if headerKey == "" {
 		headerKey = headerXForwardedFor
 	}
+	return headerKey
+}
 
-	w := &IPWhitelister{
-		tables:    tables,
-		headerKey: headerKey,
+func parseSubnetworks(subnetworks []string) ([]*net.IPNet, error) {
+	subnets := make([]*net.IPNet, len(subnetworks))
+
+	for i, str := range subnetworks {
+		_, subnet, err := net.ParseCIDR(str)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse cidr '%s': %v", str, err)
+		}
+
+		subnets[i] = subnet
 	}
 
-	return w, nil
+	return subnets, nil
 }
 
 // Verify looks up the details of the client from the context and returns an
@@ -119,7 +167,10 @@ func (w *IPWhitelister) Verify(ctx context.Context) error {
 		return nil
 	}
 
-	subnets, found := w.tables[tenantID]
+	subnets, found, err := w.subnets(ctx, tenantID)
+	if err != nil {
+		return err
+	}
 	if !found {
 		// IP whitelisting is not enabled for this tenant.
 		return nil
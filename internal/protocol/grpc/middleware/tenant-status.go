@@ -0,0 +1,53 @@
+package middleware
+
+import (
+	"context"
+	"time"
+
+	jwt_model "github.com/CHORUS-TRE/chorus-backend/internal/jwt/model"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// NewTenantStatusUnaryServerInterceptor creates a new unary interceptor
+// rejecting the tokens of the tenants that are not active, and the ones
+// revoked when the tenant was last suspended.
+func NewTenantStatusUnaryServerInterceptor(tenanter Tenanter) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := verifyTenantToken(ctx, tenanter); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// NewTenantStatusStreamServerInterceptor creates a new stream interceptor
+// rejecting the tokens of the tenants that are not active, and the ones
+// revoked when the tenant was last suspended.
+func NewTenantStatusStreamServerInterceptor(tenanter Tenanter) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := verifyTenantToken(ss.Context(), tenanter); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
+
+func verifyTenantToken(ctx context.Context, tenanter Tenanter) error {
+	claims, ok := ctx.Value(jwt_model.JWTClaimsContextKey).(*jwt_model.JWTClaims)
+	if !ok {
+		// Public endpoint, the authenticated ones are refused by the auth
+		// middleware without claims.
+		return nil
+	}
+
+	tenant, err := tenanter.GetTenant(ctx, claims.TenantID)
+	if err != nil {
+		return status.Errorf(codes.Unauthenticated, "unable to get tenant: %v", err)
+	}
+	if !tenant.AcceptsToken(time.Unix(claims.IssuedAt, 0)) {
+		return status.Error(codes.Unauthenticated, "invalid authentication token: the token was revoked")
+	}
+	return nil
+}
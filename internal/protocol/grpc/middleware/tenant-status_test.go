@@ -0,0 +1,53 @@
+package middleware
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	jwt_model "github.com/CHORUS-TRE/chorus-backend/internal/jwt/model"
+	tenant_model "github.com/CHORUS-TRE/chorus-backend/pkg/tenant/model"
+	jwt_go "github.com/golang-jwt/jwt"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type tenanter map[uint64]*tenant_model.Tenant
+
+func (t tenanter) GetTenant(ctx context.Context, tenantID uint64) (*tenant_model.Tenant, error) {
+	return t[tenantID], nil
+}
+
+func TestNewTenantStatusUnaryServerInterceptor(t *testing.T) {
+	revokedAt := time.Now().Add(-time.Hour)
+	interceptor := NewTenantStatusUnaryServerInterceptor(tenanter{
+		1: {ID: 1, Status: tenant_model.TenantActive},
+		2: {ID: 2, Status: tenant_model.TenantSuspended, TokensRevokedAt: &revokedAt},
+		3: {ID: 3, Status: tenant_model.TenantActive, TokensRevokedAt: &revokedAt},
+	})
+	withClaims := func(tenantID uint64, issuedAt time.Time) context.Context {
+		claims := &jwt_model.JWTClaims{TenantID: tenantID, StandardClaims: jwt_go.StandardClaims{IssuedAt: issuedAt.Unix()}}
+		return context.WithValue(context.Background(), jwt_model.JWTClaimsContextKey, claims)
+	}
+
+	_, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{}, testHandler)
+	require.NoError(t, err)
+
+	_, err = interceptor(withClaims(1, time.Now()), nil, &grpc.UnaryServerInfo{}, testHandler)
+	require.NoError(t, err)
+
+	_, err = interceptor(withClaims(3, time.Now()), nil, &grpc.UnaryServerInfo{}, testHandler)
+	require.NoError(t, err)
+
+	// The token issued in the second following the revocation is accepted,
+	// the ones issued within the second of the revocation are not.
+	_, err = interceptor(withClaims(3, revokedAt.Add(time.Second)), nil, &grpc.UnaryServerInfo{}, testHandler)
+	require.NoError(t, err)
+
+	for _, ctx := range []context.Context{withClaims(2, time.Now()), withClaims(3, revokedAt.Add(-time.Minute)), withClaims(3, revokedAt)} {
+		_, err = interceptor(ctx, nil, &grpc.UnaryServerInfo{}, testHandler)
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+	}
+}
@@ -20,7 +20,7 @@ import (
 )
 
 // InitServer initialize and return gRPC server with middleware interceptors specified in './middleware'.
-func InitServer(whitelister middleware.ClientWhitelister, keyFunc jwt_go.Keyfunc, claimsFactory jwt_model.ClaimsFactory, serverMetrics *metrics.ServerMetrics, cfg config.Config) (*grpc.Server, error) {
+func InitServer(whitelister middleware.ClientWhitelister, tenanter middleware.Tenanter, keyFunc jwt_go.Keyfunc, claimsFactory jwt_model.ClaimsFactory, serverMetrics *metrics.ServerMetrics, cfg config.Config) (*grpc.Server, error) {
 	// Create unary and stream interceptors.
 	var unary []grpc.UnaryServerInterceptor
 	var stream []grpc.StreamServerInterceptor
@@ -41,6 +41,11 @@ func InitServer(whitelister middleware.ClientWhitelister, keyFunc jwt_go.Keyfunc
 	unary = append(unary, middleware.NewAuthUnaryServerInterceptors(keyFunc, claimsFactory)...)
 	stream = append(stream, middleware.NewAuthStreamServerInterceptors(keyFunc, claimsFactory)...)
 
+	// Add tenant status middleware, rejecting the tokens of the suspended
+	// tenants.
+	unary = append(unary, middleware.NewTenantStatusUnaryServerInterceptor(tenanter))
+	stream = append(stream, middleware.NewTenantStatusStreamServerInterceptor(tenanter))
+
 	// Add validator middleware.
 	unary = append(unary, grpc_validator.UnaryServerInterceptor())
 	stream = append(stream, grpc_validator.StreamServerInterceptor())
@@ -0,0 +1,31 @@
+package rest
+
+import (
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+)
+
+// MIMEEventStream is the content type requested by browsers through
+// EventSource.
+const MIMEEventStream = "text/event-stream"
+
+// eventStreamMarshaler frames the messages of server-streaming RPCs as
+// server-sent events, so that they can be consumed by an EventSource.
+type eventStreamMarshaler struct {
+	runtime.JSONPb
+}
+
+func (m *eventStreamMarshaler) ContentType(_ interface{}) string {
+	return MIMEEventStream
+}
+
+func (m *eventStreamMarshaler) Marshal(v interface{}) ([]byte, error) {
+	b, err := m.JSONPb.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte("data: "), b...), nil
+}
+
+func (m *eventStreamMarshaler) Delimiter() []byte {
+	return []byte("\n\n")
+}
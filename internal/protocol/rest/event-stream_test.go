@@ -0,0 +1,19 @@
+package rest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/api/v1/chorus"
+)
+
+func TestEventStreamMarshaler(t *testing.T) {
+	m := &eventStreamMarshaler{}
+
+	b, err := m.Marshal(map[string]interface{}{"result": &chorus.Notification{Id: "1", Message: "hello"}})
+	require.NoError(t, err)
+	require.Equal(t, `data: {"result":{"id":"1","message":"hello"}}`, string(b))
+	require.Equal(t, "\n\n", string(m.Delimiter()))
+	require.Equal(t, MIMEEventStream, m.ContentType(nil))
+}
@@ -49,3 +49,9 @@ func (w *codeResponseWriter) WriteHeader(code int) {
 	w.StatusCode = code
 	w.ResponseWriter.WriteHeader(code)
 }
+
+// Unwrap lets http.ResponseController reach the underlying writer, which is
+// needed to flush streamed responses.
+func (w *codeResponseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
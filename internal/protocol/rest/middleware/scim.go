@@ -0,0 +1,19 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+)
+
+// AddSCIM routes the requests under pathPrefix to the SCIM handler, which
+// does its own authentication.
+func AddSCIM(h http.Handler, scim http.Handler, pathPrefix string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == pathPrefix || strings.HasPrefix(r.URL.Path, pathPrefix+"/") {
+			scim.ServeHTTP(w, r)
+			return
+		}
+
+		h.ServeHTTP(w, r)
+	})
+}
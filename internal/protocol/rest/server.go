@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"strings"
 
+	"github.com/CHORUS-TRE/chorus-backend/internal/api/scim"
 	"github.com/CHORUS-TRE/chorus-backend/internal/config"
 	jwt_model "github.com/CHORUS-TRE/chorus-backend/internal/jwt/model"
 	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
@@ -16,11 +17,12 @@ import (
 
 // InitServer initializes a HTTP-server and returns an empty request multiplexer
 // for a GRPC gateway and a configuration object.
-func InitServer(ctx context.Context, cfg config.Config, version string, started <-chan struct{}, pw middleware.ProxyWorkbenchHandler, keyFunc jwt_go.Keyfunc, claimsFactory jwt_model.ClaimsFactory) (http.Handler, *runtime.ServeMux, []grpc.DialOption) {
+func InitServer(ctx context.Context, cfg config.Config, version string, started <-chan struct{}, pw middleware.ProxyWorkbenchHandler, scimHandler http.Handler, keyFunc jwt_go.Keyfunc, claimsFactory jwt_model.ClaimsFactory) (http.Handler, *runtime.ServeMux, []grpc.DialOption) {
 
 	mux := runtime.NewServeMux(
 		runtime.WithMetadata(middleware.CorrelationIDMetadata),
 		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{}),
+		runtime.WithMarshalerOption(MIMEEventStream, &eventStreamMarshaler{}),
 		runtime.WithIncomingHeaderMatcher(newHeaderMatcher(cfg)),
 		runtime.WithOutgoingHeaderMatcher(newOutgoingHeaderMatcher()),
 	)
@@ -35,6 +37,7 @@ func InitServer(ctx context.Context, cfg config.Config, version string, started
 	if cfg.Services.WorkbenchService.StreamProxyEnabled {
 		handler = middleware.AddProxyWorkbench(handler, pw, keyFunc, claimsFactory)
 	}
+	handler = middleware.AddSCIM(handler, scimHandler, scim.PathPrefix)
 	if cfg.Services.AuthenticationService.DevAuthEnabled {
 		handler = middleware.AddDevAuth(handler)
 	}
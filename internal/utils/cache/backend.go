@@ -0,0 +1,31 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"strconv"
+)
+
+// ErrNotFound is returned by the backends when a key is missing or expired.
+var ErrNotFound = errors.New("cache: entry not found")
+
+// Backend stores the encoded values of the cache entries. The tags of an
+// entry group it with the other entries to drop when one of the tags is
+// invalidated.
+type Backend interface {
+	Get(ctx context.Context, key []byte) ([]byte, error)
+	// Set stores the value. When expiration is above zero, it indicates the
+	// expiration time in seconds for the value.
+	Set(ctx context.Context, key, value []byte, expiration int, tags []string) error
+	Invalidate(ctx context.Context, tags ...string) error
+}
+
+// TenantTag is the tag of the entries holding data of a tenant.
+func TenantTag(tenantID uint64) string {
+	return "tenant:" + strconv.FormatUint(tenantID, 10)
+}
+
+// UserTag is the tag of the entries holding data of a user.
+func UserTag(tenantID, userID uint64) string {
+	return "user:" + strconv.FormatUint(tenantID, 10) + ":" + strconv.FormatUint(userID, 10)
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/CHORUS-TRE/chorus-backend/internal/utils/trace"
 
 	"github.com/coocood/freecache"
+	prom "github.com/prometheus/client_golang/prometheus"
 	"go.uber.org/zap"
 )
 
@@ -98,22 +99,32 @@ func WithInterface(obj interface{}) Key {
 	}
 }
 
+var requests = prom.NewCounterVec(
+	prom.CounterOpts{
+		Name: "cache_requests_total",
+		Help: "Total number of cache reads, by caller and result (hit, miss or error).",
+	}, []string{"caller", "result"})
+
+func init() {
+	prom.MustRegister(requests)
+}
+
 type Cache struct {
-	fc  *freecache.Cache
-	log *logger.ContextLogger
+	backend Backend
+	log     *logger.ContextLogger
 }
 
-func NewCache(fc *freecache.Cache, log *logger.ContextLogger) *Cache {
-	if fc == nil {
-		panic("cache cannot be nil")
+func NewCache(backend Backend, log *logger.ContextLogger) *Cache {
+	if backend == nil {
+		panic("cache backend cannot be nil")
 	}
 	if log == nil {
 		panic("log cannot be nil")
 	}
 
 	return &Cache{
-		fc:  fc,
-		log: log,
+		backend: backend,
+		log:     log,
 	}
 }
 
@@ -127,18 +138,34 @@ func (c Cache) NewEntry(keys ...Key) CacheEntry {
 	}
 
 	return CacheEntry{
-		fc:     c.fc,
-		log:    c.log,
-		key:    h.Sum([]byte{0}),
-		caller: caller,
+		backend: c.backend,
+		log:     c.log,
+		key:     h.Sum([]byte{0}),
+		caller:  caller,
+	}
+}
+
+// Invalidate drops the entries having any of the tags. The mutating methods
+// call it so that the next reads are not served stale data.
+func (c Cache) Invalidate(ctx context.Context, tags ...string) {
+	if err := c.backend.Invalidate(ctx, tags...); err != nil {
+		c.log.Error(ctx, "cache: unable to invalidate", zap.Error(err), zap.Strings("tags", tags), zap.String(logger.LoggerKeyParentCaller, trace.Caller()))
 	}
 }
 
 type CacheEntry struct {
-	fc     *freecache.Cache
-	log    *logger.ContextLogger
-	key    []byte
-	caller string
+	backend Backend
+	log     *logger.ContextLogger
+	key     []byte
+	caller  string
+	tags    []string
+}
+
+// WithTags returns the entry tagged with tags, which are used to invalidate
+// it.
+func (e CacheEntry) WithTags(tags ...string) CacheEntry {
+	e.tags = append(e.tags, tags...)
+	return e
 }
 
 func (e CacheEntry) Get(ctx context.Context, dest ...interface{}) bool {
@@ -146,13 +173,15 @@ func (e CacheEntry) Get(ctx context.Context, dest ...interface{}) bool {
 	for i, obj := range dest {
 		e.key[0] = byte(i)
 
-		value, err := e.fc.Get(e.key)
-		if errors.Is(err, freecache.ErrNotFound) {
+		value, err := e.backend.Get(ctx, e.key)
+		if errors.Is(err, ErrNotFound) {
 			e.log.Debug(ctx, "cache miss", zap.String(logger.LoggerKeyParentCaller, e.caller))
+			requests.WithLabelValues(e.caller, "miss").Inc()
 			return false
 		}
 		if err != nil {
 			e.log.Error(ctx, "cache: unable to read", zap.Error(err), zap.String(logger.LoggerKeyParentCaller, e.caller))
+			requests.WithLabelValues(e.caller, "error").Inc()
 			return false
 		}
 
@@ -161,11 +190,13 @@ func (e CacheEntry) Get(ctx context.Context, dest ...interface{}) bool {
 		err = dec.Decode(obj)
 		if err != nil {
 			e.log.Error(ctx, "cache: unable to decode", zap.Error(err), zap.String(logger.LoggerKeyParentCaller, e.caller))
+			requests.WithLabelValues(e.caller, "error").Inc()
 			return false
 		}
 	}
 
 	e.log.Debug(ctx, "cache hit", zap.String(logger.LoggerKeyParentCaller, e.caller))
+	requests.WithLabelValues(e.caller, "hit").Inc()
 	return true
 }
 
@@ -186,7 +217,7 @@ func (e CacheEntry) Set(ctx context.Context, expiration int, values ...interface
 			return
 		}
 
-		err = e.fc.Set(e.key, data.Bytes(), expiration)
+		err = e.backend.Set(ctx, e.key, data.Bytes(), expiration, e.tags)
 		if err != nil {
 			if errors.Is(err, freecache.ErrLargeEntry) {
 				e.log.Warn(ctx, "cache: unable to set value", zap.Error(err), zap.Stringer(logger.LoggerKeyObjectType, reflect.TypeOf(obj)))
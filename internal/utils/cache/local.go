@@ -0,0 +1,91 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/coocood/freecache"
+)
+
+// maxTagEntries is the number of keys a tag of a LocalBackend holds before
+// the expired ones are dropped.
+const maxTagEntries = 1024
+
+// LocalBackend is an in-process backend. Its invalidations only apply to the
+// replica they are made on, the other replicas serving their entries until
+// they expire.
+type LocalBackend struct {
+	fc *freecache.Cache
+
+	mu   sync.Mutex
+	tags map[string]map[string]time.Time
+}
+
+// NewLocalBackend returns a backend storing up to size bytes in memory.
+func NewLocalBackend(size int) *LocalBackend {
+	return &LocalBackend{
+		fc:   freecache.NewCache(size),
+		tags: map[string]map[string]time.Time{},
+	}
+}
+
+func (b *LocalBackend) Get(ctx context.Context, key []byte) ([]byte, error) {
+	value, err := b.fc.Get(key)
+	if errors.Is(err, freecache.ErrNotFound) {
+		return nil, ErrNotFound
+	}
+	return value, err
+}
+
+func (b *LocalBackend) Set(ctx context.Context, key, value []byte, expiration int, tags []string) error {
+	if err := b.fc.Set(key, value, expiration); err != nil {
+		return err
+	}
+	if len(tags) == 0 {
+		return nil
+	}
+
+	var deadline time.Time
+	if expiration > 0 {
+		deadline = time.Now().Add(time.Duration(expiration) * time.Second)
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for _, tag := range tags {
+		keys, ok := b.tags[tag]
+		if !ok {
+			keys = map[string]time.Time{}
+			b.tags[tag] = keys
+		}
+		if len(keys) >= maxTagEntries {
+			dropExpired(keys, time.Now())
+		}
+		keys[string(key)] = deadline
+	}
+	return nil
+}
+
+func (b *LocalBackend) Invalidate(ctx context.Context, tags ...string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for _, tag := range tags {
+		for key := range b.tags[tag] {
+			b.fc.Del([]byte(key))
+		}
+		delete(b.tags, tag)
+	}
+	return nil
+}
+
+func dropExpired(keys map[string]time.Time, now time.Time) {
+	for key, deadline := range keys {
+		if !deadline.IsZero() && deadline.Before(now) {
+			delete(keys, key)
+		}
+	}
+}
@@ -0,0 +1,31 @@
+package cache
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalBackendInvalidate(t *testing.T) {
+	ctx := context.Background()
+	b := NewLocalBackend(1024 * 1024)
+
+	require.NoError(t, b.Set(ctx, []byte("list"), []byte("1"), 60, []string{TenantTag(1)}))
+	require.NoError(t, b.Set(ctx, []byte("get"), []byte("2"), 60, []string{TenantTag(1), UserTag(1, 2)}))
+	require.NoError(t, b.Set(ctx, []byte("other"), []byte("3"), 60, []string{TenantTag(2)}))
+
+	require.NoError(t, b.Invalidate(ctx, UserTag(1, 2)))
+	_, err := b.Get(ctx, []byte("get"))
+	require.ErrorIs(t, err, ErrNotFound)
+	v, err := b.Get(ctx, []byte("list"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("1"), v)
+
+	require.NoError(t, b.Invalidate(ctx, TenantTag(1)))
+	_, err = b.Get(ctx, []byte("list"))
+	require.ErrorIs(t, err, ErrNotFound)
+	v, err = b.Get(ctx, []byte("other"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("3"), v)
+}
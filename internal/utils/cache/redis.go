@@ -0,0 +1,94 @@
+package cache
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+
+	"github.com/go-redis/redis"
+)
+
+// setScript stores the value in KEYS[1] and adds KEYS[1] to the tags in
+// KEYS[2:]. A tag expires with the last of its entries.
+var setScript = redis.NewScript(`
+local expiration = tonumber(ARGV[2])
+if expiration > 0 then
+	redis.call('SET', KEYS[1], ARGV[1], 'EX', expiration)
+else
+	redis.call('SET', KEYS[1], ARGV[1])
+end
+for i = 2, #KEYS do
+	local ttl = redis.call('TTL', KEYS[i])
+	redis.call('SADD', KEYS[i], KEYS[1])
+	if expiration <= 0 then
+		redis.call('PERSIST', KEYS[i])
+	elseif ttl == -2 or (ttl >= 0 and ttl < expiration) then
+		redis.call('EXPIRE', KEYS[i], expiration)
+	end
+end
+return 1
+`)
+
+// invalidateScript deletes the tags in KEYS along with their entries.
+var invalidateScript = redis.NewScript(`
+for i = 1, #KEYS do
+	local keys = redis.call('SMEMBERS', KEYS[i])
+	for j = 1, #keys, 1000 do
+		redis.call('DEL', unpack(keys, j, math.min(j + 999, #keys)))
+	end
+	redis.call('DEL', KEYS[i])
+end
+return 1
+`)
+
+// RedisBackend is a backend shared by the replicas, so that an invalidation
+// applies to all of them.
+type RedisBackend struct {
+	client *redis.Client
+	prefix string
+}
+
+// NewRedisBackend returns a backend storing its entries in Redis, under keys
+// starting with prefix.
+func NewRedisBackend(client *redis.Client, prefix string) *RedisBackend {
+	return &RedisBackend{client: client, prefix: prefix}
+}
+
+func (b *RedisBackend) Get(ctx context.Context, key []byte) ([]byte, error) {
+	value, err := b.client.WithContext(ctx).Get(b.entryKey(key)).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, ErrNotFound
+	}
+	return value, err
+}
+
+func (b *RedisBackend) Set(ctx context.Context, key, value []byte, expiration int, tags []string) error {
+	keys := make([]string, 0, len(tags)+1)
+	keys = append(keys, b.entryKey(key))
+	for _, tag := range tags {
+		keys = append(keys, b.tagKey(tag))
+	}
+
+	return setScript.Run(b.client.WithContext(ctx), keys, value, expiration).Err()
+}
+
+func (b *RedisBackend) Invalidate(ctx context.Context, tags ...string) error {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	keys := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		keys = append(keys, b.tagKey(tag))
+	}
+
+	return invalidateScript.Run(b.client.WithContext(ctx), keys).Err()
+}
+
+func (b *RedisBackend) entryKey(key []byte) string {
+	return b.prefix + "entry:" + hex.EncodeToString(key)
+}
+
+func (b *RedisBackend) tagKey(tag string) string {
+	return b.prefix + "tag:" + tag
+}
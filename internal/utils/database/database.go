@@ -13,8 +13,13 @@ import (
 var (
 	ErrNoRowsUpdated = errors.New("database: no rows updated")
 	ErrNoRowsDeleted = errors.New("database: no rows deleted")
+	ErrDuplicateKey  = errors.New("database: duplicate key")
 )
 
+// DuplicateKeyErrorCode is the code of the errors of postgres raised on the
+// violation of a unique constraint.
+const DuplicateKeyErrorCode = "23505"
+
 // Meant to be a constant, but cannot declare a nil const.
 // This exists only to make store calls more readable when no transaction is needed
 var NoTransaction Queryable
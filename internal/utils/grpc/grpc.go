@@ -7,6 +7,7 @@ import (
 	val "github.com/go-playground/validator/v10"
 	"google.golang.org/grpc/codes"
 
+	"github.com/CHORUS-TRE/chorus-backend/internal/client/runtime"
 	"github.com/CHORUS-TRE/chorus-backend/internal/utils/database"
 	auth_service "github.com/CHORUS-TRE/chorus-backend/pkg/authentication/service"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/common/service"
@@ -17,11 +18,14 @@ func ErrorCode(err error) codes.Code {
 	if errors.Is(err, sql.ErrNoRows) || errors.Is(err, database.ErrNoRowsUpdated) || errors.Is(err, database.ErrNoRowsDeleted) {
 		return codes.NotFound
 	}
+	if errors.Is(err, runtime.ErrWorkbenchNotFound) || errors.Is(err, runtime.ErrAppNotFound) {
+		return codes.NotFound
+	}
 
 	// Find the root cause.
-	var cause error
+	cause := err
 	for {
-		if c := errors.Unwrap(err); c != nil {
+		if c := errors.Unwrap(cause); c != nil {
 			cause = c
 		} else {
 			break
@@ -33,6 +37,12 @@ func ErrorCode(err error) codes.Code {
 		return codes.InvalidArgument
 	case *service.ResourceAlreadyExistsErr:
 		return codes.AlreadyExists
+	case *service.ResourceExhaustedErr:
+		return codes.ResourceExhausted
+	case *service.FailedPreconditionErr:
+		return codes.FailedPrecondition
+	case *service.PermissionDeniedErr:
+		return codes.PermissionDenied
 	case *auth_service.ErrUnauthorized, *user_service.ErrUnauthorized:
 		return codes.Unauthenticated
 	default:
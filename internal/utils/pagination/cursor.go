@@ -46,6 +46,9 @@ type RequestCursor[T any] struct {
 	// Service-specific (inner) cursor data
 	// Will be nil if there is no cursor to pass
 	CursorData *T `validate:"cursorData"`
+	// The number of items to skip before the first page, for the clients
+	// addressing the pages by index rather than with the cursor data.
+	Offset uint64
 }
 
 func (src *RequestCursor[T]) HasCurrentPage() bool {
@@ -0,0 +1,61 @@
+package pagination
+
+// Keyset identifies a row in a sorted result set by the value of its sort
+// column. The ID is used as a tie-breaker so that the ordering is total.
+type Keyset struct {
+	ID    uint64
+	Value string
+}
+
+// KeysetCursor is the cursor data of a keyset paginated page: the keys of
+// its first and last rows. It is opaque to the clients.
+type KeysetCursor struct {
+	First Keyset
+	Last  Keyset
+}
+
+// NewKeysetResponseCursor builds the response cursor of a page fetched with
+// one extra row. It trims the extra row from items and returns the page with
+// its cursor.
+func NewKeysetResponseCursor[T any](items []T, key func(T) Keyset, req *RequestCursor[KeysetCursor]) ([]T, *ResponseCursor[KeysetCursor]) {
+	pageRequest := PageFirst
+	pageSize := uint64(len(items))
+	skipped := false
+	if req != nil {
+		skipped = req.Offset != 0
+		pageRequest = req.PageRequest
+		if req.PageSize != 0 {
+			pageSize = req.PageSize
+		}
+	}
+
+	hasMore := uint64(len(items)) > pageSize
+	if hasMore {
+		if pageRequest == PagePrevious || pageRequest == PageLast {
+			items = items[1:]
+		} else {
+			items = items[:pageSize]
+		}
+	}
+
+	cursor := &ResponseCursor[KeysetCursor]{}
+	switch pageRequest {
+	case PageNext:
+		cursor.HasPrevious, cursor.HasNext = true, hasMore
+	case PagePrevious:
+		cursor.HasPrevious, cursor.HasNext = hasMore, true
+	case PageLast:
+		cursor.HasPrevious, cursor.HasNext = hasMore, false
+	default:
+		cursor.HasPrevious, cursor.HasNext = skipped, hasMore
+	}
+
+	if len(items) != 0 {
+		cursor.CursorData = &KeysetCursor{
+			First: key(items[0]),
+			Last:  key(items[len(items)-1]),
+		}
+	}
+
+	return items, cursor
+}
@@ -0,0 +1,99 @@
+package pagination
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyset_NewKeysetResponseCursor(t *testing.T) {
+	key := func(i uint64) Keyset {
+		return Keyset{ID: i, Value: strconv.FormatUint(i, 10)}
+	}
+
+	tests := []struct {
+		name                string
+		items               []uint64
+		requestCursor       *RequestCursor[KeysetCursor]
+		expectedItems       []uint64
+		expectedCursorData  *KeysetCursor
+		expectedHasPrevious bool
+		expectedHasNext     bool
+	}{
+		{
+			name:                "With nil request cursor, returns all the items",
+			items:               []uint64{1, 2, 3},
+			requestCursor:       nil,
+			expectedItems:       []uint64{1, 2, 3},
+			expectedCursorData:  &KeysetCursor{First: key(1), Last: key(3)},
+			expectedHasPrevious: false,
+			expectedHasNext:     false,
+		},
+		{
+			name:                "With first page and an extra item, trims the last item",
+			items:               []uint64{1, 2, 3},
+			requestCursor:       &RequestCursor[KeysetCursor]{PageRequest: PageFirst, PageSize: 2},
+			expectedItems:       []uint64{1, 2},
+			expectedCursorData:  &KeysetCursor{First: key(1), Last: key(2)},
+			expectedHasPrevious: false,
+			expectedHasNext:     true,
+		},
+		{
+			name:                "With first page after an offset, has a previous page",
+			items:               []uint64{3, 4},
+			requestCursor:       &RequestCursor[KeysetCursor]{PageRequest: PageFirst, PageSize: 2, Offset: 2},
+			expectedItems:       []uint64{3, 4},
+			expectedCursorData:  &KeysetCursor{First: key(3), Last: key(4)},
+			expectedHasPrevious: true,
+			expectedHasNext:     false,
+		},
+		{
+			name:                "With next page and no extra item, has no next page",
+			items:               []uint64{3, 4},
+			requestCursor:       &RequestCursor[KeysetCursor]{PageRequest: PageNext, PageSize: 2},
+			expectedItems:       []uint64{3, 4},
+			expectedCursorData:  &KeysetCursor{First: key(3), Last: key(4)},
+			expectedHasPrevious: true,
+			expectedHasNext:     false,
+		},
+		{
+			name:                "With previous page and an extra item, trims the first item",
+			items:               []uint64{1, 2, 3},
+			requestCursor:       &RequestCursor[KeysetCursor]{PageRequest: PagePrevious, PageSize: 2},
+			expectedItems:       []uint64{2, 3},
+			expectedCursorData:  &KeysetCursor{First: key(2), Last: key(3)},
+			expectedHasPrevious: true,
+			expectedHasNext:     true,
+		},
+		{
+			name:                "With last page and an extra item, trims the first item",
+			items:               []uint64{4, 5, 6},
+			requestCursor:       &RequestCursor[KeysetCursor]{PageRequest: PageLast, PageSize: 2},
+			expectedItems:       []uint64{5, 6},
+			expectedCursorData:  &KeysetCursor{First: key(5), Last: key(6)},
+			expectedHasPrevious: true,
+			expectedHasNext:     false,
+		},
+		{
+			name:                "With no items, returns nil cursor data",
+			items:               []uint64{},
+			requestCursor:       &RequestCursor[KeysetCursor]{PageRequest: PageFirst, PageSize: 2},
+			expectedItems:       []uint64{},
+			expectedCursorData:  nil,
+			expectedHasPrevious: false,
+			expectedHasNext:     false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			items, cursor := NewKeysetResponseCursor(test.items, key, test.requestCursor)
+
+			require.Equal(t, test.expectedItems, items)
+			require.Equal(t, test.expectedCursorData, cursor.CursorData)
+			require.Equal(t, test.expectedHasPrevious, cursor.HasPrevious)
+			require.Equal(t, test.expectedHasNext, cursor.HasNext)
+		})
+	}
+}
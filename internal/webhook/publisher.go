@@ -0,0 +1,40 @@
+package webhook
+
+import (
+	"context"
+	"time"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
+	"github.com/CHORUS-TRE/chorus-backend/internal/utils/uuid"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/webhook/model"
+
+	"go.uber.org/zap"
+)
+
+// Publisher publishes the events to the webhooks of their tenant.
+type Publisher interface {
+	PublishEvent(ctx context.Context, event *model.Event) error
+}
+
+// NewEvent returns an event of the given type that occurred now.
+func NewEvent(tenantID uint64, eventType model.EventType, data model.EventData) *model.Event {
+	return &model.Event{
+		ID:        uuid.Next(),
+		TenantID:  tenantID,
+		Type:      eventType,
+		CreatedAt: time.Now().UTC(),
+		Data:      data,
+	}
+}
+
+// Publish publishes the event. A failure is only logged, as it must not fail
+// the operation the event is about.
+func Publish(ctx context.Context, publisher Publisher, event *model.Event) {
+	if err := publisher.PublishEvent(ctx, event); err != nil {
+		logger.TechLog.Error(ctx, "unable to publish event",
+			zap.String("event_id", event.ID),
+			zap.String("type", event.Type.String()),
+			zap.Error(err),
+		)
+	}
+}
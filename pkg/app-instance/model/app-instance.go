@@ -2,6 +2,7 @@ package model
 
 import (
 	"errors"
+	"strconv"
 	"time"
 )
 
@@ -14,8 +15,16 @@ type AppInstance struct {
 	AppID       uint64
 	WorkspaceID uint64
 	WorkbenchID uint64
+	// AppVersionID is the version of the app the app instance was launched
+	// or last upgraded with.
+	AppVersionID uint64
 
 	Status AppInstanceStatus
+	// Restarts is the number of times the containers of the app instance had
+	// restarted when it was last checked, and Crashing whether its owner was
+	// notified that it crashed since.
+	Restarts int32
+	Crashing bool
 
 	CreatedAt time.Time
 	UpdatedAt time.Time
@@ -47,3 +56,26 @@ func ToAppInstanceStatus(status string) (AppInstanceStatus, error) {
 		return "", errors.New("unexpected AppInstanceStatus: " + status)
 	}
 }
+
+// AppInstanceFilter restricts the app instances returned by a listing.
+// Empty fields are not filtered on.
+type AppInstanceFilter struct {
+	Status  AppInstanceStatus
+	OwnerID uint64
+}
+
+var AppInstanceSortTypeToString = map[string]string{
+	"ID":        "id",
+	"CREATEDAT": "createdat",
+}
+
+// SortValue returns the value of the column matching sortType, used as a
+// pagination keyset.
+func (a *AppInstance) SortValue(sortType string) string {
+	switch sortType {
+	case "CREATEDAT":
+		return a.CreatedAt.Format(time.RFC3339Nano)
+	default:
+		return strconv.FormatUint(a.ID, 10)
+	}
+}
@@ -0,0 +1,24 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAppInstance_SortValue(t *testing.T) {
+	createdAt := time.Date(2024, 3, 1, 12, 30, 0, 500, time.UTC)
+	a := &AppInstance{ID: 42, CreatedAt: createdAt}
+
+	expected := map[string]string{
+		"ID":        "42",
+		"CREATEDAT": "2024-03-01T12:30:00.0000005Z",
+	}
+	require.Len(t, AppInstanceSortTypeToString, len(expected), "every sort type has a keyset value")
+	for sortType, value := range expected {
+		require.Contains(t, AppInstanceSortTypeToString, sortType)
+		require.Equal(t, value, a.SortValue(sortType), sortType)
+	}
+	require.Equal(t, "42", a.SortValue("UNKNOWN"), "an unknown sort type falls back to the ID")
+}
@@ -3,49 +3,93 @@ package service
 import (
 	"context"
 	"fmt"
+	"time"
 
-	"github.com/CHORUS-TRE/chorus-backend/internal/client/helm"
+	"github.com/CHORUS-TRE/chorus-backend/internal/client/runtime"
+	"github.com/CHORUS-TRE/chorus-backend/internal/config"
+	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
+	"github.com/CHORUS-TRE/chorus-backend/internal/notification"
+	"github.com/CHORUS-TRE/chorus-backend/internal/utils/pagination"
+	"github.com/CHORUS-TRE/chorus-backend/internal/utils/uuid"
+	"github.com/CHORUS-TRE/chorus-backend/internal/webhook"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/app-instance/model"
+	app_model "github.com/CHORUS-TRE/chorus-backend/pkg/app/model"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/app/service"
 	common_model "github.com/CHORUS-TRE/chorus-backend/pkg/common/model"
+	common_service "github.com/CHORUS-TRE/chorus-backend/pkg/common/service"
+	notification_model "github.com/CHORUS-TRE/chorus-backend/pkg/notification/model"
+	quota_model "github.com/CHORUS-TRE/chorus-backend/pkg/quota/model"
+	webhook_model "github.com/CHORUS-TRE/chorus-backend/pkg/webhook/model"
+	"go.uber.org/zap"
 )
 
 type AppInstanceer interface {
 	GetAppInstance(ctx context.Context, tenantID, appInstanceID uint64) (*model.AppInstance, error)
-	ListAppInstances(ctx context.Context, tenantID uint64, pagination common_model.Pagination) ([]*model.AppInstance, error)
+	GetAppInstanceRuntime(ctx context.Context, tenantID, userID, appInstanceID uint64) (*runtime.Details, error)
+	ListAppInstances(ctx context.Context, req ListAppInstancesReq) ([]*model.AppInstance, *pagination.ResponseCursor[pagination.KeysetCursor], uint64, error)
 	CreateAppInstance(ctx context.Context, appInstance *model.AppInstance) (uint64, error)
 	UpdateAppInstance(ctx context.Context, appInstance *model.AppInstance) error
 	DeleteAppInstance(ctx context.Context, tenantId, appInstanceId uint64) error
+	RestoreAppInstance(ctx context.Context, tenantID, appInstanceID uint64) error
+	PurgeAppInstances(ctx context.Context, deletedBefore time.Time) (common_model.Purged, error)
+	StreamAppInstanceLogs(ctx context.Context, req StreamAppInstanceLogsReq, send func(line string) error) error
+	UpgradeAppInstance(ctx context.Context, tenantID, userID, appInstanceID, appVersionID uint64) error
+	CheckAppInstancesCrashed(ctx context.Context) error
 }
 
 type AppInstanceStore interface {
 	GetAppInstance(ctx context.Context, tenantID uint64, appInstanceID uint64) (*model.AppInstance, error)
-	ListAppInstances(ctx context.Context, tenantID uint64, pagination common_model.Pagination) ([]*model.AppInstance, error)
+	ListAppInstances(ctx context.Context, tenantID uint64, filter model.AppInstanceFilter, sort common_model.Sort, cursor *pagination.RequestCursor[pagination.KeysetCursor]) ([]*model.AppInstance, *pagination.ResponseCursor[pagination.KeysetCursor], uint64, error)
 	CreateAppInstance(ctx context.Context, tenantID uint64, appInstance *model.AppInstance) (uint64, error)
 	UpdateAppInstance(ctx context.Context, tenantID uint64, appInstance *model.AppInstance) error
+	UpdateAppInstanceVersion(ctx context.Context, tenantID, appInstanceID, appVersionID uint64) error
 	DeleteAppInstance(ctx context.Context, tenantID uint64, appInstanceID uint64) error
+	RestoreAppInstance(ctx context.Context, tenantID uint64, appInstanceID uint64, deletedAfter time.Time) error
+	PurgeAppInstances(ctx context.Context, deletedBefore time.Time) (common_model.Purged, error)
+	ListActiveAppInstances(ctx context.Context) ([]*model.AppInstance, error)
+	SetAppInstanceRestarts(ctx context.Context, appInstance *model.AppInstance, restarts int32, crashing bool) (bool, error)
+}
+
+// QuotaChecker checks that the new app instances fit in the quotas.
+type QuotaChecker interface {
+	CheckQuota(ctx context.Context, tenantID, workspaceID, userID uint64, requested quota_model.Usage) (func(), error)
+}
+
+// MembershipChecker checks that the users are members of the workspaces.
+type MembershipChecker interface {
+	IsWorkspaceMember(ctx context.Context, tenantID, workspaceID, userID uint64) (bool, error)
 }
 
 type AppInstanceService struct {
-	store  AppInstanceStore
-	client helm.HelmClienter
-	apper  service.Apper
+	cfg       config.Config
+	store     AppInstanceStore
+	runtime   runtime.WorkbenchRuntime
+	apper     service.Apper
+	quota     QuotaChecker
+	members   MembershipChecker
+	notifier  notification.Notifier
+	publisher webhook.Publisher
 }
 
-func NewAppInstanceService(store AppInstanceStore, client helm.HelmClienter, apper service.Apper) *AppInstanceService {
+func NewAppInstanceService(cfg config.Config, store AppInstanceStore, runtime runtime.WorkbenchRuntime, apper service.Apper, quota QuotaChecker, members MembershipChecker, notifier notification.Notifier, publisher webhook.Publisher) *AppInstanceService {
 	return &AppInstanceService{
-		store:  store,
-		client: client,
-		apper:  apper,
+		cfg:       cfg,
+		store:     store,
+		runtime:   runtime,
+		apper:     apper,
+		quota:     quota,
+		members:   members,
+		notifier:  notifier,
+		publisher: publisher,
 	}
 }
 
-func (s *AppInstanceService) ListAppInstances(ctx context.Context, tenantID uint64, pagination common_model.Pagination) ([]*model.AppInstance, error) {
-	appInstances, err := s.store.ListAppInstances(ctx, tenantID, pagination)
+func (s *AppInstanceService) ListAppInstances(ctx context.Context, req ListAppInstancesReq) ([]*model.AppInstance, *pagination.ResponseCursor[pagination.KeysetCursor], uint64, error) {
+	appInstances, cursor, count, err := s.store.ListAppInstances(ctx, req.TenantID, req.Filter.ToBusinessFilter(), req.Sort.ToBusinessSort(), req.Cursor)
 	if err != nil {
-		return nil, fmt.Errorf("unable to query appInstances: %w", err)
+		return nil, nil, 0, fmt.Errorf("unable to query app instances: %w", err)
 	}
-	return appInstances, nil
+	return appInstances, cursor, count, nil
 }
 
 func (s *AppInstanceService) GetAppInstance(ctx context.Context, tenantID, appInstanceID uint64) (*model.AppInstance, error) {
@@ -57,16 +101,101 @@ func (s *AppInstanceService) GetAppInstance(ctx context.Context, tenantID, appIn
 	return appInstance, nil
 }
 
+// GetAppInstanceRuntime returns the live state of the container of an app
+// instance as observed in the runtime, along with its recent events, to the
+// members of its workspace.
+func (s *AppInstanceService) GetAppInstanceRuntime(ctx context.Context, tenantID, userID, appInstanceID uint64) (*runtime.Details, error) {
+	appInstance, err := s.store.GetAppInstance(ctx, tenantID, appInstanceID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get appInstance %v: %w", appInstanceID, err)
+	}
+
+	if err := s.checkMember(ctx, tenantID, appInstance.WorkspaceID, userID); err != nil {
+		return nil, err
+	}
+
+	details, err := s.runtime.AppDetails(ctx, s.getWorkspaceName(appInstance.WorkspaceID), s.getWorkbenchName(appInstance.WorkbenchID), s.getAppInstanceName(appInstanceID))
+	if err != nil {
+		return nil, fmt.Errorf("unable to get the runtime details of appInstance %v: %w", appInstanceID, err)
+	}
+	return details, nil
+}
+
 func (s *AppInstanceService) DeleteAppInstance(ctx context.Context, tenantID, appInstanceID uint64) error {
-	err := s.store.DeleteAppInstance(ctx, tenantID, appInstanceID)
+	appInstance, err := s.store.GetAppInstance(ctx, tenantID, appInstanceID)
 	if err != nil {
 		return fmt.Errorf("unable to get appInstance %v: %w", appInstanceID, err)
 	}
 
+	err = s.store.DeleteAppInstance(ctx, tenantID, appInstanceID)
+	if err != nil {
+		return fmt.Errorf("unable to delete appInstance %v: %w", appInstanceID, err)
+	}
+
+	// Only the active app instances are deployed in their workbench.
+	if appInstance.Status != model.AppInstanceActive {
+		return nil
+	}
+
+	err = s.runtime.DeleteApp(s.getWorkspaceName(appInstance.WorkspaceID), s.getWorkbenchName(appInstance.WorkbenchID), s.getAppInstanceName(appInstanceID))
+	if err != nil {
+		return fmt.Errorf("unable to delete app instance %v: %w", appInstanceID, err)
+	}
+
+	return nil
+}
+
+// RestoreAppInstance restores an app instance deleted during the grace
+// period. The app instance is left inactive and is not redeployed.
+func (s *AppInstanceService) RestoreAppInstance(ctx context.Context, tenantID, appInstanceID uint64) error {
+	deletedAfter := time.Now().Add(-s.cfg.Services.SoftDelete.GracePeriod)
+	if err := s.store.RestoreAppInstance(ctx, tenantID, appInstanceID, deletedAfter); err != nil {
+		return fmt.Errorf("unable to restore appInstance %v: %w", appInstanceID, err)
+	}
+	return nil
+}
+
+func (s *AppInstanceService) PurgeAppInstances(ctx context.Context, deletedBefore time.Time) (common_model.Purged, error) {
+	purged, err := s.store.PurgeAppInstances(ctx, deletedBefore)
+	if err != nil {
+		return nil, fmt.Errorf("unable to purge app instances: %w", err)
+	}
+	return purged, nil
+}
+
+// StreamAppInstanceLogs calls send for every line of the logs of the
+// container of an app instance, until they end or the context is done.
+func (s *AppInstanceService) StreamAppInstanceLogs(ctx context.Context, req StreamAppInstanceLogsReq, send func(line string) error) error {
+	appInstance, err := s.store.GetAppInstance(ctx, req.TenantID, req.AppInstanceID)
+	if err != nil {
+		return fmt.Errorf("unable to get appInstance %v: %w", req.AppInstanceID, err)
+	}
+
+	if err := s.checkMember(ctx, req.TenantID, appInstance.WorkspaceID, req.UserID); err != nil {
+		return err
+	}
+
+	logs, err := s.runtime.AppLogs(ctx, s.getWorkspaceName(appInstance.WorkspaceID), s.getWorkbenchName(appInstance.WorkbenchID), s.getAppInstanceName(req.AppInstanceID), req.LogOptions())
+	if err != nil {
+		return fmt.Errorf("unable to get the logs of appInstance %v: %w", req.AppInstanceID, err)
+	}
+	defer logs.Close()
+
+	if err := runtime.SendLines(ctx, logs, send); err != nil {
+		return fmt.Errorf("unable to stream the logs of appInstance %v: %w", req.AppInstanceID, err)
+	}
 	return nil
 }
 
 func (s *AppInstanceService) UpdateAppInstance(ctx context.Context, appInstance *model.AppInstance) error {
+	if appInstance.Status == model.AppInstanceActive {
+		release, err := s.checkActivation(ctx, appInstance)
+		if err != nil {
+			return fmt.Errorf("unable to update appInstance %v: %w", appInstance.ID, err)
+		}
+		defer release()
+	}
+
 	if err := s.store.UpdateAppInstance(ctx, appInstance.TenantID, appInstance); err != nil {
 		return fmt.Errorf("unable to update appInstance %v: %w", appInstance.ID, err)
 	}
@@ -75,27 +204,237 @@ func (s *AppInstanceService) UpdateAppInstance(ctx context.Context, appInstance
 }
 
 func (s *AppInstanceService) CreateAppInstance(ctx context.Context, appInstance *model.AppInstance) (uint64, error) {
-	id, err := s.store.CreateAppInstance(ctx, appInstance.TenantID, appInstance)
+	app, err := s.apper.GetApp(ctx, appInstance.TenantID, appInstance.AppID)
 	if err != nil {
-		return 0, fmt.Errorf("unable to create appInstance %v: %w", appInstance.ID, err)
+		return 0, fmt.Errorf("unable to get app %v: %w", appInstance.AppID, err)
 	}
 
-	app, err := s.apper.GetApp(ctx, appInstance.TenantID, appInstance.AppID)
+	version, err := s.appVersion(ctx, app, appInstance.AppVersionID)
 	if err != nil {
-		return 0, fmt.Errorf("unable to get app %v: %w", appInstance.AppID, err)
+		return 0, fmt.Errorf("unable to create appInstance: %w", err)
+	}
+	appInstance.AppVersionID = version.ID
+
+	// Only the active app instances count towards the quotas.
+	release := func() {}
+	if appInstance.Status == model.AppInstanceActive {
+		requested := quota_model.Usage{AppInstances: 1, CPU: app.CPURequest, Memory: app.MemoryRequest}
+		release, err = s.quota.CheckQuota(ctx, appInstance.TenantID, appInstance.WorkspaceID, appInstance.UserID, requested)
+		if err != nil {
+			return 0, fmt.Errorf("unable to create appInstance: %w", err)
+		}
+	}
+
+	id, err := s.store.CreateAppInstance(ctx, appInstance.TenantID, appInstance)
+	release()
+	if err != nil {
+		return 0, fmt.Errorf("unable to create appInstance %v: %w", appInstance.ID, err)
 	}
 
 	wsName := s.getWorkspaceName(appInstance.WorkspaceID)
 	wbName := s.getWorkbenchName(appInstance.WorkbenchID)
 
-	err = s.client.CreateAppInstance(wsName, wbName, app.Name, app.GetImage())
+	err = s.runtime.CreateAppInstance(wsName, wbName, s.getAppInstanceName(id), version.GetImage())
 	if err != nil {
+		failed := *appInstance
+		failed.ID = id
+		s.notifyCrash(ctx, &failed, app)
 		return 0, fmt.Errorf("unable to create app instance %v: %w", id, err)
 	}
 
 	return id, nil
 }
 
+// UpgradeAppInstance moves an app instance to a newer version of its app, the
+// default one when none is given, for a member of its workspace. An active
+// app instance is redeployed with the image of the version.
+func (s *AppInstanceService) UpgradeAppInstance(ctx context.Context, tenantID, userID, appInstanceID, appVersionID uint64) error {
+	appInstance, err := s.store.GetAppInstance(ctx, tenantID, appInstanceID)
+	if err != nil {
+		return fmt.Errorf("unable to get appInstance %v: %w", appInstanceID, err)
+	}
+	if err := s.checkMember(ctx, tenantID, appInstance.WorkspaceID, userID); err != nil {
+		return err
+	}
+	if appInstance.Status == model.AppInstanceDeleted {
+		return fmt.Errorf("appInstance %v is deleted: %w", appInstanceID, &common_service.FailedPreconditionErr{})
+	}
+
+	app, err := s.apper.GetApp(ctx, tenantID, appInstance.AppID)
+	if err != nil {
+		return fmt.Errorf("unable to get app %v: %w", appInstance.AppID, err)
+	}
+
+	version, err := s.appVersion(ctx, app, appVersionID)
+	if err != nil {
+		return fmt.Errorf("unable to upgrade appInstance %v: %w", appInstanceID, err)
+	}
+	// The versions of an app are ordered by their creation.
+	if version.ID <= appInstance.AppVersionID {
+		return fmt.Errorf("version %v is not newer than version %v of appInstance %v: %w", version.ID, appInstance.AppVersionID, appInstanceID, &common_service.FailedPreconditionErr{})
+	}
+
+	// Creating the app again in its workbench replaces it.
+	if appInstance.Status == model.AppInstanceActive {
+		err := s.runtime.CreateAppInstance(s.getWorkspaceName(appInstance.WorkspaceID), s.getWorkbenchName(appInstance.WorkbenchID), s.getAppInstanceName(appInstanceID), version.GetImage())
+		if err != nil {
+			return fmt.Errorf("unable to redeploy appInstance %v: %w", appInstanceID, err)
+		}
+	}
+
+	if err := s.store.UpdateAppInstanceVersion(ctx, tenantID, appInstanceID, version.ID); err != nil {
+		return fmt.Errorf("unable to update the version of appInstance %v: %w", appInstanceID, err)
+	}
+
+	return nil
+}
+
+// appVersion returns the version of an app an app instance is deployed with,
+// the default one of the app when none is requested. Only the versions that
+// are not deprecated and are pinned to a digest are deployed.
+func (s *AppInstanceService) appVersion(ctx context.Context, app *app_model.App, appVersionID uint64) (*app_model.AppVersion, error) {
+	if appVersionID == 0 {
+		if app.DefaultVersionID == nil {
+			return nil, fmt.Errorf("app %v has no default version: %w", app.ID, &common_service.FailedPreconditionErr{})
+		}
+		appVersionID = *app.DefaultVersionID
+	}
+
+	version, err := s.apper.GetAppVersion(ctx, app.TenantID, appVersionID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get app version %v: %w", appVersionID, err)
+	}
+	if version.AppID != app.ID {
+		return nil, fmt.Errorf("version %v is not a version of app %v: %w", version.ID, app.ID, &common_service.InvalidParametersErr{})
+	}
+	if version.Deprecated {
+		return nil, fmt.Errorf("version %v of app %v is deprecated: %w", version.ID, app.ID, &common_service.FailedPreconditionErr{})
+	}
+	// The versions registered before the digests were pinned have to be
+	// resolved first.
+	if version.DockerImageDigest == "" {
+		return nil, fmt.Errorf("version %v of app %v has no image digest and has to be resolved: %w", version.ID, app.ID, &common_service.FailedPreconditionErr{})
+	}
+
+	return version, nil
+}
+
+// CheckAppInstancesCrashed notifies the owners of the active app instances
+// whose containers crashed since they were last checked. The owner of an app
+// instance that keeps crashing is notified once, until it runs again.
+func (s *AppInstanceService) CheckAppInstancesCrashed(ctx context.Context) error {
+	appInstances, err := s.store.ListActiveAppInstances(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to query active app instances: %w", err)
+	}
+
+	var failed int
+	for _, appInstance := range appInstances {
+		details, err := s.runtime.AppDetails(ctx, s.getWorkspaceName(appInstance.WorkspaceID), s.getWorkbenchName(appInstance.WorkbenchID), s.getAppInstanceName(appInstance.ID))
+		if err != nil {
+			failed++
+			logger.TechLog.Error(ctx, "unable to get the runtime details of app instance", logger.WithAppInstanceIDField(appInstance.ID), zap.Error(err))
+			continue
+		}
+		s.checkCrashed(ctx, appInstance, details)
+	}
+
+	if failed != 0 {
+		return fmt.Errorf("unable to check %v of %v active app instances", failed, len(appInstances))
+	}
+	return nil
+}
+
+// checkCrashed records the restarts of an app instance and notifies its
+// owner when it crashed, unless it was already notified or recorded.
+func (s *AppInstanceService) checkCrashed(ctx context.Context, appInstance *model.AppInstance, details *runtime.Details) {
+	restarts, crashing := details.Restarts()
+	ready, _ := details.Started()
+
+	notify := false
+	switch {
+	case restarts < appInstance.Restarts:
+		// The restarts start over when the app instance is redeployed.
+		crashing = false
+	case restarts > appInstance.Restarts && crashing:
+		notify = !appInstance.Crashing
+	case restarts > appInstance.Restarts:
+		crashing = appInstance.Crashing
+	case appInstance.Crashing && ready:
+		crashing = false
+	default:
+		return
+	}
+
+	set, err := s.store.SetAppInstanceRestarts(ctx, appInstance, restarts, crashing)
+	if err != nil {
+		logger.TechLog.Error(ctx, "unable to record the restarts of app instance", logger.WithAppInstanceIDField(appInstance.ID), zap.Error(err))
+		return
+	}
+	if !set || !notify {
+		return
+	}
+
+	app, err := s.apper.GetApp(ctx, appInstance.TenantID, appInstance.AppID)
+	if err != nil {
+		logger.TechLog.Error(ctx, "unable to get the app of crashed app instance", logger.WithAppInstanceIDField(appInstance.ID), zap.Error(err))
+		return
+	}
+	s.notifyCrash(ctx, appInstance, app)
+}
+
+// notifyCrash notifies the owner of the app instance that it failed to be
+// deployed or crashed, and publishes the failure.
+func (s *AppInstanceService) notifyCrash(ctx context.Context, appInstance *model.AppInstance, app *app_model.App) {
+	n := notification.NewNotificationBuilder(uuid.Next(), appInstance.TenantID).
+		WithType(notification_model.NotificationTypeAppInstanceCrashed).
+		WithLink(notification.WorkbenchLink(appInstance.WorkspaceID, appInstance.WorkbenchID)).
+		WithParam("app", app.Name).
+		Build()
+	notification.Notify(ctx, s.notifier, n, appInstance.UserID)
+
+	webhook.Publish(ctx, s.publisher, webhook.NewEvent(appInstance.TenantID, webhook_model.EventAppInstanceFailed, webhook_model.EventData{
+		"appInstanceId": appInstance.ID,
+		"appId":         app.ID,
+		"app":           app.Name,
+		"workspaceId":   appInstance.WorkspaceID,
+		"workbenchId":   appInstance.WorkbenchID,
+		"userId":        appInstance.UserID,
+	}))
+}
+
+// checkActivation checks the quotas when an inactive app instance is
+// activated. The quotas stay locked until the returned function is called.
+func (s *AppInstanceService) checkActivation(ctx context.Context, appInstance *model.AppInstance) (func(), error) {
+	current, err := s.store.GetAppInstance(ctx, appInstance.TenantID, appInstance.ID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get appInstance %v: %w", appInstance.ID, err)
+	}
+	if current.Status == model.AppInstanceActive {
+		return func() {}, nil
+	}
+
+	app, err := s.apper.GetApp(ctx, current.TenantID, current.AppID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get app %v: %w", current.AppID, err)
+	}
+
+	requested := quota_model.Usage{AppInstances: 1, CPU: app.CPURequest, Memory: app.MemoryRequest}
+	return s.quota.CheckQuota(ctx, current.TenantID, current.WorkspaceID, current.UserID, requested)
+}
+
+// checkMember checks that a user is a member of a workspace.
+func (s *AppInstanceService) checkMember(ctx context.Context, tenantID, workspaceID, userID uint64) error {
+	isMember, err := s.members.IsWorkspaceMember(ctx, tenantID, workspaceID, userID)
+	if err != nil {
+		return fmt.Errorf("unable to check membership of workspace %v: %w", workspaceID, err)
+	}
+	if !isMember {
+		return fmt.Errorf("user %v is not a member of workspace %v: %w", userID, workspaceID, &common_service.PermissionDeniedErr{})
+	}
+	return nil
+}
+
 func (s *AppInstanceService) getWorkspaceName(id uint64) string {
 	return fmt.Sprintf("workspace%v", id)
 }
@@ -103,3 +442,9 @@ func (s *AppInstanceService) getWorkspaceName(id uint64) string {
 func (s *AppInstanceService) getWorkbenchName(id uint64) string {
 	return fmt.Sprintf("workbench%v", id)
 }
+
+// getAppInstanceName returns the name of an app instance in its workbench,
+// where several instances of the same app can run.
+func (s *AppInstanceService) getAppInstanceName(id uint64) string {
+	return fmt.Sprintf("app-instance%v", id)
+}
@@ -0,0 +1,262 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/client/runtime"
+	"github.com/CHORUS-TRE/chorus-backend/internal/config"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/app-instance/model"
+	app_model "github.com/CHORUS-TRE/chorus-backend/pkg/app/model"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/app/service"
+	common_service "github.com/CHORUS-TRE/chorus-backend/pkg/common/service"
+	notification_model "github.com/CHORUS-TRE/chorus-backend/pkg/notification/model"
+	notification_service "github.com/CHORUS-TRE/chorus-backend/pkg/notification/service"
+	quota_model "github.com/CHORUS-TRE/chorus-backend/pkg/quota/model"
+	webhook_model "github.com/CHORUS-TRE/chorus-backend/pkg/webhook/model"
+	"github.com/CHORUS-TRE/chorus-backend/tests/unit"
+)
+
+type appInstanceStore struct {
+	AppInstanceStore
+
+	appInstances map[uint64]*model.AppInstance
+}
+
+func (s *appInstanceStore) CreateAppInstance(ctx context.Context, tenantID uint64, appInstance *model.AppInstance) (uint64, error) {
+	id := uint64(len(s.appInstances) + 1)
+	created := *appInstance
+	created.ID = id
+	s.appInstances[id] = &created
+	return id, nil
+}
+
+func (s *appInstanceStore) GetAppInstance(ctx context.Context, tenantID uint64, appInstanceID uint64) (*model.AppInstance, error) {
+	return s.appInstances[appInstanceID], nil
+}
+
+func (s *appInstanceStore) DeleteAppInstance(ctx context.Context, tenantID uint64, appInstanceID uint64) error {
+	return nil
+}
+
+func (s *appInstanceStore) ListActiveAppInstances(ctx context.Context) ([]*model.AppInstance, error) {
+	var appInstances []*model.AppInstance
+	for _, appInstance := range s.appInstances {
+		if appInstance.Status == model.AppInstanceActive {
+			read := *appInstance
+			appInstances = append(appInstances, &read)
+		}
+	}
+	return appInstances, nil
+}
+
+func (s *appInstanceStore) SetAppInstanceRestarts(ctx context.Context, appInstance *model.AppInstance, restarts int32, crashing bool) (bool, error) {
+	current := s.appInstances[appInstance.ID]
+	if current.Restarts != appInstance.Restarts || current.Crashing != appInstance.Crashing {
+		return false, nil
+	}
+	current.Restarts, current.Crashing = restarts, crashing
+	return true, nil
+}
+
+func (s *appInstanceStore) UpdateAppInstanceVersion(ctx context.Context, tenantID, appInstanceID, appVersionID uint64) error {
+	s.appInstances[appInstanceID].AppVersionID = appVersionID
+	return nil
+}
+
+type apper struct {
+	service.Apper
+}
+
+func (apper) GetApp(ctx context.Context, tenantID, appID uint64) (*app_model.App, error) {
+	defaultVersionID := uint64(1)
+	return &app_model.App{ID: appID, TenantID: tenantID, Name: "jupyter", DefaultVersionID: &defaultVersionID}, nil
+}
+
+func (apper) GetAppVersion(ctx context.Context, tenantID, appVersionID uint64) (*app_model.AppVersion, error) {
+	return &app_model.AppVersion{ID: appVersionID, AppID: 1, DockerImageName: "jupyter", DockerImageDigest: fmt.Sprintf("sha256:%v", appVersionID)}, nil
+}
+
+type quotaChecker struct{}
+
+func (quotaChecker) CheckQuota(ctx context.Context, tenantID, workspaceID, userID uint64, requested quota_model.Usage) (func(), error) {
+	return func() {}, nil
+}
+
+type appRuntime struct {
+	runtime.WorkbenchRuntime
+
+	apps    map[string]string
+	details map[string]*runtime.Details
+}
+
+func (r *appRuntime) CreateAppInstance(namespace, workbenchName, appName, appImage string) error {
+	r.apps[appName] = appImage
+	return nil
+}
+
+func (r *appRuntime) DeleteApp(namespace, workbenchName, appName string) error {
+	delete(r.apps, appName)
+	return nil
+}
+
+func (r *appRuntime) AppDetails(ctx context.Context, namespace, workbenchName, appName string) (*runtime.Details, error) {
+	if details, ok := r.details[appName]; ok {
+		return details, nil
+	}
+	return &runtime.Details{Pods: []runtime.PodDetails{{Name: appName}}}, nil
+}
+
+type members struct {
+	userIDs []uint64
+}
+
+func (m *members) IsWorkspaceMember(ctx context.Context, tenantID, workspaceID, userID uint64) (bool, error) {
+	return slices.Contains(m.userIDs, userID), nil
+}
+
+type notifier struct {
+	types []notification_model.NotificationType
+}
+
+func (n *notifier) CreateNotification(ctx context.Context, req notification_service.CreateNotificationRequest) error {
+	n.types = append(n.types, req.Notification.Type)
+	return nil
+}
+
+type publisher struct {
+	types []webhook_model.EventType
+}
+
+func (p *publisher) PublishEvent(ctx context.Context, event *webhook_model.Event) error {
+	p.types = append(p.types, event.Type)
+	return nil
+}
+
+func TestCreateAppInstance_SameApp(t *testing.T) {
+	store := &appInstanceStore{appInstances: map[uint64]*model.AppInstance{}}
+	rt := &appRuntime{apps: map[string]string{}}
+	s := NewAppInstanceService(config.Config{}, store, rt, apper{}, quotaChecker{}, nil, nil, nil)
+
+	for i := 0; i < 2; i++ {
+		_, err := s.CreateAppInstance(context.Background(), &model.AppInstance{
+			TenantID: 1, AppID: 1, WorkspaceID: 2, WorkbenchID: 3, Status: model.AppInstanceActive,
+		})
+		require.NoError(t, err)
+	}
+	require.Len(t, rt.apps, 2, "the instances of an app do not replace each other")
+
+	require.NoError(t, s.DeleteAppInstance(context.Background(), 1, 1))
+	require.Equal(t, map[string]string{"app-instance2": "jupyter@sha256:1"}, rt.apps, "deleting an instance keeps the other one")
+}
+
+func TestGetAppInstanceRuntime(t *testing.T) {
+	store := &appInstanceStore{appInstances: map[uint64]*model.AppInstance{1: {ID: 1, TenantID: 1, WorkspaceID: 2, WorkbenchID: 3}}}
+	s := NewAppInstanceService(config.Config{}, store, &appRuntime{}, apper{}, quotaChecker{}, &members{userIDs: []uint64{4}}, nil, nil)
+
+	details, err := s.GetAppInstanceRuntime(context.Background(), 1, 4, 1)
+	require.NoError(t, err)
+	require.Equal(t, "app-instance1", details.Pods[0].Name)
+
+	_, err = s.GetAppInstanceRuntime(context.Background(), 1, 5, 1)
+	require.ErrorAs(t, err, new(*common_service.PermissionDeniedErr), "a user outside the workspace cannot see the runtime state")
+}
+
+func TestUpgradeAppInstance(t *testing.T) {
+	store := &appInstanceStore{appInstances: map[uint64]*model.AppInstance{
+		1: {ID: 1, TenantID: 1, AppID: 1, AppVersionID: 1, WorkspaceID: 2, WorkbenchID: 3, Status: model.AppInstanceActive},
+	}}
+	rt := &appRuntime{apps: map[string]string{"app-instance1": "jupyter@sha256:1"}}
+	s := NewAppInstanceService(config.Config{}, store, rt, apper{}, quotaChecker{}, &members{userIDs: []uint64{4}}, nil, nil)
+
+	err := s.UpgradeAppInstance(context.Background(), 1, 5, 1, 2)
+	require.ErrorAs(t, err, new(*common_service.PermissionDeniedErr), "a user outside the workspace cannot upgrade the app instance")
+	require.Equal(t, uint64(1), store.appInstances[1].AppVersionID)
+	require.Equal(t, "jupyter@sha256:1", rt.apps["app-instance1"])
+
+	require.NoError(t, s.UpgradeAppInstance(context.Background(), 1, 4, 1, 2))
+	require.Equal(t, uint64(2), store.appInstances[1].AppVersionID)
+	require.Equal(t, "jupyter@sha256:2", rt.apps["app-instance1"], "the active app instance is redeployed")
+
+	err = s.UpgradeAppInstance(context.Background(), 1, 4, 1, 1)
+	require.ErrorAs(t, err, new(*common_service.FailedPreconditionErr), "an app instance is not downgraded")
+}
+
+func TestCheckAppInstancesCrashed(t *testing.T) {
+	unit.InitTestLogger()
+
+	pod := func(containers ...runtime.ContainerDetails) *runtime.Details {
+		return &runtime.Details{Pods: []runtime.PodDetails{{Phase: "Running", Containers: containers}}}
+	}
+	failed := &runtime.Termination{Reason: "Error", ExitCode: 1}
+
+	store := &appInstanceStore{appInstances: map[uint64]*model.AppInstance{
+		1: {ID: 1, TenantID: 1, AppID: 1, Status: model.AppInstanceActive},
+		2: {ID: 2, TenantID: 1, AppID: 1, Status: model.AppInstanceActive},
+		3: {ID: 3, TenantID: 1, AppID: 1, Status: model.AppInstanceInactive},
+	}}
+	rt := &appRuntime{details: map[string]*runtime.Details{
+		"app-instance2": pod(runtime.ContainerDetails{Ready: true, RestartCount: 1, LastTermination: &runtime.Termination{Reason: "Completed"}}),
+		"app-instance3": pod(runtime.ContainerDetails{Reason: runtime.ReasonCrashLoopBackOff, RestartCount: 1, LastTermination: failed}),
+	}}
+	n, p := &notifier{}, &publisher{}
+	s := NewAppInstanceService(config.Config{}, store, rt, apper{}, quotaChecker{}, nil, n, p)
+
+	steps := []struct {
+		name            string
+		details         *runtime.Details
+		expectsRestarts int32
+		expectsCrashing bool
+		expectsNotified int
+	}{
+		{
+			name:            "a container restarted after an error, its owner is notified",
+			details:         pod(runtime.ContainerDetails{Ready: true, RestartCount: 1, LastTermination: failed}),
+			expectsRestarts: 1,
+			expectsCrashing: true,
+			expectsNotified: 1,
+		},
+		{
+			name:            "a container keeps crashing, its owner is not notified again",
+			details:         pod(runtime.ContainerDetails{Reason: runtime.ReasonCrashLoopBackOff, RestartCount: 3, LastTermination: failed}),
+			expectsRestarts: 3,
+			expectsCrashing: true,
+			expectsNotified: 1,
+		},
+		{
+			name:            "the containers run again",
+			details:         pod(runtime.ContainerDetails{Ready: true, RestartCount: 3, LastTermination: failed}),
+			expectsRestarts: 3,
+			expectsNotified: 1,
+		},
+		{
+			name:            "a container crashed again, its owner is notified",
+			details:         pod(runtime.ContainerDetails{Reason: runtime.ReasonCrashLoopBackOff, RestartCount: 4, LastTermination: failed}),
+			expectsRestarts: 4,
+			expectsCrashing: true,
+			expectsNotified: 2,
+		},
+		{
+			name:            "the app instance is redeployed",
+			details:         pod(runtime.ContainerDetails{}),
+			expectsNotified: 2,
+		},
+	}
+
+	for _, step := range steps {
+		rt.details["app-instance1"] = step.details
+		require.NoError(t, s.CheckAppInstancesCrashed(context.Background()), step.name)
+		require.Equal(t, step.expectsRestarts, store.appInstances[1].Restarts, step.name)
+		require.Equal(t, step.expectsCrashing, store.appInstances[1].Crashing, step.name)
+		require.Len(t, n.types, step.expectsNotified, step.name)
+	}
+	require.Equal(t, []notification_model.NotificationType{notification_model.NotificationTypeAppInstanceCrashed, notification_model.NotificationTypeAppInstanceCrashed}, n.types)
+	require.Equal(t, []webhook_model.EventType{webhook_model.EventAppInstanceFailed, webhook_model.EventAppInstanceFailed}, p.types)
+	require.Equal(t, int32(1), store.appInstances[2].Restarts, "a container that completed did not crash")
+	require.False(t, store.appInstances[2].Crashing)
+	require.Zero(t, store.appInstances[3].Restarts, "the inactive app instances are not checked")
+}
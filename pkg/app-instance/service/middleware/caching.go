@@ -2,26 +2,26 @@ package middleware
 
 import (
 	"context"
+	"time"
 
+	"github.com/CHORUS-TRE/chorus-backend/internal/client/runtime"
 	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
 	"github.com/CHORUS-TRE/chorus-backend/internal/utils/cache"
+	"github.com/CHORUS-TRE/chorus-backend/internal/utils/pagination"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/app-instance/model"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/app-instance/service"
 	common_model "github.com/CHORUS-TRE/chorus-backend/pkg/common/model"
-
-	"github.com/coocood/freecache"
 )
 
 const (
-	appInstanceCacheSize   = 100 * 1024 * 1024 // Max 100MiB stored in memory
 	defaultCacheExpiration = 5
 	longCacheExpiration    = 60
 )
 
-func AppInstanceCaching(log *logger.ContextLogger) func(service.AppInstanceer) *Caching {
+func AppInstanceCaching(log *logger.ContextLogger, backend cache.Backend) func(service.AppInstanceer) *Caching {
 	return func(next service.AppInstanceer) *Caching {
 		return &Caching{
-			cache: cache.NewCache(freecache.NewCache(appInstanceCacheSize), log),
+			cache: cache.NewCache(backend, log),
 			next:  next,
 		}
 	}
@@ -32,14 +32,15 @@ type Caching struct {
 	next  service.AppInstanceer
 }
 
-func (c *Caching) ListAppInstances(ctx context.Context, tenantID uint64, pagination common_model.Pagination) (reply []*model.AppInstance, err error) {
-	entry := c.cache.NewEntry(cache.WithUint64(tenantID), cache.WithInterface(pagination))
+func (c *Caching) ListAppInstances(ctx context.Context, req service.ListAppInstancesReq) (reply []*model.AppInstance, cursor *pagination.ResponseCursor[pagination.KeysetCursor], count uint64, err error) {
+	entry := c.cache.NewEntry(cache.WithInterface(req)).WithTags(cache.TenantTag(req.TenantID))
 	reply = []*model.AppInstance{}
+	cursor = &pagination.ResponseCursor[pagination.KeysetCursor]{}
 
-	if ok := entry.Get(ctx, &reply); !ok {
-		reply, err = c.next.ListAppInstances(ctx, tenantID, pagination)
+	if ok := entry.Get(ctx, &reply, &cursor, &count); !ok {
+		reply, cursor, count, err = c.next.ListAppInstances(ctx, req)
 		if err == nil {
-			entry.Set(ctx, defaultCacheExpiration, reply)
+			entry.Set(ctx, defaultCacheExpiration, reply, cursor, count)
 		}
 	}
 
@@ -47,7 +48,7 @@ func (c *Caching) ListAppInstances(ctx context.Context, tenantID uint64, paginat
 }
 
 func (c *Caching) GetAppInstance(ctx context.Context, tenantID, appInstanceID uint64) (reply *model.AppInstance, err error) {
-	entry := c.cache.NewEntry(cache.WithUint64(tenantID), cache.WithUint64(appInstanceID))
+	entry := c.cache.NewEntry(cache.WithUint64(tenantID), cache.WithUint64(appInstanceID)).WithTags(cache.TenantTag(tenantID))
 	reply = &model.AppInstance{}
 
 	if ok := entry.Get(ctx, &reply); !ok {
@@ -60,14 +61,54 @@ func (c *Caching) GetAppInstance(ctx context.Context, tenantID, appInstanceID ui
 	return
 }
 
+// GetAppInstanceRuntime is not cached as it reports the live state of the
+// runtime.
+func (c *Caching) GetAppInstanceRuntime(ctx context.Context, tenantID, userID, appInstanceID uint64) (*runtime.Details, error) {
+	return c.next.GetAppInstanceRuntime(ctx, tenantID, userID, appInstanceID)
+}
+
 func (c *Caching) DeleteAppInstance(ctx context.Context, tenantID, appInstanceID uint64) error {
-	return c.next.DeleteAppInstance(ctx, tenantID, appInstanceID)
+	err := c.next.DeleteAppInstance(ctx, tenantID, appInstanceID)
+	c.cache.Invalidate(ctx, cache.TenantTag(tenantID))
+	return err
+}
+
+func (c *Caching) StreamAppInstanceLogs(ctx context.Context, req service.StreamAppInstanceLogsReq, send func(line string) error) error {
+	return c.next.StreamAppInstanceLogs(ctx, req, send)
+}
+
+func (c *Caching) RestoreAppInstance(ctx context.Context, tenantID, appInstanceID uint64) error {
+	err := c.next.RestoreAppInstance(ctx, tenantID, appInstanceID)
+	c.cache.Invalidate(ctx, cache.TenantTag(tenantID))
+	return err
+}
+
+func (c *Caching) UpgradeAppInstance(ctx context.Context, tenantID, userID, appInstanceID, appVersionID uint64) error {
+	err := c.next.UpgradeAppInstance(ctx, tenantID, userID, appInstanceID, appVersionID)
+	c.cache.Invalidate(ctx, cache.TenantTag(tenantID))
+	return err
+}
+
+func (c *Caching) PurgeAppInstances(ctx context.Context, deletedBefore time.Time) (common_model.Purged, error) {
+	purged, err := c.next.PurgeAppInstances(ctx, deletedBefore)
+	for tenantID := range purged {
+		c.cache.Invalidate(ctx, cache.TenantTag(tenantID))
+	}
+	return purged, err
 }
 
 func (c *Caching) UpdateAppInstance(ctx context.Context, appInstance *model.AppInstance) error {
-	return c.next.UpdateAppInstance(ctx, appInstance)
+	err := c.next.UpdateAppInstance(ctx, appInstance)
+	c.cache.Invalidate(ctx, cache.TenantTag(appInstance.TenantID))
+	return err
 }
 
 func (c *Caching) CreateAppInstance(ctx context.Context, appInstance *model.AppInstance) (uint64, error) {
-	return c.next.CreateAppInstance(ctx, appInstance)
+	id, err := c.next.CreateAppInstance(ctx, appInstance)
+	c.cache.Invalidate(ctx, cache.TenantTag(appInstance.TenantID))
+	return id, err
+}
+
+func (c *Caching) CheckAppInstancesCrashed(ctx context.Context) error {
+	return c.next.CheckAppInstancesCrashed(ctx)
 }
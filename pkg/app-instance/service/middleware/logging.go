@@ -7,7 +7,9 @@ import (
 
 	"go.uber.org/zap"
 
+	"github.com/CHORUS-TRE/chorus-backend/internal/client/runtime"
 	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
+	"github.com/CHORUS-TRE/chorus-backend/internal/utils/pagination"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/app-instance/model"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/app-instance/service"
 	common_model "github.com/CHORUS-TRE/chorus-backend/pkg/common/model"
@@ -27,23 +29,24 @@ func Logging(logger *logger.ContextLogger) func(service.AppInstanceer) service.A
 	}
 }
 
-func (c appInstanceServiceLogging) ListAppInstances(ctx context.Context, tenantID uint64, pagination common_model.Pagination) ([]*model.AppInstance, error) {
+func (c appInstanceServiceLogging) ListAppInstances(ctx context.Context, req service.ListAppInstancesReq) ([]*model.AppInstance, *pagination.ResponseCursor[pagination.KeysetCursor], uint64, error) {
 	now := time.Now()
 
-	res, err := c.next.ListAppInstances(ctx, tenantID, pagination)
+	res, cursor, count, err := c.next.ListAppInstances(ctx, req)
 	if err != nil {
 		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
 			zap.Error(err),
 			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
 		)
-		return res, fmt.Errorf("unable to get appInstances: %w", err)
+		return res, cursor, count, fmt.Errorf("unable to get app instances: %w", err)
 	}
 
 	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
-		zap.Int("num_appInstances", len(res)),
+		zap.Int("num_app_instances", len(res)),
+		logger.WithTotalItemsField(count),
 		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
 	)
-	return res, nil
+	return res, cursor, count, nil
 }
 
 func (c appInstanceServiceLogging) GetAppInstance(ctx context.Context, tenantID, appInstanceID uint64) (*model.AppInstance, error) {
@@ -66,6 +69,27 @@ func (c appInstanceServiceLogging) GetAppInstance(ctx context.Context, tenantID,
 	return res, nil
 }
 
+func (c appInstanceServiceLogging) GetAppInstanceRuntime(ctx context.Context, tenantID, userID, appInstanceID uint64) (*runtime.Details, error) {
+	now := time.Now()
+
+	res, err := c.next.GetAppInstanceRuntime(ctx, tenantID, userID, appInstanceID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			logger.WithAppInstanceIDField(appInstanceID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return res, fmt.Errorf("unable to get appInstance runtime: %w", err)
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		logger.WithAppInstanceIDField(appInstanceID),
+		zap.Int("num_pods", len(res.Pods)),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, nil
+}
+
 func (c appInstanceServiceLogging) DeleteAppInstance(ctx context.Context, tenantID, appInstanceID uint64) error {
 	now := time.Now()
 
@@ -86,6 +110,94 @@ func (c appInstanceServiceLogging) DeleteAppInstance(ctx context.Context, tenant
 	return nil
 }
 
+func (c appInstanceServiceLogging) StreamAppInstanceLogs(ctx context.Context, req service.StreamAppInstanceLogsReq, send func(line string) error) error {
+	now := time.Now()
+
+	var lines int
+	err := c.next.StreamAppInstanceLogs(ctx, req, func(line string) error {
+		lines++
+		return send(line)
+	})
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			logger.WithAppInstanceIDField(req.AppInstanceID),
+			logger.WithUserIDField(req.UserID),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return fmt.Errorf("unable to stream appInstance logs: %w", err)
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		logger.WithAppInstanceIDField(req.AppInstanceID),
+		logger.WithUserIDField(req.UserID),
+		zap.Int("num_lines", lines),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return nil
+}
+
+func (c appInstanceServiceLogging) RestoreAppInstance(ctx context.Context, tenantID, appInstanceID uint64) error {
+	now := time.Now()
+
+	err := c.next.RestoreAppInstance(ctx, tenantID, appInstanceID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			logger.WithAppInstanceIDField(appInstanceID),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return fmt.Errorf("unable to restore appInstance: %w", err)
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		logger.WithAppInstanceIDField(appInstanceID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return nil
+}
+
+func (c appInstanceServiceLogging) UpgradeAppInstance(ctx context.Context, tenantID, userID, appInstanceID, appVersionID uint64) error {
+	now := time.Now()
+
+	err := c.next.UpgradeAppInstance(ctx, tenantID, userID, appInstanceID, appVersionID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			logger.WithAppInstanceIDField(appInstanceID),
+			logger.WithAppVersionIDField(appVersionID),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return fmt.Errorf("unable to upgrade appInstance: %w", err)
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		logger.WithAppInstanceIDField(appInstanceID),
+		logger.WithAppVersionIDField(appVersionID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return nil
+}
+
+func (c appInstanceServiceLogging) PurgeAppInstances(ctx context.Context, deletedBefore time.Time) (common_model.Purged, error) {
+	now := time.Now()
+
+	purged, err := c.next.PurgeAppInstances(ctx, deletedBefore)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return purged, fmt.Errorf("unable to purge app instances: %w", err)
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		zap.Int64("num_purged", purged.Total()),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return purged, nil
+}
+
 func (c appInstanceServiceLogging) UpdateAppInstance(ctx context.Context, appInstance *model.AppInstance) error {
 	now := time.Now()
 
@@ -124,3 +236,21 @@ func (c appInstanceServiceLogging) CreateAppInstance(ctx context.Context, appIns
 	)
 	return appInstanceId, nil
 }
+
+func (c appInstanceServiceLogging) CheckAppInstancesCrashed(ctx context.Context) error {
+	now := time.Now()
+
+	err := c.next.CheckAppInstancesCrashed(ctx)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return fmt.Errorf("unable to check crashed app instances: %w", err)
+	}
+
+	c.logger.Debug(ctx, logger.LoggerMessageRequestCompleted,
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return nil
+}
@@ -2,10 +2,15 @@ package middleware
 
 import (
 	"context"
+	"fmt"
+	"time"
 
+	"github.com/CHORUS-TRE/chorus-backend/internal/client/runtime"
+	"github.com/CHORUS-TRE/chorus-backend/internal/utils/pagination"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/app-instance/model"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/app-instance/service"
 	common_model "github.com/CHORUS-TRE/chorus-backend/pkg/common/model"
+	common_service "github.com/CHORUS-TRE/chorus-backend/pkg/common/service"
 
 	val "github.com/go-playground/validator/v10"
 )
@@ -24,21 +29,47 @@ func Validation(validate *val.Validate) func(service.AppInstanceer) service.AppI
 	}
 }
 
-func (v validation) ListAppInstances(ctx context.Context, tenantID uint64, pagination common_model.Pagination) ([]*model.AppInstance, error) {
-	if err := v.validate.Struct(pagination); err != nil {
-		return nil, err
+func (v validation) ListAppInstances(ctx context.Context, req service.ListAppInstancesReq) ([]*model.AppInstance, *pagination.ResponseCursor[pagination.KeysetCursor], uint64, error) {
+	if err := v.validate.Struct(req); err != nil {
+		return nil, nil, 0, err
 	}
-	return v.next.ListAppInstances(ctx, tenantID, pagination)
+	if req.Cursor != nil && req.Cursor.PageSize > service.MaxAppInstancesPageSize {
+		return nil, nil, 0, fmt.Errorf("page size must not exceed %v: %w", service.MaxAppInstancesPageSize, &common_service.InvalidParametersErr{})
+	}
+	return v.next.ListAppInstances(ctx, req)
 }
 
 func (v validation) GetAppInstance(ctx context.Context, tenantID, appInstanceID uint64) (*model.AppInstance, error) {
 	return v.next.GetAppInstance(ctx, tenantID, appInstanceID)
 }
 
+func (v validation) GetAppInstanceRuntime(ctx context.Context, tenantID, userID, appInstanceID uint64) (*runtime.Details, error) {
+	return v.next.GetAppInstanceRuntime(ctx, tenantID, userID, appInstanceID)
+}
+
 func (v validation) DeleteAppInstance(ctx context.Context, tenantID, appInstanceID uint64) error {
 	return v.next.DeleteAppInstance(ctx, tenantID, appInstanceID)
 }
 
+func (v validation) StreamAppInstanceLogs(ctx context.Context, req service.StreamAppInstanceLogsReq, send func(line string) error) error {
+	if err := v.validate.Struct(req); err != nil {
+		return err
+	}
+	return v.next.StreamAppInstanceLogs(ctx, req, send)
+}
+
+func (v validation) RestoreAppInstance(ctx context.Context, tenantID, appInstanceID uint64) error {
+	return v.next.RestoreAppInstance(ctx, tenantID, appInstanceID)
+}
+
+func (v validation) UpgradeAppInstance(ctx context.Context, tenantID, userID, appInstanceID, appVersionID uint64) error {
+	return v.next.UpgradeAppInstance(ctx, tenantID, userID, appInstanceID, appVersionID)
+}
+
+func (v validation) PurgeAppInstances(ctx context.Context, deletedBefore time.Time) (common_model.Purged, error) {
+	return v.next.PurgeAppInstances(ctx, deletedBefore)
+}
+
 func (v validation) UpdateAppInstance(ctx context.Context, appInstance *model.AppInstance) error {
 	if err := v.validate.Struct(appInstance); err != nil {
 		return v.next.UpdateAppInstance(ctx, appInstance)
@@ -52,3 +83,7 @@ func (v validation) CreateAppInstance(ctx context.Context, appInstance *model.Ap
 	}
 	return v.next.CreateAppInstance(ctx, appInstance)
 }
+
+func (v validation) CheckAppInstancesCrashed(ctx context.Context) error {
+	return v.next.CheckAppInstancesCrashed(ctx)
+}
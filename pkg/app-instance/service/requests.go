@@ -0,0 +1,60 @@
+package service
+
+import (
+	"time"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/client/runtime"
+	"github.com/CHORUS-TRE/chorus-backend/internal/utils/pagination"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/app-instance/model"
+	common_model "github.com/CHORUS-TRE/chorus-backend/pkg/common/model"
+)
+
+// MaxAppInstancesPageSize is the maximum number of app instances that can be requested
+// in a single page.
+const MaxAppInstancesPageSize = 500
+
+type ListAppInstancesReq struct {
+	TenantID uint64
+	Cursor   *pagination.RequestCursor[pagination.KeysetCursor]
+	Filter   AppInstanceFilter
+	Sort     Sort `validate:"required"`
+}
+
+type AppInstanceFilter struct {
+	Status  string `validate:"omitempty,oneof=active inactive deleted"`
+	OwnerID uint64
+}
+
+type Sort struct {
+	SortOrder string `validate:"required,oneof=DESC ASC"`
+	SortType  string `validate:"required,oneof=ID CREATEDAT"`
+}
+
+func (s Sort) ToBusinessSort() common_model.Sort {
+	return common_model.Sort{
+		SortOrder: s.SortOrder,
+		SortType:  s.SortType,
+	}
+}
+
+func (f AppInstanceFilter) ToBusinessFilter() model.AppInstanceFilter {
+	return model.AppInstanceFilter{
+		Status:  model.AppInstanceStatus(f.Status),
+		OwnerID: f.OwnerID,
+	}
+}
+
+// StreamAppInstanceLogsReq selects the logs of an app instance, which are
+// only streamed to the members of its workspace.
+type StreamAppInstanceLogsReq struct {
+	TenantID      uint64 `validate:"required"`
+	UserID        uint64 `validate:"required"`
+	AppInstanceID uint64 `validate:"required"`
+	Follow        bool
+	TailLines     int64 `validate:"gte=0,lte=10000"`
+	SinceTime     time.Time
+}
+
+func (r StreamAppInstanceLogsReq) LogOptions() runtime.LogOptions {
+	return runtime.LogOptions{Follow: r.Follow, TailLines: r.TailLines, SinceTime: r.SinceTime}
+}
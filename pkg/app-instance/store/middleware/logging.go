@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
+	"github.com/CHORUS-TRE/chorus-backend/internal/utils/pagination"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/app-instance/model"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/app-instance/service"
 	common_model "github.com/CHORUS-TRE/chorus-backend/pkg/common/model"
@@ -26,25 +27,26 @@ func Logging(logger *logger.ContextLogger) func(service.AppInstanceStore) servic
 	}
 }
 
-func (c appInstanceStorageLogging) ListAppInstances(ctx context.Context, tenantID uint64, pagination common_model.Pagination) ([]*model.AppInstance, error) {
+func (c appInstanceStorageLogging) ListAppInstances(ctx context.Context, tenantID uint64, filter model.AppInstanceFilter, sort common_model.Sort, cursor *pagination.RequestCursor[pagination.KeysetCursor]) ([]*model.AppInstance, *pagination.ResponseCursor[pagination.KeysetCursor], uint64, error) {
 	c.logger.Debug(ctx, "request started")
 
 	now := time.Now()
 
-	res, err := c.next.ListAppInstances(ctx, tenantID, pagination)
+	res, resCursor, count, err := c.next.ListAppInstances(ctx, tenantID, filter, sort, cursor)
 	if err != nil {
 		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
 			zap.Error(err),
 			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
 		)
-		return nil, err
+		return nil, nil, 0, err
 	}
 
 	c.logger.Debug(ctx, "request completed",
 		logger.WithCountField(len(res)),
+		logger.WithTotalItemsField(count),
 		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
 	)
-	return res, nil
+	return res, resCursor, count, nil
 }
 
 func (c appInstanceStorageLogging) GetAppInstance(ctx context.Context, tenantID uint64, appInstanceID uint64) (*model.AppInstance, error) {
@@ -88,6 +90,45 @@ func (c appInstanceStorageLogging) DeleteAppInstance(ctx context.Context, tenant
 	return nil
 }
 
+func (c appInstanceStorageLogging) RestoreAppInstance(ctx context.Context, tenantID, appInstanceID uint64, deletedAfter time.Time) error {
+	c.logger.Debug(ctx, "request started")
+	now := time.Now()
+
+	err := c.next.RestoreAppInstance(ctx, tenantID, appInstanceID, deletedAfter)
+	if err != nil {
+		c.logger.Error(ctx, "request completed",
+			logger.WithAppInstanceIDField(appInstanceID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return err
+	}
+	c.logger.Debug(ctx, "request completed",
+		logger.WithAppInstanceIDField(appInstanceID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return nil
+}
+
+func (c appInstanceStorageLogging) PurgeAppInstances(ctx context.Context, deletedBefore time.Time) (common_model.Purged, error) {
+	c.logger.Debug(ctx, "request started")
+	now := time.Now()
+
+	purged, err := c.next.PurgeAppInstances(ctx, deletedBefore)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return purged, err
+	}
+	c.logger.Debug(ctx, "request completed",
+		zap.Int64("num_purged", purged.Total()),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return purged, nil
+}
+
 func (c appInstanceStorageLogging) UpdateAppInstance(ctx context.Context, tenantID uint64, appInstance *model.AppInstance) error {
 	c.logger.Debug(ctx, "request started")
 	now := time.Now()
@@ -128,3 +169,65 @@ func (c appInstanceStorageLogging) CreateAppInstance(ctx context.Context, tenant
 	)
 	return appInstanceId, nil
 }
+
+func (c appInstanceStorageLogging) UpdateAppInstanceVersion(ctx context.Context, tenantID, appInstanceID, appVersionID uint64) error {
+	c.logger.Debug(ctx, "request started")
+	now := time.Now()
+
+	err := c.next.UpdateAppInstanceVersion(ctx, tenantID, appInstanceID, appVersionID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			logger.WithAppInstanceIDField(appInstanceID),
+			logger.WithAppVersionIDField(appVersionID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return err
+	}
+	c.logger.Debug(ctx, "request completed",
+		logger.WithAppInstanceIDField(appInstanceID),
+		logger.WithAppVersionIDField(appVersionID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return nil
+}
+
+func (c appInstanceStorageLogging) ListActiveAppInstances(ctx context.Context) ([]*model.AppInstance, error) {
+	c.logger.Debug(ctx, "request started")
+	now := time.Now()
+
+	res, err := c.next.ListActiveAppInstances(ctx)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, err
+	}
+	c.logger.Debug(ctx, "request completed",
+		zap.Int("num_app_instances", len(res)),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, nil
+}
+
+func (c appInstanceStorageLogging) SetAppInstanceRestarts(ctx context.Context, appInstance *model.AppInstance, restarts int32, crashing bool) (bool, error) {
+	c.logger.Debug(ctx, "request started")
+	now := time.Now()
+
+	set, err := c.next.SetAppInstanceRestarts(ctx, appInstance, restarts, crashing)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			logger.WithAppInstanceIDField(appInstance.ID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return false, err
+	}
+	c.logger.Debug(ctx, "request completed",
+		logger.WithAppInstanceIDField(appInstance.ID),
+		zap.Bool("set", set),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return set, nil
+}
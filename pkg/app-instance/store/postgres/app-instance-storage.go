@@ -3,12 +3,16 @@ package postgres
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/jmoiron/sqlx"
 
 	"github.com/CHORUS-TRE/chorus-backend/internal/utils/database"
+	"github.com/CHORUS-TRE/chorus-backend/internal/utils/pagination"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/app-instance/model"
 	common_model "github.com/CHORUS-TRE/chorus-backend/pkg/common/model"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/common/storage"
 )
 
 // AppInstanceStorage is the handler through which a PostgresDB backend can be queried.
@@ -23,7 +27,7 @@ func NewAppInstanceStorage(db *sqlx.DB) *AppInstanceStorage {
 
 func (s *AppInstanceStorage) GetAppInstance(ctx context.Context, tenantID uint64, appInstanceID uint64) (*model.AppInstance, error) {
 	const query = `
-		SELECT id, tenantid, userid, appid, workspaceid, workbenchid, status, createdat, updatedat
+		SELECT id, tenantid, userid, appid, workspaceid, workbenchid, appversionid, status, createdat, updatedat, deletedat
 			FROM app_instances
 		WHERE tenantid = $1 AND id = $2;
 	`
@@ -36,30 +40,95 @@ func (s *AppInstanceStorage) GetAppInstance(ctx context.Context, tenantID uint64
 	return &appInstance, nil
 }
 
-func (s *AppInstanceStorage) ListAppInstances(ctx context.Context, tenantID uint64, pagination common_model.Pagination) ([]*model.AppInstance, error) {
-	const query = `
-SELECT id, tenantid, userid, appid, workspaceid, workbenchid, status, createdat, updatedat
-	FROM app_instances
-WHERE tenantid = $1 AND status != 'deleted';
-`
+// ListAppInstances queries the stocked app instances matching the filter, one page at a
+// time. AppInstances that are 'deleted' are skipped unless explicitly filtered on.
+// It also returns the total number of app instances matching the filter.
+func (s *AppInstanceStorage) ListAppInstances(ctx context.Context, tenantID uint64, filter model.AppInstanceFilter, sort common_model.Sort, cursor *pagination.RequestCursor[pagination.KeysetCursor]) ([]*model.AppInstance, *pagination.ResponseCursor[pagination.KeysetCursor], uint64, error) {
+	args, whereClauses := buildAppInstancesWhereClauses(tenantID, filter)
+
+	sortType := strings.ToUpper(sort.SortType)
+	column, ok := model.AppInstanceSortTypeToString[sortType]
+	if !ok {
+		sortType, column = "ID", model.AppInstanceSortTypeToString["ID"]
+	}
+	selectArgs, keysetClause, sortClause, reversed := storage.KeysetClauses(args, column, "id", strings.ToUpper(sort.SortOrder), cursor)
+
+	selectQuery := `
+SELECT id, tenantid, userid, appid, workspaceid, workbenchid, appversionid, status, createdat, updatedat, deletedat
+FROM app_instances
+` + whereClauses + keysetClause + sortClause
+	query, selectArgs, err := sqlx.In(selectQuery, selectArgs...)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	query = sqlx.Rebind(sqlx.DOLLAR, query)
+
 	var appInstances []*model.AppInstance
-	if err := s.db.SelectContext(ctx, &appInstances, query, tenantID); err != nil {
-		return nil, err
+	if err := s.db.SelectContext(ctx, &appInstances, query, selectArgs...); err != nil {
+		return nil, nil, 0, err
+	}
+	if reversed {
+		storage.Reverse(appInstances)
 	}
 
-	return appInstances, nil
+	appInstances, responseCursor := pagination.NewKeysetResponseCursor(appInstances, func(a *model.AppInstance) pagination.Keyset {
+		return pagination.Keyset{ID: a.ID, Value: a.SortValue(sortType)}
+	}, cursor)
+
+	count, err := s.countAppInstances(ctx, whereClauses, args)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+
+	return appInstances, responseCursor, count, nil
+}
+
+func buildAppInstancesWhereClauses(tenantID uint64, filter model.AppInstanceFilter) ([]interface{}, string) {
+	args := []interface{}{tenantID}
+	whereClauses := "WHERE tenantid = ?"
+
+	if filter.Status != "" {
+		args = append(args, filter.Status.String())
+		whereClauses += " AND status = ?"
+	} else {
+		whereClauses += " AND status != 'deleted'"
+	}
+
+	if filter.OwnerID != 0 {
+		args = append(args, filter.OwnerID)
+		whereClauses += " AND userid = ?"
+	}
+
+	return args, whereClauses
+}
+
+func (s *AppInstanceStorage) countAppInstances(ctx context.Context, whereClauses string, args []interface{}) (uint64, error) {
+	countQuery := `
+SELECT COUNT(id) FROM app_instances
+` + whereClauses
+	query, args, err := sqlx.In(countQuery, args...)
+	if err != nil {
+		return 0, err
+	}
+	query = sqlx.Rebind(sqlx.DOLLAR, query)
+
+	var count uint64
+	if err := s.db.GetContext(ctx, &count, query, args...); err != nil {
+		return 0, err
+	}
+	return count, nil
 }
 
 // CreateAppInstance saves the provided appInstance object in the database 'appInstances' table.
 func (s *AppInstanceStorage) CreateAppInstance(ctx context.Context, tenantID uint64, appInstance *model.AppInstance) (uint64, error) {
 	const appInstanceQuery = `
-INSERT INTO app_instances (tenantid, userid, appid, workspaceid, workbenchid, status, createdat, updatedat)
-VALUES ($1, $2, $3, $4, $5, $6, NOW(), NOW()) RETURNING id;
+INSERT INTO app_instances (tenantid, userid, appid, workspaceid, workbenchid, appversionid, status, createdat, updatedat)
+VALUES ($1, $2, $3, $4, $5, $6, $7, NOW(), NOW()) RETURNING id;
 	`
 
 	var id uint64
 	err := s.db.GetContext(ctx, &id, appInstanceQuery,
-		tenantID, appInstance.UserID, appInstance.AppID, appInstance.WorkspaceID, appInstance.WorkbenchID, appInstance.Status,
+		tenantID, appInstance.UserID, appInstance.AppID, appInstance.WorkspaceID, appInstance.WorkbenchID, appInstance.AppVersionID, appInstance.Status,
 	)
 	if err != nil {
 		return 0, err
@@ -72,7 +141,7 @@ func (s *AppInstanceStorage) UpdateAppInstance(ctx context.Context, tenantID uin
 	const appInstanceUpdateQuery = `
 		UPDATE app_instances
 		SET status = $3, updatedat = NOW()
-		WHERE tenantid = $1 AND id = $2;
+		WHERE tenantid = $1 AND id = $2 AND status != 'deleted';
 	`
 
 	// Update User
@@ -92,15 +161,72 @@ func (s *AppInstanceStorage) UpdateAppInstance(ctx context.Context, tenantID uin
 	return err
 }
 
+// UpdateAppInstanceVersion records the version of the app an app instance
+// was upgraded to.
+func (s *AppInstanceStorage) UpdateAppInstanceVersion(ctx context.Context, tenantID, appInstanceID, appVersionID uint64) error {
+	const query = `
+UPDATE app_instances SET appversionid = $3, updatedat = NOW()
+WHERE tenantid = $1 AND id = $2 AND status != 'deleted';
+`
+
+	rows, err := s.db.ExecContext(ctx, query, tenantID, appInstanceID, appVersionID)
+	if err != nil {
+		return err
+	}
+	affected, err := rows.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return database.ErrNoRowsUpdated
+	}
+
+	return nil
+}
+
+// ListActiveAppInstances returns the active app instances of all the tenants.
+func (s *AppInstanceStorage) ListActiveAppInstances(ctx context.Context) ([]*model.AppInstance, error) {
+	const query = `
+SELECT id, tenantid, userid, appid, workspaceid, workbenchid, appversionid, status, restarts, crashing, createdat, updatedat, deletedat
+FROM app_instances
+WHERE status = 'active';
+`
+
+	var appInstances []*model.AppInstance
+	if err := s.db.SelectContext(ctx, &appInstances, query); err != nil {
+		return nil, err
+	}
+	return appInstances, nil
+}
+
+// SetAppInstanceRestarts records the restarts of an app instance and whether
+// it is crashing. It returns false when they changed since appInstance was
+// read, such as by another replica.
+func (s *AppInstanceStorage) SetAppInstanceRestarts(ctx context.Context, appInstance *model.AppInstance, restarts int32, crashing bool) (bool, error) {
+	const query = `
+UPDATE app_instances SET restarts = $5, crashing = $6, updatedat = NOW()
+WHERE tenantid = $1 AND id = $2 AND restarts = $3 AND crashing = $4;
+`
+	rows, err := s.db.ExecContext(ctx, query, appInstance.TenantID, appInstance.ID, appInstance.Restarts, appInstance.Crashing, restarts, crashing)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := rows.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected == 1, nil
+}
+
 func (s *AppInstanceStorage) DeleteAppInstance(ctx context.Context, tenantID uint64, appInstanceID uint64) error {
 	const query = `
-		UPDATE app_instances SET 
-			(status, updatedat, deletedat) = 
-			($3, NOW(), NOW())
-		WHERE tenantid = $1 AND id = $2;
-	`
+UPDATE app_instances SET status = 'deleted', updatedat = NOW(), deletedat = NOW()
+WHERE tenantid = $1 AND id = $2 AND status != 'deleted';
+`
 
-	rows, err := s.db.ExecContext(ctx, query, tenantID, appInstanceID, model.AppInstanceDeleted.String())
+	rows, err := s.db.ExecContext(ctx, query, tenantID, appInstanceID)
 	if err != nil {
 		return fmt.Errorf("unable to exec: %w", err)
 	}
@@ -115,3 +241,40 @@ func (s *AppInstanceStorage) DeleteAppInstance(ctx context.Context, tenantID uin
 
 	return nil
 }
+
+// RestoreAppInstance restores an app instance deleted after deletedAfter, as
+// long as its workbench and its app are not deleted. The restored app
+// instance is inactive.
+func (s *AppInstanceStorage) RestoreAppInstance(ctx context.Context, tenantID uint64, appInstanceID uint64, deletedAfter time.Time) error {
+	const query = `
+UPDATE app_instances ai SET status = 'inactive', updatedat = NOW(), deletedat = NULL
+WHERE ai.tenantid = $1 AND ai.id = $2 AND ai.status = 'deleted' AND ai.deletedat > $3
+	AND EXISTS (SELECT 1 FROM workbenchs wb WHERE wb.id = ai.workbenchid AND wb.status != 'deleted')
+	AND EXISTS (SELECT 1 FROM apps a WHERE a.id = ai.appid AND a.status != 'deleted');
+`
+
+	rows, err := s.db.ExecContext(ctx, query, tenantID, appInstanceID, deletedAfter)
+	if err != nil {
+		return fmt.Errorf("unable to exec: %w", err)
+	}
+	affected, err := rows.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("unable to get rows affected: %w", err)
+	}
+
+	if affected == 0 {
+		return database.ErrNoRowsUpdated
+	}
+
+	return nil
+}
+
+// PurgeAppInstances hard-deletes the app instances deleted before
+// deletedBefore, and returns their number per tenant.
+func (s *AppInstanceStorage) PurgeAppInstances(ctx context.Context, deletedBefore time.Time) (common_model.Purged, error) {
+	const query = `
+DELETE FROM app_instances WHERE status = 'deleted' AND deletedat < $1
+RETURNING tenantid;
+`
+	return storage.Purge(ctx, s.db, query, deletedBefore)
+}
@@ -0,0 +1,73 @@
+//go:build integration
+
+package postgres_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/utils/database"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/app-instance/store/postgres"
+	"github.com/CHORUS-TRE/chorus-backend/tests/helpers"
+)
+
+const tenantID = 88888
+
+func setupTables(t *testing.T) {
+	cleanTables(t)
+	t.Cleanup(func() { cleanTables(t) })
+
+	q := `
+	INSERT INTO tenants (id, name) VALUES (88888, 'test tenant');
+
+	INSERT INTO users (id, tenantid, firstname, lastname, username, password, status, createdat, updatedat)
+	VALUES (90000, 88888, 'hello', 'moto', 'hmoto', '', 'active', NOW(), NOW());
+
+	INSERT INTO workspaces (id, tenantid, userid, name, shortname, description, status, createdat, updatedat)
+	VALUES (91000, 88888, 90000, 'workspace', 'workspace', '', 'active', NOW(), NOW());
+
+	INSERT INTO workbenchs (id, tenantid, userid, workspaceid, name, shortname, description, status, createdat, updatedat)
+	VALUES (92000, 88888, 90000, 91000, 'workbench', 'workbench', '', 'active', NOW(), NOW());
+
+	INSERT INTO apps (id, tenantid, userid, name, description, status, dockerimagename, dockerimagetag, createdat, updatedat)
+	VALUES (93000, 88888, 90000, 'app', '', 'active', 'image', '1.1', NOW(), NOW());
+
+	INSERT INTO app_versions (id, tenantid, userid, appid, version, changelog, dockerimagename, dockerimagetag, dockerimagedigest, createdat, updatedat)
+	VALUES (94000, 88888, 90000, 93000, '1.0', '', 'image', '1.0', 'sha256:a', NOW(), NOW()),
+		(94001, 88888, 90000, 93000, '1.1', '', 'image', '1.1', 'sha256:b', NOW(), NOW());
+
+	INSERT INTO app_instances (id, tenantid, userid, appid, appversionid, workspaceid, workbenchid, status, createdat, updatedat)
+	VALUES (95000, 88888, 90000, 93000, 94000, 91000, 92000, 'active', NOW(), NOW()),
+		(95001, 88888, 90000, 93000, 94000, 91000, 92000, 'deleted', NOW(), NOW());
+	`
+	helpers.Populate(q)
+}
+
+func cleanTables(t *testing.T) {
+	q := `
+	DELETE FROM app_instances WHERE tenantid = 88888;
+	DELETE FROM apps WHERE tenantid = 88888;
+	DELETE FROM workbenchs WHERE tenantid = 88888;
+	DELETE FROM workspaces WHERE tenantid = 88888;
+	DELETE FROM users WHERE tenantid = 88888;
+	DELETE FROM tenants WHERE id = 88888;
+	`
+	_, err := helpers.DB().ExecContext(context.Background(), q)
+	require.NoError(t, err)
+}
+
+func TestAppInstanceStorage_UpdateAppInstanceVersion(t *testing.T) {
+	setupTables(t)
+	ctx := context.Background()
+	s := postgres.NewAppInstanceStorage(helpers.DB())
+
+	require.NoError(t, s.UpdateAppInstanceVersion(ctx, tenantID, 95000, 94001))
+	appInstance, err := s.GetAppInstance(ctx, tenantID, 95000)
+	require.NoError(t, err)
+	require.Equal(t, uint64(94001), appInstance.AppVersionID)
+
+	err = s.UpdateAppInstanceVersion(ctx, tenantID, 95001, 94001)
+	require.ErrorIs(t, err, database.ErrNoRowsUpdated, "a deleted app instance is not upgraded")
+}
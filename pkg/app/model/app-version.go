@@ -0,0 +1,42 @@
+package model
+
+import "time"
+
+// AppVersion maps an entry in the 'app_versions' database table. A version
+// pins the image of an app to a digest; only its changelog and deprecation
+// are updated afterwards.
+type AppVersion struct {
+	ID uint64
+
+	TenantID uint64
+	UserID   uint64
+	AppID    uint64
+
+	Version   string
+	Changelog string
+	// Deprecated versions are kept for the app instances using them, but no
+	// app instance is created with or upgraded to them anymore.
+	Deprecated bool
+
+	DockerImageName   string
+	DockerImageTag    string
+	DockerImageDigest string
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// GetImage returns the image the version is deployed with, pinned to its
+// digest when it has one.
+func (v AppVersion) GetImage() string {
+	return image(v.DockerImageName, v.DockerImageTag, v.DockerImageDigest)
+}
+
+// DefaultVersionName is the name of the version an app is registered with,
+// named after its tag.
+func DefaultVersionName(tag string) string {
+	if tag == "" {
+		return "latest"
+	}
+	return tag
+}
@@ -2,6 +2,7 @@ package model
 
 import (
 	"errors"
+	"strconv"
 	"time"
 )
 
@@ -16,20 +17,41 @@ type App struct {
 	Description string
 	Status      AppStatus
 
-	DockerImageName string
-	DockerImageTag  string
+	// DockerImageName, DockerImageTag and DockerImageDigest are the image of
+	// the default version of the app, which new app instances are created
+	// with. The apps are deployed by digest, so that a tag that moves does not
+	// change what runs.
+	DockerImageName   string
+	DockerImageTag    string
+	DockerImageDigest string
+	DefaultVersionID  *uint64
+
+	// CPURequest and MemoryRequest are the resources requested by each
+	// instance of the app, in millicores and MiB. They count towards the
+	// quotas.
+	CPURequest    uint64
+	MemoryRequest uint64
 
 	CreatedAt time.Time
 	UpdatedAt time.Time
 	DeletedAt *time.Time
 }
 
+// GetImage returns the image of the default version of the app, pinned to its
+// digest when it has one.
 func (a App) GetImage() string {
-	if a.DockerImageTag == "" {
-		return a.DockerImageName
+	return image(a.DockerImageName, a.DockerImageTag, a.DockerImageDigest)
+}
+
+func image(name, tag, digest string) string {
+	if digest != "" {
+		return name + "@" + digest
+	}
+	if tag == "" {
+		return name
 	}
 
-	return a.DockerImageName + ":" + a.DockerImageTag
+	return name + ":" + tag
 }
 
 // AppStatus represents the status of an app.
@@ -57,3 +79,29 @@ func ToAppStatus(status string) (AppStatus, error) {
 		return "", errors.New("unexpected AppStatus: " + status)
 	}
 }
+
+// AppFilter restricts the apps returned by a listing.
+// Empty fields are not filtered on.
+type AppFilter struct {
+	Status  AppStatus
+	OwnerID uint64
+}
+
+var AppSortTypeToString = map[string]string{
+	"ID":        "id",
+	"NAME":      "name",
+	"CREATEDAT": "createdat",
+}
+
+// SortValue returns the value of the column matching sortType, used as a
+// pagination keyset.
+func (a *App) SortValue(sortType string) string {
+	switch sortType {
+	case "NAME":
+		return a.Name
+	case "CREATEDAT":
+		return a.CreatedAt.Format(time.RFC3339Nano)
+	default:
+		return strconv.FormatUint(a.ID, 10)
+	}
+}
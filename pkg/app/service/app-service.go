@@ -2,50 +2,84 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
+	"github.com/CHORUS-TRE/chorus-backend/internal/client/registry"
+	"github.com/CHORUS-TRE/chorus-backend/internal/config"
+	"github.com/CHORUS-TRE/chorus-backend/internal/utils/pagination"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/app/model"
 	common_model "github.com/CHORUS-TRE/chorus-backend/pkg/common/model"
+	common_service "github.com/CHORUS-TRE/chorus-backend/pkg/common/service"
+	tenant_model "github.com/CHORUS-TRE/chorus-backend/pkg/tenant/model"
 )
 
 type Apper interface {
 	GetApp(ctx context.Context, tenantID, appID uint64) (*model.App, error)
-	ListApps(ctx context.Context, tenantID uint64, pagination common_model.Pagination) ([]*model.App, error)
+	ListApps(ctx context.Context, req ListAppsReq) ([]*model.App, *pagination.ResponseCursor[pagination.KeysetCursor], uint64, error)
 	CreateApp(ctx context.Context, app *model.App) (uint64, error)
 	UpdateApp(ctx context.Context, app *model.App) error
 	DeleteApp(ctx context.Context, tenantId, appId uint64) error
+	RestoreApp(ctx context.Context, tenantID, appID uint64) error
+	PurgeApps(ctx context.Context, deletedBefore time.Time) (common_model.Purged, error)
+
+	GetAppVersion(ctx context.Context, tenantID, appVersionID uint64) (*model.AppVersion, error)
+	ListAppVersions(ctx context.Context, tenantID, appID uint64) ([]*model.AppVersion, error)
+	CreateAppVersion(ctx context.Context, req CreateAppVersionReq) (uint64, error)
+	UpdateAppVersion(ctx context.Context, req UpdateAppVersionReq) error
+	ResolveAppImage(ctx context.Context, req ResolveAppImageReq) (uint64, error)
 }
 
 type AppStore interface {
 	GetApp(ctx context.Context, tenantID uint64, appID uint64) (*model.App, error)
-	ListApps(ctx context.Context, tenantID uint64, pagination common_model.Pagination) ([]*model.App, error)
-	CreateApp(ctx context.Context, tenantID uint64, app *model.App) (uint64, error)
+	ListApps(ctx context.Context, tenantID uint64, filter model.AppFilter, sort common_model.Sort, cursor *pagination.RequestCursor[pagination.KeysetCursor]) ([]*model.App, *pagination.ResponseCursor[pagination.KeysetCursor], uint64, error)
+	CreateApp(ctx context.Context, tenantID uint64, app *model.App, version *model.AppVersion) (uint64, error)
 	UpdateApp(ctx context.Context, tenantID uint64, app *model.App) error
 	DeleteApp(ctx context.Context, tenantID uint64, appID uint64) error
+	RestoreApp(ctx context.Context, tenantID uint64, appID uint64, deletedAfter time.Time) error
+	PurgeApps(ctx context.Context, deletedBefore time.Time) (common_model.Purged, error)
+
+	GetAppVersion(ctx context.Context, tenantID, appVersionID uint64) (*model.AppVersion, error)
+	ListAppVersions(ctx context.Context, tenantID, appID uint64) ([]*model.AppVersion, error)
+	CreateAppVersion(ctx context.Context, tenantID uint64, version *model.AppVersion, setDefault bool) (uint64, error)
+	UpdateAppVersion(ctx context.Context, tenantID uint64, version *model.AppVersion, setDefault bool) error
+}
+
+// TenantStore returns the tenants, whose settings list the registries the
+// images of their apps are allowed from.
+type TenantStore interface {
+	GetTenant(ctx context.Context, tenantID uint64) (*tenant_model.Tenant, error)
 }
 
 type AppService struct {
-	store AppStore
+	cfg      config.Config
+	store    AppStore
+	tenants  TenantStore
+	registry registry.Client
 }
 
-func NewAppService(store AppStore) *AppService {
+func NewAppService(cfg config.Config, store AppStore, tenants TenantStore, registryClient registry.Client) *AppService {
 	return &AppService{
-		store: store,
+		cfg:      cfg,
+		store:    store,
+		tenants:  tenants,
+		registry: registryClient,
 	}
 }
 
-func (u *AppService) ListApps(ctx context.Context, tenantID uint64, pagination common_model.Pagination) ([]*model.App, error) {
-	apps, err := u.store.ListApps(ctx, tenantID, pagination)
+func (u *AppService) ListApps(ctx context.Context, req ListAppsReq) ([]*model.App, *pagination.ResponseCursor[pagination.KeysetCursor], uint64, error) {
+	apps, cursor, count, err := u.store.ListApps(ctx, req.TenantID, req.Filter.ToBusinessFilter(), req.Sort.ToBusinessSort(), req.Cursor)
 	if err != nil {
-		return nil, fmt.Errorf("unable to query apps: %w", err)
+		return nil, nil, 0, fmt.Errorf("unable to query apps: %w", err)
 	}
-	return apps, nil
+	return apps, cursor, count, nil
 }
 
 func (u *AppService) GetApp(ctx context.Context, tenantID, appID uint64) (*model.App, error) {
 	app, err := u.store.GetApp(ctx, tenantID, appID)
 	if err != nil {
-		return nil, fmt.Errorf("unable to get app %v: %w", app.ID, err)
+		return nil, fmt.Errorf("unable to get app %v: %w", appID, err)
 	}
 
 	return app, nil
@@ -60,6 +94,23 @@ func (u *AppService) DeleteApp(ctx context.Context, tenantID, appID uint64) erro
 	return nil
 }
 
+// RestoreApp restores an app deleted during the grace period.
+func (u *AppService) RestoreApp(ctx context.Context, tenantID, appID uint64) error {
+	deletedAfter := time.Now().Add(-u.cfg.Services.SoftDelete.GracePeriod)
+	if err := u.store.RestoreApp(ctx, tenantID, appID, deletedAfter); err != nil {
+		return fmt.Errorf("unable to restore app %v: %w", appID, err)
+	}
+	return nil
+}
+
+func (u *AppService) PurgeApps(ctx context.Context, deletedBefore time.Time) (common_model.Purged, error) {
+	purged, err := u.store.PurgeApps(ctx, deletedBefore)
+	if err != nil {
+		return nil, fmt.Errorf("unable to purge apps: %w", err)
+	}
+	return purged, nil
+}
+
 func (u *AppService) UpdateApp(ctx context.Context, app *model.App) error {
 	if err := u.store.UpdateApp(ctx, app.TenantID, app); err != nil {
 		return fmt.Errorf("unable to update app %v: %w", app.ID, err)
@@ -68,11 +119,63 @@ func (u *AppService) UpdateApp(ctx context.Context, app *model.App) error {
 	return nil
 }
 
+// CreateApp registers an app along with its first version, named after its
+// tag and pinned to the digest the tag currently points to.
 func (u *AppService) CreateApp(ctx context.Context, app *model.App) (uint64, error) {
-	id, err := u.store.CreateApp(ctx, app.TenantID, app)
+	digest, err := u.resolveImage(ctx, app.TenantID, app.DockerImageName, app.DockerImageTag)
+	if err != nil {
+		return 0, fmt.Errorf("unable to create app %v: %w", app.Name, err)
+	}
+	app.DockerImageDigest = digest
+
+	version := &model.AppVersion{
+		UserID:            app.UserID,
+		Version:           model.DefaultVersionName(app.DockerImageTag),
+		DockerImageName:   app.DockerImageName,
+		DockerImageTag:    app.DockerImageTag,
+		DockerImageDigest: digest,
+	}
+	id, err := u.store.CreateApp(ctx, app.TenantID, app, version)
 	if err != nil {
 		return 0, fmt.Errorf("unable to create app %v: %w", app.Name, err)
 	}
 
 	return id, nil
 }
+
+// resolveImage checks that the image is from a registry allowed for the
+// tenant and returns the digest its tag points to, "latest" when empty.
+func (u *AppService) resolveImage(ctx context.Context, tenantID uint64, name, tag string) (string, error) {
+	ref, err := registry.ParseReference(name)
+	if err != nil {
+		return "", fmt.Errorf("%v: %w", err, &common_service.InvalidParametersErr{})
+	}
+
+	tenant, err := u.tenants.GetTenant(ctx, tenantID)
+	if err != nil {
+		return "", fmt.Errorf("unable to get tenant %v: %w", tenantID, err)
+	}
+	var allowed []string
+	if tenant.Settings != nil {
+		allowed = tenant.Settings.Registries.Allowed
+	}
+	if len(allowed) == 0 {
+		allowed = u.cfg.Tenants[tenantID].Registries.Allowed
+	}
+	if !ref.Allowed(allowed) {
+		return "", fmt.Errorf("registry of image %v is not allowed: %w", ref, &common_service.PermissionDeniedErr{})
+	}
+
+	if tag == "" {
+		tag = "latest"
+	}
+	digest, err := u.registry.ResolveDigest(ctx, ref, tag)
+	if errors.Is(err, registry.ErrImageNotFound) {
+		return "", fmt.Errorf("%v: %w", err, &common_service.InvalidParametersErr{})
+	}
+	if err != nil {
+		return "", fmt.Errorf("unable to resolve image %v:%v: %w", ref, tag, err)
+	}
+
+	return digest, nil
+}
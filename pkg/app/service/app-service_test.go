@@ -0,0 +1,200 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/client/registry"
+	"github.com/CHORUS-TRE/chorus-backend/internal/config"
+	"github.com/CHORUS-TRE/chorus-backend/internal/utils/database"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/app/model"
+	common_service "github.com/CHORUS-TRE/chorus-backend/pkg/common/service"
+	tenant_model "github.com/CHORUS-TRE/chorus-backend/pkg/tenant/model"
+)
+
+type appStore struct {
+	AppStore
+	apps     map[uint64]*model.App
+	versions []*model.AppVersion
+}
+
+func (s *appStore) GetApp(ctx context.Context, tenantID, appID uint64) (*model.App, error) {
+	app, ok := s.apps[appID]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	a := *app
+	return &a, nil
+}
+
+func (s *appStore) CreateApp(ctx context.Context, tenantID uint64, app *model.App, version *model.AppVersion) (uint64, error) {
+	app.ID = uint64(len(s.apps) + 1)
+	s.apps[app.ID] = app
+	version.AppID = app.ID
+	if _, err := s.CreateAppVersion(ctx, tenantID, version, true); err != nil {
+		return 0, err
+	}
+	return app.ID, nil
+}
+
+func (s *appStore) GetAppVersion(ctx context.Context, tenantID, appVersionID uint64) (*model.AppVersion, error) {
+	if appVersionID == 0 || appVersionID > uint64(len(s.versions)) {
+		return nil, errors.New("not found")
+	}
+	v := *s.versions[appVersionID-1]
+	return &v, nil
+}
+
+func (s *appStore) CreateAppVersion(ctx context.Context, tenantID uint64, version *model.AppVersion, setDefault bool) (uint64, error) {
+	for _, v := range s.versions {
+		if v.AppID == version.AppID && v.Version == version.Version {
+			return 0, database.ErrDuplicateKey
+		}
+	}
+	version.ID = uint64(len(s.versions) + 1)
+	s.versions = append(s.versions, version)
+	if setDefault {
+		s.setDefault(version)
+	}
+	return version.ID, nil
+}
+
+func (s *appStore) UpdateAppVersion(ctx context.Context, tenantID uint64, version *model.AppVersion, setDefault bool) error {
+	if setDefault && version.Deprecated {
+		return database.ErrNoRowsUpdated
+	}
+	v := *version
+	s.versions[version.ID-1] = &v
+	if setDefault {
+		s.setDefault(&v)
+	}
+	return nil
+}
+
+func (s *appStore) setDefault(version *model.AppVersion) {
+	app := s.apps[version.AppID]
+	app.DefaultVersionID = &version.ID
+	app.DockerImageDigest = version.DockerImageDigest
+}
+
+type tenantStore struct {
+	settings *tenant_model.TenantSettings
+}
+
+func (s *tenantStore) GetTenant(ctx context.Context, tenantID uint64) (*tenant_model.Tenant, error) {
+	return &tenant_model.Tenant{ID: tenantID, Settings: s.settings}, nil
+}
+
+type registryClient struct {
+	digests map[string]string
+}
+
+func (c *registryClient) ResolveDigest(ctx context.Context, ref registry.Reference, tag string) (string, error) {
+	digest, ok := c.digests[ref.String()+":"+tag]
+	if !ok {
+		return "", registry.ErrImageNotFound
+	}
+	return digest, nil
+}
+
+func TestCreateApp(t *testing.T) {
+	cfg := config.Config{Tenants: map[uint64]config.Tenant{}}
+	configured := cfg.Tenants[1]
+	configured.Registries.Allowed = []string{"registry.example.com/chorus"}
+	cfg.Tenants[1] = configured
+
+	tests := []struct {
+		name          string
+		settings      *tenant_model.TenantSettings
+		image         string
+		tag           string
+		expectsDigest string
+		expectsErr    any
+	}{
+		{
+			name:          "Without settings, allows the configured registries",
+			image:         "registry.example.com/chorus/vscode",
+			expectsDigest: "sha256:latest",
+		},
+		{
+			name:          "With settings listing no registries, allows the configured registries",
+			settings:      &tenant_model.TenantSettings{},
+			image:         "registry.example.com/chorus/vscode",
+			tag:           "1.2.0",
+			expectsDigest: "sha256:1.2.0",
+		},
+		{
+			name:       "With settings listing registries, overrides the configured registries",
+			settings:   &tenant_model.TenantSettings{Registries: tenant_model.RegistriesSettings{Allowed: []string{"docker.io"}}},
+			image:      "registry.example.com/chorus/vscode",
+			expectsErr: new(*common_service.PermissionDeniedErr),
+		},
+		{
+			name:       "With an unknown tag, rejects the app",
+			image:      "registry.example.com/chorus/vscode",
+			tag:        "missing",
+			expectsErr: new(*common_service.InvalidParametersErr),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &appStore{apps: map[uint64]*model.App{}}
+			s := NewAppService(cfg, store, &tenantStore{settings: tt.settings}, &registryClient{digests: map[string]string{
+				"registry.example.com/chorus/vscode:latest": "sha256:latest",
+				"registry.example.com/chorus/vscode:1.2.0":  "sha256:1.2.0",
+			}})
+
+			id, err := s.CreateApp(context.Background(), &model.App{TenantID: 1, UserID: 2, Name: "vscode", DockerImageName: tt.image, DockerImageTag: tt.tag})
+			if tt.expectsErr != nil {
+				require.ErrorAs(t, err, tt.expectsErr)
+				require.Empty(t, store.apps)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expectsDigest, store.apps[id].DockerImageDigest)
+			require.Len(t, store.versions, 1)
+			require.Equal(t, model.DefaultVersionName(tt.tag), store.versions[0].Version)
+			require.Equal(t, tt.expectsDigest, store.versions[0].DockerImageDigest)
+		})
+	}
+}
+
+func TestResolveAppImage(t *testing.T) {
+	cfg := config.Config{Tenants: map[uint64]config.Tenant{}}
+	settings := &tenant_model.TenantSettings{Registries: tenant_model.RegistriesSettings{Allowed: []string{"registry.example.com"}}}
+	digests := map[string]string{"registry.example.com/chorus/vscode:latest": "sha256:a"}
+
+	store := &appStore{apps: map[uint64]*model.App{}}
+	s := NewAppService(cfg, store, &tenantStore{settings: settings}, &registryClient{digests: digests})
+	appID, err := s.CreateApp(context.Background(), &model.App{TenantID: 1, UserID: 2, Name: "vscode", DockerImageName: "registry.example.com/chorus/vscode"})
+	require.NoError(t, err)
+	defaultID := *store.apps[appID].DefaultVersionID
+
+	// The digest did not change, the default version is kept.
+	id, err := s.ResolveAppImage(context.Background(), ResolveAppImageReq{TenantID: 1, UserID: 3, AppID: appID, Version: "2"})
+	require.NoError(t, err)
+	require.Equal(t, defaultID, id)
+	require.Len(t, store.versions, 1)
+
+	// The tag moved, the new digest is added as a version of the same app.
+	digests["registry.example.com/chorus/vscode:latest"] = "sha256:b"
+	id, err = s.ResolveAppImage(context.Background(), ResolveAppImageReq{TenantID: 1, UserID: 3, AppID: appID, Version: "2"})
+	require.NoError(t, err)
+	require.NotEqual(t, defaultID, id)
+	require.Len(t, store.apps, 1)
+	require.Len(t, store.versions, 2)
+	require.Equal(t, appID, store.versions[1].AppID)
+	require.Equal(t, "2", store.versions[1].Version)
+	require.Equal(t, "sha256:b", store.versions[1].DockerImageDigest)
+	require.Equal(t, uint64(3), store.versions[1].UserID)
+
+	// The app is deleted.
+	store.apps[appID].Status = model.AppDeleted
+	_, err = s.ResolveAppImage(context.Background(), ResolveAppImageReq{TenantID: 1, UserID: 3, AppID: appID, Version: "3"})
+	require.True(t, errors.As(err, new(*common_service.FailedPreconditionErr)))
+}
@@ -0,0 +1,146 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/utils/database"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/app/model"
+	common_service "github.com/CHORUS-TRE/chorus-backend/pkg/common/service"
+)
+
+func (u *AppService) GetAppVersion(ctx context.Context, tenantID, appVersionID uint64) (*model.AppVersion, error) {
+	version, err := u.store.GetAppVersion(ctx, tenantID, appVersionID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get app version %v: %w", appVersionID, err)
+	}
+
+	return version, nil
+}
+
+func (u *AppService) ListAppVersions(ctx context.Context, tenantID, appID uint64) ([]*model.AppVersion, error) {
+	versions, err := u.store.ListAppVersions(ctx, tenantID, appID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query the versions of app %v: %w", appID, err)
+	}
+
+	return versions, nil
+}
+
+// CreateAppVersion adds a version to an app, pinned to the digest its tag
+// currently points to.
+func (u *AppService) CreateAppVersion(ctx context.Context, req CreateAppVersionReq) (uint64, error) {
+	if _, err := u.getActiveApp(ctx, req.TenantID, req.AppID); err != nil {
+		return 0, err
+	}
+
+	digest, err := u.resolveImage(ctx, req.TenantID, req.DockerImageName, req.DockerImageTag)
+	if err != nil {
+		return 0, fmt.Errorf("unable to create version %v of app %v: %w", req.Version, req.AppID, err)
+	}
+
+	version := &model.AppVersion{
+		UserID:            req.UserID,
+		AppID:             req.AppID,
+		Version:           req.Version,
+		Changelog:         req.Changelog,
+		DockerImageName:   req.DockerImageName,
+		DockerImageTag:    req.DockerImageTag,
+		DockerImageDigest: digest,
+	}
+	return u.createAppVersion(ctx, req.TenantID, version, req.Default)
+}
+
+// UpdateAppVersion updates the changelog and the deprecation of a version,
+// and makes it the default one of its app if requested. The default version
+// of an app cannot be deprecated.
+func (u *AppService) UpdateAppVersion(ctx context.Context, req UpdateAppVersionReq) error {
+	if req.Deprecated && req.Default {
+		return fmt.Errorf("app version %v cannot be both deprecated and the default one: %w", req.AppVersionID, &common_service.InvalidParametersErr{})
+	}
+
+	version, err := u.store.GetAppVersion(ctx, req.TenantID, req.AppVersionID)
+	if err != nil {
+		return fmt.Errorf("unable to get app version %v: %w", req.AppVersionID, err)
+	}
+
+	app, err := u.getActiveApp(ctx, req.TenantID, version.AppID)
+	if err != nil {
+		return err
+	}
+	if req.Deprecated && isDefaultVersion(app, version.ID) {
+		return fmt.Errorf("version %v is the default one of app %v and cannot be deprecated: %w", version.ID, app.ID, &common_service.FailedPreconditionErr{})
+	}
+
+	version.Changelog = req.Changelog
+	version.Deprecated = req.Deprecated
+	setDefault := req.Default && !isDefaultVersion(app, version.ID)
+	if err := u.store.UpdateAppVersion(ctx, req.TenantID, version, setDefault); err != nil {
+		return fmt.Errorf("unable to update app version %v: %w", version.ID, err)
+	}
+
+	return nil
+}
+
+// ResolveAppImage resolves the tag of the default version of an app again,
+// and adds the digest it now points to as a new version of the app. The app
+// instances stay on their version until they are upgraded. The default
+// version is returned when the digest did not change.
+//
+// The new version becomes the default one when the default version has no
+// digest, as for the apps registered before the images were pinned, whose
+// instances cannot be created until then.
+func (u *AppService) ResolveAppImage(ctx context.Context, req ResolveAppImageReq) (uint64, error) {
+	app, err := u.getActiveApp(ctx, req.TenantID, req.AppID)
+	if err != nil {
+		return 0, err
+	}
+
+	digest, err := u.resolveImage(ctx, req.TenantID, app.DockerImageName, app.DockerImageTag)
+	if err != nil {
+		return 0, fmt.Errorf("unable to resolve app %v: %w", app.ID, err)
+	}
+	if digest == app.DockerImageDigest && app.DefaultVersionID != nil {
+		return *app.DefaultVersionID, nil
+	}
+
+	version := &model.AppVersion{
+		UserID:            req.UserID,
+		AppID:             app.ID,
+		Version:           req.Version,
+		Changelog:         req.Changelog,
+		DockerImageName:   app.DockerImageName,
+		DockerImageTag:    app.DockerImageTag,
+		DockerImageDigest: digest,
+	}
+	return u.createAppVersion(ctx, req.TenantID, version, app.DockerImageDigest == "")
+}
+
+func (u *AppService) createAppVersion(ctx context.Context, tenantID uint64, version *model.AppVersion, setDefault bool) (uint64, error) {
+	id, err := u.store.CreateAppVersion(ctx, tenantID, version, setDefault)
+	if errors.Is(err, database.ErrDuplicateKey) {
+		return 0, fmt.Errorf("version %v of app %v: %w", version.Version, version.AppID, &common_service.ResourceAlreadyExistsErr{})
+	}
+	if err != nil {
+		return 0, fmt.Errorf("unable to create version %v of app %v: %w", version.Version, version.AppID, err)
+	}
+
+	return id, nil
+}
+
+func (u *AppService) getActiveApp(ctx context.Context, tenantID, appID uint64) (*model.App, error) {
+	app, err := u.store.GetApp(ctx, tenantID, appID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get app %v: %w", appID, err)
+	}
+	if app.Status == model.AppDeleted {
+		return nil, fmt.Errorf("app %v is deleted: %w", appID, &common_service.FailedPreconditionErr{})
+	}
+
+	return app, nil
+}
+
+func isDefaultVersion(app *model.App, appVersionID uint64) bool {
+	return app.DefaultVersionID != nil && *app.DefaultVersionID == appVersionID
+}
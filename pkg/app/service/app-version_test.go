@@ -0,0 +1,88 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/config"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/app/model"
+	common_service "github.com/CHORUS-TRE/chorus-backend/pkg/common/service"
+	tenant_model "github.com/CHORUS-TRE/chorus-backend/pkg/tenant/model"
+)
+
+const image = "registry.example.com/chorus/vscode"
+
+// newVersionedApp returns a service along with an app registered with the
+// version "1.0" of its image.
+func newVersionedApp(t *testing.T, digests map[string]string) (*AppService, *appStore, uint64) {
+	settings := &tenant_model.TenantSettings{Registries: tenant_model.RegistriesSettings{Allowed: []string{"registry.example.com"}}}
+	store := &appStore{apps: map[uint64]*model.App{}}
+	s := NewAppService(config.Config{}, store, &tenantStore{settings: settings}, &registryClient{digests: digests})
+
+	appID, err := s.CreateApp(context.Background(), &model.App{TenantID: 1, UserID: 2, Name: "vscode", DockerImageName: image, DockerImageTag: "1.0"})
+	require.NoError(t, err)
+	return s, store, appID
+}
+
+func TestCreateAppVersion(t *testing.T) {
+	s, store, appID := newVersionedApp(t, map[string]string{image + ":1.0": "sha256:a", image + ":1.1": "sha256:b"})
+	req := CreateAppVersionReq{TenantID: 1, UserID: 2, AppID: appID, Version: "1.1", DockerImageName: image, DockerImageTag: "1.1"}
+
+	id, err := s.CreateAppVersion(context.Background(), req)
+	require.NoError(t, err)
+	require.Equal(t, "sha256:b", store.versions[id-1].DockerImageDigest)
+	require.NotEqual(t, id, *store.apps[appID].DefaultVersionID, "the version is not the default one unless requested")
+
+	_, err = s.CreateAppVersion(context.Background(), req)
+	require.True(t, errors.As(err, new(*common_service.ResourceAlreadyExistsErr)))
+
+	req.Version, req.Default = "1.1-default", true
+	id, err = s.CreateAppVersion(context.Background(), req)
+	require.NoError(t, err)
+	require.Equal(t, id, *store.apps[appID].DefaultVersionID)
+}
+
+func TestUpdateAppVersion(t *testing.T) {
+	s, store, appID := newVersionedApp(t, map[string]string{image + ":1.0": "sha256:a", image + ":1.1": "sha256:b"})
+	defaultID := *store.apps[appID].DefaultVersionID
+	id, err := s.CreateAppVersion(context.Background(), CreateAppVersionReq{TenantID: 1, UserID: 2, AppID: appID, Version: "1.1", DockerImageName: image, DockerImageTag: "1.1"})
+	require.NoError(t, err)
+
+	// A version cannot be both deprecated and the default one, nothing is
+	// written.
+	err = s.UpdateAppVersion(context.Background(), UpdateAppVersionReq{TenantID: 1, AppVersionID: id, Changelog: "changed", Deprecated: true, Default: true})
+	require.True(t, errors.As(err, new(*common_service.InvalidParametersErr)))
+	require.False(t, store.versions[id-1].Deprecated)
+	require.Empty(t, store.versions[id-1].Changelog)
+
+	// The default version cannot be deprecated.
+	err = s.UpdateAppVersion(context.Background(), UpdateAppVersionReq{TenantID: 1, AppVersionID: defaultID, Deprecated: true})
+	require.True(t, errors.As(err, new(*common_service.FailedPreconditionErr)))
+
+	require.NoError(t, s.UpdateAppVersion(context.Background(), UpdateAppVersionReq{TenantID: 1, AppVersionID: id, Changelog: "fixes", Default: true}))
+	require.Equal(t, "fixes", store.versions[id-1].Changelog)
+	require.Equal(t, id, *store.apps[appID].DefaultVersionID)
+	require.Equal(t, "sha256:b", store.apps[appID].DockerImageDigest)
+
+	// The former default version can now be deprecated.
+	require.NoError(t, s.UpdateAppVersion(context.Background(), UpdateAppVersionReq{TenantID: 1, AppVersionID: defaultID, Deprecated: true}))
+	require.True(t, store.versions[defaultID-1].Deprecated)
+}
+
+func TestResolveAppImage_WithoutDigest(t *testing.T) {
+	digests := map[string]string{image + ":1.0": "sha256:a"}
+	s, store, appID := newVersionedApp(t, digests)
+
+	// The app was registered before the images were pinned.
+	defaultID := *store.apps[appID].DefaultVersionID
+	store.apps[appID].DockerImageDigest = ""
+	store.versions[defaultID-1].DockerImageDigest = ""
+
+	id, err := s.ResolveAppImage(context.Background(), ResolveAppImageReq{TenantID: 1, UserID: 3, AppID: appID, Version: "1.0-resolved"})
+	require.NoError(t, err)
+	require.Equal(t, id, *store.apps[appID].DefaultVersionID, "the resolved version becomes the default one")
+	require.Equal(t, "sha256:a", store.apps[appID].DockerImageDigest)
+}
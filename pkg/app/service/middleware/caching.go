@@ -2,26 +2,25 @@ package middleware
 
 import (
 	"context"
+	"time"
 
 	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
 	"github.com/CHORUS-TRE/chorus-backend/internal/utils/cache"
+	"github.com/CHORUS-TRE/chorus-backend/internal/utils/pagination"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/app/model"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/app/service"
 	common_model "github.com/CHORUS-TRE/chorus-backend/pkg/common/model"
-
-	"github.com/coocood/freecache"
 )
 
 const (
-	appCacheSize           = 100 * 1024 * 1024 // Max 100MiB stored in memory
 	defaultCacheExpiration = 5
 	longCacheExpiration    = 60
 )
 
-func AppCaching(log *logger.ContextLogger) func(service.Apper) *Caching {
+func AppCaching(log *logger.ContextLogger, backend cache.Backend) func(service.Apper) *Caching {
 	return func(next service.Apper) *Caching {
 		return &Caching{
-			cache: cache.NewCache(freecache.NewCache(appCacheSize), log),
+			cache: cache.NewCache(backend, log),
 			next:  next,
 		}
 	}
@@ -32,14 +31,15 @@ type Caching struct {
 	next  service.Apper
 }
 
-func (c *Caching) ListApps(ctx context.Context, tenantID uint64, pagination common_model.Pagination) (reply []*model.App, err error) {
-	entry := c.cache.NewEntry(cache.WithUint64(tenantID), cache.WithInterface(pagination))
+func (c *Caching) ListApps(ctx context.Context, req service.ListAppsReq) (reply []*model.App, cursor *pagination.ResponseCursor[pagination.KeysetCursor], count uint64, err error) {
+	entry := c.cache.NewEntry(cache.WithInterface(req)).WithTags(cache.TenantTag(req.TenantID))
 	reply = []*model.App{}
+	cursor = &pagination.ResponseCursor[pagination.KeysetCursor]{}
 
-	if ok := entry.Get(ctx, &reply); !ok {
-		reply, err = c.next.ListApps(ctx, tenantID, pagination)
+	if ok := entry.Get(ctx, &reply, &cursor, &count); !ok {
+		reply, cursor, count, err = c.next.ListApps(ctx, req)
 		if err == nil {
-			entry.Set(ctx, defaultCacheExpiration, reply)
+			entry.Set(ctx, defaultCacheExpiration, reply, cursor, count)
 		}
 	}
 
@@ -47,7 +47,7 @@ func (c *Caching) ListApps(ctx context.Context, tenantID uint64, pagination comm
 }
 
 func (c *Caching) GetApp(ctx context.Context, tenantID, appID uint64) (reply *model.App, err error) {
-	entry := c.cache.NewEntry(cache.WithUint64(tenantID), cache.WithUint64(appID))
+	entry := c.cache.NewEntry(cache.WithUint64(tenantID), cache.WithUint64(appID)).WithTags(cache.TenantTag(tenantID))
 	reply = &model.App{}
 
 	if ok := entry.Get(ctx, &reply); !ok {
@@ -61,13 +61,79 @@ func (c *Caching) GetApp(ctx context.Context, tenantID, appID uint64) (reply *mo
 }
 
 func (c *Caching) DeleteApp(ctx context.Context, tenantID, appID uint64) error {
-	return c.next.DeleteApp(ctx, tenantID, appID)
+	err := c.next.DeleteApp(ctx, tenantID, appID)
+	c.cache.Invalidate(ctx, cache.TenantTag(tenantID))
+	return err
+}
+
+func (c *Caching) RestoreApp(ctx context.Context, tenantID, appID uint64) error {
+	err := c.next.RestoreApp(ctx, tenantID, appID)
+	c.cache.Invalidate(ctx, cache.TenantTag(tenantID))
+	return err
+}
+
+func (c *Caching) PurgeApps(ctx context.Context, deletedBefore time.Time) (common_model.Purged, error) {
+	purged, err := c.next.PurgeApps(ctx, deletedBefore)
+	for tenantID := range purged {
+		c.cache.Invalidate(ctx, cache.TenantTag(tenantID))
+	}
+	return purged, err
 }
 
 func (c *Caching) UpdateApp(ctx context.Context, app *model.App) error {
-	return c.next.UpdateApp(ctx, app)
+	err := c.next.UpdateApp(ctx, app)
+	c.cache.Invalidate(ctx, cache.TenantTag(app.TenantID))
+	return err
 }
 
 func (c *Caching) CreateApp(ctx context.Context, app *model.App) (uint64, error) {
-	return c.next.CreateApp(ctx, app)
+	id, err := c.next.CreateApp(ctx, app)
+	c.cache.Invalidate(ctx, cache.TenantTag(app.TenantID))
+	return id, err
+}
+
+func (c *Caching) GetAppVersion(ctx context.Context, tenantID, appVersionID uint64) (reply *model.AppVersion, err error) {
+	entry := c.cache.NewEntry(cache.WithUint64(tenantID), cache.WithUint64(appVersionID)).WithTags(cache.TenantTag(tenantID))
+	reply = &model.AppVersion{}
+
+	if ok := entry.Get(ctx, &reply); !ok {
+		reply, err = c.next.GetAppVersion(ctx, tenantID, appVersionID)
+		if err == nil {
+			entry.Set(ctx, defaultCacheExpiration, reply)
+		}
+	}
+
+	return
+}
+
+func (c *Caching) ListAppVersions(ctx context.Context, tenantID, appID uint64) (reply []*model.AppVersion, err error) {
+	entry := c.cache.NewEntry(cache.WithUint64(tenantID), cache.WithUint64(appID)).WithTags(cache.TenantTag(tenantID))
+	reply = []*model.AppVersion{}
+
+	if ok := entry.Get(ctx, &reply); !ok {
+		reply, err = c.next.ListAppVersions(ctx, tenantID, appID)
+		if err == nil {
+			entry.Set(ctx, defaultCacheExpiration, reply)
+		}
+	}
+
+	return
+}
+
+func (c *Caching) CreateAppVersion(ctx context.Context, req service.CreateAppVersionReq) (uint64, error) {
+	id, err := c.next.CreateAppVersion(ctx, req)
+	c.cache.Invalidate(ctx, cache.TenantTag(req.TenantID))
+	return id, err
+}
+
+func (c *Caching) UpdateAppVersion(ctx context.Context, req service.UpdateAppVersionReq) error {
+	err := c.next.UpdateAppVersion(ctx, req)
+	c.cache.Invalidate(ctx, cache.TenantTag(req.TenantID))
+	return err
+}
+
+func (c *Caching) ResolveAppImage(ctx context.Context, req service.ResolveAppImageReq) (uint64, error) {
+	id, err := c.next.ResolveAppImage(ctx, req)
+	c.cache.Invalidate(ctx, cache.TenantTag(req.TenantID))
+	return id, err
 }
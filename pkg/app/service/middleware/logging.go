@@ -8,6 +8,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
+	"github.com/CHORUS-TRE/chorus-backend/internal/utils/pagination"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/app/model"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/app/service"
 	common_model "github.com/CHORUS-TRE/chorus-backend/pkg/common/model"
@@ -27,23 +28,24 @@ func Logging(logger *logger.ContextLogger) func(service.Apper) service.Apper {
 	}
 }
 
-func (c appServiceLogging) ListApps(ctx context.Context, tenantID uint64, pagination common_model.Pagination) ([]*model.App, error) {
+func (c appServiceLogging) ListApps(ctx context.Context, req service.ListAppsReq) ([]*model.App, *pagination.ResponseCursor[pagination.KeysetCursor], uint64, error) {
 	now := time.Now()
 
-	res, err := c.next.ListApps(ctx, tenantID, pagination)
+	res, cursor, count, err := c.next.ListApps(ctx, req)
 	if err != nil {
 		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
 			zap.Error(err),
 			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
 		)
-		return res, fmt.Errorf("unable to get apps: %w", err)
+		return res, cursor, count, fmt.Errorf("unable to get apps: %w", err)
 	}
 
 	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
 		zap.Int("num_apps", len(res)),
+		logger.WithTotalItemsField(count),
 		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
 	)
-	return res, nil
+	return res, cursor, count, nil
 }
 
 func (c appServiceLogging) GetApp(ctx context.Context, tenantID, appID uint64) (*model.App, error) {
@@ -86,6 +88,45 @@ func (c appServiceLogging) DeleteApp(ctx context.Context, tenantID, appID uint64
 	return nil
 }
 
+func (c appServiceLogging) RestoreApp(ctx context.Context, tenantID, appID uint64) error {
+	now := time.Now()
+
+	err := c.next.RestoreApp(ctx, tenantID, appID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			logger.WithAppIDField(appID),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return fmt.Errorf("unable to restore app: %w", err)
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		logger.WithAppIDField(appID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return nil
+}
+
+func (c appServiceLogging) PurgeApps(ctx context.Context, deletedBefore time.Time) (common_model.Purged, error) {
+	now := time.Now()
+
+	purged, err := c.next.PurgeApps(ctx, deletedBefore)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return purged, fmt.Errorf("unable to purge apps: %w", err)
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		zap.Int64("num_purged", purged.Total()),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return purged, nil
+}
+
 func (c appServiceLogging) UpdateApp(ctx context.Context, app *model.App) error {
 	now := time.Now()
 
@@ -124,3 +165,106 @@ func (c appServiceLogging) CreateApp(ctx context.Context, app *model.App) (uint6
 	)
 	return appId, nil
 }
+
+func (c appServiceLogging) GetAppVersion(ctx context.Context, tenantID, appVersionID uint64) (*model.AppVersion, error) {
+	now := time.Now()
+
+	res, err := c.next.GetAppVersion(ctx, tenantID, appVersionID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			logger.WithAppVersionIDField(appVersionID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, fmt.Errorf("unable to get app version: %w", err)
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		logger.WithAppVersionIDField(appVersionID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, nil
+}
+
+func (c appServiceLogging) ListAppVersions(ctx context.Context, tenantID, appID uint64) ([]*model.AppVersion, error) {
+	now := time.Now()
+
+	res, err := c.next.ListAppVersions(ctx, tenantID, appID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			logger.WithAppIDField(appID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, fmt.Errorf("unable to list app versions: %w", err)
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		logger.WithAppIDField(appID),
+		logger.WithCountField(len(res)),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, nil
+}
+
+func (c appServiceLogging) CreateAppVersion(ctx context.Context, req service.CreateAppVersionReq) (uint64, error) {
+	now := time.Now()
+
+	id, err := c.next.CreateAppVersion(ctx, req)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			logger.WithAppIDField(req.AppID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return id, fmt.Errorf("unable to create app version: %w", err)
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		logger.WithAppIDField(req.AppID),
+		logger.WithAppVersionIDField(id),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return id, nil
+}
+
+func (c appServiceLogging) UpdateAppVersion(ctx context.Context, req service.UpdateAppVersionReq) error {
+	now := time.Now()
+
+	err := c.next.UpdateAppVersion(ctx, req)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			logger.WithAppVersionIDField(req.AppVersionID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return fmt.Errorf("unable to update app version: %w", err)
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		logger.WithAppVersionIDField(req.AppVersionID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return nil
+}
+
+func (c appServiceLogging) ResolveAppImage(ctx context.Context, req service.ResolveAppImageReq) (uint64, error) {
+	now := time.Now()
+
+	id, err := c.next.ResolveAppImage(ctx, req)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			logger.WithAppIDField(req.AppID),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return id, fmt.Errorf("unable to resolve app: %w", err)
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		logger.WithAppIDField(req.AppID),
+		logger.WithAppVersionIDField(id),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return id, nil
+}
@@ -2,10 +2,14 @@ package middleware
 
 import (
 	"context"
+	"fmt"
+	"time"
 
+	"github.com/CHORUS-TRE/chorus-backend/internal/utils/pagination"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/app/model"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/app/service"
 	common_model "github.com/CHORUS-TRE/chorus-backend/pkg/common/model"
+	common_service "github.com/CHORUS-TRE/chorus-backend/pkg/common/service"
 
 	val "github.com/go-playground/validator/v10"
 )
@@ -24,11 +28,14 @@ func Validation(validate *val.Validate) func(service.Apper) service.Apper {
 	}
 }
 
-func (v validation) ListApps(ctx context.Context, tenantID uint64, pagination common_model.Pagination) ([]*model.App, error) {
-	if err := v.validate.Struct(pagination); err != nil {
-		return nil, err
+func (v validation) ListApps(ctx context.Context, req service.ListAppsReq) ([]*model.App, *pagination.ResponseCursor[pagination.KeysetCursor], uint64, error) {
+	if err := v.validate.Struct(req); err != nil {
+		return nil, nil, 0, err
 	}
-	return v.next.ListApps(ctx, tenantID, pagination)
+	if req.Cursor != nil && req.Cursor.PageSize > service.MaxAppsPageSize {
+		return nil, nil, 0, fmt.Errorf("page size must not exceed %v: %w", service.MaxAppsPageSize, &common_service.InvalidParametersErr{})
+	}
+	return v.next.ListApps(ctx, req)
 }
 
 func (v validation) GetApp(ctx context.Context, tenantID, appID uint64) (*model.App, error) {
@@ -39,6 +46,14 @@ func (v validation) DeleteApp(ctx context.Context, tenantID, appID uint64) error
 	return v.next.DeleteApp(ctx, tenantID, appID)
 }
 
+func (v validation) RestoreApp(ctx context.Context, tenantID, appID uint64) error {
+	return v.next.RestoreApp(ctx, tenantID, appID)
+}
+
+func (v validation) PurgeApps(ctx context.Context, deletedBefore time.Time) (common_model.Purged, error) {
+	return v.next.PurgeApps(ctx, deletedBefore)
+}
+
 func (v validation) UpdateApp(ctx context.Context, app *model.App) error {
 	if err := v.validate.Struct(app); err != nil {
 		return v.next.UpdateApp(ctx, app)
@@ -52,3 +67,32 @@ func (v validation) CreateApp(ctx context.Context, app *model.App) (uint64, erro
 	}
 	return v.next.CreateApp(ctx, app)
 }
+
+func (v validation) GetAppVersion(ctx context.Context, tenantID, appVersionID uint64) (*model.AppVersion, error) {
+	return v.next.GetAppVersion(ctx, tenantID, appVersionID)
+}
+
+func (v validation) ListAppVersions(ctx context.Context, tenantID, appID uint64) ([]*model.AppVersion, error) {
+	return v.next.ListAppVersions(ctx, tenantID, appID)
+}
+
+func (v validation) CreateAppVersion(ctx context.Context, req service.CreateAppVersionReq) (uint64, error) {
+	if err := v.validate.Struct(req); err != nil {
+		return 0, err
+	}
+	return v.next.CreateAppVersion(ctx, req)
+}
+
+func (v validation) UpdateAppVersion(ctx context.Context, req service.UpdateAppVersionReq) error {
+	if err := v.validate.Struct(req); err != nil {
+		return err
+	}
+	return v.next.UpdateAppVersion(ctx, req)
+}
+
+func (v validation) ResolveAppImage(ctx context.Context, req service.ResolveAppImageReq) (uint64, error) {
+	if err := v.validate.Struct(req); err != nil {
+		return 0, err
+	}
+	return v.next.ResolveAppImage(ctx, req)
+}
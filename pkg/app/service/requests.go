@@ -0,0 +1,78 @@
+package service
+
+import (
+	"github.com/CHORUS-TRE/chorus-backend/internal/utils/pagination"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/app/model"
+	common_model "github.com/CHORUS-TRE/chorus-backend/pkg/common/model"
+)
+
+// MaxAppsPageSize is the maximum number of apps that can be requested
+// in a single page.
+const MaxAppsPageSize = 500
+
+type ListAppsReq struct {
+	TenantID uint64
+	Cursor   *pagination.RequestCursor[pagination.KeysetCursor]
+	Filter   AppFilter
+	Sort     Sort `validate:"required"`
+}
+
+type AppFilter struct {
+	Status  string `validate:"omitempty,oneof=active inactive deleted"`
+	OwnerID uint64
+}
+
+type Sort struct {
+	SortOrder string `validate:"required,oneof=DESC ASC"`
+	SortType  string `validate:"required,oneof=ID NAME CREATEDAT"`
+}
+
+func (s Sort) ToBusinessSort() common_model.Sort {
+	return common_model.Sort{
+		SortOrder: s.SortOrder,
+		SortType:  s.SortType,
+	}
+}
+
+func (f AppFilter) ToBusinessFilter() model.AppFilter {
+	return model.AppFilter{
+		Status:  model.AppStatus(f.Status),
+		OwnerID: f.OwnerID,
+	}
+}
+
+type CreateAppVersionReq struct {
+	TenantID uint64
+	UserID   uint64
+	AppID    uint64 `validate:"required"`
+
+	Version         string `validate:"required,max=255"`
+	Changelog       string `validate:"max=65535"`
+	DockerImageName string `validate:"required,max=255"`
+	DockerImageTag  string `validate:"max=128"`
+
+	// Default makes the version the default one of the app.
+	Default bool
+}
+
+type UpdateAppVersionReq struct {
+	TenantID     uint64
+	AppVersionID uint64 `validate:"required"`
+
+	Changelog  string `validate:"max=65535"`
+	Deprecated bool
+
+	// Default makes the version the default one of the app, which a
+	// deprecated version cannot be.
+	Default bool `validate:"excluded_if=Deprecated true"`
+}
+
+type ResolveAppImageReq struct {
+	TenantID uint64
+	UserID   uint64
+	AppID    uint64 `validate:"required"`
+
+	// Version is the name of the version created when the digest changed.
+	Version   string `validate:"required,max=255"`
+	Changelog string `validate:"max=65535"`
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
+	"github.com/CHORUS-TRE/chorus-backend/internal/utils/pagination"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/app/model"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/app/service"
 	common_model "github.com/CHORUS-TRE/chorus-backend/pkg/common/model"
@@ -26,25 +27,26 @@ func Logging(logger *logger.ContextLogger) func(service.AppStore) service.AppSto
 	}
 }
 
-func (c appStorageLogging) ListApps(ctx context.Context, tenantID uint64, pagination common_model.Pagination) ([]*model.App, error) {
+func (c appStorageLogging) ListApps(ctx context.Context, tenantID uint64, filter model.AppFilter, sort common_model.Sort, cursor *pagination.RequestCursor[pagination.KeysetCursor]) ([]*model.App, *pagination.ResponseCursor[pagination.KeysetCursor], uint64, error) {
 	c.logger.Debug(ctx, "request started")
 
 	now := time.Now()
 
-	res, err := c.next.ListApps(ctx, tenantID, pagination)
+	res, resCursor, count, err := c.next.ListApps(ctx, tenantID, filter, sort, cursor)
 	if err != nil {
 		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
 			zap.Error(err),
 			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
 		)
-		return nil, err
+		return nil, nil, 0, err
 	}
 
 	c.logger.Debug(ctx, "request completed",
 		logger.WithCountField(len(res)),
+		logger.WithTotalItemsField(count),
 		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
 	)
-	return res, nil
+	return res, resCursor, count, nil
 }
 
 func (c appStorageLogging) GetApp(ctx context.Context, tenantID uint64, appID uint64) (*model.App, error) {
@@ -88,6 +90,45 @@ func (c appStorageLogging) DeleteApp(ctx context.Context, tenantID, appID uint64
 	return nil
 }
 
+func (c appStorageLogging) RestoreApp(ctx context.Context, tenantID, appID uint64, deletedAfter time.Time) error {
+	c.logger.Debug(ctx, "request started")
+	now := time.Now()
+
+	err := c.next.RestoreApp(ctx, tenantID, appID, deletedAfter)
+	if err != nil {
+		c.logger.Error(ctx, "request completed",
+			logger.WithAppIDField(appID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return err
+	}
+	c.logger.Debug(ctx, "request completed",
+		logger.WithAppIDField(appID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return nil
+}
+
+func (c appStorageLogging) PurgeApps(ctx context.Context, deletedBefore time.Time) (common_model.Purged, error) {
+	c.logger.Debug(ctx, "request started")
+	now := time.Now()
+
+	purged, err := c.next.PurgeApps(ctx, deletedBefore)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return purged, err
+	}
+	c.logger.Debug(ctx, "request completed",
+		zap.Int64("num_purged", purged.Total()),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return purged, nil
+}
+
 func (c appStorageLogging) UpdateApp(ctx context.Context, tenantID uint64, app *model.App) error {
 	c.logger.Debug(ctx, "request started")
 	now := time.Now()
@@ -108,12 +149,12 @@ func (c appStorageLogging) UpdateApp(ctx context.Context, tenantID uint64, app *
 	return nil
 }
 
-func (c appStorageLogging) CreateApp(ctx context.Context, tenantID uint64, app *model.App) (uint64, error) {
+func (c appStorageLogging) CreateApp(ctx context.Context, tenantID uint64, app *model.App, version *model.AppVersion) (uint64, error) {
 	c.logger.Debug(ctx, "request started")
 
 	now := time.Now()
 
-	appId, err := c.next.CreateApp(ctx, tenantID, app)
+	appId, err := c.next.CreateApp(ctx, tenantID, app, version)
 	if err != nil {
 		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
 			zap.Error(err),
@@ -128,3 +169,89 @@ func (c appStorageLogging) CreateApp(ctx context.Context, tenantID uint64, app *
 	)
 	return appId, nil
 }
+
+func (c appStorageLogging) GetAppVersion(ctx context.Context, tenantID, appVersionID uint64) (*model.AppVersion, error) {
+	c.logger.Debug(ctx, "request started")
+	now := time.Now()
+
+	res, err := c.next.GetAppVersion(ctx, tenantID, appVersionID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			logger.WithAppVersionIDField(appVersionID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		logger.WithAppVersionIDField(appVersionID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, nil
+}
+
+func (c appStorageLogging) ListAppVersions(ctx context.Context, tenantID, appID uint64) ([]*model.AppVersion, error) {
+	c.logger.Debug(ctx, "request started")
+	now := time.Now()
+
+	res, err := c.next.ListAppVersions(ctx, tenantID, appID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			logger.WithAppIDField(appID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		logger.WithAppIDField(appID),
+		logger.WithCountField(len(res)),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, nil
+}
+
+func (c appStorageLogging) CreateAppVersion(ctx context.Context, tenantID uint64, version *model.AppVersion, setDefault bool) (uint64, error) {
+	c.logger.Debug(ctx, "request started")
+	now := time.Now()
+
+	id, err := c.next.CreateAppVersion(ctx, tenantID, version, setDefault)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			logger.WithAppIDField(version.AppID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return 0, err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		logger.WithAppIDField(version.AppID),
+		logger.WithAppVersionIDField(id),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return id, nil
+}
+
+func (c appStorageLogging) UpdateAppVersion(ctx context.Context, tenantID uint64, version *model.AppVersion, setDefault bool) error {
+	c.logger.Debug(ctx, "request started")
+	now := time.Now()
+
+	err := c.next.UpdateAppVersion(ctx, tenantID, version, setDefault)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			logger.WithAppVersionIDField(version.ID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		logger.WithAppVersionIDField(version.ID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return nil
+}
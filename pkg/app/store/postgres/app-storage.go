@@ -3,13 +3,16 @@ package postgres
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/jmoiron/sqlx"
 
 	"github.com/CHORUS-TRE/chorus-backend/internal/utils/database"
-	"github.com/CHORUS-TRE/chorus-backend/internal/utils/uuid"
+	"github.com/CHORUS-TRE/chorus-backend/internal/utils/pagination"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/app/model"
 	common_model "github.com/CHORUS-TRE/chorus-backend/pkg/common/model"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/common/storage"
 )
 
 // AppStorage is the handler through which a PostgresDB backend can be queried.
@@ -24,7 +27,7 @@ func NewAppStorage(db *sqlx.DB) *AppStorage {
 
 func (s *AppStorage) GetApp(ctx context.Context, tenantID uint64, appID uint64) (*model.App, error) {
 	const query = `
-		SELECT id, tenantid, userid, name, description, status, dockerimagename, dockerimagetag, createdat, updatedat
+		SELECT id, tenantid, userid, name, description, status, dockerimagename, dockerimagetag, dockerimagedigest, defaultversionid, cpurequest, memoryrequest, createdat, updatedat, deletedat
 			FROM apps
 		WHERE tenantid = $1 AND id = $2;
 	`
@@ -37,47 +40,134 @@ func (s *AppStorage) GetApp(ctx context.Context, tenantID uint64, appID uint64)
 	return &app, nil
 }
 
-func (s *AppStorage) ListApps(ctx context.Context, tenantID uint64, pagination common_model.Pagination) ([]*model.App, error) {
-	const query = `
-SELECT id, tenantid, userid, name, description, status, dockerimagename, dockerimagetag, createdat, updatedat
-	FROM apps
-WHERE tenantid = $1 AND status != 'deleted';
-`
+// ListApps queries the stocked apps matching the filter, one page at a
+// time. Apps that are 'deleted' are skipped unless explicitly filtered on.
+// It also returns the total number of apps matching the filter.
+func (s *AppStorage) ListApps(ctx context.Context, tenantID uint64, filter model.AppFilter, sort common_model.Sort, cursor *pagination.RequestCursor[pagination.KeysetCursor]) ([]*model.App, *pagination.ResponseCursor[pagination.KeysetCursor], uint64, error) {
+	args, whereClauses := buildAppsWhereClauses(tenantID, filter)
+
+	sortType := strings.ToUpper(sort.SortType)
+	column, ok := model.AppSortTypeToString[sortType]
+	if !ok {
+		sortType, column = "ID", model.AppSortTypeToString["ID"]
+	}
+	selectArgs, keysetClause, sortClause, reversed := storage.KeysetClauses(args, column, "id", strings.ToUpper(sort.SortOrder), cursor)
+
+	selectQuery := `
+SELECT id, tenantid, userid, name, description, status, dockerimagename, dockerimagetag, dockerimagedigest, defaultversionid, cpurequest, memoryrequest, createdat, updatedat, deletedat
+FROM apps
+` + whereClauses + keysetClause + sortClause
+	query, selectArgs, err := sqlx.In(selectQuery, selectArgs...)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	query = sqlx.Rebind(sqlx.DOLLAR, query)
+
 	var apps []*model.App
-	if err := s.db.SelectContext(ctx, &apps, query, tenantID); err != nil {
-		return nil, err
+	if err := s.db.SelectContext(ctx, &apps, query, selectArgs...); err != nil {
+		return nil, nil, 0, err
+	}
+	if reversed {
+		storage.Reverse(apps)
+	}
+
+	apps, responseCursor := pagination.NewKeysetResponseCursor(apps, func(a *model.App) pagination.Keyset {
+		return pagination.Keyset{ID: a.ID, Value: a.SortValue(sortType)}
+	}, cursor)
+
+	count, err := s.countApps(ctx, whereClauses, args)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+
+	return apps, responseCursor, count, nil
+}
+
+func buildAppsWhereClauses(tenantID uint64, filter model.AppFilter) ([]interface{}, string) {
+	args := []interface{}{tenantID}
+	whereClauses := "WHERE tenantid = ?"
+
+	if filter.Status != "" {
+		args = append(args, filter.Status.String())
+		whereClauses += " AND status = ?"
+	} else {
+		whereClauses += " AND status != 'deleted'"
 	}
 
-	return apps, nil
+	if filter.OwnerID != 0 {
+		args = append(args, filter.OwnerID)
+		whereClauses += " AND userid = ?"
+	}
+
+	return args, whereClauses
 }
 
-// CreateApp saves the provided app object in the database 'apps' table.
-func (s *AppStorage) CreateApp(ctx context.Context, tenantID uint64, app *model.App) (uint64, error) {
+func (s *AppStorage) countApps(ctx context.Context, whereClauses string, args []interface{}) (uint64, error) {
+	countQuery := `
+SELECT COUNT(id) FROM apps
+` + whereClauses
+	query, args, err := sqlx.In(countQuery, args...)
+	if err != nil {
+		return 0, err
+	}
+	query = sqlx.Rebind(sqlx.DOLLAR, query)
+
+	var count uint64
+	if err := s.db.GetContext(ctx, &count, query, args...); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// CreateApp saves the provided app object in the database 'apps' table, along
+// with its first version which becomes its default one.
+func (s *AppStorage) CreateApp(ctx context.Context, tenantID uint64, app *model.App, version *model.AppVersion) (uint64, error) {
 	const appQuery = `
-INSERT INTO apps (tenantid, userid, name, description, status, dockerimagename, dockerimagetag, createdat, updatedat)
-VALUES ($1, $2, $3, $4, $5, $6, $7, NOW(), NOW()) RETURNING id;
+INSERT INTO apps (tenantid, userid, name, description, status, dockerimagename, dockerimagetag, dockerimagedigest, cpurequest, memoryrequest, createdat, updatedat)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, NOW(), NOW()) RETURNING id;
 	`
+	const defaultVersionQuery = `
+UPDATE apps SET defaultversionid = $3 WHERE tenantid = $1 AND id = $2;
+`
+
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
 
 	var id uint64
-	err := s.db.GetContext(ctx, &id, appQuery,
-		tenantID, app.UserID, app.Name, app.Description, app.Status, app.DockerImageName, app.DockerImageTag,
+	err = tx.GetContext(ctx, &id, appQuery,
+		tenantID, app.UserID, app.Name, app.Description, app.Status, app.DockerImageName, app.DockerImageTag, app.DockerImageDigest, app.CPURequest, app.MemoryRequest,
 	)
 	if err != nil {
-		return 0, err
+		return 0, storage.Rollback(tx, err)
 	}
 
+	version.AppID = id
+	versionID, err := createAppVersion(ctx, tx, tenantID, version)
+	if err != nil {
+		return 0, storage.Rollback(tx, fmt.Errorf("unable to create version: %w", err))
+	}
+
+	if _, err := tx.ExecContext(ctx, defaultVersionQuery, tenantID, id, versionID); err != nil {
+		return 0, storage.Rollback(tx, fmt.Errorf("unable to set default version: %w", err))
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
 	return id, nil
 }
 
 func (s *AppStorage) UpdateApp(ctx context.Context, tenantID uint64, app *model.App) (err error) {
 	const appUpdateQuery = `
 		UPDATE apps
-		SET name = $3, description = $4, status = $5, updatedat = NOW()
-		WHERE tenantid = $1 AND id = $2;
+		SET name = $3, description = $4, status = $5, cpurequest = $6, memoryrequest = $7, updatedat = NOW()
+		WHERE tenantid = $1 AND id = $2 AND status != 'deleted';
 	`
 
 	// Update User
-	rows, err := s.db.ExecContext(ctx, appUpdateQuery, tenantID, app.ID, app.Name, app.Description, app.Status)
+	rows, err := s.db.ExecContext(ctx, appUpdateQuery, tenantID, app.ID, app.Name, app.Description, app.Status, app.CPURequest, app.MemoryRequest)
 	if err != nil {
 		return err
 	}
@@ -95,13 +185,11 @@ func (s *AppStorage) UpdateApp(ctx context.Context, tenantID uint64, app *model.
 
 func (s *AppStorage) DeleteApp(ctx context.Context, tenantID uint64, appID uint64) error {
 	const query = `
-		UPDATE apps	SET 
-			(status, name, updatedat, deletedat) = 
-			($3, concat(name, $4::TEXT), NOW(), NOW())
-		WHERE tenantid = $1 AND id = $2;
-	`
+UPDATE apps SET status = 'deleted', deletedstatus = status, updatedat = NOW(), deletedat = NOW()
+WHERE tenantid = $1 AND id = $2 AND status != 'deleted';
+`
 
-	rows, err := s.db.ExecContext(ctx, query, tenantID, appID, model.AppDeleted.String(), "-"+uuid.Next())
+	rows, err := s.db.ExecContext(ctx, query, tenantID, appID)
 	if err != nil {
 		return fmt.Errorf("unable to exec: %w", err)
 	}
@@ -116,3 +204,39 @@ func (s *AppStorage) DeleteApp(ctx context.Context, tenantID uint64, appID uint6
 
 	return nil
 }
+
+// RestoreApp restores an app deleted after deletedAfter to the status it had
+// before.
+func (s *AppStorage) RestoreApp(ctx context.Context, tenantID uint64, appID uint64, deletedAfter time.Time) error {
+	const query = `
+UPDATE apps SET status = COALESCE(deletedstatus, 'active'), deletedstatus = NULL, updatedat = NOW(), deletedat = NULL
+WHERE tenantid = $1 AND id = $2 AND status = 'deleted' AND deletedat > $3;
+`
+
+	rows, err := s.db.ExecContext(ctx, query, tenantID, appID, deletedAfter)
+	if err != nil {
+		return fmt.Errorf("unable to exec: %w", err)
+	}
+	affected, err := rows.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("unable to get rows affected: %w", err)
+	}
+
+	if affected == 0 {
+		return database.ErrNoRowsUpdated
+	}
+
+	return nil
+}
+
+// PurgeApps hard-deletes the apps deleted before deletedBefore that have no
+// app instance left, and returns their number per tenant.
+func (s *AppStorage) PurgeApps(ctx context.Context, deletedBefore time.Time) (common_model.Purged, error) {
+	const query = `
+DELETE FROM apps a
+WHERE a.status = 'deleted' AND a.deletedat < $1
+	AND NOT EXISTS (SELECT 1 FROM app_instances ai WHERE ai.appid = a.id)
+RETURNING a.tenantid;
+`
+	return storage.Purge(ctx, s.db, query, deletedBefore)
+}
@@ -0,0 +1,89 @@
+//go:build integration
+
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/CHORUS-TRE/chorus-backend/pkg/app/store/postgres"
+	"github.com/CHORUS-TRE/chorus-backend/tests/helpers"
+)
+
+const tenantID = 88888
+
+func setupTables(t *testing.T) {
+	cleanTables(t)
+	t.Cleanup(func() { cleanTables(t) })
+
+	q := `
+	INSERT INTO tenants (id, name) VALUES (88888, 'test tenant');
+
+	INSERT INTO users (id, tenantid, firstname, lastname, username, password, status, createdat, updatedat)
+	VALUES (90000, 88888, 'hello', 'moto', 'hmoto', '', 'active', NOW(), NOW());
+
+	INSERT INTO apps (id, tenantid, userid, name, description, status, dockerimagename, dockerimagetag, createdat, updatedat)
+	VALUES (93000, 88888, 90000, 'inactive', '', 'inactive', 'image', 'latest', NOW(), NOW()),
+		(93001, 88888, 90000, 'active', '', 'active', 'image', 'latest', NOW(), NOW());
+	`
+	helpers.Populate(q)
+}
+
+func cleanTables(t *testing.T) {
+	q := `
+	DELETE FROM apps WHERE tenantid = 88888;
+	DELETE FROM users WHERE tenantid = 88888;
+	DELETE FROM tenants WHERE id = 88888;
+	`
+	_, err := helpers.DB().ExecContext(context.Background(), q)
+	require.NoError(t, err)
+}
+
+func status(t *testing.T, id uint64) string {
+	var s string
+	require.NoError(t, helpers.DB().Get(&s, `SELECT status FROM apps WHERE id = $1`, id))
+	return s
+}
+
+func TestAppStorage_DeleteRestore(t *testing.T) {
+	setupTables(t)
+	ctx := context.Background()
+	s := postgres.NewAppStorage(helpers.DB())
+
+	require.NoError(t, s.DeleteApp(ctx, tenantID, 93000))
+	require.Equal(t, "deleted", status(t, 93000))
+	require.Error(t, s.DeleteApp(ctx, tenantID, 93000), "a deleted app cannot be deleted again")
+
+	err := s.RestoreApp(ctx, tenantID, 93000, time.Now().Add(time.Hour))
+	require.Error(t, err, "an app deleted before the grace period cannot be restored")
+
+	require.NoError(t, s.RestoreApp(ctx, tenantID, 93000, time.Now().Add(-time.Hour)))
+	require.Equal(t, "inactive", status(t, 93000), "the app gets its status back")
+
+	require.NoError(t, s.DeleteApp(ctx, tenantID, 93001))
+	require.NoError(t, s.RestoreApp(ctx, tenantID, 93001, time.Now().Add(-time.Hour)))
+	require.Equal(t, "active", status(t, 93001))
+
+	err = s.RestoreApp(ctx, tenantID, 93001, time.Now().Add(-time.Hour))
+	require.Error(t, err, "an app that is not deleted cannot be restored")
+}
+
+func TestAppStorage_PurgeApps(t *testing.T) {
+	setupTables(t)
+	ctx := context.Background()
+	s := postgres.NewAppStorage(helpers.DB())
+
+	require.NoError(t, s.DeleteApp(ctx, tenantID, 93000))
+
+	purged, err := s.PurgeApps(ctx, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	require.Zero(t, purged[tenantID], "the apps deleted during the grace period are kept")
+
+	purged, err = s.PurgeApps(ctx, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, int64(1), purged[tenantID])
+	require.Equal(t, 1, helpers.CountRow(t, helpers.DB(), "apps", "tenantid", tenantID), "the apps that are not deleted are kept")
+}
@@ -0,0 +1,50 @@
+package postgres
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/CHORUS-TRE/chorus-backend/pkg/app/model"
+)
+
+func TestBuildAppsWhereClauses(t *testing.T) {
+	tests := []struct {
+		name         string
+		filter       model.AppFilter
+		expectsArgs  []interface{}
+		expectsWhere string
+	}{
+		{
+			name:         "Without filter, skips the deleted apps",
+			expectsArgs:  []interface{}{uint64(1)},
+			expectsWhere: "WHERE tenantid = ? AND status != 'deleted'",
+		},
+		{
+			name:         "With a status, returns the apps with that status",
+			filter:       model.AppFilter{Status: model.AppDeleted},
+			expectsArgs:  []interface{}{uint64(1), "deleted"},
+			expectsWhere: "WHERE tenantid = ? AND status = ?",
+		},
+		{
+			name:         "With an owner, returns the apps of that user",
+			filter:       model.AppFilter{OwnerID: 42},
+			expectsArgs:  []interface{}{uint64(1), uint64(42)},
+			expectsWhere: "WHERE tenantid = ? AND status != 'deleted' AND userid = ?",
+		},
+		{
+			name:         "With a status and an owner, combines both",
+			filter:       model.AppFilter{Status: model.AppDeleted, OwnerID: 42},
+			expectsArgs:  []interface{}{uint64(1), "deleted", uint64(42)},
+			expectsWhere: "WHERE tenantid = ? AND status = ? AND userid = ?",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			args, where := buildAppsWhereClauses(1, tt.filter)
+			require.Equal(t, tt.expectsArgs, args)
+			require.Equal(t, tt.expectsWhere, where)
+		})
+	}
+}
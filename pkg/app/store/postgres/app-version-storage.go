@@ -0,0 +1,153 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/utils/database"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/app/model"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/common/storage"
+)
+
+func (s *AppStorage) GetAppVersion(ctx context.Context, tenantID, appVersionID uint64) (*model.AppVersion, error) {
+	const query = `
+SELECT id, tenantid, userid, appid, version, changelog, deprecated, dockerimagename, dockerimagetag, dockerimagedigest, createdat, updatedat
+	FROM app_versions
+WHERE tenantid = $1 AND id = $2;
+`
+	var version model.AppVersion
+	if err := s.db.GetContext(ctx, &version, query, tenantID, appVersionID); err != nil {
+		return nil, err
+	}
+
+	return &version, nil
+}
+
+// ListAppVersions returns the versions of an app, the most recent first.
+func (s *AppStorage) ListAppVersions(ctx context.Context, tenantID, appID uint64) ([]*model.AppVersion, error) {
+	const query = `
+SELECT id, tenantid, userid, appid, version, changelog, deprecated, dockerimagename, dockerimagetag, dockerimagedigest, createdat, updatedat
+	FROM app_versions
+WHERE tenantid = $1 AND appid = $2
+ORDER BY id DESC;
+`
+	var versions []*model.AppVersion
+	if err := s.db.SelectContext(ctx, &versions, query, tenantID, appID); err != nil {
+		return nil, err
+	}
+
+	return versions, nil
+}
+
+// CreateAppVersion adds a version to an app, and makes it the default one of
+// the app in the same transaction when setDefault is set.
+func (s *AppStorage) CreateAppVersion(ctx context.Context, tenantID uint64, version *model.AppVersion, setDefault bool) (uint64, error) {
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	id, err := createAppVersion(ctx, tx, tenantID, version)
+	if err != nil {
+		return 0, storage.Rollback(tx, err)
+	}
+
+	if setDefault {
+		if err := setDefaultAppVersion(ctx, tx, tenantID, version.AppID, id); err != nil {
+			return 0, storage.Rollback(tx, fmt.Errorf("unable to set default version: %w", err))
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+// createAppVersion inserts a version, returning database.ErrDuplicateKey when
+// the app already has a version with that name.
+func createAppVersion(ctx context.Context, q sqlx.QueryerContext, tenantID uint64, version *model.AppVersion) (uint64, error) {
+	const query = `
+INSERT INTO app_versions (tenantid, userid, appid, version, changelog, deprecated, dockerimagename, dockerimagetag, dockerimagedigest, createdat, updatedat)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, NOW(), NOW())
+RETURNING id;
+`
+	var id uint64
+	err := sqlx.GetContext(ctx, q, &id, query,
+		tenantID, version.UserID, version.AppID, version.Version, version.Changelog, version.Deprecated, version.DockerImageName, version.DockerImageTag, version.DockerImageDigest,
+	)
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == database.DuplicateKeyErrorCode && pqErr.Constraint == "app_versions_version_key" {
+		return 0, fmt.Errorf("version %v of app %v: %w", version.Version, version.AppID, database.ErrDuplicateKey)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// UpdateAppVersion updates the changelog and the deprecation of a version,
+// its image being immutable, and makes it the default one of its app in the
+// same transaction when setDefault is set.
+func (s *AppStorage) UpdateAppVersion(ctx context.Context, tenantID uint64, version *model.AppVersion, setDefault bool) error {
+	const query = `
+UPDATE app_versions
+SET changelog = $3, deprecated = $4, updatedat = NOW()
+WHERE tenantid = $1 AND id = $2;
+`
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	rows, err := tx.ExecContext(ctx, query, tenantID, version.ID, version.Changelog, version.Deprecated)
+	if err != nil {
+		return storage.Rollback(tx, err)
+	}
+	affected, err := rows.RowsAffected()
+	if err != nil {
+		return storage.Rollback(tx, err)
+	}
+	if affected == 0 {
+		return storage.Rollback(tx, database.ErrNoRowsUpdated)
+	}
+
+	if setDefault {
+		if err := setDefaultAppVersion(ctx, tx, tenantID, version.AppID, version.ID); err != nil {
+			return storage.Rollback(tx, fmt.Errorf("unable to set default version: %w", err))
+		}
+	}
+
+	return tx.Commit()
+}
+
+// setDefaultAppVersion makes a version that is not deprecated the default one
+// of its app, whose image becomes the one of the version.
+func setDefaultAppVersion(ctx context.Context, e sqlx.ExecerContext, tenantID, appID, appVersionID uint64) error {
+	const query = `
+UPDATE apps a
+SET defaultversionid = v.id, dockerimagename = v.dockerimagename, dockerimagetag = v.dockerimagetag, dockerimagedigest = v.dockerimagedigest, updatedat = NOW()
+FROM app_versions v
+WHERE a.tenantid = $1 AND a.id = $2 AND a.status != 'deleted'
+	AND v.id = $3 AND v.appid = a.id AND NOT v.deprecated;
+`
+	rows, err := e.ExecContext(ctx, query, tenantID, appID, appVersionID)
+	if err != nil {
+		return err
+	}
+	affected, err := rows.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return database.ErrNoRowsUpdated
+	}
+
+	return nil
+}
@@ -0,0 +1,78 @@
+//go:build integration
+
+package postgres_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/utils/database"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/app/model"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/app/store/postgres"
+	"github.com/CHORUS-TRE/chorus-backend/tests/helpers"
+)
+
+func newVersion(name, digest string) *model.AppVersion {
+	return &model.AppVersion{UserID: 90000, AppID: 93001, Version: name, DockerImageName: "image", DockerImageTag: name, DockerImageDigest: digest}
+}
+
+func TestAppStorage_CreateAppVersion(t *testing.T) {
+	setupTables(t)
+	ctx := context.Background()
+	s := postgres.NewAppStorage(helpers.DB())
+
+	id, err := s.CreateAppVersion(ctx, tenantID, newVersion("1.0", "sha256:a"), true)
+	require.NoError(t, err)
+	app, err := s.GetApp(ctx, tenantID, 93001)
+	require.NoError(t, err)
+	require.Equal(t, id, *app.DefaultVersionID)
+	require.Equal(t, "1.0", app.DockerImageTag, "the app gets the image of its default version")
+	require.Equal(t, "sha256:a", app.DockerImageDigest)
+
+	_, err = s.CreateAppVersion(ctx, tenantID, newVersion("1.0", "sha256:b"), false)
+	require.ErrorIs(t, err, database.ErrDuplicateKey)
+
+	other, err := s.CreateAppVersion(ctx, tenantID, newVersion("1.1", "sha256:b"), false)
+	require.NoError(t, err)
+	app, err = s.GetApp(ctx, tenantID, 93001)
+	require.NoError(t, err)
+	require.Equal(t, id, *app.DefaultVersionID)
+
+	versions, err := s.ListAppVersions(ctx, tenantID, 93001)
+	require.NoError(t, err)
+	require.Len(t, versions, 2)
+	require.Equal(t, other, versions[0].ID, "the most recent version comes first")
+}
+
+func TestAppStorage_UpdateAppVersion(t *testing.T) {
+	setupTables(t)
+	ctx := context.Background()
+	s := postgres.NewAppStorage(helpers.DB())
+
+	_, err := s.CreateAppVersion(ctx, tenantID, newVersion("1.0", "sha256:a"), true)
+	require.NoError(t, err)
+	version := newVersion("1.1", "sha256:b")
+	version.ID, err = s.CreateAppVersion(ctx, tenantID, version, false)
+	require.NoError(t, err)
+
+	// A deprecated version cannot be made the default one, and the update is
+	// rolled back along.
+	version.Changelog, version.Deprecated = "deprecated", true
+	require.ErrorIs(t, s.UpdateAppVersion(ctx, tenantID, version, true), database.ErrNoRowsUpdated)
+	got, err := s.GetAppVersion(ctx, tenantID, version.ID)
+	require.NoError(t, err)
+	require.False(t, got.Deprecated)
+	require.Empty(t, got.Changelog)
+
+	version.Changelog, version.Deprecated = "fixes", false
+	require.NoError(t, s.UpdateAppVersion(ctx, tenantID, version, true))
+	got, err = s.GetAppVersion(ctx, tenantID, version.ID)
+	require.NoError(t, err)
+	require.Equal(t, "fixes", got.Changelog)
+	app, err := s.GetApp(ctx, tenantID, 93001)
+	require.NoError(t, err)
+	require.Equal(t, version.ID, *app.DefaultVersionID)
+	require.Equal(t, "sha256:b", app.DockerImageDigest)
+}
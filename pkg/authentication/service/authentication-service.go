@@ -11,6 +11,7 @@ import (
 
 	"github.com/CHORUS-TRE/chorus-backend/internal/config"
 	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
+	"github.com/CHORUS-TRE/chorus-backend/internal/notification"
 	"github.com/CHORUS-TRE/chorus-backend/internal/utils/crypto"
 	"github.com/CHORUS-TRE/chorus-backend/internal/utils/uuid"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/authentication/model"
@@ -40,6 +41,7 @@ type Userer interface {
 // AuthenticationStore groups the functions for accessing the database.
 type AuthenticationStore interface {
 	GetActiveUser(ctx context.Context, username, source string) (*model.User, error)
+	UpdateUserLocale(ctx context.Context, tenantID, userID uint64, locale string) error
 }
 
 // AuthenticationService is the authentication service handler.
@@ -181,6 +183,8 @@ func (a *AuthenticationService) Authenticate(ctx context.Context, username, pass
 		}
 	}
 
+	a.recordLocale(ctx, user)
+
 	token, err := createJWTToken(a.signingKey, user, a.jwtExpirationTime)
 	if err != nil {
 		logger.TechLog.Error(ctx, "unable to create JWT token", zap.Error(err))
@@ -272,6 +276,8 @@ func (a *AuthenticationService) OAuthCallback(ctx context.Context, providerID, s
 		}
 	}
 
+	a.recordLocale(ctx, user)
+
 	jwtToken, err := createJWTToken(a.signingKey, user, a.jwtExpirationTime)
 	if err != nil {
 		logger.TechLog.Error(ctx, "unable to create JWT token", zap.Error(err))
@@ -291,6 +297,19 @@ func (a *AuthenticationService) getAuthMode(id string) (*config.Mode, error) {
 	return nil, &ErrInvalidArgument{}
 }
 
+// recordLocale records the locale of the client the user signs in with, in
+// which the notifications of the user are emailed. A failure is only logged,
+// as it must not prevent the user from signing in.
+func (a *AuthenticationService) recordLocale(ctx context.Context, user *model.User) {
+	locale, ok := notification.LocaleFromContext(ctx)
+	if !ok {
+		return
+	}
+	if err := a.store.UpdateUserLocale(ctx, user.TenantID, user.ID, string(locale)); err != nil {
+		logger.TechLog.Error(ctx, "unable to record user locale", zap.Uint64("user_id", user.ID), zap.Error(err))
+	}
+}
+
 // verifyPassword checks whether the hashed password matches a provided hash.
 func verifyPassword(hash, password string) bool {
 	if err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)); err != nil {
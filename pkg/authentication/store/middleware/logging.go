@@ -38,6 +38,28 @@ func (a authenticationStorageLogging) GetActiveUser(ctx context.Context, usernam
 	return res, nil
 }
 
+func (a authenticationStorageLogging) UpdateUserLocale(ctx context.Context, tenantID, userID uint64, locale string) error {
+	a.logger.Debug(ctx, "request started")
+
+	now := time.Now()
+
+	err := a.next.UpdateUserLocale(ctx, tenantID, userID, locale)
+	if err != nil {
+		a.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return err
+	}
+
+	a.logger.Debug(ctx, "request completed",
+		logger.WithUserIDField(userID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+
+	return nil
+}
+
 func Logging(logger *logger.ContextLogger) func(service.AuthenticationStore) service.AuthenticationStore {
 	return func(next service.AuthenticationStore) service.AuthenticationStore {
 		return &authenticationStorageLogging{
@@ -2,6 +2,7 @@ package postgres
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/CHORUS-TRE/chorus-backend/pkg/authentication/model"
 
@@ -20,11 +21,13 @@ func NewAuthenticationStorage(db *sqlx.DB) *AuthenticationStorage {
 }
 
 // GetActiveUser fetches a user entry from the database that matches the provided username.
+// The users of the tenants that are suspended or deleted are not returned.
 func (s *AuthenticationStorage) GetActiveUser(ctx context.Context, username, source string) (*model.User, error) {
 	const query = `
 SELECT id, tenantid, firstname, lastname, username, source, password, totpsecret, totpenabled
 FROM users
-WHERE username = $1 AND source = $2 AND status = 'active';
+WHERE username = $1 AND source = $2 AND status = 'active'
+	AND NOT EXISTS (SELECT 1 FROM tenants t WHERE t.id = users.tenantid AND t.status != 'active');
 `
 	var u model.User
 	if err := s.db.GetContext(ctx, &u, query, username, source); err != nil {
@@ -39,6 +42,18 @@ WHERE username = $1 AND source = $2 AND status = 'active';
 	return &u, nil
 }
 
+// UpdateUserLocale records the locale the notifications of the user are
+// emailed in.
+func (s *AuthenticationStorage) UpdateUserLocale(ctx context.Context, tenantID, userID uint64, locale string) error {
+	const query = `
+UPDATE users SET locale = $3 WHERE tenantid = $1 AND id = $2;
+`
+	if _, err := s.db.ExecContext(ctx, query, tenantID, userID, locale); err != nil {
+		return fmt.Errorf("unable to update locale of user %v: %w", userID, err)
+	}
+	return nil
+}
+
 // getRoles fetches all the roles of a given user.
 func (s *AuthenticationStorage) getRoles(ctx context.Context, userID uint64) ([]string, error) {
 	const query = `
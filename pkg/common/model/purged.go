@@ -0,0 +1,13 @@
+package model
+
+// Purged is the number of resources hard-deleted by a purge, per tenant.
+type Purged map[uint64]int64
+
+// Total returns the number of resources purged across the tenants.
+func (p Purged) Total() int64 {
+	var total int64
+	for _, n := range p {
+		total += n
+	}
+	return total
+}
@@ -11,3 +11,21 @@ type InvalidParametersErr struct{}
 func (e *InvalidParametersErr) Error() string {
 	return "invalid parameters"
 }
+
+type ResourceExhaustedErr struct{}
+
+func (e *ResourceExhaustedErr) Error() string {
+	return "resource exhausted"
+}
+
+type FailedPreconditionErr struct{}
+
+func (e *FailedPreconditionErr) Error() string {
+	return "failed precondition"
+}
+
+type PermissionDeniedErr struct{}
+
+func (e *PermissionDeniedErr) Error() string {
+	return "permission denied"
+}
@@ -0,0 +1,67 @@
+package storage
+
+import (
+	"fmt"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/utils/pagination"
+)
+
+// KeysetClauses builds the clauses selecting the page requested by cursor on
+// a result set sorted by (column, idColumn). It returns the args with the
+// keyset values appended, a condition to add to the WHERE clause (empty for
+// the first and last pages) and the ORDER BY ... LIMIT clause, both using '?'
+// placeholders. The offset of the cursor only applies to the first page.
+//
+// One extra row is requested so that NewKeysetResponseCursor can tell whether
+// there are more pages. When reversed is true, the rows are fetched in
+// inverse order and must be reversed with Reverse before building the cursor.
+func KeysetClauses(args []interface{}, column, idColumn, sortOrder string, cursor *pagination.RequestCursor[pagination.KeysetCursor]) (_ []interface{}, where string, orderLimit string, reversed bool) {
+	order := pagination.SortOrder(SortOrderToString(sortOrder))
+
+	pageRequest := pagination.PageFirst
+	if cursor != nil {
+		pageRequest = cursor.PageRequest
+	}
+
+	switch pageRequest {
+	case pagination.PageNext:
+		if cursor.HasCurrentPage() {
+			args = append(args, cursor.CursorData.Last.Value, cursor.CursorData.Last.ID)
+			where = fmt.Sprintf(" AND (%s, %s) %s (?, ?)", column, idColumn, comparator(order))
+		}
+	case pagination.PagePrevious:
+		if cursor.HasCurrentPage() {
+			args = append(args, cursor.CursorData.First.Value, cursor.CursorData.First.ID)
+			where = fmt.Sprintf(" AND (%s, %s) %s (?, ?)", column, idColumn, comparator(order.Inverse()))
+		}
+		order, reversed = order.Inverse(), true
+	case pagination.PageLast:
+		order, reversed = order.Inverse(), true
+	}
+
+	orderLimit = fmt.Sprintf(" ORDER BY %s %s, %s %s", column, order, idColumn, order)
+	if cursor != nil && cursor.PageSize != 0 {
+		args = append(args, cursor.PageSize+1)
+		orderLimit += " LIMIT ?"
+	}
+	if pageRequest == pagination.PageFirst && cursor != nil && cursor.Offset != 0 {
+		args = append(args, cursor.Offset)
+		orderLimit += " OFFSET ?"
+	}
+
+	return args, where, orderLimit, reversed
+}
+
+// Reverse reverses the order of items in place.
+func Reverse[T any](items []T) {
+	for i, j := 0, len(items)-1; i < j; i, j = i+1, j-1 {
+		items[i], items[j] = items[j], items[i]
+	}
+}
+
+func comparator(order pagination.SortOrder) string {
+	if order == pagination.DESC {
+		return "<"
+	}
+	return ">"
+}
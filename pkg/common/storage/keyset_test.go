@@ -0,0 +1,99 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/utils/pagination"
+)
+
+func TestKeysetClauses(t *testing.T) {
+	page := &pagination.KeysetCursor{
+		First: pagination.Keyset{ID: 3, Value: "b"},
+		Last:  pagination.Keyset{ID: 7, Value: "f"},
+	}
+	cursor := func(request pagination.PageRequest) *pagination.RequestCursor[pagination.KeysetCursor] {
+		return &pagination.RequestCursor[pagination.KeysetCursor]{PageRequest: request, PageSize: 10, CursorData: page}
+	}
+
+	tests := []struct {
+		name            string
+		order           string
+		cursor          *pagination.RequestCursor[pagination.KeysetCursor]
+		expectsArgs     []interface{}
+		expectsWhere    string
+		expectsOrder    string
+		expectsReversed bool
+	}{
+		{
+			name:         "Without cursor, sorts every row",
+			order:        "ASC",
+			expectsArgs:  []interface{}{uint64(1)},
+			expectsOrder: " ORDER BY name ASC, id ASC",
+		},
+		{
+			name:         "With an invalid order, sorts ascending",
+			order:        "RANDOM",
+			expectsArgs:  []interface{}{uint64(1)},
+			expectsOrder: " ORDER BY name ASC, id ASC",
+		},
+		{
+			name:         "First page, descending",
+			order:        "DESC",
+			cursor:       &pagination.RequestCursor[pagination.KeysetCursor]{PageRequest: pagination.PageFirst, PageSize: 10},
+			expectsArgs:  []interface{}{uint64(1), uint64(11)},
+			expectsOrder: " ORDER BY name DESC, id DESC LIMIT ?",
+		},
+		{
+			name:         "First page with an offset, skips the rows",
+			order:        "ASC",
+			cursor:       &pagination.RequestCursor[pagination.KeysetCursor]{PageRequest: pagination.PageFirst, PageSize: 10, Offset: 20},
+			expectsArgs:  []interface{}{uint64(1), uint64(11), uint64(20)},
+			expectsOrder: " ORDER BY name ASC, id ASC LIMIT ? OFFSET ?",
+		},
+		{
+			name:         "Next page, ascending, starts after the last row",
+			order:        "ASC",
+			cursor:       cursor(pagination.PageNext),
+			expectsArgs:  []interface{}{uint64(1), "f", uint64(7), uint64(11)},
+			expectsWhere: " AND (name, id) > (?, ?)",
+			expectsOrder: " ORDER BY name ASC, id ASC LIMIT ?",
+		},
+		{
+			name:         "Next page, descending, starts after the last row",
+			order:        "DESC",
+			cursor:       cursor(pagination.PageNext),
+			expectsArgs:  []interface{}{uint64(1), "f", uint64(7), uint64(11)},
+			expectsWhere: " AND (name, id) < (?, ?)",
+			expectsOrder: " ORDER BY name DESC, id DESC LIMIT ?",
+		},
+		{
+			name:            "Previous page, ascending, ends before the first row",
+			order:           "ASC",
+			cursor:          cursor(pagination.PagePrevious),
+			expectsArgs:     []interface{}{uint64(1), "b", uint64(3), uint64(11)},
+			expectsWhere:    " AND (name, id) < (?, ?)",
+			expectsOrder:    " ORDER BY name DESC, id DESC LIMIT ?",
+			expectsReversed: true,
+		},
+		{
+			name:            "Last page, ascending, is fetched in reverse",
+			order:           "ASC",
+			cursor:          cursor(pagination.PageLast),
+			expectsArgs:     []interface{}{uint64(1), uint64(11)},
+			expectsOrder:    " ORDER BY name DESC, id DESC LIMIT ?",
+			expectsReversed: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			args, where, order, reversed := KeysetClauses([]interface{}{uint64(1)}, "name", "id", tt.order, tt.cursor)
+			require.Equal(t, tt.expectsArgs, args)
+			require.Equal(t, tt.expectsWhere, where)
+			require.Equal(t, tt.expectsOrder, order)
+			require.Equal(t, tt.expectsReversed, reversed)
+		})
+	}
+}
@@ -1,10 +1,14 @@
 package storage
 
 import (
+	"context"
 	"fmt"
+	"strings"
 
 	"github.com/jmoiron/sqlx"
 	"github.com/lib/pq"
+
+	"github.com/CHORUS-TRE/chorus-backend/pkg/common/model"
 )
 
 func Rollback(tx *sqlx.Tx, txErr error) error {
@@ -23,9 +27,32 @@ func PqInt64ToUint64(array pq.Int64Array) []uint64 {
 	return output
 }
 
+// EscapeLike escapes the wildcards of a LIKE pattern, so that the term is
+// matched literally in a pattern using ESCAPE '\'.
+func EscapeLike(term string) string {
+	return likeEscaper.Replace(term)
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
 func SortOrderToString(sortOrder string) string {
 	if sortOrder != "DESC" && sortOrder != "ASC" {
 		return "ASC"
 	}
 	return sortOrder
 }
+
+// Purge runs a query hard-deleting resources and returning their tenantid,
+// and returns the number of deleted resources per tenant.
+func Purge(ctx context.Context, db sqlx.QueryerContext, query string, args ...interface{}) (model.Purged, error) {
+	var tenantIDs []uint64
+	if err := sqlx.SelectContext(ctx, db, &tenantIDs, query, args...); err != nil {
+		return nil, err
+	}
+
+	purged := model.Purged{}
+	for _, id := range tenantIDs {
+		purged[id]++
+	}
+	return purged, nil
+}
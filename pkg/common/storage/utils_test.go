@@ -0,0 +1,14 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEscapeLike(t *testing.T) {
+	require.Equal(t, "john", EscapeLike("john"))
+	require.Equal(t, `\_`, EscapeLike("_"))
+	require.Equal(t, `100\%`, EscapeLike("100%"))
+	require.Equal(t, `a\\b\_c`, EscapeLike(`a\b_c`))
+}
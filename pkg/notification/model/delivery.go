@@ -0,0 +1,40 @@
+package model
+
+import "time"
+
+// NotificationDelivery is an email to send for a notification.
+type NotificationDelivery struct {
+	ID             uint64
+	TenantID       uint64
+	UserID         uint64
+	NotificationID string
+	Frequency      EmailFrequency
+	Status         DeliveryStatus
+	Attempts       int
+	LastError      *string
+	NextAttemptAt  time.Time
+	SentAt         *time.Time
+
+	// Email and Locale are the address and the locale of the user, and
+	// Notification the notification to deliver, all filled in when the
+	// delivery is claimed.
+	Email        string
+	Locale       Locale
+	Notification Notification
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// DeliveryStatus represents the status of a delivery.
+type DeliveryStatus string
+
+const (
+	DeliveryPending DeliveryStatus = "pending"
+	DeliverySent    DeliveryStatus = "sent"
+	DeliveryFailed  DeliveryStatus = "failed"
+)
+
+func (s DeliveryStatus) String() string {
+	return string(s)
+}
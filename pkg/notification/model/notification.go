@@ -1,15 +1,75 @@
 package model
 
 import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
 	"time"
 )
 
 type Notification struct {
 	ID        string
 	TenantID  uint64
+	Type      NotificationType
 	Message   string
+	Link      string
+	Params    NotificationParams
 	CreatedAt time.Time
 	ReadAt    *time.Time
+
+	// Pinned, StartsAt and EndsAt are only set on the announcements. The
+	// notification is displayed between StartsAt and EndsAt, and a pinned one
+	// is listed first until it ends.
+	Pinned   bool
+	StartsAt *time.Time
+	EndsAt   *time.Time
+}
+
+// Started returns whether the display window of the notification has started.
+func (n *Notification) Started(now time.Time) bool {
+	return n.StartsAt == nil || !n.StartsAt.After(now)
+}
+
+// NotificationType identifies the event a notification was emitted for.
+type NotificationType string
+
+const (
+	NotificationTypeWorkbenchReady       NotificationType = "workbench_ready"
+	NotificationTypeWorkbenchFailed      NotificationType = "workbench_failed"
+	NotificationTypeAppInstanceCrashed   NotificationType = "app_instance_crashed"
+	NotificationTypePasswordChanged      NotificationType = "password_changed"
+	NotificationTypeTotpChanged          NotificationType = "totp_changed"
+	NotificationTypeWorkspaceMemberAdded NotificationType = "workspace_member_added"
+	NotificationTypeQuotaApproaching     NotificationType = "quota_approaching"
+	NotificationTypeAnnouncement         NotificationType = "announcement"
+)
+
+func (t NotificationType) String() string {
+	return string(t)
+}
+
+// NotificationParams are the values substituted in the message template of
+// the notification type.
+type NotificationParams map[string]string
+
+func (p NotificationParams) Value() (driver.Value, error) {
+	if p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p)
+}
+
+func (p *NotificationParams) Scan(src interface{}) error {
+	var b []byte
+	switch v := src.(type) {
+	case []byte:
+		b = v
+	case string:
+		b = []byte(v)
+	default:
+		return errors.New("unexpected type for notification params")
+	}
+	return json.Unmarshal(b, p)
 }
 
 var NotificationSortTypeToString = map[string]string{
@@ -0,0 +1,66 @@
+package model
+
+import (
+	"fmt"
+	"time"
+)
+
+// NotificationPreference is the choice of a user to also receive the
+// notifications of a type by email.
+type NotificationPreference struct {
+	TenantID       uint64
+	UserID         uint64
+	Type           NotificationType `db:"notificationtype"`
+	EmailFrequency EmailFrequency
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// EmailFrequency represents how often the notifications are sent by email.
+type EmailFrequency string
+
+const (
+	EmailNever     EmailFrequency = "never"
+	EmailImmediate EmailFrequency = "immediate"
+	EmailDaily     EmailFrequency = "daily"
+)
+
+func (f EmailFrequency) String() string {
+	return string(f)
+}
+
+func ToEmailFrequency(frequency string) (EmailFrequency, error) {
+	switch frequency {
+	case EmailNever.String():
+		return EmailNever, nil
+	case EmailImmediate.String():
+		return EmailImmediate, nil
+	case EmailDaily.String():
+		return EmailDaily, nil
+	default:
+		return "", fmt.Errorf("unexpected EmailFrequency: %s", frequency)
+	}
+}
+
+// NotificationTypes lists the types of the notifications emitted by the
+// platform, for which the users can set their preferences.
+var NotificationTypes = []NotificationType{
+	NotificationTypeWorkbenchReady,
+	NotificationTypeWorkbenchFailed,
+	NotificationTypeAppInstanceCrashed,
+	NotificationTypePasswordChanged,
+	NotificationTypeTotpChanged,
+	NotificationTypeWorkspaceMemberAdded,
+	NotificationTypeQuotaApproaching,
+	NotificationTypeAnnouncement,
+}
+
+func ToNotificationType(notifType string) (NotificationType, error) {
+	for _, t := range NotificationTypes {
+		if t.String() == notifType {
+			return t, nil
+		}
+	}
+	return "", fmt.Errorf("unexpected NotificationType: %s", notifType)
+}
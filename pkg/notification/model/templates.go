@@ -0,0 +1,121 @@
+package model
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+// Locale is a language in which the notification messages can be rendered.
+type Locale string
+
+const (
+	LocaleEN Locale = "en"
+	LocaleFR Locale = "fr"
+
+	DefaultLocale = LocaleEN
+)
+
+// messageTemplates holds the message of each notification type per locale.
+// The templates are executed with the params of the notification.
+var messageTemplates = map[Locale]map[NotificationType]string{
+	LocaleEN: {
+		NotificationTypeWorkbenchReady:       `Your workbench "{{.workbench}}" is ready.`,
+		NotificationTypeWorkbenchFailed:      `Your workbench "{{.workbench}}" could not be started.`,
+		NotificationTypeAppInstanceCrashed:   `The app "{{.app}}" crashed.`,
+		NotificationTypePasswordChanged:      `Your password has been changed. If you did not do it, please contact your administrator.`,
+		NotificationTypeTotpChanged:          `Your two-factor authentication has been changed. If you did not do it, please contact your administrator.`,
+		NotificationTypeWorkspaceMemberAdded: `You have been added to the workspace "{{.workspace}}".`,
+		NotificationTypeQuotaApproaching:     `The {{.scope}} quota is almost reached for {{.resource}}: {{.used}} used out of {{.max}}.`,
+		NotificationTypeAnnouncement:         `{{.message}}`,
+	},
+	LocaleFR: {
+		NotificationTypeWorkbenchReady:       `Votre workbench « {{.workbench}} » est prêt.`,
+		NotificationTypeWorkbenchFailed:      `Votre workbench « {{.workbench}} » n'a pas pu être démarré.`,
+		NotificationTypeAppInstanceCrashed:   `L'application « {{.app}} » s'est arrêtée de manière inattendue.`,
+		NotificationTypePasswordChanged:      `Votre mot de passe a été modifié. Si vous n'êtes pas à l'origine de ce changement, veuillez contacter votre administrateur.`,
+		NotificationTypeTotpChanged:          `Votre authentification à deux facteurs a été modifiée. Si vous n'êtes pas à l'origine de ce changement, veuillez contacter votre administrateur.`,
+		NotificationTypeWorkspaceMemberAdded: `Vous avez été ajouté à l'espace de travail « {{.workspace}} ».`,
+		NotificationTypeQuotaApproaching:     `Le quota {{.scope}} est presque atteint pour {{.resource}} : {{.used}} utilisés sur {{.max}}.`,
+		NotificationTypeAnnouncement:         `{{.message}}`,
+	},
+}
+
+var templates = mustParseTemplates(messageTemplates)
+
+func mustParseTemplates(messages map[Locale]map[NotificationType]string) map[Locale]map[NotificationType]*template.Template {
+	res := map[Locale]map[NotificationType]*template.Template{}
+	for locale, m := range messages {
+		res[locale] = map[NotificationType]*template.Template{}
+		for notifType, msg := range m {
+			name := fmt.Sprintf("%v.%v", locale, notifType)
+			res[locale][notifType] = template.Must(template.New(name).Option("missingkey=zero").Parse(msg))
+		}
+	}
+	return res
+}
+
+// HasTemplate returns whether a message template exists for the notification
+// type.
+func HasTemplate(notifType NotificationType) bool {
+	_, ok := templates[DefaultLocale][notifType]
+	return ok
+}
+
+// RenderMessage renders the message of the notification type in the given
+// locale, falling back to the default locale when it is not translated.
+func RenderMessage(locale Locale, notifType NotificationType, params NotificationParams) (string, error) {
+	t, ok := templates[locale][notifType]
+	if !ok {
+		t, ok = templates[DefaultLocale][notifType]
+	}
+	if !ok {
+		return "", fmt.Errorf("no message template for notification type %q", notifType)
+	}
+
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, map[string]string(params)); err != nil {
+		return "", fmt.Errorf("unable to render notification %q: %w", notifType, err)
+	}
+	return buf.String(), nil
+}
+
+// ParseLocale returns the supported locale preferred by an Accept-Language
+// header, or the default locale when the header names none of them.
+func ParseLocale(acceptLanguage string) Locale {
+	best, bestQuality := DefaultLocale, 0.0
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		quality := 1.0
+		if v, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			q, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				continue
+			}
+			quality = q
+		}
+
+		language, _, _ := strings.Cut(tag, "-")
+		locale := Locale(strings.ToLower(strings.TrimSpace(language)))
+		if _, ok := templates[locale]; ok && quality > bestQuality {
+			best, bestQuality = locale, quality
+		}
+	}
+	return best
+}
+
+// LocalizedMessage returns the message of the notification rendered in the
+// locale of its recipient. The message stored with the notification, which is
+// rendered in the default locale, is returned for the types without template.
+func (n *Notification) LocalizedMessage(locale Locale) string {
+	if !HasTemplate(n.Type) {
+		return n.Message
+	}
+	msg, err := RenderMessage(locale, n.Type, n.Params)
+	if err != nil {
+		return n.Message
+	}
+	return msg
+}
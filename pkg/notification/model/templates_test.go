@@ -0,0 +1,49 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMessageTemplates(t *testing.T) {
+	for locale, m := range messageTemplates {
+		require.Len(t, m, len(messageTemplates[DefaultLocale]), "locale %v", locale)
+	}
+}
+
+func TestRenderMessage(t *testing.T) {
+	params := NotificationParams{"workspace": "genomics"}
+
+	msg, err := RenderMessage(LocaleEN, NotificationTypeWorkspaceMemberAdded, params)
+	require.NoError(t, err)
+	require.Equal(t, `You have been added to the workspace "genomics".`, msg)
+
+	msg, err = RenderMessage(LocaleFR, NotificationTypeWorkspaceMemberAdded, params)
+	require.NoError(t, err)
+	require.Equal(t, `Vous avez été ajouté à l'espace de travail « genomics ».`, msg)
+
+	msg, err = RenderMessage("de", NotificationTypeWorkspaceMemberAdded, params)
+	require.NoError(t, err)
+	require.Equal(t, `You have been added to the workspace "genomics".`, msg)
+
+	_, err = RenderMessage(LocaleEN, "unknown", params)
+	require.Error(t, err)
+}
+
+func TestParseLocale(t *testing.T) {
+	require.Equal(t, LocaleFR, ParseLocale("fr-CH,fr;q=0.9,en;q=0.8"))
+	require.Equal(t, LocaleEN, ParseLocale("de-CH,en;q=0.5,fr;q=0.3"))
+	require.Equal(t, LocaleFR, ParseLocale("en;q=0.2, FR"))
+	require.Equal(t, DefaultLocale, ParseLocale("de"))
+	require.Equal(t, DefaultLocale, ParseLocale(""))
+}
+
+func TestLocalizedMessage(t *testing.T) {
+	n := &Notification{Type: NotificationTypeWorkbenchReady, Message: `Your workbench "analysis" is ready.`, Params: NotificationParams{"workbench": "analysis"}}
+	require.Equal(t, `Votre workbench « analysis » est prêt.`, n.LocalizedMessage(LocaleFR))
+	require.Equal(t, n.Message, n.LocalizedMessage(LocaleEN))
+
+	n = &Notification{Type: "technicalError", Message: "A technical error occurred."}
+	require.Equal(t, n.Message, n.LocalizedMessage(LocaleFR), "the types without template keep their message")
+}
@@ -0,0 +1,156 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/mailer"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/notification/model"
+)
+
+const (
+	// deliveryLease is the time a replica has to send the deliveries it
+	// claimed before another one retries them.
+	deliveryLease = 5 * time.Minute
+
+	defaultDeliveryBatchSize   = 100
+	defaultDeliveryMaxAttempts = 5
+
+	maxDeliveryBackoff = time.Hour
+)
+
+// DeliverNotifications sends the emails of the due deliveries. The
+// immediate deliveries are sent one by one while the daily ones are sent as
+// a digest per user. The failed deliveries are retried with an exponential
+// backoff until the maximum number of attempts is reached.
+func (s NotificationService) DeliverNotifications(ctx context.Context) error {
+	conf := s.cfg.Services.NotificationService.EmailDelivery
+
+	batchSize := conf.BatchSize
+	if batchSize <= 0 {
+		batchSize = defaultDeliveryBatchSize
+	}
+	maxAttempts := conf.MaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = defaultDeliveryMaxAttempts
+	}
+
+	deliveries, err := s.store.ClaimNotificationDeliveries(ctx, batchSize, deliveryLease)
+	if err != nil {
+		return fmt.Errorf("unable to claim deliveries: %w", err)
+	}
+
+	var failed int
+	for _, group := range groupDeliveries(deliveries) {
+		sendErr := s.sendDeliveries(ctx, group)
+		if sendErr != nil {
+			failed++
+		}
+
+		now := time.Now()
+		for _, d := range group {
+			recordAttempt(d, sendErr, maxAttempts, now)
+			if err := s.store.UpdateNotificationDelivery(ctx, d); err != nil {
+				return fmt.Errorf("unable to record delivery: %w", err)
+			}
+		}
+	}
+
+	if failed != 0 {
+		return fmt.Errorf("unable to send %v of %v emails", failed, len(deliveries))
+	}
+	return nil
+}
+
+// groupDeliveries returns the deliveries to send in a single email. The
+// deliveries are expected to be sorted by tenant and user.
+func groupDeliveries(deliveries []*model.NotificationDelivery) [][]*model.NotificationDelivery {
+	var res [][]*model.NotificationDelivery
+	digests := map[[2]uint64]int{}
+
+	for _, d := range deliveries {
+		if d.Frequency != model.EmailDaily {
+			res = append(res, []*model.NotificationDelivery{d})
+			continue
+		}
+
+		key := [2]uint64{d.TenantID, d.UserID}
+		if i, ok := digests[key]; ok {
+			res[i] = append(res[i], d)
+			continue
+		}
+		digests[key] = len(res)
+		res = append(res, []*model.NotificationDelivery{d})
+	}
+
+	return res
+}
+
+func (s NotificationService) sendDeliveries(ctx context.Context, group []*model.NotificationDelivery) error {
+	first := group[0]
+	webURL := s.cfg.Services.NotificationService.EmailDelivery.WebURL
+
+	toMail := func(d *model.NotificationDelivery) mailer.Notification {
+		n := mailer.Notification{Message: d.Notification.LocalizedMessage(d.Locale)}
+		if d.Notification.Link != "" {
+			n.Link = webURL + d.Notification.Link
+		}
+		return n
+	}
+
+	if first.Frequency != model.EmailDaily {
+		subject := s.mailer.GetSubject(ctx, first.TenantID, mailer.NotificationKey.String())
+		if subject == "" {
+			subject = "CHORUS notification"
+		}
+		tmpl := s.mailer.GetTemplate(ctx, first.TenantID, mailer.NotificationKey)
+		return s.mailer.Send(ctx, first.TenantID, []string{first.Email}, subject, tmpl, toMail(first))
+	}
+
+	digest := mailer.NotificationsDigest{}
+	for _, d := range group {
+		digest.Notifications = append(digest.Notifications, toMail(d))
+	}
+
+	subject := s.mailer.GetSubject(ctx, first.TenantID, mailer.NotificationsDigestKey.String())
+	if subject == "" {
+		subject = "Your CHORUS notifications"
+	}
+	tmpl := s.mailer.GetTemplate(ctx, first.TenantID, mailer.NotificationsDigestKey)
+	return s.mailer.Send(ctx, first.TenantID, []string{first.Email}, subject, tmpl, digest)
+}
+
+// recordAttempt updates the delivery with the outcome of an attempt to send
+// it.
+func recordAttempt(d *model.NotificationDelivery, err error, maxAttempts int, now time.Time) {
+	if err == nil {
+		d.Status = model.DeliverySent
+		d.SentAt = &now
+		d.LastError = nil
+		return
+	}
+
+	msg := err.Error()
+	d.LastError = &msg
+	if d.Attempts >= maxAttempts {
+		d.Status = model.DeliveryFailed
+		return
+	}
+
+	d.Status = model.DeliveryPending
+	d.NextAttemptAt = now.Add(deliveryBackoff(d.Attempts))
+}
+
+// deliveryBackoff returns the delay before the next attempt, doubling from a
+// minute after each attempt.
+func deliveryBackoff(attempts int) time.Duration {
+	backoff := time.Minute
+	for i := 1; i < attempts; i++ {
+		backoff *= 2
+		if backoff >= maxDeliveryBackoff {
+			return maxDeliveryBackoff
+		}
+	}
+	return backoff
+}
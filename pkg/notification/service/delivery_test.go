@@ -0,0 +1,87 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"html/template"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/mailer"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/notification/model"
+)
+
+func TestGroupDeliveries(t *testing.T) {
+	deliveries := []*model.NotificationDelivery{
+		{ID: 1, TenantID: 1, UserID: 1, Frequency: model.EmailDaily},
+		{ID: 2, TenantID: 1, UserID: 1, Frequency: model.EmailImmediate},
+		{ID: 3, TenantID: 1, UserID: 1, Frequency: model.EmailDaily},
+		{ID: 4, TenantID: 1, UserID: 2, Frequency: model.EmailDaily},
+		{ID: 5, TenantID: 1, UserID: 2, Frequency: model.EmailImmediate},
+	}
+
+	var ids [][]uint64
+	for _, group := range groupDeliveries(deliveries) {
+		var g []uint64
+		for _, d := range group {
+			g = append(g, d.ID)
+		}
+		ids = append(ids, g)
+	}
+
+	require.Equal(t, [][]uint64{{1, 3}, {2}, {4}, {5}}, ids)
+}
+
+func TestRecordAttempt(t *testing.T) {
+	now := time.Now()
+
+	d := &model.NotificationDelivery{Attempts: 1, Status: model.DeliveryPending}
+	recordAttempt(d, nil, 3, now)
+	require.Equal(t, model.DeliverySent, d.Status)
+	require.Equal(t, &now, d.SentAt)
+
+	d = &model.NotificationDelivery{Attempts: 2, Status: model.DeliveryPending}
+	recordAttempt(d, errors.New("smtp down"), 3, now)
+	require.Equal(t, model.DeliveryPending, d.Status)
+	require.Equal(t, now.Add(2*time.Minute), d.NextAttemptAt)
+	require.Equal(t, "smtp down", *d.LastError)
+
+	d = &model.NotificationDelivery{Attempts: 3, Status: model.DeliveryPending}
+	recordAttempt(d, errors.New("smtp down"), 3, now)
+	require.Equal(t, model.DeliveryFailed, d.Status)
+}
+
+func TestDeliveryBackoff(t *testing.T) {
+	require.Equal(t, time.Minute, deliveryBackoff(1))
+	require.Equal(t, 4*time.Minute, deliveryBackoff(3))
+	require.Equal(t, maxDeliveryBackoff, deliveryBackoff(20))
+}
+
+type sentMails struct {
+	mailer.Mailer
+	data []interface{}
+}
+
+func (m *sentMails) Send(ctx context.Context, tenantID uint64, to []string, subject string, tmpl *template.Template, data interface{}) error {
+	m.data = append(m.data, data)
+	return nil
+}
+
+func (m *sentMails) GetSubject(ctx context.Context, tenantID uint64, emailKey string) string {
+	return ""
+}
+
+func (m *sentMails) GetTemplate(ctx context.Context, tenantID uint64, tmplKey mailer.TemplateKey) *template.Template {
+	return nil
+}
+
+func TestSendDeliveries_Locale(t *testing.T) {
+	mails := &sentMails{}
+	s := NotificationService{mailer: mails}
+	n := model.Notification{Type: model.NotificationTypeWorkbenchReady, Message: `Your workbench "analysis" is ready.`, Params: model.NotificationParams{"workbench": "analysis"}}
+
+	require.NoError(t, s.sendDeliveries(context.Background(), []*model.NotificationDelivery{{Frequency: model.EmailImmediate, Locale: model.LocaleFR, Notification: n}}))
+	require.Equal(t, mailer.Notification{Message: `Votre workbench « analysis » est prêt.`}, mails.data[0])
+}
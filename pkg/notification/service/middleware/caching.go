@@ -7,19 +7,16 @@ import (
 	"github.com/CHORUS-TRE/chorus-backend/internal/utils/cache"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/notification/model"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/notification/service"
-
-	"github.com/coocood/freecache"
 )
 
 const (
-	notificationCacheSize  = 100 * 1024 * 1024 // Max 100MiB stored in memory
 	defaultCacheExpiration = 2
 )
 
-func NotificationCaching(log *logger.ContextLogger) func(service.Notificationer) *Caching {
+func NotificationCaching(log *logger.ContextLogger, backend cache.Backend) func(service.Notificationer) *Caching {
 	return func(next service.Notificationer) *Caching {
 		return &Caching{
-			cache: cache.NewCache(freecache.NewCache(notificationCacheSize), log),
+			cache: cache.NewCache(backend, log),
 			next:  next,
 		}
 	}
@@ -30,8 +27,25 @@ type Caching struct {
 	next  service.Notificationer
 }
 
+func (c *Caching) CreateNotification(ctx context.Context, req service.CreateNotificationRequest) error {
+	err := c.next.CreateNotification(ctx, req)
+
+	tags := make([]string, 0, len(req.UserIDs))
+	for _, userID := range req.UserIDs {
+		tags = append(tags, cache.UserTag(req.Notification.TenantID, userID))
+	}
+	c.cache.Invalidate(ctx, tags...)
+	return err
+}
+
+func (c *Caching) CreateAnnouncement(ctx context.Context, req service.CreateAnnouncementRequest) (string, int, error) {
+	id, recipients, err := c.next.CreateAnnouncement(ctx, req)
+	c.cache.Invalidate(ctx, cache.TenantTag(req.TenantID))
+	return id, recipients, err
+}
+
 func (c *Caching) CountUnreadNotifications(ctx context.Context, req service.CountUnreadNotificationRequest) (reply uint32, err error) {
-	entry := c.cache.NewEntry(cache.WithInterface(req))
+	entry := c.cache.NewEntry(cache.WithInterface(req)).WithTags(cache.TenantTag(req.TenantID), cache.UserTag(req.TenantID, req.UserID))
 
 	if ok := entry.Get(ctx, &reply); !ok {
 		reply, err = c.next.CountUnreadNotifications(ctx, req)
@@ -44,11 +58,13 @@ func (c *Caching) CountUnreadNotifications(ctx context.Context, req service.Coun
 }
 
 func (c *Caching) MarkNotificationsAsRead(ctx context.Context, req service.MarkNotificationsAsReadRequest) error {
-	return c.next.MarkNotificationsAsRead(ctx, req)
+	err := c.next.MarkNotificationsAsRead(ctx, req)
+	c.cache.Invalidate(ctx, cache.UserTag(req.TenantID, req.UserID))
+	return err
 }
 
 func (c *Caching) GetNotifications(ctx context.Context, req service.GetNotificationsRequest) (reply []*model.Notification, count uint32, err error) {
-	entry := c.cache.NewEntry(cache.WithInterface(req))
+	entry := c.cache.NewEntry(cache.WithInterface(req)).WithTags(cache.TenantTag(req.TenantID), cache.UserTag(req.TenantID, req.UserID))
 
 	if ok := entry.Get(ctx, &reply, &count); !ok {
 		reply, count, err = c.next.GetNotifications(ctx, req)
@@ -59,3 +75,25 @@ func (c *Caching) GetNotifications(ctx context.Context, req service.GetNotificat
 
 	return
 }
+
+func (c *Caching) StreamNotifications(ctx context.Context, req service.StreamNotificationsRequest, send func(*model.Notification) error) error {
+	return c.next.StreamNotifications(ctx, req, send)
+}
+
+func (c *Caching) GetNotificationPreferences(ctx context.Context, req service.GetNotificationPreferencesRequest) ([]*model.NotificationPreference, error) {
+	return c.next.GetNotificationPreferences(ctx, req)
+}
+
+func (c *Caching) UpdateNotificationPreferences(ctx context.Context, req service.UpdateNotificationPreferencesRequest) error {
+	return c.next.UpdateNotificationPreferences(ctx, req)
+}
+
+func (c *Caching) DeliverNotifications(ctx context.Context) error {
+	return c.next.DeliverNotifications(ctx)
+}
+
+func (c *Caching) ArchiveNotifications(ctx context.Context, tenantID uint64, retentionDays int) (int64, int64, error) {
+	archived, purged, err := c.next.ArchiveNotifications(ctx, tenantID, retentionDays)
+	c.cache.Invalidate(ctx, cache.TenantTag(tenantID))
+	return archived, purged, err
+}
@@ -27,6 +27,36 @@ func Logging(log *logger.ContextLogger) func(service.Notificationer) service.Not
 	}
 }
 
+func (c notificationServiceLogging) CreateNotification(ctx context.Context, req service.CreateNotificationRequest) error {
+	log := logger.With(c.logger,
+		zap.String("service", "CreateNotification"),
+		zap.Any("notification", req.Notification),
+		zap.Uint64s("user_ids", req.UserIDs),
+	)
+	c.logger.Debug(ctx, logger.LoggerMessageRequestStarted)
+
+	now := time.Now()
+
+	err := c.next.CreateNotification(ctx, req)
+	return common.LogErrorIfAny(err, ctx, now, log)
+}
+
+func (c notificationServiceLogging) CreateAnnouncement(ctx context.Context, req service.CreateAnnouncementRequest) (string, int, error) {
+	log := logger.With(c.logger,
+		zap.String("service", "CreateAnnouncement"),
+		zap.Uint64("tenant_id", req.TenantID),
+		zap.Bool("pinned", req.Pinned),
+		zap.Strings("roles", req.Roles),
+		zap.Uint64s("workspace_ids", req.WorkspaceIDs),
+	)
+	c.logger.Debug(ctx, logger.LoggerMessageRequestStarted)
+
+	now := time.Now()
+
+	id, recipients, err := c.next.CreateAnnouncement(ctx, req)
+	return id, recipients, common.LogErrorIfAny(err, ctx, now, logger.With(log, zap.String("notification_id", id), zap.Int("recipients", recipients)))
+}
+
 func (c notificationServiceLogging) CountUnreadNotifications(ctx context.Context, req service.CountUnreadNotificationRequest) (uint32, error) {
 	log := logger.With(c.logger,
 		zap.String("service", "CountUnreadNotifications"),
@@ -73,3 +103,68 @@ func (c notificationServiceLogging) GetNotifications(ctx context.Context, req se
 	res, count, err := c.next.GetNotifications(ctx, req)
 	return res, count, common.LogErrorIfAny(err, ctx, now, logger.With(log, zap.Any("result", res)))
 }
+func (c notificationServiceLogging) StreamNotifications(ctx context.Context, req service.StreamNotificationsRequest, send func(*model.Notification) error) error {
+	log := logger.With(c.logger,
+		zap.String("service", "StreamNotifications"),
+		zap.Uint64("tenant_id", req.TenantID),
+		zap.Uint64("user_id", req.UserID),
+	)
+	c.logger.Debug(ctx, logger.LoggerMessageRequestStarted)
+
+	now := time.Now()
+
+	err := c.next.StreamNotifications(ctx, req, send)
+	return common.LogErrorIfAny(err, ctx, now, log)
+}
+func (c notificationServiceLogging) GetNotificationPreferences(ctx context.Context, req service.GetNotificationPreferencesRequest) ([]*model.NotificationPreference, error) {
+	log := logger.With(c.logger,
+		zap.String("service", "GetNotificationPreferences"),
+		zap.Uint64("tenant_id", req.TenantID),
+		zap.Uint64("user_id", req.UserID),
+	)
+	c.logger.Debug(ctx, logger.LoggerMessageRequestStarted)
+
+	now := time.Now()
+
+	res, err := c.next.GetNotificationPreferences(ctx, req)
+	return res, common.LogErrorIfAny(err, ctx, now, logger.With(log, zap.Any("result", res)))
+}
+func (c notificationServiceLogging) UpdateNotificationPreferences(ctx context.Context, req service.UpdateNotificationPreferencesRequest) error {
+	log := logger.With(c.logger,
+		zap.String("service", "UpdateNotificationPreferences"),
+		zap.Uint64("tenant_id", req.TenantID),
+		zap.Uint64("user_id", req.UserID),
+		zap.Any("preferences", req.Preferences),
+	)
+	c.logger.Debug(ctx, logger.LoggerMessageRequestStarted)
+
+	now := time.Now()
+
+	err := c.next.UpdateNotificationPreferences(ctx, req)
+	return common.LogErrorIfAny(err, ctx, now, log)
+}
+func (c notificationServiceLogging) DeliverNotifications(ctx context.Context) error {
+	log := logger.With(c.logger,
+		zap.String("service", "DeliverNotifications"),
+	)
+	c.logger.Debug(ctx, logger.LoggerMessageRequestStarted)
+
+	now := time.Now()
+
+	err := c.next.DeliverNotifications(ctx)
+	return common.LogErrorIfAny(err, ctx, now, log)
+}
+
+func (c notificationServiceLogging) ArchiveNotifications(ctx context.Context, tenantID uint64, retentionDays int) (int64, int64, error) {
+	log := logger.With(c.logger,
+		zap.String("service", "ArchiveNotifications"),
+		zap.Uint64("tenant_id", tenantID),
+		zap.Int("retention_days", retentionDays),
+	)
+	c.logger.Debug(ctx, logger.LoggerMessageRequestStarted)
+
+	now := time.Now()
+
+	archived, purged, err := c.next.ArchiveNotifications(ctx, tenantID, retentionDays)
+	return archived, purged, common.LogErrorIfAny(err, ctx, now, logger.With(log, zap.Int64("archived", archived), zap.Int64("purged", purged)))
+}
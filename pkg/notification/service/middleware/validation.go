@@ -24,6 +24,20 @@ func Validation(validate *val.Validate) func(service.Notificationer) service.Not
 	}
 }
 
+func (v validation) CreateNotification(ctx context.Context, req service.CreateNotificationRequest) error {
+	if err := v.validate.Struct(req); err != nil {
+		return fmt.Errorf("unable to create notification: %w", err)
+	}
+	return v.next.CreateNotification(ctx, req)
+}
+
+func (v validation) CreateAnnouncement(ctx context.Context, req service.CreateAnnouncementRequest) (string, int, error) {
+	if err := v.validate.Struct(req); err != nil {
+		return "", 0, fmt.Errorf("unable to create announcement: %w", err)
+	}
+	return v.next.CreateAnnouncement(ctx, req)
+}
+
 func (v validation) CountUnreadNotifications(ctx context.Context, req service.CountUnreadNotificationRequest) (uint32, error) {
 	if err := v.validate.Struct(req); err != nil {
 		return 0, err
@@ -42,3 +56,28 @@ func (v validation) GetNotifications(ctx context.Context, req service.GetNotific
 	}
 	return v.next.GetNotifications(ctx, req)
 }
+func (v validation) StreamNotifications(ctx context.Context, req service.StreamNotificationsRequest, send func(*model.Notification) error) error {
+	if err := v.validate.Struct(req); err != nil {
+		return err
+	}
+	return v.next.StreamNotifications(ctx, req, send)
+}
+func (v validation) GetNotificationPreferences(ctx context.Context, req service.GetNotificationPreferencesRequest) ([]*model.NotificationPreference, error) {
+	if err := v.validate.Struct(req); err != nil {
+		return nil, err
+	}
+	return v.next.GetNotificationPreferences(ctx, req)
+}
+func (v validation) UpdateNotificationPreferences(ctx context.Context, req service.UpdateNotificationPreferencesRequest) error {
+	if err := v.validate.Struct(req); err != nil {
+		return fmt.Errorf("unable to update notification preferences: %w", err)
+	}
+	return v.next.UpdateNotificationPreferences(ctx, req)
+}
+func (v validation) DeliverNotifications(ctx context.Context) error {
+	return v.next.DeliverNotifications(ctx)
+}
+
+func (v validation) ArchiveNotifications(ctx context.Context, tenantID uint64, retentionDays int) (int64, int64, error) {
+	return v.next.ArchiveNotifications(ctx, tenantID, retentionDays)
+}
@@ -3,15 +3,29 @@ package service
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"github.com/CHORUS-TRE/chorus-backend/internal/config"
+	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
+	"github.com/CHORUS-TRE/chorus-backend/internal/mailer"
+	"github.com/CHORUS-TRE/chorus-backend/internal/utils/uuid"
 	common "github.com/CHORUS-TRE/chorus-backend/pkg/common/model"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/common/service"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/notification/model"
+	"go.uber.org/zap"
 )
 
 type Notificationer interface {
+	CreateNotification(ctx context.Context, req CreateNotificationRequest) error
+	CreateAnnouncement(ctx context.Context, req CreateAnnouncementRequest) (string, int, error)
 	CountUnreadNotifications(ctx context.Context, req CountUnreadNotificationRequest) (uint32, error)
 	MarkNotificationsAsRead(ctx context.Context, req MarkNotificationsAsReadRequest) error
 	GetNotifications(ctx context.Context, req GetNotificationsRequest) ([]*model.Notification, uint32, error)
+	StreamNotifications(ctx context.Context, req StreamNotificationsRequest, send func(*model.Notification) error) error
+	GetNotificationPreferences(ctx context.Context, req GetNotificationPreferencesRequest) ([]*model.NotificationPreference, error)
+	UpdateNotificationPreferences(ctx context.Context, req UpdateNotificationPreferencesRequest) error
+	DeliverNotifications(ctx context.Context) error
+	ArchiveNotifications(ctx context.Context, tenantID uint64, retentionDays int) (int64, int64, error)
 }
 
 // NotificationStore groups the database interface functions.
@@ -20,14 +34,76 @@ type NotificationStore interface {
 	CountUnreadNotifications(ctx context.Context, tenantID, userID uint64) (uint32, error)
 	MarkNotificationsAsRead(ctx context.Context, tenantID, userID uint64, notificationIDs []string, markAll bool) error
 	GetNotifications(ctx context.Context, tenantID, userID uint64, query string, isRead *bool, offset, limit uint64, sort common.Sort) ([]*model.Notification, uint32, error)
+	GetNotification(ctx context.Context, tenantID, userID uint64, notificationID string) (*model.Notification, error)
+	GetAnnouncementRecipients(ctx context.Context, tenantID uint64, roles []string, workspaceIDs []uint64) ([]uint64, error)
+	GetNotificationPreferences(ctx context.Context, tenantID, userID uint64) ([]*model.NotificationPreference, error)
+	SetNotificationPreferences(ctx context.Context, tenantID, userID uint64, preferences []*model.NotificationPreference) error
+	ClaimNotificationDeliveries(ctx context.Context, limit int, lease time.Duration) ([]*model.NotificationDelivery, error)
+	UpdateNotificationDelivery(ctx context.Context, delivery *model.NotificationDelivery) error
+	ArchiveReadNotifications(ctx context.Context, tenantID uint64, readBefore time.Time) (int64, error)
+	PurgeNotifications(ctx context.Context, tenantID uint64, createdBefore time.Time) (int64, error)
+}
+
+// NotificationListener announces the IDs of the notifications created for a
+// user, whichever replica created them. The channel is closed when the
+// subscriber falls behind.
+type NotificationListener interface {
+	Subscribe(tenantID, userID uint64) (<-chan string, func())
 }
 
 type NotificationService struct {
-	store NotificationStore
+	cfg      config.Config
+	store    NotificationStore
+	listener NotificationListener
+	mailer   mailer.Mailer
 }
 
-func NewNotificationService(store NotificationStore) *NotificationService {
-	return &NotificationService{store: store}
+func NewNotificationService(cfg config.Config, store NotificationStore, listener NotificationListener, mailer mailer.Mailer) *NotificationService {
+	return &NotificationService{cfg: cfg, store: store, listener: listener, mailer: mailer}
+}
+
+func (s NotificationService) CreateNotification(ctx context.Context, req CreateNotificationRequest) error {
+	if err := s.store.CreateNotification(ctx, req.Notification, req.UserIDs); err != nil {
+		return fmt.Errorf("unable to create notification %v: %w", req.Notification.ID, err)
+	}
+	return nil
+}
+
+// CreateAnnouncement notifies the recipients of the announcement and returns
+// its ID along with the number of recipients.
+func (s NotificationService) CreateAnnouncement(ctx context.Context, req CreateAnnouncementRequest) (string, int, error) {
+	if req.EndsAt != nil {
+		if !req.EndsAt.After(time.Now()) {
+			return "", 0, fmt.Errorf("the announcement must end in the future: %w", &service.InvalidParametersErr{})
+		}
+		if req.StartsAt != nil && !req.EndsAt.After(*req.StartsAt) {
+			return "", 0, fmt.Errorf("the announcement must end after it starts: %w", &service.InvalidParametersErr{})
+		}
+	}
+
+	userIDs, err := s.store.GetAnnouncementRecipients(ctx, req.TenantID, req.Roles, req.WorkspaceIDs)
+	if err != nil {
+		return "", 0, fmt.Errorf("unable to get announcement recipients: %w", err)
+	}
+	if len(userIDs) == 0 {
+		return "", 0, fmt.Errorf("no active user matches the announcement recipients: %w", &service.InvalidParametersErr{})
+	}
+
+	notification := &model.Notification{
+		ID:       uuid.Next(),
+		TenantID: req.TenantID,
+		Type:     model.NotificationTypeAnnouncement,
+		Message:  req.Message,
+		Link:     req.Link,
+		Params:   model.NotificationParams{"message": req.Message},
+		Pinned:   req.Pinned,
+		StartsAt: req.StartsAt,
+		EndsAt:   req.EndsAt,
+	}
+	if err := s.store.CreateNotification(ctx, notification, userIDs); err != nil {
+		return "", 0, fmt.Errorf("unable to create announcement: %w", err)
+	}
+	return notification.ID, len(userIDs), nil
 }
 
 func (s NotificationService) CountUnreadNotifications(ctx context.Context, req CountUnreadNotificationRequest) (uint32, error) {
@@ -50,3 +126,75 @@ func (s NotificationService) GetNotifications(ctx context.Context, req GetNotifi
 	}
 	return notifications, count, nil
 }
+
+// StreamNotifications calls send for every notification created for the user
+// until ctx is done. The announcements scheduled for later are not streamed,
+// the clients listing them once they start. It fails when the stream falls
+// behind, the clients having to list the notifications again before
+// reconnecting.
+func (s NotificationService) StreamNotifications(ctx context.Context, req StreamNotificationsRequest, send func(*model.Notification) error) error {
+	ids, unsubscribe := s.listener.Subscribe(req.TenantID, req.UserID)
+	defer unsubscribe()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case id, ok := <-ids:
+			if !ok {
+				return fmt.Errorf("notification stream fell behind: %w", &service.FailedPreconditionErr{})
+			}
+			notification, err := s.store.GetNotification(ctx, req.TenantID, req.UserID, id)
+			if err != nil {
+				logger.TechLog.Error(ctx, "unable to get notification", zap.String("notification_id", id), zap.Error(err))
+				continue
+			}
+			if !notification.Started(time.Now()) {
+				continue
+			}
+			if err := send(notification); err != nil {
+				return fmt.Errorf("unable to send notification %v: %w", id, err)
+			}
+		}
+	}
+}
+
+// GetNotificationPreferences returns the preference of the user for every
+// notification type, the types without preference being never sent by email.
+func (s NotificationService) GetNotificationPreferences(ctx context.Context, req GetNotificationPreferencesRequest) ([]*model.NotificationPreference, error) {
+	preferences, err := s.store.GetNotificationPreferences(ctx, req.TenantID, req.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get notification preferences: %w", err)
+	}
+
+	byType := make(map[model.NotificationType]*model.NotificationPreference, len(preferences))
+	for _, p := range preferences {
+		byType[p.Type] = p
+	}
+
+	res := make([]*model.NotificationPreference, 0, len(model.NotificationTypes))
+	for _, t := range model.NotificationTypes {
+		p, ok := byType[t]
+		if !ok {
+			p = &model.NotificationPreference{TenantID: req.TenantID, UserID: req.UserID, Type: t, EmailFrequency: model.EmailNever}
+		}
+		res = append(res, p)
+	}
+	return res, nil
+}
+
+func (s NotificationService) UpdateNotificationPreferences(ctx context.Context, req UpdateNotificationPreferencesRequest) error {
+	for _, p := range req.Preferences {
+		if _, err := model.ToNotificationType(p.Type.String()); err != nil {
+			return fmt.Errorf("%v: %w", err.Error(), &service.InvalidParametersErr{})
+		}
+		if _, err := model.ToEmailFrequency(p.EmailFrequency.String()); err != nil {
+			return fmt.Errorf("%v: %w", err.Error(), &service.InvalidParametersErr{})
+		}
+	}
+
+	if err := s.store.SetNotificationPreferences(ctx, req.TenantID, req.UserID, req.Preferences); err != nil {
+		return fmt.Errorf("unable to update notification preferences: %w", err)
+	}
+	return nil
+}
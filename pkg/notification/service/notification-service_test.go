@@ -0,0 +1,56 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/config"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/common/service"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/notification/model"
+	"github.com/CHORUS-TRE/chorus-backend/tests/unit"
+)
+
+type notificationListener struct {
+	ids chan string
+}
+
+func (l notificationListener) Subscribe(tenantID, userID uint64) (<-chan string, func()) {
+	return l.ids, func() {}
+}
+
+type notificationStore struct {
+	NotificationStore
+	notifications map[string]*model.Notification
+}
+
+func (s notificationStore) GetNotification(ctx context.Context, tenantID, userID uint64, notificationID string) (*model.Notification, error) {
+	n, ok := s.notifications[notificationID]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	return n, nil
+}
+
+func TestStreamNotifications(t *testing.T) {
+	unit.InitTestLogger()
+
+	ids := make(chan string, 3)
+	ids <- "missing"
+	ids <- "found"
+	close(ids)
+
+	s := NewNotificationService(config.Config{}, notificationStore{notifications: map[string]*model.Notification{"found": {ID: "found"}}}, notificationListener{ids: ids}, nil)
+
+	var sent []string
+	err := s.StreamNotifications(context.Background(), StreamNotificationsRequest{TenantID: 1, UserID: 1}, func(n *model.Notification) error {
+		sent = append(sent, n.ID)
+		return nil
+	})
+
+	require.Equal(t, []string{"found"}, sent, "a notification that cannot be read is skipped")
+	require.True(t, errors.As(err, new(*service.FailedPreconditionErr)), "a stream falling behind is closed")
+}
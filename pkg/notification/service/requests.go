@@ -1,6 +1,11 @@
 package service
 
-import common "github.com/CHORUS-TRE/chorus-backend/pkg/common/model"
+import (
+	"time"
+
+	common "github.com/CHORUS-TRE/chorus-backend/pkg/common/model"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/notification/model"
+)
 
 type GetNotificationsRequest struct {
 	TenantID uint64 `validate:"required"`
@@ -36,3 +41,42 @@ type CountUnreadNotificationRequest struct {
 	TenantID uint64 `validate:"required"`
 	UserID   uint64 `validate:"required"`
 }
+
+type StreamNotificationsRequest struct {
+	TenantID uint64 `validate:"required"`
+	UserID   uint64 `validate:"required"`
+}
+
+type CreateNotificationRequest struct {
+	Notification *model.Notification `validate:"required"`
+	UserIDs      []uint64            `validate:"required,min=1,dive,required"`
+}
+
+type GetNotificationPreferencesRequest struct {
+	TenantID uint64 `validate:"required"`
+	UserID   uint64 `validate:"required"`
+}
+
+type UpdateNotificationPreferencesRequest struct {
+	TenantID    uint64                          `validate:"required"`
+	UserID      uint64                          `validate:"required"`
+	Preferences []*model.NotificationPreference `validate:"dive,required"`
+}
+
+// CreateAnnouncementRequest broadcasts a message to the active users of the
+// tenant, restricted to the users having one of the roles and member of one
+// of the workspaces when they are given.
+type CreateAnnouncementRequest struct {
+	TenantID uint64 `validate:"required"`
+	Message  string `validate:"required,max=2000"`
+	Link     string `validate:"max=2048"`
+
+	// Pinned keeps the announcement on top of the notifications until
+	// EndsAt, which is then required.
+	Pinned   bool
+	StartsAt *time.Time
+	EndsAt   *time.Time `validate:"required_if=Pinned true"`
+
+	Roles        []string `validate:"dive,required"`
+	WorkspaceIDs []uint64 `validate:"dive,required"`
+}
@@ -0,0 +1,31 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/CHORUS-TRE/chorus-backend/pkg/common/service"
+)
+
+// ArchiveNotifications archives the entries of the tenant read more than
+// retentionDays ago, then purges the notifications older than that which
+// have no recipient left. It returns the number of archived entries and of
+// purged notifications.
+func (s NotificationService) ArchiveNotifications(ctx context.Context, tenantID uint64, retentionDays int) (int64, int64, error) {
+	if retentionDays <= 0 {
+		return 0, 0, fmt.Errorf("the retention period must be positive: %w", &service.InvalidParametersErr{})
+	}
+	before := time.Now().AddDate(0, 0, -retentionDays)
+
+	archived, err := s.store.ArchiveReadNotifications(ctx, tenantID, before)
+	if err != nil {
+		return 0, 0, fmt.Errorf("unable to archive read notifications of tenant %v: %w", tenantID, err)
+	}
+
+	purged, err := s.store.PurgeNotifications(ctx, tenantID, before)
+	if err != nil {
+		return archived, 0, fmt.Errorf("unable to purge notifications of tenant %v: %w", tenantID, err)
+	}
+	return archived, purged, nil
+}
@@ -90,3 +90,119 @@ func (s *notificationStorageLogging) GetNotifications(ctx context.Context, tenan
 	res, count, err := s.next.GetNotifications(ctx, tenantID, userID, query, isRead, offset, limit, sort)
 	return res, count, common.LogErrorIfAny(err, ctx, now, logger.With(log, zap.Any("result", res)))
 }
+
+func (s *notificationStorageLogging) GetNotification(ctx context.Context, tenantID, userID uint64, notificationID string) (*model.Notification, error) {
+	log := logger.With(s.logger,
+		zap.String("service", "GetNotification"),
+		zap.Uint64("tenant_id", tenantID),
+		zap.Uint64("user_id", userID),
+		zap.String("notification_id", notificationID),
+	)
+	s.logger.Debug(ctx, logger.LoggerMessageRequestStarted)
+
+	now := time.Now()
+
+	res, err := s.next.GetNotification(ctx, tenantID, userID, notificationID)
+	return res, common.LogErrorIfAny(err, ctx, now, log)
+}
+
+func (s *notificationStorageLogging) GetAnnouncementRecipients(ctx context.Context, tenantID uint64, roles []string, workspaceIDs []uint64) ([]uint64, error) {
+	log := logger.With(s.logger,
+		zap.String("service", "GetAnnouncementRecipients"),
+		zap.Uint64("tenant_id", tenantID),
+		zap.Strings("roles", roles),
+		zap.Uint64s("workspace_ids", workspaceIDs),
+	)
+	s.logger.Debug(ctx, logger.LoggerMessageRequestStarted)
+
+	now := time.Now()
+
+	res, err := s.next.GetAnnouncementRecipients(ctx, tenantID, roles, workspaceIDs)
+	return res, common.LogErrorIfAny(err, ctx, now, logger.With(log, zap.Int("num_recipients", len(res))))
+}
+
+func (s *notificationStorageLogging) GetNotificationPreferences(ctx context.Context, tenantID, userID uint64) ([]*model.NotificationPreference, error) {
+	log := logger.With(s.logger,
+		zap.String("service", "GetNotificationPreferences"),
+		zap.Uint64("tenant_id", tenantID),
+		zap.Uint64("user_id", userID),
+	)
+	s.logger.Debug(ctx, logger.LoggerMessageRequestStarted)
+
+	now := time.Now()
+
+	res, err := s.next.GetNotificationPreferences(ctx, tenantID, userID)
+	return res, common.LogErrorIfAny(err, ctx, now, logger.With(log, zap.Int("num_preferences", len(res))))
+}
+
+func (s *notificationStorageLogging) SetNotificationPreferences(ctx context.Context, tenantID, userID uint64, preferences []*model.NotificationPreference) error {
+	log := logger.With(s.logger,
+		zap.String("service", "SetNotificationPreferences"),
+		zap.Uint64("tenant_id", tenantID),
+		zap.Uint64("user_id", userID),
+		zap.Any("preferences", preferences),
+	)
+	s.logger.Debug(ctx, logger.LoggerMessageRequestStarted)
+
+	now := time.Now()
+
+	err := s.next.SetNotificationPreferences(ctx, tenantID, userID, preferences)
+	return common.LogErrorIfAny(err, ctx, now, log)
+}
+
+func (s *notificationStorageLogging) ClaimNotificationDeliveries(ctx context.Context, limit int, lease time.Duration) ([]*model.NotificationDelivery, error) {
+	log := logger.With(s.logger,
+		zap.String("service", "ClaimNotificationDeliveries"),
+		zap.Int("limit", limit),
+		zap.Duration("lease", lease),
+	)
+	s.logger.Debug(ctx, logger.LoggerMessageRequestStarted)
+
+	now := time.Now()
+
+	res, err := s.next.ClaimNotificationDeliveries(ctx, limit, lease)
+	return res, common.LogErrorIfAny(err, ctx, now, logger.With(log, zap.Int("num_deliveries", len(res))))
+}
+
+func (s *notificationStorageLogging) UpdateNotificationDelivery(ctx context.Context, delivery *model.NotificationDelivery) error {
+	log := logger.With(s.logger,
+		zap.String("service", "UpdateNotificationDelivery"),
+		zap.Uint64("tenant_id", delivery.TenantID),
+		zap.Uint64("delivery_id", delivery.ID),
+		zap.String("status", delivery.Status.String()),
+	)
+	s.logger.Debug(ctx, logger.LoggerMessageRequestStarted)
+
+	now := time.Now()
+
+	err := s.next.UpdateNotificationDelivery(ctx, delivery)
+	return common.LogErrorIfAny(err, ctx, now, log)
+}
+
+func (s *notificationStorageLogging) ArchiveReadNotifications(ctx context.Context, tenantID uint64, readBefore time.Time) (int64, error) {
+	log := logger.With(s.logger,
+		zap.String("service", "ArchiveReadNotifications"),
+		zap.Uint64("tenant_id", tenantID),
+		zap.Time("read_before", readBefore),
+	)
+	s.logger.Debug(ctx, logger.LoggerMessageRequestStarted)
+
+	now := time.Now()
+
+	res, err := s.next.ArchiveReadNotifications(ctx, tenantID, readBefore)
+	return res, common.LogErrorIfAny(err, ctx, now, logger.With(log, zap.Int64("archived", res)))
+}
+
+func (s *notificationStorageLogging) PurgeNotifications(ctx context.Context, tenantID uint64, createdBefore time.Time) (int64, error) {
+	log := logger.With(s.logger,
+		zap.String("service", "PurgeNotifications"),
+		zap.Uint64("tenant_id", tenantID),
+		zap.Time("created_before", createdBefore),
+	)
+	s.logger.Debug(ctx, logger.LoggerMessageRequestStarted)
+
+	now := time.Now()
+
+	res, err := s.next.PurgeNotifications(ctx, tenantID, createdBefore)
+	return res, common.LogErrorIfAny(err, ctx, now, logger.With(log, zap.Int64("purged", res)))
+}
@@ -0,0 +1,40 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/lib/pq"
+)
+
+// GetAnnouncementRecipients returns the active users of the tenant having one
+// of the roles and member of one of the workspaces. An empty list of roles or
+// of workspaces does not restrict the users.
+func (s *NotificationStorage) GetAnnouncementRecipients(ctx context.Context, tenantID uint64, roles []string, workspaceIDs []uint64) ([]uint64, error) {
+	const query = `
+SELECT u.id FROM users u
+WHERE u.tenantid = $1 AND u.status = 'active'
+AND (cardinality($2::TEXT[]) = 0 OR EXISTS (
+	SELECT 1 FROM user_role ur JOIN roles r ON r.id = ur.roleid
+	WHERE ur.userid = u.id AND r.name = ANY($2) AND (r.tenantid IS NULL OR r.tenantid = u.tenantid)
+))
+AND (cardinality($3::BIGINT[]) = 0 OR EXISTS (
+	SELECT 1 FROM workspace_members wm
+	WHERE wm.tenantid = u.tenantid AND wm.userid = u.id AND wm.workspaceid = ANY($3)
+))
+ORDER BY u.id
+`
+	// nil arrays would be sent as NULL rather than as empty arrays
+	if roles == nil {
+		roles = []string{}
+	}
+	ids := make([]int64, 0, len(workspaceIDs))
+	for _, id := range workspaceIDs {
+		ids = append(ids, int64(id))
+	}
+
+	var userIDs []uint64
+	if err := s.db.SelectContext(ctx, &userIDs, query, tenantID, pq.Array(roles), pq.Array(ids)); err != nil {
+		return nil, err
+	}
+	return userIDs, nil
+}
@@ -0,0 +1,111 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/CHORUS-TRE/chorus-backend/pkg/notification/model"
+)
+
+// queueDeliveryQuery queues the email delivery of a notification to a user
+// according to their preference for its type. The deliveries are sent once
+// the notification is displayed, the daily ones at the beginning of the next
+// day.
+const queueDeliveryQuery = `
+INSERT INTO notification_deliveries (tenantid, userid, notificationid, frequency, status, nextattemptat)
+SELECT p.tenantid, p.userid, $2, p.emailfrequency, 'pending',
+	CASE WHEN p.emailfrequency = 'daily'
+		THEN date_trunc('day', GREATEST(NOW(), COALESCE($5::TIMESTAMP, NOW()))) + INTERVAL '1 day'
+		ELSE GREATEST(NOW(), COALESCE($5::TIMESTAMP, NOW()))
+	END
+FROM notification_preferences p
+WHERE p.tenantid = $1 AND p.userid = $3 AND p.notificationtype = $4 AND p.emailfrequency != 'never'
+`
+
+func (s *NotificationStorage) GetNotificationPreferences(ctx context.Context, tenantID, userID uint64) ([]*model.NotificationPreference, error) {
+	const query = `
+SELECT tenantid, userid, notificationtype, emailfrequency, createdat, updatedat
+FROM notification_preferences
+WHERE tenantid = $1 AND userid = $2
+ORDER BY notificationtype
+`
+	var preferences []*model.NotificationPreference
+	if err := s.db.SelectContext(ctx, &preferences, query, tenantID, userID); err != nil {
+		return nil, err
+	}
+	return preferences, nil
+}
+
+func (s *NotificationStorage) SetNotificationPreferences(ctx context.Context, tenantID, userID uint64, preferences []*model.NotificationPreference) error {
+	tx, err := s.db.BeginTxx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return fmt.Errorf("unable to begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	const query = `
+INSERT INTO notification_preferences (tenantid, userid, notificationtype, emailfrequency, createdat, updatedat)
+VALUES ($1, $2, $3, $4, NOW(), NOW())
+ON CONFLICT (tenantid, userid, notificationtype) DO UPDATE SET emailfrequency = EXCLUDED.emailfrequency, updatedat = NOW()
+`
+	for _, p := range preferences {
+		if _, err := tx.ExecContext(ctx, query, tenantID, userID, p.Type, p.EmailFrequency); err != nil {
+			return fmt.Errorf("unable to set preference for %v: %w", p.Type, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("unable to commit preferences: %w", err)
+	}
+	return nil
+}
+
+// ClaimNotificationDeliveries returns up to limit pending deliveries that are
+// due, and postpones them by lease so that no other replica sends them
+// meanwhile.
+func (s *NotificationStorage) ClaimNotificationDeliveries(ctx context.Context, limit int, lease time.Duration) ([]*model.NotificationDelivery, error) {
+	const query = `
+WITH claimed AS (
+	UPDATE notification_deliveries d
+	SET attempts = d.attempts + 1, nextattemptat = NOW() + $2::DOUBLE PRECISION * INTERVAL '1 second', updatedat = NOW()
+	WHERE d.id IN (
+		SELECT id FROM notification_deliveries
+		WHERE status = 'pending' AND nextattemptat <= NOW()
+		ORDER BY nextattemptat
+		LIMIT $1
+		FOR UPDATE SKIP LOCKED
+	)
+	RETURNING d.*
+)
+SELECT c.id, c.tenantid, c.userid, c.notificationid, c.frequency, c.status, c.attempts, c.lasterror,
+	c.nextattemptat, c.sentat, c.createdat, c.updatedat, u.username AS email, u.locale,
+	n.id AS "notification.id", n.tenantid AS "notification.tenantid", n.type AS "notification.type",
+	n.message AS "notification.message", n.link AS "notification.link", n.params AS "notification.params",
+	n.createdat AS "notification.createdat"
+FROM claimed c
+JOIN notifications n ON n.id = c.notificationid
+JOIN users u ON u.id = c.userid
+ORDER BY c.tenantid, c.userid, n.createdat
+`
+	var deliveries []*model.NotificationDelivery
+	if err := s.db.SelectContext(ctx, &deliveries, query, limit, lease.Seconds()); err != nil {
+		return nil, err
+	}
+	return deliveries, nil
+}
+
+func (s *NotificationStorage) UpdateNotificationDelivery(ctx context.Context, delivery *model.NotificationDelivery) error {
+	const query = `
+UPDATE notification_deliveries
+SET status = $2, lasterror = $3, nextattemptat = $4, sentat = $5, updatedat = NOW()
+WHERE id = $1
+`
+	if _, err := s.db.ExecContext(ctx, query, delivery.ID, delivery.Status, delivery.LastError, delivery.NextAttemptAt, delivery.SentAt); err != nil {
+		return fmt.Errorf("unable to update delivery %v: %w", delivery.ID, err)
+	}
+	return nil
+}
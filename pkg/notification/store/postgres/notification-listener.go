@@ -0,0 +1,133 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+)
+
+// NotificationChannel is the postgres channel on which new notifications are
+// announced.
+const NotificationChannel = "notifications"
+
+const (
+	listenerMinReconnectInterval = 1 * time.Second
+	listenerMaxReconnectInterval = 1 * time.Minute
+	listenerPingInterval         = 90 * time.Second
+	subscriberBufferSize         = 16
+)
+
+type notificationEvent struct {
+	TenantID       uint64 `json:"tenantId"`
+	UserID         uint64 `json:"userId"`
+	NotificationID string `json:"id"`
+}
+
+type subscriberKey struct {
+	tenantID uint64
+	userID   uint64
+}
+
+// NotificationListener holds a single LISTEN connection per replica and fans
+// out the received notification IDs to the subscribed users.
+type NotificationListener struct {
+	listener *pq.Listener
+
+	mu          sync.Mutex
+	subscribers map[subscriberKey]map[chan string]struct{}
+}
+
+func NewNotificationListener(dataSourceName string) (*NotificationListener, error) {
+	l := &NotificationListener{
+		subscribers: map[subscriberKey]map[chan string]struct{}{},
+	}
+
+	l.listener = pq.NewListener(dataSourceName, listenerMinReconnectInterval, listenerMaxReconnectInterval, func(ev pq.ListenerEventType, err error) {
+		if err != nil {
+			logger.TechLog.Error(context.Background(), "notification listener event", zap.Int("event", int(ev)), zap.Error(err))
+		}
+	})
+	if err := l.listener.Listen(NotificationChannel); err != nil {
+		return nil, fmt.Errorf("unable to listen on channel %v: %w", NotificationChannel, err)
+	}
+
+	go l.run()
+
+	return l, nil
+}
+
+// Subscribe returns a channel receiving the IDs of the notifications created
+// for the given user, and a function to call once the caller is done. The
+// channel is closed when the subscriber falls behind, as events would
+// otherwise be lost: the caller then has to resync from the database.
+func (l *NotificationListener) Subscribe(tenantID, userID uint64) (<-chan string, func()) {
+	key := subscriberKey{tenantID: tenantID, userID: userID}
+	ch := make(chan string, subscriberBufferSize)
+
+	l.mu.Lock()
+	if l.subscribers[key] == nil {
+		l.subscribers[key] = map[chan string]struct{}{}
+	}
+	l.subscribers[key][ch] = struct{}{}
+	l.mu.Unlock()
+
+	return ch, func() {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		delete(l.subscribers[key], ch)
+		if len(l.subscribers[key]) == 0 {
+			delete(l.subscribers, key)
+		}
+	}
+}
+
+func (l *NotificationListener) run() {
+	ctx := context.Background()
+	for {
+		select {
+		case n, ok := <-l.listener.Notify:
+			if !ok {
+				return
+			}
+			// a nil notification is sent after the connection has been re-established
+			if n == nil {
+				continue
+			}
+			var ev notificationEvent
+			if err := json.Unmarshal([]byte(n.Extra), &ev); err != nil {
+				logger.TechLog.Error(ctx, "unable to decode notification event", zap.String("payload", n.Extra), zap.Error(err))
+				continue
+			}
+			l.dispatch(ctx, ev)
+		case <-time.After(listenerPingInterval):
+			if err := l.listener.Ping(); err != nil {
+				logger.TechLog.Error(ctx, "notification listener ping failed", zap.Error(err))
+			}
+		}
+	}
+}
+
+func (l *NotificationListener) dispatch(ctx context.Context, ev notificationEvent) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	key := subscriberKey{tenantID: ev.TenantID, userID: ev.UserID}
+	for ch := range l.subscribers[key] {
+		select {
+		case ch <- ev.NotificationID:
+		default:
+			logger.TechLog.Warn(ctx, "notification subscriber is too slow, disconnecting it", zap.Uint64("user_id", ev.UserID), zap.String("notification_id", ev.NotificationID))
+			delete(l.subscribers[key], ch)
+			close(ch)
+		}
+	}
+	if len(l.subscribers[key]) == 0 {
+		delete(l.subscribers, key)
+	}
+}
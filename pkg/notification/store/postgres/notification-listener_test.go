@@ -0,0 +1,34 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/CHORUS-TRE/chorus-backend/tests/unit"
+)
+
+func TestNotificationListener_Dispatch(t *testing.T) {
+	unit.InitTestLogger()
+
+	l := &NotificationListener{subscribers: map[subscriberKey]map[chan string]struct{}{}}
+
+	slow, _ := l.Subscribe(1, 1)
+	fast, unsubscribe := l.Subscribe(1, 1)
+	defer unsubscribe()
+
+	for i := 0; i < subscriberBufferSize; i++ {
+		l.dispatch(context.Background(), notificationEvent{TenantID: 1, UserID: 1, NotificationID: "id"})
+		<-fast
+	}
+	l.dispatch(context.Background(), notificationEvent{TenantID: 1, UserID: 1, NotificationID: "dropped"})
+	require.Equal(t, "dropped", <-fast)
+
+	for i := 0; i < subscriberBufferSize; i++ {
+		require.Equal(t, "id", <-slow)
+	}
+	_, ok := <-slow
+	require.False(t, ok, "a subscriber falling behind is disconnected")
+	require.Len(t, l.subscribers[subscriberKey{tenantID: 1, userID: 1}], 1)
+}
@@ -0,0 +1,72 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+// ArchiveReadNotifications moves the entries of the tenant read before
+// readBefore to the archive, along with the username of their reader, and
+// returns the number of archived entries. The entries of the pinned
+// announcements are kept until the announcements end.
+func (s *NotificationStorage) ArchiveReadNotifications(ctx context.Context, tenantID uint64, readBefore time.Time) (int64, error) {
+	const query = `
+WITH archived AS (
+	DELETE FROM notifications_read_by nrb
+	USING notifications n, users u
+	WHERE nrb.tenantid = $1 AND nrb.readat IS NOT NULL AND nrb.readat < $2
+	AND n.id = nrb.notificationid AND NOT (n.pinned AND n.endsat IS NOT NULL AND n.endsat > NOW())
+	AND u.id = nrb.userid
+	RETURNING nrb.tenantid, nrb.notificationid, nrb.userid, u.username, nrb.readat
+)
+INSERT INTO notifications_read_by_archive (tenantid, notificationid, userid, username, readat)
+SELECT tenantid, notificationid, userid, username, readat FROM archived
+ON CONFLICT (notificationid, userid) DO NOTHING;
+`
+	rows, err := s.db.ExecContext(ctx, query, tenantID, readBefore)
+	if err != nil {
+		return 0, err
+	}
+
+	return rows.RowsAffected()
+}
+
+// PurgeNotifications deletes the notifications of the tenant created before
+// createdBefore that have no recipient left, along with their email
+// deliveries, and returns the number of deleted notifications.
+func (s *NotificationStorage) PurgeNotifications(ctx context.Context, tenantID uint64, createdBefore time.Time) (int64, error) {
+	tx, err := s.db.BeginTxx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return 0, fmt.Errorf("unable to begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	const purgeable = `
+SELECT n.id FROM notifications n
+WHERE n.tenantid = $1 AND n.createdat < $2
+AND NOT EXISTS (SELECT 1 FROM notifications_read_by nrb WHERE nrb.notificationid = n.id)
+`
+	const deleteDeliveriesQuery = `DELETE FROM notification_deliveries WHERE notificationid IN (` + purgeable + `);`
+	if _, err := tx.ExecContext(ctx, deleteDeliveriesQuery, tenantID, createdBefore); err != nil {
+		return 0, fmt.Errorf("unable to delete notification deliveries: %w", err)
+	}
+
+	const deleteNotificationsQuery = `DELETE FROM notifications WHERE id IN (` + purgeable + `);`
+	rows, err := tx.ExecContext(ctx, deleteNotificationsQuery, tenantID, createdBefore)
+	if err != nil {
+		return 0, fmt.Errorf("unable to delete notifications: %w", err)
+	}
+	purged, err := rows.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("unable to commit purge: %w", err)
+	}
+	return purged, nil
+}
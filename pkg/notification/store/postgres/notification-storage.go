@@ -2,19 +2,23 @@ package postgres
 
 import (
 	"context"
+	"database/sql"
+	"encoding/json"
 	"fmt"
 	"strings"
 
 	"github.com/CHORUS-TRE/chorus-backend/pkg/common/storage"
 
-	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
 	common "github.com/CHORUS-TRE/chorus-backend/pkg/common/model"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/notification/model"
 	"github.com/jmoiron/sqlx"
 	"github.com/lib/pq"
-	"go.uber.org/zap"
 )
 
+// displayedClause restricts the notifications to the ones within their
+// display window.
+const displayedClause = "(n.startsat IS NULL OR n.startsat <= NOW()) AND (n.endsat IS NULL OR n.endsat > NOW())"
+
 type NotificationStorage struct {
 	db *sqlx.DB
 }
@@ -23,10 +27,27 @@ func NewNotificationStorage(db *sqlx.DB) *NotificationStorage {
 	return &NotificationStorage{db: db}
 }
 
+// CreateNotification inserts the notification and its recipients in a single
+// transaction and signals every recipient on the notification channel, so
+// that listeners on any replica are woken up once the transaction commits.
 func (s *NotificationStorage) CreateNotification(ctx context.Context, notification *model.Notification, userIDs []uint64) error {
-	const query = `INSERT INTO notifications (id, tenantid, message) VALUES ($1, $2, $3)`
+	tx, err := s.db.BeginTxx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return fmt.Errorf("unable to begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
 
-	if _, err := s.db.ExecContext(ctx, query, notification.ID, notification.TenantID, notification.Message); err != nil {
+	const query = `
+INSERT INTO notifications (id, tenantid, type, message, link, params, pinned, startsat, endsat)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+`
+	_, err = tx.ExecContext(ctx, query,
+		notification.ID, notification.TenantID, notification.Type, notification.Message, notification.Link, notification.Params,
+		notification.Pinned, notification.StartsAt, notification.EndsAt,
+	)
+	if err != nil {
 		// on duplicate key return no error
 		const DuplicateKeyErrorCode = "23505"
 		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == DuplicateKeyErrorCode {
@@ -35,19 +56,51 @@ func (s *NotificationStorage) CreateNotification(ctx context.Context, notificati
 		return fmt.Errorf("unable to create notification: %w", err)
 	}
 	for _, userID := range userIDs {
-		const query = `INSERT INTO notifications_read_by (tenantid, notificationid, userid) VALUES ($1,$2,$3)`
-		if _, err := s.db.ExecContext(ctx, query, notification.TenantID, notification.ID, userID); err != nil {
-			logger.TechLog.Error(ctx, "unable to insert notifications_read_by", zap.Uint64("user-id", userID))
+		const query = `INSERT INTO notifications_read_by (tenantid, notificationid, userid) VALUES ($1,$2,$3) ON CONFLICT DO NOTHING`
+		if _, err := tx.ExecContext(ctx, query, notification.TenantID, notification.ID, userID); err != nil {
+			return fmt.Errorf("unable to insert notifications_read_by for user %v: %w", userID, err)
+		}
+
+		if _, err := tx.ExecContext(ctx, queueDeliveryQuery, notification.TenantID, notification.ID, userID, notification.Type, notification.StartsAt); err != nil {
+			return fmt.Errorf("unable to queue email delivery for user %v: %w", userID, err)
+		}
+
+		payload, err := json.Marshal(notificationEvent{TenantID: notification.TenantID, UserID: userID, NotificationID: notification.ID})
+		if err != nil {
+			return fmt.Errorf("unable to marshal notification event: %w", err)
 		}
+		if _, err := tx.ExecContext(ctx, `SELECT pg_notify($1, $2)`, NotificationChannel, string(payload)); err != nil {
+			return fmt.Errorf("unable to notify user %v: %w", userID, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("unable to commit notification: %w", err)
 	}
 	return nil
 }
 
+func (s *NotificationStorage) GetNotification(ctx context.Context, tenantID, userID uint64, notificationID string) (*model.Notification, error) {
+	const query = `
+SELECT n.id, n.tenantid, n.type, n.message, n.link, n.params, n.createdat, n.pinned, n.startsat, n.endsat, nrb.readat
+FROM notifications n
+JOIN notifications_read_by nrb ON n.id = nrb.notificationid
+WHERE n.tenantid = $1 AND nrb.userid = $2 AND n.id = $3
+`
+	var notification model.Notification
+	if err := s.db.GetContext(ctx, &notification, query, tenantID, userID, notificationID); err != nil {
+		return nil, err
+	}
+	return &notification, nil
+}
+
 func (s *NotificationStorage) CountUnreadNotifications(ctx context.Context, tenantID, userID uint64) (uint32, error) {
 	const query = `
 SELECT count(*) as count FROM notifications_read_by nrb
+JOIN notifications n ON n.id = nrb.notificationid
 WHERE nrb.tenantid = $1 AND nrb.userid = $2
 AND nrb.readat IS NULL
+AND ` + displayedClause + `
 	`
 	var count uint32
 	if err := s.db.GetContext(ctx, &count, query, tenantID, userID); err != nil {
@@ -94,7 +147,7 @@ func (s *NotificationStorage) GetNotifications(ctx context.Context, tenantID, us
 func buildWhereClauses(tenantID, userID uint64, query string, isRead *bool) ([]interface{}, string) {
 	var args []interface{}
 	args = append(args, tenantID, userID)
-	whereClauses := "WHERE n.tenantid = ? AND nrb.userid = ? "
+	whereClauses := "WHERE n.tenantid = ? AND nrb.userid = ? AND " + displayedClause
 
 	if query != "" {
 		likeQuery := "%" + query + "%"
@@ -106,7 +159,8 @@ func buildWhereClauses(tenantID, userID uint64, query string, isRead *bool) ([]i
 		if *isRead {
 			whereClauses += " AND nrb.readat IS NOT NULL"
 		} else {
-			whereClauses += " AND nrb.readat IS NULL"
+			// the pinned announcements stay listed once read
+			whereClauses += " AND (nrb.readat IS NULL OR n.pinned)"
 		}
 	}
 
@@ -117,13 +171,14 @@ func buildSortClause(args []interface{}, sort common.Sort, offset uint64, limit
 	columnName := model.NotificationSortTypeToString[strings.ToUpper(sort.SortType)]
 	sortOrder := storage.SortOrderToString(strings.ToUpper(sort.SortOrder))
 	args = append(args, offset, limit)
-	sortClause := fmt.Sprintf(` ORDER BY %s %s offset ? limit ?`, columnName, sortOrder)
+	sortClause := fmt.Sprintf(` ORDER BY n.pinned DESC, %s %s offset ? limit ?`, columnName, sortOrder)
 	return args, sortClause
 }
 
 func (s *NotificationStorage) getNotifications(ctx context.Context, whereClause, sortClause string, args []interface{}) ([]*model.Notification, error) {
 	selectQuery := `
-SELECT n.id, n.tenantid, n.message, n.createdat, nrb.readat FROM notifications n
+SELECT n.id, n.tenantid, n.type, n.message, n.link, n.params, n.createdat, n.pinned, n.startsat, n.endsat, nrb.readat
+FROM notifications n
 left join notifications_read_by nrb on n.id = nrb.notificationid
 ` + whereClause + sortClause
 	query, args, err := sqlx.In(selectQuery, args...)
@@ -0,0 +1,128 @@
+package model
+
+import (
+	"fmt"
+	"time"
+)
+
+// Quota maps an entry in the 'quotas' database table. A nil limit is
+// unlimited.
+type Quota struct {
+	ID uint64
+
+	TenantID uint64
+	Scope    QuotaScope
+	// ScopeID is the ID of the workspace or of the user the quota applies to.
+	// It is 0 for the tenant quota and for the default quota of all the
+	// workspaces or of all the users of the tenant.
+	ScopeID uint64
+
+	MaxWorkbenches  *uint64
+	MaxAppInstances *uint64
+	// MaxCPU is the total CPU requested by the app instances, in millicores.
+	MaxCPU *uint64
+	// MaxMemory is the total memory requested by the app instances, in MiB.
+	MaxMemory *uint64
+	// MaxSnapshots is the number of workbench snapshots kept.
+	MaxSnapshots *uint64
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// QuotaScope represents what a quota applies to.
+type QuotaScope string
+
+const (
+	QuotaScopeTenant    QuotaScope = "tenant"
+	QuotaScopeWorkspace QuotaScope = "workspace"
+	QuotaScopeUser      QuotaScope = "user"
+)
+
+func (s QuotaScope) String() string {
+	return string(s)
+}
+
+func ToQuotaScope(scope string) (QuotaScope, error) {
+	switch scope {
+	case QuotaScopeTenant.String():
+		return QuotaScopeTenant, nil
+	case QuotaScopeWorkspace.String():
+		return QuotaScopeWorkspace, nil
+	case QuotaScopeUser.String():
+		return QuotaScopeUser, nil
+	default:
+		return "", fmt.Errorf("unexpected QuotaScope: %s", scope)
+	}
+}
+
+// Usage is the resources used within a scope, or the resources requested
+// by a new workbench, app instance or snapshot.
+type Usage struct {
+	Workbenches  uint64
+	AppInstances uint64
+	CPU          uint64
+	Memory       uint64
+	Snapshots    uint64
+}
+
+// QuotaUsage is the usage of a scope along with its quota, which is nil when
+// the scope has none.
+type QuotaUsage struct {
+	Scope   QuotaScope
+	ScopeID uint64
+	Quota   *Quota
+	Usage   Usage
+}
+
+// Limit is a limit of a quota along with the usage it applies to.
+type Limit struct {
+	Name      string
+	Max       *uint64
+	Used      uint64
+	Requested uint64
+}
+
+func (q *Quota) limits(usage, requested Usage) []Limit {
+	return []Limit{
+		{"workbenches", q.MaxWorkbenches, usage.Workbenches, requested.Workbenches},
+		{"app instances", q.MaxAppInstances, usage.AppInstances, requested.AppInstances},
+		{"cpu (millicores)", q.MaxCPU, usage.CPU, requested.CPU},
+		{"memory (MiB)", q.MaxMemory, usage.Memory, requested.Memory},
+		{"snapshots", q.MaxSnapshots, usage.Snapshots, requested.Snapshots},
+	}
+}
+
+// Exceeded returns a description of the first limit of the quota that the
+// usage would exceed with the requested resources, or an empty string when
+// none would be exceeded.
+func (q *Quota) Exceeded(usage, requested Usage) string {
+	for _, l := range q.limits(usage, requested) {
+		if l.Max == nil || l.Requested == 0 {
+			continue
+		}
+		if l.Used+l.Requested > *l.Max {
+			return fmt.Sprintf("%v: %v used and %v requested out of %v", l.Name, l.Used, l.Requested, *l.Max)
+		}
+	}
+
+	return ""
+}
+
+// Approaching returns the first limit of the quota whose usage reaches the
+// given ratio of its maximum with the requested resources, or nil when none
+// does. A limit whose usage had already reached the ratio is not returned, so
+// that the users are only warned once.
+func (q *Quota) Approaching(usage, requested Usage, ratio float64) *Limit {
+	for _, l := range q.limits(usage, requested) {
+		if l.Max == nil || l.Requested == 0 {
+			continue
+		}
+		threshold := ratio * float64(*l.Max)
+		if float64(l.Used) < threshold && float64(l.Used+l.Requested) >= threshold {
+			return &l
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,115 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQuota_Exceeded(t *testing.T) {
+	limit := func(v uint64) *uint64 { return &v }
+
+	quota := &Quota{
+		MaxWorkbenches: limit(2),
+		MaxCPU:         limit(1000),
+	}
+
+	tests := []struct {
+		name           string
+		usage          Usage
+		requested      Usage
+		expectsExceeds bool
+	}{
+		{
+			name:      "With usage below the limit, is not exceeded",
+			usage:     Usage{Workbenches: 1},
+			requested: Usage{Workbenches: 1},
+		},
+		{
+			name:           "With usage at the limit, is exceeded",
+			usage:          Usage{Workbenches: 2},
+			requested:      Usage{Workbenches: 1},
+			expectsExceeds: true,
+		},
+		{
+			name:           "With requested resources above the limit, is exceeded",
+			usage:          Usage{CPU: 500},
+			requested:      Usage{AppInstances: 1, CPU: 600},
+			expectsExceeds: true,
+		},
+		{
+			name:      "With unlimited resources, is not exceeded",
+			usage:     Usage{AppInstances: 100, Memory: 1 << 20},
+			requested: Usage{AppInstances: 1, Memory: 1024},
+		},
+		{
+			name:      "With a limit already exceeded but nothing requested, is not exceeded",
+			usage:     Usage{Workbenches: 3},
+			requested: Usage{AppInstances: 1},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			exceeded := quota.Exceeded(test.usage, test.requested)
+			assert.Equal(t, test.expectsExceeds, exceeded != "")
+		})
+	}
+}
+
+func TestQuota_Approaching(t *testing.T) {
+	limit := func(v uint64) *uint64 { return &v }
+
+	quota := &Quota{
+		MaxWorkbenches: limit(10),
+		MaxMemory:      limit(1000),
+	}
+
+	tests := []struct {
+		name          string
+		usage         Usage
+		requested     Usage
+		expectedLimit string
+	}{
+		{
+			name:      "With usage below the ratio, is not approaching",
+			usage:     Usage{Workbenches: 6},
+			requested: Usage{Workbenches: 1},
+		},
+		{
+			name:          "With usage reaching the ratio, is approaching",
+			usage:         Usage{Workbenches: 7},
+			requested:     Usage{Workbenches: 1},
+			expectedLimit: "workbenches",
+		},
+		{
+			name:      "With usage already above the ratio, is not approaching again",
+			usage:     Usage{Workbenches: 8},
+			requested: Usage{Workbenches: 1},
+		},
+		{
+			name:          "With requested resources crossing the ratio, is approaching",
+			usage:         Usage{Memory: 100},
+			requested:     Usage{AppInstances: 1, Memory: 800},
+			expectedLimit: "memory (MiB)",
+		},
+		{
+			name:      "With unlimited resources, is not approaching",
+			usage:     Usage{AppInstances: 100},
+			requested: Usage{AppInstances: 1},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			l := quota.Approaching(test.usage, test.requested, 0.8)
+			if test.expectedLimit == "" {
+				assert.Nil(t, l)
+				return
+			}
+			if assert.NotNil(t, l) {
+				assert.Equal(t, test.expectedLimit, l.Name)
+			}
+		})
+	}
+}
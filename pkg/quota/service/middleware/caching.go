@@ -0,0 +1,64 @@
+package middleware
+
+import (
+	"context"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
+	"github.com/CHORUS-TRE/chorus-backend/internal/utils/cache"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/quota/model"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/quota/service"
+)
+
+const (
+	defaultCacheExpiration = 5
+)
+
+func QuotaCaching(log *logger.ContextLogger, backend cache.Backend) func(service.Quotaer) *Caching {
+	return func(next service.Quotaer) *Caching {
+		return &Caching{
+			cache: cache.NewCache(backend, log),
+			next:  next,
+		}
+	}
+}
+
+type Caching struct {
+	cache *cache.Cache
+	next  service.Quotaer
+}
+
+func (c *Caching) ListQuotas(ctx context.Context, tenantID uint64) (reply []*model.Quota, err error) {
+	entry := c.cache.NewEntry(cache.WithUint64(tenantID)).WithTags(cache.TenantTag(tenantID))
+	reply = []*model.Quota{}
+
+	if ok := entry.Get(ctx, &reply); !ok {
+		reply, err = c.next.ListQuotas(ctx, tenantID)
+		if err == nil {
+			entry.Set(ctx, defaultCacheExpiration, reply)
+		}
+	}
+
+	return
+}
+
+func (c *Caching) SetQuota(ctx context.Context, quota *model.Quota) (uint64, error) {
+	id, err := c.next.SetQuota(ctx, quota)
+	c.cache.Invalidate(ctx, cache.TenantTag(quota.TenantID))
+	return id, err
+}
+
+func (c *Caching) DeleteQuota(ctx context.Context, tenantID, quotaID uint64) error {
+	err := c.next.DeleteQuota(ctx, tenantID, quotaID)
+	c.cache.Invalidate(ctx, cache.TenantTag(tenantID))
+	return err
+}
+
+// GetUsage and CheckQuota are not cached as the usage changes with every
+// created workbench and app instance.
+func (c *Caching) GetUsage(ctx context.Context, tenantID, workspaceID, userID uint64) ([]*model.QuotaUsage, error) {
+	return c.next.GetUsage(ctx, tenantID, workspaceID, userID)
+}
+
+func (c *Caching) CheckQuota(ctx context.Context, tenantID, workspaceID, userID uint64, requested model.Usage) (func(), error) {
+	return c.next.CheckQuota(ctx, tenantID, workspaceID, userID, requested)
+}
@@ -0,0 +1,122 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/quota/model"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/quota/service"
+
+	"go.uber.org/zap"
+)
+
+type quotaServiceLogging struct {
+	logger *logger.ContextLogger
+	next   service.Quotaer
+}
+
+func Logging(logger *logger.ContextLogger) func(service.Quotaer) service.Quotaer {
+	return func(next service.Quotaer) service.Quotaer {
+		return &quotaServiceLogging{
+			logger: logger,
+			next:   next,
+		}
+	}
+}
+
+func (c quotaServiceLogging) ListQuotas(ctx context.Context, tenantID uint64) ([]*model.Quota, error) {
+	now := time.Now()
+
+	res, err := c.next.ListQuotas(ctx, tenantID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return res, fmt.Errorf("unable to get quotas: %w", err)
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		logger.WithCountField(len(res)),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, nil
+}
+
+func (c quotaServiceLogging) SetQuota(ctx context.Context, quota *model.Quota) (uint64, error) {
+	now := time.Now()
+
+	res, err := c.next.SetQuota(ctx, quota)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return 0, fmt.Errorf("unable to set quota: %w", err)
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		logger.WithQuotaIDField(res),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, nil
+}
+
+func (c quotaServiceLogging) DeleteQuota(ctx context.Context, tenantID, quotaID uint64) error {
+	now := time.Now()
+
+	err := c.next.DeleteQuota(ctx, tenantID, quotaID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			logger.WithQuotaIDField(quotaID),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return fmt.Errorf("unable to delete quota: %w", err)
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		logger.WithQuotaIDField(quotaID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return nil
+}
+
+func (c quotaServiceLogging) GetUsage(ctx context.Context, tenantID, workspaceID, userID uint64) ([]*model.QuotaUsage, error) {
+	now := time.Now()
+
+	res, err := c.next.GetUsage(ctx, tenantID, workspaceID, userID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return res, fmt.Errorf("unable to get usage: %w", err)
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, nil
+}
+
+func (c quotaServiceLogging) CheckQuota(ctx context.Context, tenantID, workspaceID, userID uint64, requested model.Usage) (func(), error) {
+	now := time.Now()
+
+	release, err := c.next.CheckQuota(ctx, tenantID, workspaceID, userID, requested)
+	if err != nil {
+		c.logger.Warn(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			logger.WithUserIDField(userID),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, fmt.Errorf("unable to check quota: %w", err)
+	}
+
+	c.logger.Debug(ctx, logger.LoggerMessageRequestCompleted,
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return release, nil
+}
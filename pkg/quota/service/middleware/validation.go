@@ -0,0 +1,47 @@
+package middleware
+
+import (
+	"context"
+
+	"github.com/CHORUS-TRE/chorus-backend/pkg/quota/model"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/quota/service"
+
+	val "github.com/go-playground/validator/v10"
+)
+
+type validation struct {
+	next     service.Quotaer
+	validate *val.Validate
+}
+
+func Validation(validate *val.Validate) func(service.Quotaer) service.Quotaer {
+	return func(next service.Quotaer) service.Quotaer {
+		return &validation{
+			next:     next,
+			validate: validate,
+		}
+	}
+}
+
+func (v validation) ListQuotas(ctx context.Context, tenantID uint64) ([]*model.Quota, error) {
+	return v.next.ListQuotas(ctx, tenantID)
+}
+
+func (v validation) SetQuota(ctx context.Context, quota *model.Quota) (uint64, error) {
+	if err := v.validate.Struct(quota); err != nil {
+		return 0, err
+	}
+	return v.next.SetQuota(ctx, quota)
+}
+
+func (v validation) DeleteQuota(ctx context.Context, tenantID, quotaID uint64) error {
+	return v.next.DeleteQuota(ctx, tenantID, quotaID)
+}
+
+func (v validation) GetUsage(ctx context.Context, tenantID, workspaceID, userID uint64) ([]*model.QuotaUsage, error) {
+	return v.next.GetUsage(ctx, tenantID, workspaceID, userID)
+}
+
+func (v validation) CheckQuota(ctx context.Context, tenantID, workspaceID, userID uint64, requested model.Usage) (func(), error) {
+	return v.next.CheckQuota(ctx, tenantID, workspaceID, userID, requested)
+}
@@ -0,0 +1,199 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/notification"
+	"github.com/CHORUS-TRE/chorus-backend/internal/utils/uuid"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/common/service"
+	notification_model "github.com/CHORUS-TRE/chorus-backend/pkg/notification/model"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/quota/model"
+)
+
+// approachingRatio is the share of a limit from which the users are warned
+// that the quota is almost reached.
+const approachingRatio = 0.8
+
+type Quotaer interface {
+	ListQuotas(ctx context.Context, tenantID uint64) ([]*model.Quota, error)
+	SetQuota(ctx context.Context, quota *model.Quota) (uint64, error)
+	DeleteQuota(ctx context.Context, tenantID, quotaID uint64) error
+	GetUsage(ctx context.Context, tenantID, workspaceID, userID uint64) ([]*model.QuotaUsage, error)
+	CheckQuota(ctx context.Context, tenantID, workspaceID, userID uint64, requested model.Usage) (func(), error)
+}
+
+type QuotaStore interface {
+	ListQuotas(ctx context.Context, tenantID uint64) ([]*model.Quota, error)
+	GetQuotas(ctx context.Context, tenantID, workspaceID, userID uint64) ([]*model.Quota, error)
+	SetQuota(ctx context.Context, tenantID uint64, quota *model.Quota) (uint64, error)
+	DeleteQuota(ctx context.Context, tenantID, quotaID uint64) error
+	GetUsage(ctx context.Context, tenantID uint64, scope model.QuotaScope, scopeID uint64) (*model.Usage, error)
+	LockQuotas(ctx context.Context, tenantID uint64) (func(), error)
+}
+
+type QuotaService struct {
+	store    QuotaStore
+	notifier notification.Notifier
+}
+
+func NewQuotaService(store QuotaStore, notifier notification.Notifier) *QuotaService {
+	return &QuotaService{store: store, notifier: notifier}
+}
+
+func (s *QuotaService) ListQuotas(ctx context.Context, tenantID uint64) ([]*model.Quota, error) {
+	quotas, err := s.store.ListQuotas(ctx, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query quotas: %w", err)
+	}
+	return quotas, nil
+}
+
+func (s *QuotaService) SetQuota(ctx context.Context, quota *model.Quota) (uint64, error) {
+	if _, err := model.ToQuotaScope(quota.Scope.String()); err != nil {
+		return 0, fmt.Errorf("%v: %w", err.Error(), &service.InvalidParametersErr{})
+	}
+	if quota.Scope == model.QuotaScopeTenant && quota.ScopeID != 0 {
+		return 0, fmt.Errorf("the scope id of a tenant quota must be 0: %w", &service.InvalidParametersErr{})
+	}
+
+	id, err := s.store.SetQuota(ctx, quota.TenantID, quota)
+	if err != nil {
+		return 0, fmt.Errorf("unable to set quota: %w", err)
+	}
+	return id, nil
+}
+
+func (s *QuotaService) DeleteQuota(ctx context.Context, tenantID, quotaID uint64) error {
+	if err := s.store.DeleteQuota(ctx, tenantID, quotaID); err != nil {
+		return fmt.Errorf("unable to delete quota %v: %w", quotaID, err)
+	}
+	return nil
+}
+
+// GetUsage returns the usage of the tenant, of the workspace and of the user
+// along with the quota applying to each of them. The workspace is omitted
+// when workspaceID is 0.
+func (s *QuotaService) GetUsage(ctx context.Context, tenantID, workspaceID, userID uint64) ([]*model.QuotaUsage, error) {
+	quotas, err := s.getQuotas(ctx, tenantID, workspaceID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []*model.QuotaUsage
+	for _, scope := range scopes(workspaceID, userID) {
+		usage, err := s.store.GetUsage(ctx, tenantID, scope.Scope, scope.ScopeID)
+		if err != nil {
+			return nil, fmt.Errorf("unable to get %v usage: %w", scope.Scope, err)
+		}
+		scope.Quota = quotas[scope.Scope]
+		scope.Usage = *usage
+		res = append(res, scope)
+	}
+	return res, nil
+}
+
+// CheckQuota returns a ResourceExhaustedErr when the requested resources
+// would exceed the quota of the tenant, of the workspace or of the user.
+// Otherwise the user is notified of the quotas the request brings close to
+// their limits.
+//
+// The quotas of the tenant stay locked until the returned release function is
+// called, which the caller does once the requested resources are stored, so
+// that concurrent requests cannot all pass the check. It can be called more
+// than once.
+func (s *QuotaService) CheckQuota(ctx context.Context, tenantID, workspaceID, userID uint64, requested model.Usage) (func(), error) {
+	quotas, err := s.getQuotas(ctx, tenantID, workspaceID, userID)
+	if err != nil {
+		return nil, err
+	}
+	// Without any quota, there is nothing to check nor to lock.
+	if len(quotas) == 0 {
+		return func() {}, nil
+	}
+
+	release, err := s.store.LockQuotas(ctx, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to lock the quotas: %w", err)
+	}
+	if err := s.checkQuota(ctx, quotas, tenantID, workspaceID, userID, requested); err != nil {
+		release()
+		return nil, err
+	}
+
+	return release, nil
+}
+
+func (s *QuotaService) checkQuota(ctx context.Context, quotas map[model.QuotaScope]*model.Quota, tenantID, workspaceID, userID uint64, requested model.Usage) error {
+	approaching := map[model.QuotaScope]*model.Limit{}
+	for _, scope := range scopes(workspaceID, userID) {
+		quota := quotas[scope.Scope]
+		if quota == nil {
+			continue
+		}
+
+		usage, err := s.store.GetUsage(ctx, tenantID, scope.Scope, scope.ScopeID)
+		if err != nil {
+			return fmt.Errorf("unable to get %v usage: %w", scope.Scope, err)
+		}
+
+		if exceeded := quota.Exceeded(*usage, requested); exceeded != "" {
+			return fmt.Errorf("%v quota exceeded for %v: %w", scope.Scope, exceeded, &service.ResourceExhaustedErr{})
+		}
+		if l := quota.Approaching(*usage, requested, approachingRatio); l != nil {
+			approaching[scope.Scope] = l
+		}
+	}
+
+	for scope, l := range approaching {
+		s.notifyApproaching(ctx, tenantID, workspaceID, userID, scope, l)
+	}
+
+	return nil
+}
+
+func (s *QuotaService) notifyApproaching(ctx context.Context, tenantID, workspaceID, userID uint64, scope model.QuotaScope, l *model.Limit) {
+	link := notification.AccountLink
+	if scope == model.QuotaScopeWorkspace {
+		link = notification.WorkspaceLink(workspaceID)
+	}
+
+	n := notification.NewNotificationBuilder(uuid.Next(), tenantID).
+		WithType(notification_model.NotificationTypeQuotaApproaching).
+		WithLink(link).
+		WithParam("scope", scope.String()).
+		WithParam("resource", l.Name).
+		WithParam("used", fmt.Sprint(l.Used+l.Requested)).
+		WithParam("max", fmt.Sprint(*l.Max)).
+		Build()
+	notification.Notify(ctx, s.notifier, n, userID)
+}
+
+// getQuotas returns the quota applying to each scope, the quota of a
+// workspace or of a user taking precedence over the default one.
+func (s *QuotaService) getQuotas(ctx context.Context, tenantID, workspaceID, userID uint64) (map[model.QuotaScope]*model.Quota, error) {
+	quotas, err := s.store.GetQuotas(ctx, tenantID, workspaceID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query quotas: %w", err)
+	}
+
+	res := make(map[model.QuotaScope]*model.Quota)
+	for _, q := range quotas {
+		if q.Scope == model.QuotaScopeWorkspace && workspaceID == 0 {
+			continue
+		}
+		if current, ok := res[q.Scope]; ok && current.ScopeID != 0 {
+			continue
+		}
+		res[q.Scope] = q
+	}
+	return res, nil
+}
+
+func scopes(workspaceID, userID uint64) []*model.QuotaUsage {
+	res := []*model.QuotaUsage{{Scope: model.QuotaScopeTenant}}
+	if workspaceID != 0 {
+		res = append(res, &model.QuotaUsage{Scope: model.QuotaScopeWorkspace, ScopeID: workspaceID})
+	}
+	return append(res, &model.QuotaUsage{Scope: model.QuotaScopeUser, ScopeID: userID})
+}
@@ -0,0 +1,86 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	common_service "github.com/CHORUS-TRE/chorus-backend/pkg/common/service"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/quota/model"
+)
+
+type quotaStore struct {
+	QuotaStore
+	quotas   []*model.Quota
+	usage    model.Usage
+	locks    int
+	releases int
+}
+
+func (s *quotaStore) GetQuotas(ctx context.Context, tenantID, workspaceID, userID uint64) ([]*model.Quota, error) {
+	return s.quotas, nil
+}
+
+func (s *quotaStore) GetUsage(ctx context.Context, tenantID uint64, scope model.QuotaScope, scopeID uint64) (*model.Usage, error) {
+	usage := s.usage
+	return &usage, nil
+}
+
+func (s *quotaStore) LockQuotas(ctx context.Context, tenantID uint64) (func(), error) {
+	s.locks++
+	return func() { s.releases++ }, nil
+}
+
+func TestCheckQuota(t *testing.T) {
+	limit := func(v uint64) *uint64 { return &v }
+
+	tests := []struct {
+		name             string
+		quotas           []*model.Quota
+		usage            model.Usage
+		expectsExhausted bool
+		expectsLocks     int
+	}{
+		{
+			name:         "Without quotas, does not lock",
+			usage:        model.Usage{Workbenches: 100},
+			expectsLocks: 0,
+		},
+		{
+			name:         "Within the quota, keeps the lock until released",
+			quotas:       []*model.Quota{{Scope: model.QuotaScopeTenant, MaxWorkbenches: limit(10)}},
+			usage:        model.Usage{Workbenches: 1},
+			expectsLocks: 1,
+		},
+		{
+			name:             "With the quota exceeded, releases the lock",
+			quotas:           []*model.Quota{{Scope: model.QuotaScopeTenant, MaxWorkbenches: limit(10)}},
+			usage:            model.Usage{Workbenches: 10},
+			expectsExhausted: true,
+			expectsLocks:     1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &quotaStore{quotas: tt.quotas, usage: tt.usage}
+			s := NewQuotaService(store, nil)
+
+			release, err := s.CheckQuota(context.Background(), 1, 2, 3, model.Usage{Workbenches: 1})
+			require.Equal(t, tt.expectsLocks, store.locks)
+			if tt.expectsExhausted {
+				require.True(t, errors.As(err, new(*common_service.ResourceExhaustedErr)))
+				require.Nil(t, release)
+				require.Equal(t, 1, store.releases)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, 0, store.releases)
+			release()
+			require.Equal(t, tt.expectsLocks, store.releases)
+		})
+	}
+}
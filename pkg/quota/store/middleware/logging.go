@@ -0,0 +1,150 @@
+package middleware
+
+import (
+	"context"
+	"time"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/quota/model"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/quota/service"
+
+	"go.uber.org/zap"
+)
+
+type quotaStorageLogging struct {
+	logger *logger.ContextLogger
+	next   service.QuotaStore
+}
+
+func Logging(logger *logger.ContextLogger) func(service.QuotaStore) service.QuotaStore {
+	return func(next service.QuotaStore) service.QuotaStore {
+		return &quotaStorageLogging{
+			logger: logger,
+			next:   next,
+		}
+	}
+}
+
+func (c quotaStorageLogging) ListQuotas(ctx context.Context, tenantID uint64) ([]*model.Quota, error) {
+	c.logger.Debug(ctx, "request started")
+
+	now := time.Now()
+
+	res, err := c.next.ListQuotas(ctx, tenantID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		logger.WithCountField(len(res)),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, nil
+}
+
+func (c quotaStorageLogging) GetQuotas(ctx context.Context, tenantID, workspaceID, userID uint64) ([]*model.Quota, error) {
+	c.logger.Debug(ctx, "request started")
+
+	now := time.Now()
+
+	res, err := c.next.GetQuotas(ctx, tenantID, workspaceID, userID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		logger.WithCountField(len(res)),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, nil
+}
+
+func (c quotaStorageLogging) SetQuota(ctx context.Context, tenantID uint64, quota *model.Quota) (uint64, error) {
+	c.logger.Debug(ctx, "request started")
+
+	now := time.Now()
+
+	res, err := c.next.SetQuota(ctx, tenantID, quota)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return 0, err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		logger.WithQuotaIDField(res),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, nil
+}
+
+func (c quotaStorageLogging) DeleteQuota(ctx context.Context, tenantID, quotaID uint64) error {
+	c.logger.Debug(ctx, "request started")
+
+	now := time.Now()
+
+	err := c.next.DeleteQuota(ctx, tenantID, quotaID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		logger.WithQuotaIDField(quotaID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return nil
+}
+
+func (c quotaStorageLogging) GetUsage(ctx context.Context, tenantID uint64, scope model.QuotaScope, scopeID uint64) (*model.Usage, error) {
+	c.logger.Debug(ctx, "request started")
+
+	now := time.Now()
+
+	res, err := c.next.GetUsage(ctx, tenantID, scope, scopeID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, nil
+}
+
+func (c quotaStorageLogging) LockQuotas(ctx context.Context, tenantID uint64) (func(), error) {
+	c.logger.Debug(ctx, "request started")
+
+	now := time.Now()
+
+	res, err := c.next.LockQuotas(ctx, tenantID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, nil
+}
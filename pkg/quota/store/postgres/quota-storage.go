@@ -0,0 +1,167 @@
+package postgres
+
+import (
+	"context"
+	"database/sql/driver"
+	"sync"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/utils/database"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/quota/model"
+)
+
+// QuotaStorage is the handler through which a PostgresDB backend can be queried.
+type QuotaStorage struct {
+	db *sqlx.DB
+}
+
+// NewQuotaStorage returns a fresh quota service storage instance.
+func NewQuotaStorage(db *sqlx.DB) *QuotaStorage {
+	return &QuotaStorage{db: db}
+}
+
+func (s *QuotaStorage) ListQuotas(ctx context.Context, tenantID uint64) ([]*model.Quota, error) {
+	const query = `
+SELECT id, tenantid, scope, scopeid, maxworkbenches, maxappinstances, maxcpu, maxmemory, maxsnapshots, createdat, updatedat
+	FROM quotas
+WHERE tenantid = $1
+ORDER BY scope, scopeid;
+`
+	var quotas []*model.Quota
+	if err := s.db.SelectContext(ctx, &quotas, query, tenantID); err != nil {
+		return nil, err
+	}
+
+	return quotas, nil
+}
+
+// GetQuotas returns the quotas applying to a workspace and a user: the quota
+// of the tenant and the specific and default quotas of the workspace and of
+// the user.
+func (s *QuotaStorage) GetQuotas(ctx context.Context, tenantID, workspaceID, userID uint64) ([]*model.Quota, error) {
+	const query = `
+SELECT id, tenantid, scope, scopeid, maxworkbenches, maxappinstances, maxcpu, maxmemory, maxsnapshots, createdat, updatedat
+	FROM quotas
+WHERE tenantid = $1 AND (
+	scope = 'tenant' OR
+	(scope = 'workspace' AND scopeid IN (0, $2)) OR
+	(scope = 'user' AND scopeid IN (0, $3))
+);
+`
+	var quotas []*model.Quota
+	if err := s.db.SelectContext(ctx, &quotas, query, tenantID, workspaceID, userID); err != nil {
+		return nil, err
+	}
+
+	return quotas, nil
+}
+
+// SetQuota creates the quota of a scope or replaces its limits.
+func (s *QuotaStorage) SetQuota(ctx context.Context, tenantID uint64, quota *model.Quota) (uint64, error) {
+	const query = `
+INSERT INTO quotas (tenantid, scope, scopeid, maxworkbenches, maxappinstances, maxcpu, maxmemory, maxsnapshots, createdat, updatedat)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NOW(), NOW())
+ON CONFLICT (tenantid, scope, scopeid) DO UPDATE SET
+	maxworkbenches = EXCLUDED.maxworkbenches,
+	maxappinstances = EXCLUDED.maxappinstances,
+	maxcpu = EXCLUDED.maxcpu,
+	maxmemory = EXCLUDED.maxmemory,
+	maxsnapshots = EXCLUDED.maxsnapshots,
+	updatedat = NOW()
+RETURNING id;
+`
+	var id uint64
+	err := s.db.GetContext(ctx, &id, query,
+		tenantID, quota.Scope, quota.ScopeID, quota.MaxWorkbenches, quota.MaxAppInstances, quota.MaxCPU, quota.MaxMemory, quota.MaxSnapshots,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+func (s *QuotaStorage) DeleteQuota(ctx context.Context, tenantID, quotaID uint64) error {
+	const query = `
+DELETE FROM quotas WHERE tenantid = $1 AND id = $2;
+`
+	rows, err := s.db.ExecContext(ctx, query, tenantID, quotaID)
+	if err != nil {
+		return err
+	}
+	affected, err := rows.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return database.ErrNoRowsDeleted
+	}
+
+	return nil
+}
+
+// GetUsage returns the workbenches that are not deleted, the active app
+// instances of a scope along with the resources they request, and the
+// workbench snapshots. A scopeID of 0 is the whole tenant.
+func (s *QuotaStorage) GetUsage(ctx context.Context, tenantID uint64, scope model.QuotaScope, scopeID uint64) (*model.Usage, error) {
+	const query = `
+SELECT
+	(SELECT count(*) FROM workbenchs w
+		WHERE w.tenantid = $1 AND w.status != 'deleted' AND (
+			$2::TEXT = 'tenant' OR
+			($2::TEXT = 'workspace' AND w.workspaceid = $3) OR
+			($2::TEXT = 'user' AND w.userid = $3)
+		)) AS workbenches,
+	(SELECT count(*) FROM workbench_snapshots s
+		WHERE s.tenantid = $1 AND (
+			$2::TEXT = 'tenant' OR
+			($2::TEXT = 'workspace' AND s.workspaceid = $3) OR
+			($2::TEXT = 'user' AND s.userid = $3)
+		)) AS snapshots,
+	count(ai.id) AS appinstances,
+	COALESCE(sum(a.cpurequest), 0)::BIGINT AS cpu,
+	COALESCE(sum(a.memoryrequest), 0)::BIGINT AS memory
+FROM app_instances ai
+	JOIN apps a ON a.id = ai.appid
+WHERE ai.tenantid = $1 AND ai.status = 'active' AND (
+	$2::TEXT = 'tenant' OR
+	($2::TEXT = 'workspace' AND ai.workspaceid = $3) OR
+	($2::TEXT = 'user' AND ai.userid = $3)
+);
+`
+	var usage model.Usage
+	if err := s.db.GetContext(ctx, &usage, query, tenantID, scope.String(), scopeID); err != nil {
+		return nil, err
+	}
+
+	return &usage, nil
+}
+
+// LockQuotas takes the advisory lock of the quotas of a tenant on a dedicated
+// connection, waiting for it to be released when it is taken. The returned
+// function releases it and can be called more than once.
+func (s *QuotaStorage) LockQuotas(ctx context.Context, tenantID uint64) (func(), error) {
+	const lockQuery = `SELECT pg_advisory_lock(hashtextextended('quotas:' || $1::TEXT, 0));`
+	const unlockQuery = `SELECT pg_advisory_unlock(hashtextextended('quotas:' || $1::TEXT, 0));`
+
+	conn, err := s.db.Connx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := conn.ExecContext(ctx, lockQuery, tenantID); err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	return sync.OnceFunc(func() {
+		if _, err := conn.ExecContext(context.Background(), unlockQuery, tenantID); err != nil {
+			// Closing the session releases the lock, rather than returning
+			// the connection to the pool while it still holds it.
+			//nolint:errcheck
+			conn.Raw(func(interface{}) error { return driver.ErrBadConn })
+		}
+		conn.Close()
+	}), nil
+}
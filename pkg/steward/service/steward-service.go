@@ -16,8 +16,7 @@ type Tenanter interface {
 }
 
 type Userer interface {
-	CreateRole(ctx context.Context, role string) error
-	GetRoles(ctx context.Context) ([]*user_model.Role, error)
+	ListRoles(ctx context.Context, tenantID uint64) ([]*user_model.Role, error)
 }
 
 type Stewarder interface {
@@ -40,9 +39,9 @@ func (s *StewardService) InitializeNewTenant(ctx context.Context, tenantID uint6
 		return fmt.Errorf("tenant %v is reserved for technical users and cannot be initialized manually", tenantID)
 	}
 
-	// 1) ensure that default roles exist
-	if err := s.createDefaultRoles(ctx); err != nil {
-		return fmt.Errorf("unable to create default roles: %w", err)
+	// 1) ensure that the built-in roles exist
+	if err := s.checkBuiltInRoles(ctx, tenantID); err != nil {
+		return fmt.Errorf("unable to check built-in roles: %w", err)
 	}
 
 	// 2) ensure that technical tenant is created with required users
@@ -58,16 +57,30 @@ func (s *StewardService) InitializeNewTenant(ctx context.Context, tenantID uint6
 	return nil
 }
 
-func (s *StewardService) createDefaultRoles(ctx context.Context) error {
+// checkBuiltInRoles verifies that the built-in roles, which are created by
+// the migrations, exist.
+func (s *StewardService) checkBuiltInRoles(ctx context.Context, tenantID uint64) error {
+	roles, err := s.userer.ListRoles(ctx, tenantID)
+	if err != nil {
+		return err
+	}
 
-	for _, r := range []string{user_model.RoleAuthenticated.String(), user_model.RoleAdmin.String(), user_model.RoleChorus.String()} {
-		if err := s.userer.CreateRole(ctx, r); err != nil {
-			return fmt.Errorf("unable to create '%v' role: %w", r, err)
+	for _, r := range []user_model.UserRole{user_model.RoleAuthenticated, user_model.RoleAdmin, user_model.RoleChorus} {
+		found := false
+		for _, role := range roles {
+			if role.IsBuiltIn() && role.Name == r.String() {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("missing '%v' role", r)
 		}
 	}
 
 	return nil
 }
+
 func (s *StewardService) createTechnicalTenant(ctx context.Context) error {
 
 	err := s.tenanter.CreateTenant(ctx, s.conf.Daemon.TenantID, fmt.Sprintf("CHORUS-TECHNICAL-TENANT-%v", s.conf.Daemon.TenantID))
@@ -1,12 +1,169 @@
 package model
 
-import "time"
+import (
+	"crypto/sha256"
+	"database/sql/driver"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
 
 const TechnicalTenantID = uint64(9999999)
 
 type Tenant struct {
-	ID           uint64
-	Name         string
+	ID     uint64
+	Name   string
+	Status TenantStatus
+
+	// Settings is nil until the settings of the tenant are first stored,
+	// the tenant configuration being used until then.
+	Settings *TenantSettings
+
+	// TokensRevokedAt is when the tenant was last suspended. The tokens
+	// issued before are rejected, even once the tenant is resumed.
+	TokensRevokedAt *time.Time `db:"tokensrevokedat"`
+
 	CreationDate time.Time `db:"createdat"`
 	UpdateDate   time.Time `db:"updatedat"`
 }
+
+// AcceptsToken returns whether the users of the tenant can be authenticated
+// with a token issued at issuedAt, which is the case of the active tenants
+// whose tokens were not revoked since. The times are compared to the second,
+// the precision of the token issue times, so the tokens issued within the
+// second of the revocation are rejected.
+func (t *Tenant) AcceptsToken(issuedAt time.Time) bool {
+	if t.Status != TenantActive {
+		return false
+	}
+	return t.TokensRevokedAt == nil || issuedAt.Truncate(time.Second).After(t.TokensRevokedAt.Truncate(time.Second))
+}
+
+// TenantStatus represents the status of a tenant. The users of a tenant that
+// is not active cannot log in.
+type TenantStatus string
+
+const (
+	TenantActive    TenantStatus = "active"
+	TenantSuspended TenantStatus = "suspended"
+	TenantDeleted   TenantStatus = "deleted"
+)
+
+func (s TenantStatus) String() string {
+	return string(s)
+}
+
+func ToTenantStatus(status string) (TenantStatus, error) {
+	switch status {
+	case TenantActive.String():
+		return TenantActive, nil
+	case TenantSuspended.String():
+		return TenantSuspended, nil
+	case TenantDeleted.String():
+		return TenantDeleted, nil
+	default:
+		return "", fmt.Errorf("unexpected TenantStatus: %s", status)
+	}
+}
+
+// TenantSettings are the per-tenant settings, stored as JSON in the 'settings'
+// column of the 'tenants' table.
+type TenantSettings struct {
+	IPWhitelist   IPWhitelistSettings   `json:"ipWhitelist"`
+	Mailing       MailingSettings       `json:"mailing"`
+	SCIM          SCIMSettings          `json:"scim"`
+	Notifications NotificationsSettings `json:"notifications"`
+	Registries    RegistriesSettings    `json:"registries"`
+}
+
+// TenantSettingsUpdate holds the sections of the settings of a tenant to
+// update. The sections left nil are kept as they are.
+type TenantSettingsUpdate struct {
+	IPWhitelist   *IPWhitelistSettings
+	Mailing       *MailingSettings
+	SCIM          *SCIMSettings
+	Notifications *NotificationsSettings
+	Registries    *RegistriesSettings
+}
+
+// Apply returns the settings with the sections of the update replaced. The
+// hash of the SCIM token is kept.
+func (u TenantSettingsUpdate) Apply(settings TenantSettings) TenantSettings {
+	if u.IPWhitelist != nil {
+		settings.IPWhitelist = *u.IPWhitelist
+	}
+	if u.Mailing != nil {
+		settings.Mailing = *u.Mailing
+	}
+	if u.SCIM != nil {
+		tokenHash := settings.SCIM.TokenHash
+		settings.SCIM = *u.SCIM
+		settings.SCIM.TokenHash = tokenHash
+	}
+	if u.Notifications != nil {
+		settings.Notifications = *u.Notifications
+	}
+	if u.Registries != nil {
+		settings.Registries = *u.Registries
+	}
+	return settings
+}
+
+type IPWhitelistSettings struct {
+	Enabled     bool     `json:"enabled"`
+	Subnetworks []string `json:"subnetworks" validate:"dive,cidr"`
+}
+
+type MailingSettings struct {
+	FromEmail string `json:"fromEmail" validate:"omitempty,email"`
+	FromName  string `json:"fromName" validate:"omitempty,generalstring"`
+}
+
+// NotificationsSettings configures the retention of the notifications of the
+// tenant. The default retention period applies when RetentionDays is 0.
+type NotificationsSettings struct {
+	RetentionDays int `json:"retentionDays" validate:"gte=0"`
+}
+
+// RegistriesSettings lists the registries, or repository prefixes within
+// them, that the images of the apps of the tenant are allowed from. The
+// registries of the configuration of the tenant apply when it is empty, and no
+// image is allowed when both are.
+type RegistriesSettings struct {
+	Allowed []string `json:"allowed" validate:"dive,required,max=255"`
+}
+
+// SCIMSettings configures the SCIM provisioning of the tenant. Only the hash
+// of the bearer token is stored. The provisioned users cannot be of the
+// 'internal' source, whose users sign in with a local password.
+type SCIMSettings struct {
+	Enabled   bool   `json:"enabled"`
+	Source    string `json:"source" validate:"omitempty,generalstring,ne=internal"`
+	TokenHash string `json:"tokenHash"`
+}
+
+// HashSCIMToken returns the hash of a SCIM bearer token, as stored in the
+// settings.
+func HashSCIMToken(token string) string {
+	h := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(h[:])
+}
+
+func (s TenantSettings) Value() (driver.Value, error) {
+	return json.Marshal(s)
+}
+
+func (s *TenantSettings) Scan(src interface{}) error {
+	var b []byte
+	switch v := src.(type) {
+	case []byte:
+		b = v
+	case string:
+		b = []byte(v)
+	default:
+		return errors.New("unexpected type for tenant settings")
+	}
+	return json.Unmarshal(b, s)
+}
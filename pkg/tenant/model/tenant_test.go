@@ -0,0 +1,48 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTenantSettingsUpdateApply(t *testing.T) {
+	settings := TenantSettings{
+		IPWhitelist:   IPWhitelistSettings{Enabled: true, Subnetworks: []string{"10.0.0.0/8"}},
+		SCIM:          SCIMSettings{Enabled: true, Source: "okta", TokenHash: "hash"},
+		Notifications: NotificationsSettings{RetentionDays: 30},
+		Registries:    RegistriesSettings{Allowed: []string{"registry.example.com"}},
+	}
+
+	got := TenantSettingsUpdate{
+		Mailing: &MailingSettings{FromEmail: "noreply@example.com"},
+		SCIM:    &SCIMSettings{Enabled: true, Source: "entra"},
+	}.Apply(settings)
+
+	require.Equal(t, settings.IPWhitelist, got.IPWhitelist)
+	require.Equal(t, settings.Notifications, got.Notifications)
+	require.Equal(t, settings.Registries, got.Registries)
+	require.Equal(t, MailingSettings{FromEmail: "noreply@example.com"}, got.Mailing)
+	require.Equal(t, SCIMSettings{Enabled: true, Source: "entra", TokenHash: "hash"}, got.SCIM)
+
+	require.Equal(t, settings, TenantSettingsUpdate{}.Apply(settings))
+}
+
+func TestTenantAcceptsToken(t *testing.T) {
+	now := time.Now()
+	revokedAt := now.Add(-time.Hour)
+
+	require.True(t, (&Tenant{Status: TenantActive}).AcceptsToken(now))
+	require.False(t, (&Tenant{Status: TenantSuspended}).AcceptsToken(now))
+	require.True(t, (&Tenant{Status: TenantActive, TokensRevokedAt: &revokedAt}).AcceptsToken(now))
+	require.False(t, (&Tenant{Status: TenantActive, TokensRevokedAt: &revokedAt}).AcceptsToken(revokedAt.Add(-time.Minute)))
+
+	// The tokens carry their issue time to the second: the ones issued within
+	// the second of the revocation are rejected, before or after it.
+	revokedAt = time.Date(2024, 5, 1, 12, 0, 5, 300_000_000, time.UTC)
+	tenant := &Tenant{Status: TenantActive, TokensRevokedAt: &revokedAt}
+	require.False(t, tenant.AcceptsToken(time.Unix(revokedAt.Unix(), 0)))
+	require.False(t, tenant.AcceptsToken(revokedAt.Add(500*time.Millisecond)))
+	require.True(t, tenant.AcceptsToken(time.Unix(revokedAt.Unix()+1, 0)))
+}
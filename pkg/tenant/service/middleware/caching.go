@@ -7,19 +7,19 @@ import (
 	"github.com/CHORUS-TRE/chorus-backend/internal/utils/cache"
 	tenant_model "github.com/CHORUS-TRE/chorus-backend/pkg/tenant/model"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/tenant/service"
-
-	"github.com/coocood/freecache"
 )
 
 const (
-	tenantCacheSize        = 100 * 1024 * 1024 // Max 100MiB stored in memory
 	defaultCacheExpiration = 5
+
+	// tenantsTag is the tag of the list of tenants.
+	tenantsTag = "tenants"
 )
 
-func TenantCaching(log *logger.ContextLogger) func(service.Tenanter) *Caching {
+func TenantCaching(log *logger.ContextLogger, backend cache.Backend) func(service.Tenanter) *Caching {
 	return func(next service.Tenanter) *Caching {
 		return &Caching{
-			cache: cache.NewCache(freecache.NewCache(tenantCacheSize), log),
+			cache: cache.NewCache(backend, log),
 			next:  next,
 		}
 	}
@@ -31,9 +31,62 @@ type Caching struct {
 }
 
 func (c *Caching) CreateTenant(ctx context.Context, tenantID uint64, name string) error {
-	return c.next.CreateTenant(ctx, tenantID, name)
+	err := c.next.CreateTenant(ctx, tenantID, name)
+	c.cache.Invalidate(ctx, cache.TenantTag(tenantID), tenantsTag)
+	return err
+}
+
+// GetTenant is called on every request through the IP whitelisting and the
+// tenant status check, the changes to the settings are therefore applied
+// after at most defaultCacheExpiration.
+func (c *Caching) GetTenant(ctx context.Context, tenantID uint64) (reply *tenant_model.Tenant, err error) {
+	entry := c.cache.NewEntry(cache.WithUint64(tenantID)).WithTags(cache.TenantTag(tenantID))
+	reply = &tenant_model.Tenant{}
+
+	if ok := entry.Get(ctx, &reply); !ok {
+		reply, err = c.next.GetTenant(ctx, tenantID)
+		if err == nil {
+			entry.Set(ctx, defaultCacheExpiration, reply)
+		}
+	}
+
+	return
+}
+
+func (c *Caching) ListTenants(ctx context.Context) (reply []*tenant_model.Tenant, err error) {
+	entry := c.cache.NewEntry().WithTags(tenantsTag)
+	reply = []*tenant_model.Tenant{}
+
+	if ok := entry.Get(ctx, &reply); !ok {
+		reply, err = c.next.ListTenants(ctx)
+		if err == nil {
+			entry.Set(ctx, defaultCacheExpiration, reply)
+		}
+	}
+
+	return
+}
+
+func (c *Caching) UpdateTenant(ctx context.Context, req service.UpdateTenantReq) error {
+	err := c.next.UpdateTenant(ctx, req)
+	c.cache.Invalidate(ctx, cache.TenantTag(req.ID), tenantsTag)
+	return err
+}
+
+func (c *Caching) SuspendTenant(ctx context.Context, tenantID uint64) error {
+	err := c.next.SuspendTenant(ctx, tenantID)
+	c.cache.Invalidate(ctx, cache.TenantTag(tenantID), tenantsTag)
+	return err
+}
+
+func (c *Caching) ResumeTenant(ctx context.Context, tenantID uint64) error {
+	err := c.next.ResumeTenant(ctx, tenantID)
+	c.cache.Invalidate(ctx, cache.TenantTag(tenantID), tenantsTag)
+	return err
 }
 
-func (c *Caching) GetTenant(ctx context.Context, tenantID uint64) (*tenant_model.Tenant, error) {
-	return c.next.GetTenant(ctx, tenantID)
+func (c *Caching) DeleteTenant(ctx context.Context, tenantID uint64) error {
+	err := c.next.DeleteTenant(ctx, tenantID)
+	c.cache.Invalidate(ctx, cache.TenantTag(tenantID), tenantsTag)
+	return err
 }
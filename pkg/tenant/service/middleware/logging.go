@@ -48,3 +48,62 @@ func (l tenantServiceLogging) GetTenant(ctx context.Context, tenantID uint64) (*
 
 	return tenant, common.LogErrorIfAny(err, ctx, now, log)
 }
+
+func (l tenantServiceLogging) ListTenants(ctx context.Context) ([]*tenant_model.Tenant, error) {
+	now := time.Now()
+
+	tenants, err := l.next.ListTenants(ctx)
+	if err == nil {
+		l.logger.Debug(ctx, "tenants listed", logger.WithCountField(len(tenants)))
+	}
+
+	return tenants, common.LogErrorIfAny(err, ctx, now, l.logger)
+}
+
+func (l tenantServiceLogging) UpdateTenant(ctx context.Context, req service.UpdateTenantReq) error {
+	now := time.Now()
+
+	log := logger.With(l.logger,
+		logger.WithTenantIDField(req.ID),
+	)
+
+	err := l.next.UpdateTenant(ctx, req)
+
+	return common.LogErrorIfAny(err, ctx, now, log)
+}
+
+func (l tenantServiceLogging) SuspendTenant(ctx context.Context, tenantID uint64) error {
+	now := time.Now()
+
+	log := logger.With(l.logger,
+		logger.WithTenantIDField(tenantID),
+	)
+
+	err := l.next.SuspendTenant(ctx, tenantID)
+
+	return common.LogErrorIfAny(err, ctx, now, log)
+}
+
+func (l tenantServiceLogging) ResumeTenant(ctx context.Context, tenantID uint64) error {
+	now := time.Now()
+
+	log := logger.With(l.logger,
+		logger.WithTenantIDField(tenantID),
+	)
+
+	err := l.next.ResumeTenant(ctx, tenantID)
+
+	return common.LogErrorIfAny(err, ctx, now, log)
+}
+
+func (l tenantServiceLogging) DeleteTenant(ctx context.Context, tenantID uint64) error {
+	now := time.Now()
+
+	log := logger.With(l.logger,
+		logger.WithTenantIDField(tenantID),
+	)
+
+	err := l.next.DeleteTenant(ctx, tenantID)
+
+	return common.LogErrorIfAny(err, ctx, now, log)
+}
@@ -30,3 +30,26 @@ func (v validation) CreateTenant(ctx context.Context, tenantID uint64, name stri
 func (v validation) GetTenant(ctx context.Context, tenantID uint64) (*tenant_model.Tenant, error) {
 	return v.next.GetTenant(ctx, tenantID)
 }
+
+func (v validation) ListTenants(ctx context.Context) ([]*tenant_model.Tenant, error) {
+	return v.next.ListTenants(ctx)
+}
+
+func (v validation) UpdateTenant(ctx context.Context, req service.UpdateTenantReq) error {
+	if err := v.validate.Struct(req); err != nil {
+		return err
+	}
+	return v.next.UpdateTenant(ctx, req)
+}
+
+func (v validation) SuspendTenant(ctx context.Context, tenantID uint64) error {
+	return v.next.SuspendTenant(ctx, tenantID)
+}
+
+func (v validation) ResumeTenant(ctx context.Context, tenantID uint64) error {
+	return v.next.ResumeTenant(ctx, tenantID)
+}
+
+func (v validation) DeleteTenant(ctx context.Context, tenantID uint64) error {
+	return v.next.DeleteTenant(ctx, tenantID)
+}
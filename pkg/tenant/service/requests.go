@@ -0,0 +1,15 @@
+package service
+
+import "github.com/CHORUS-TRE/chorus-backend/pkg/tenant/model"
+
+type UpdateTenantReq struct {
+	ID   uint64
+	Name string `validate:"required,max=255,generalstring"`
+	// Settings holds the sections of the settings to update, the other ones
+	// are kept.
+	Settings model.TenantSettingsUpdate
+
+	// SCIMToken is the new SCIM bearer token of the tenant. The current one is
+	// kept when it is empty.
+	SCIMToken string
+}
@@ -2,28 +2,46 @@ package service
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/CHORUS-TRE/chorus-backend/internal/config"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/common/service"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/tenant/model"
 )
 
 type Tenanter interface {
 	CreateTenant(ctx context.Context, tenantID uint64, name string) error
 	GetTenant(ctx context.Context, tenantID uint64) (*model.Tenant, error)
+	ListTenants(ctx context.Context) ([]*model.Tenant, error)
+	UpdateTenant(ctx context.Context, req UpdateTenantReq) error
+	SuspendTenant(ctx context.Context, tenantID uint64) error
+	ResumeTenant(ctx context.Context, tenantID uint64) error
+	DeleteTenant(ctx context.Context, tenantID uint64) error
 }
 
 type TenantStore interface {
 	GetTenant(ctx context.Context, tenantID uint64) (*model.Tenant, error)
+	ListTenants(ctx context.Context) ([]*model.Tenant, error)
 	CreateTenant(ctx context.Context, tenantID uint64, name string) error
+	UpdateTenant(ctx context.Context, tenant *model.Tenant) error
+	UpdateTenantStatus(ctx context.Context, tenantID uint64, status model.TenantStatus) error
+	DeleteTenant(ctx context.Context, tenantID uint64) error
+}
+
+// Workbencher stops the workbenches of the tenants that are suspended or
+// deleted.
+type Workbencher interface {
+	StopWorkbenchs(ctx context.Context, tenantID uint64) error
 }
 
 type TenantService struct {
-	store TenantStore
-	conf  config.Config
+	store     TenantStore
+	workbench Workbencher
+	conf      config.Config
 }
 
-func NewTenantService(store TenantStore, conf config.Config) *TenantService {
-	return &TenantService{store: store, conf: conf}
+func NewTenantService(store TenantStore, workbench Workbencher, conf config.Config) *TenantService {
+	return &TenantService{store: store, workbench: workbench, conf: conf}
 }
 
 func (s *TenantService) CreateTenant(ctx context.Context, tenantID uint64, name string) error {
@@ -31,5 +49,129 @@ func (s *TenantService) CreateTenant(ctx context.Context, tenantID uint64, name
 }
 
 func (s *TenantService) GetTenant(ctx context.Context, tenantID uint64) (*model.Tenant, error) {
-	return s.store.GetTenant(ctx, tenantID)
+	tenant, err := s.store.GetTenant(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	s.fillSettings(tenant)
+	return tenant, nil
+}
+
+func (s *TenantService) ListTenants(ctx context.Context) ([]*model.Tenant, error) {
+	tenants, err := s.store.ListTenants(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query tenants: %w", err)
+	}
+	for _, t := range tenants {
+		s.fillSettings(t)
+	}
+	return tenants, nil
+}
+
+func (s *TenantService) UpdateTenant(ctx context.Context, req UpdateTenantReq) error {
+	tenant, err := s.GetTenant(ctx, req.ID)
+	if err != nil {
+		return fmt.Errorf("unable to get tenant %v: %w", req.ID, err)
+	}
+
+	settings := req.Settings.Apply(*tenant.Settings)
+	if req.SCIMToken != "" {
+		settings.SCIM.TokenHash = model.HashSCIMToken(req.SCIMToken)
+	}
+	if settings.SCIM.Enabled && settings.SCIM.TokenHash == "" {
+		return fmt.Errorf("a SCIM token is required to enable SCIM: %w", &service.InvalidParametersErr{})
+	}
+
+	tenant.Name = req.Name
+	tenant.Settings = &settings
+	if err := s.store.UpdateTenant(ctx, tenant); err != nil {
+		return fmt.Errorf("unable to update tenant %v: %w", req.ID, err)
+	}
+	return nil
+}
+
+// SuspendTenant prevents the users of the tenant from logging in and stops
+// its workbenches.
+func (s *TenantService) SuspendTenant(ctx context.Context, tenantID uint64) error {
+	if err := s.checkNotTechnical(tenantID); err != nil {
+		return err
+	}
+
+	if err := s.store.UpdateTenantStatus(ctx, tenantID, model.TenantSuspended); err != nil {
+		return fmt.Errorf("unable to suspend tenant %v: %w", tenantID, err)
+	}
+
+	if err := s.workbench.StopWorkbenchs(ctx, tenantID); err != nil {
+		return fmt.Errorf("unable to stop the workbenchs of tenant %v: %w", tenantID, err)
+	}
+	return nil
+}
+
+func (s *TenantService) ResumeTenant(ctx context.Context, tenantID uint64) error {
+	if err := s.store.UpdateTenantStatus(ctx, tenantID, model.TenantActive); err != nil {
+		return fmt.Errorf("unable to resume tenant %v: %w", tenantID, err)
+	}
+	return nil
+}
+
+// DeleteTenant stops the workbenches of the tenant, then deletes the tenant
+// along with its workspaces, workbenches, app instances and users.
+func (s *TenantService) DeleteTenant(ctx context.Context, tenantID uint64) error {
+	if err := s.checkNotTechnical(tenantID); err != nil {
+		return err
+	}
+
+	if _, err := s.store.GetTenant(ctx, tenantID); err != nil {
+		return fmt.Errorf("unable to get tenant %v: %w", tenantID, err)
+	}
+
+	if err := s.workbench.StopWorkbenchs(ctx, tenantID); err != nil {
+		return fmt.Errorf("unable to stop the workbenchs of tenant %v: %w", tenantID, err)
+	}
+
+	if err := s.store.DeleteTenant(ctx, tenantID); err != nil {
+		return fmt.Errorf("unable to delete tenant %v: %w", tenantID, err)
+	}
+	return nil
+}
+
+func (s *TenantService) checkNotTechnical(tenantID uint64) error {
+	if tenantID == model.TechnicalTenantID || tenantID == s.conf.Daemon.TenantID {
+		return fmt.Errorf("technical tenant %v cannot be suspended or deleted: %w", tenantID, &service.InvalidParametersErr{})
+	}
+	return nil
+}
+
+// fillSettings sets the settings of a tenant whose settings were never
+// stored from its configuration.
+func (s *TenantService) fillSettings(tenant *model.Tenant) {
+	if tenant.Settings != nil {
+		return
+	}
+
+	conf := s.conf.Tenants[tenant.ID]
+	settings := &model.TenantSettings{
+		IPWhitelist: model.IPWhitelistSettings{
+			Enabled:     conf.Enabled && conf.IPWhitelist.Enabled,
+			Subnetworks: conf.IPWhitelist.Subnetworks,
+		},
+		Mailing: model.MailingSettings{
+			FromEmail: conf.Mailing.Sender.FromEmail,
+			FromName:  conf.Mailing.Sender.FromName,
+		},
+		SCIM: model.SCIMSettings{
+			Enabled: conf.SCIM.Enabled,
+			Source:  conf.SCIM.Source,
+		},
+		Notifications: model.NotificationsSettings{
+			RetentionDays: conf.Notifications.RetentionDays,
+		},
+		Registries: model.RegistriesSettings{
+			Allowed: conf.Registries.Allowed,
+		},
+	}
+	if token := conf.SCIM.Token.PlainText(); token != "" {
+		settings.SCIM.TokenHash = model.HashSCIMToken(token)
+	}
+	tenant.Settings = settings
 }
@@ -3,10 +3,14 @@ package postgres
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/jmoiron/sqlx"
 
+	"github.com/CHORUS-TRE/chorus-backend/internal/utils/database"
+	"github.com/CHORUS-TRE/chorus-backend/internal/utils/uuid"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/common/storage"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/tenant/model"
 )
 
@@ -19,14 +23,29 @@ func NewTenantStorage(db *sqlx.DB) *TenantStorage {
 }
 
 func (s *TenantStorage) GetTenant(ctx context.Context, tenantID uint64) (*model.Tenant, error) {
-	const q = `SELECT * FROM tenants where id = $1`
+	const q = `SELECT id, name, status, settings, tokensrevokedat, createdat, updatedat FROM tenants where id = $1`
 	t := &model.Tenant{}
-	if err := s.db.Get(t, q, tenantID); err != nil {
+	if err := s.db.GetContext(ctx, t, q, tenantID); err != nil {
 		return nil, fmt.Errorf("unable to get tenant: %w", err)
 	}
 	return t, nil
 }
 
+// ListTenants queries all the tenants that are not deleted.
+func (s *TenantStorage) ListTenants(ctx context.Context) ([]*model.Tenant, error) {
+	const q = `
+SELECT id, name, status, settings, tokensrevokedat, createdat, updatedat
+FROM tenants
+WHERE status != $1
+ORDER BY id;
+	`
+	var tenants []*model.Tenant
+	if err := s.db.SelectContext(ctx, &tenants, q, model.TenantDeleted.String()); err != nil {
+		return nil, fmt.Errorf("unable to list tenants: %w", err)
+	}
+	return tenants, nil
+}
+
 func (s *TenantStorage) CreateTenant(ctx context.Context, tenantID uint64, name string) error {
 	ins := `
 		INSERT INTO tenants(id, name, createdat, updatedat) VALUES($1, $2, $3, $3);
@@ -38,3 +57,95 @@ func (s *TenantStorage) CreateTenant(ctx context.Context, tenantID uint64, name
 
 	return nil
 }
+
+// UpdateTenant updates the name and the settings of a tenant that is not
+// deleted.
+func (s *TenantStorage) UpdateTenant(ctx context.Context, tenant *model.Tenant) error {
+	const q = `
+UPDATE tenants
+SET name = $2, settings = $3, updatedat = NOW()
+WHERE id = $1 AND status != $4;
+	`
+	rows, err := s.db.ExecContext(ctx, q, tenant.ID, tenant.Name, tenant.Settings, model.TenantDeleted.String())
+	if err != nil {
+		return fmt.Errorf("unable to update tenant: %w", err)
+	}
+	return checkAffected(rows.RowsAffected())
+}
+
+// UpdateTenantStatus sets the status of a tenant that is not deleted.
+// Suspending a tenant revokes the tokens issued to its users. The revocation
+// time is truncated to the second, the precision of the token issue times.
+func (s *TenantStorage) UpdateTenantStatus(ctx context.Context, tenantID uint64, status model.TenantStatus) error {
+	const q = `
+UPDATE tenants
+SET status = $2, updatedat = NOW(),
+	tokensrevokedat = CASE WHEN $2::TEXT = $4 THEN date_trunc('second', NOW()) ELSE tokensrevokedat END
+WHERE id = $1 AND status != $3;
+	`
+	rows, err := s.db.ExecContext(ctx, q, tenantID, status.String(), model.TenantDeleted.String(), model.TenantSuspended.String())
+	if err != nil {
+		return fmt.Errorf("unable to update tenant status: %w", err)
+	}
+	return checkAffected(rows.RowsAffected())
+}
+
+// DeleteTenant marks the tenant as deleted and soft-deletes its app instances,
+// workbenches, workspaces and users. The workspace memberships are removed.
+func (s *TenantStorage) DeleteTenant(ctx context.Context, tenantID uint64) error {
+	const deleteTenantQuery = `
+UPDATE tenants SET status = $2, updatedat = NOW() WHERE id = $1 AND status != $2;
+	`
+	// The suffix frees the unique names for the tenants that may be created
+	// with the same ID later on.
+	cascadeQueries := []string{
+		`UPDATE app_instances SET status = 'deleted', updatedat = NOW(), deletedat = NOW()
+		 WHERE tenantid = $1 AND status != 'deleted';`,
+		`UPDATE workbenchs SET status = 'deleted', name = concat(name, $2::TEXT), updatedat = NOW(), deletedat = NOW()
+		 WHERE tenantid = $1 AND status != 'deleted';`,
+		`DELETE FROM workspace_members WHERE tenantid = $1;`,
+		`UPDATE workspaces SET status = 'deleted', name = concat(name, $2::TEXT), updatedat = NOW(), deletedat = NOW()
+		 WHERE tenantid = $1 AND status != 'deleted';`,
+		`UPDATE users SET status = 'deleted', username = concat(username, $2::TEXT), updatedat = NOW()
+		 WHERE tenantid = $1 AND status != 'deleted';`,
+	}
+
+	tx, err := s.db.Beginx()
+	if err != nil {
+		return err
+	}
+
+	rows, err := tx.ExecContext(ctx, deleteTenantQuery, tenantID, model.TenantDeleted.String())
+	if err != nil {
+		return storage.Rollback(tx, fmt.Errorf("unable to delete tenant: %w", err))
+	}
+	if err := checkAffected(rows.RowsAffected()); err != nil {
+		if err == database.ErrNoRowsUpdated {
+			err = database.ErrNoRowsDeleted
+		}
+		return storage.Rollback(tx, err)
+	}
+
+	suffix := "-" + uuid.Next()
+	for _, q := range cascadeQueries {
+		args := []interface{}{tenantID}
+		if strings.Contains(q, "$2") {
+			args = append(args, suffix)
+		}
+		if _, err := tx.ExecContext(ctx, q, args...); err != nil {
+			return storage.Rollback(tx, fmt.Errorf("unable to delete tenant resources: %w", err))
+		}
+	}
+
+	return tx.Commit()
+}
+
+func checkAffected(affected int64, err error) error {
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return database.ErrNoRowsUpdated
+	}
+	return nil
+}
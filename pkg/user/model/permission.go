@@ -0,0 +1,110 @@
+package model
+
+import "fmt"
+
+// Permission grants access to a set of operations. Permissions are granted to
+// the users through their roles.
+type Permission string
+
+const (
+	PermissionUsersSelf  Permission = "users:self"
+	PermissionUsersRead  Permission = "users:read"
+	PermissionUsersWrite Permission = "users:write"
+	PermissionRolesRead  Permission = "roles:read"
+	PermissionRolesWrite Permission = "roles:write"
+
+	PermissionWorkspacesRead    Permission = "workspaces:read"
+	PermissionWorkspacesWrite   Permission = "workspaces:write"
+	PermissionWorkbenchesRead   Permission = "workbenches:read"
+	PermissionWorkbenchesWrite  Permission = "workbenches:write"
+	PermissionAppsRead          Permission = "apps:read"
+	PermissionAppsWrite         Permission = "apps:write"
+	PermissionAppsResolve       Permission = "apps:resolve"
+	PermissionAppInstancesRead  Permission = "app-instances:read"
+	PermissionAppInstancesWrite Permission = "app-instances:write"
+
+	PermissionNotificationsRead     Permission = "notifications:read"
+	PermissionNotificationsWrite    Permission = "notifications:write"
+	PermissionNotificationsAnnounce Permission = "notifications:announce"
+
+	PermissionQuotasRead  Permission = "quotas:read"
+	PermissionQuotasWrite Permission = "quotas:write"
+
+	PermissionWebhooksRead  Permission = "webhooks:read"
+	PermissionWebhooksWrite Permission = "webhooks:write"
+
+	PermissionTenantsInitialize Permission = "tenants:initialize"
+	PermissionTenantsRead       Permission = "tenants:read"
+	PermissionTenantsWrite      Permission = "tenants:write"
+)
+
+// Permissions lists all the known permissions, with their description.
+var Permissions = map[Permission]string{
+	PermissionUsersSelf:  "Read and update one's own user",
+	PermissionUsersRead:  "List and read the users of the tenant",
+	PermissionUsersWrite: "Update, delete and reset the password of the users of the tenant",
+	PermissionRolesRead:  "List and read the roles of the tenant",
+	PermissionRolesWrite: "Create, update and delete the roles of the tenant",
+
+	PermissionWorkspacesRead:    "List and read workspaces",
+	PermissionWorkspacesWrite:   "Create, update and delete workspaces",
+	PermissionWorkbenchesRead:   "List and read workbenches",
+	PermissionWorkbenchesWrite:  "Create, update and delete workbenches",
+	PermissionAppsRead:          "List and read apps",
+	PermissionAppsWrite:         "Create, update and delete apps",
+	PermissionAppsResolve:       "Resolve the image tags of the apps again into new apps",
+	PermissionAppInstancesRead:  "List and read app instances",
+	PermissionAppInstancesWrite: "Create, update and delete app instances",
+
+	PermissionNotificationsRead:     "List and count one's notifications",
+	PermissionNotificationsWrite:    "Mark one's notifications as read and update one's notification preferences",
+	PermissionNotificationsAnnounce: "Broadcast announcements to the users of the tenant",
+
+	PermissionQuotasRead:  "List the quotas of the tenant and read the resource usage",
+	PermissionQuotasWrite: "Set and delete the quotas of the tenant",
+
+	PermissionWebhooksRead:  "List the webhooks of the tenant and read their delivery log",
+	PermissionWebhooksWrite: "Create, update and delete the webhooks of the tenant and replay their deliveries",
+
+	PermissionTenantsInitialize: "Initialize new tenants",
+	PermissionTenantsRead:       "List and read all the tenants",
+	PermissionTenantsWrite:      "Update, suspend and delete all the tenants",
+}
+
+// PlatformPermissions are only granted to the technical users of the
+// platform. They reach beyond a single tenant and cannot be part of the
+// custom roles of a tenant.
+var PlatformPermissions = map[Permission]struct{}{
+	PermissionTenantsInitialize: {},
+	PermissionTenantsRead:       {},
+	PermissionTenantsWrite:      {},
+}
+
+func (p Permission) String() string {
+	return string(p)
+}
+
+// IsPlatform returns whether the permission is a platform permission.
+func (p Permission) IsPlatform() bool {
+	_, ok := PlatformPermissions[p]
+	return ok
+}
+
+func ToPermission(permission string) (Permission, error) {
+	if _, ok := Permissions[Permission(permission)]; !ok {
+		return "", fmt.Errorf("unexpected permission %v", permission)
+	}
+	return Permission(permission), nil
+}
+
+func ToPermissions(permissions []string) ([]Permission, error) {
+	var res []Permission
+	for _, permission := range permissions {
+		p, err := ToPermission(permission)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, p)
+	}
+	return res, nil
+}
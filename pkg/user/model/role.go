@@ -1,15 +1,34 @@
 package model
 
-import "fmt"
+import (
+	"fmt"
+	"regexp"
+	"time"
+)
 
-// Role maps an entry in the 'roles' database table.
+// Role maps an entry in the 'roles' database table. Built-in roles are shared
+// by all the tenants and have no TenantID.
 type Role struct {
-	ID   uint64
-	Name string
+	ID       uint64
+	TenantID *uint64
+
+	Name        string
+	Description string
+	Permissions []Permission // Permissions is not a column of the 'roles' table.
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// IsBuiltIn returns whether the role is a built-in role, which cannot be
+// modified nor deleted.
+func (r *Role) IsBuiltIn() bool {
+	return r.TenantID == nil
 }
 
 type UserRole string
 
+// The built-in roles, created by the migrations.
 const (
 	RoleAdmin          UserRole = "admin"
 	RoleAuthenticated  UserRole = "authenticated"
@@ -18,23 +37,23 @@ const (
 	RoleFileDownloader UserRole = "filedownloader"
 )
 
-var ValidRoles = map[UserRole]struct{}{RoleAdmin: {}, RoleAuthenticated: {}}
+// ReservedRoles are the roles of the technical users, which cannot be
+// assigned through the API.
+var ReservedRoles = map[UserRole]struct{}{RoleChorus: {}}
+
+var roleNameRegexp = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,62}$`)
 
 func (r UserRole) String() string {
 	return string(r)
 }
 
+// ToUserRole checks that role is a well-formed role name. Whether the role
+// exists is up to the user service.
 func ToUserRole(role string) (UserRole, error) {
-	switch role {
-	case RoleAdmin.String():
-		return RoleAdmin, nil
-	case RoleAuthenticated.String():
-		return RoleAuthenticated, nil
-	case RoleChorus.String():
-		return RoleChorus, nil
-	default:
+	if !roleNameRegexp.MatchString(role) {
 		return "", fmt.Errorf("unexpected role %v", role)
 	}
+	return UserRole(role), nil
 }
 
 func ToUserRoles(roles []string) ([]UserRole, error) {
@@ -12,3 +12,39 @@ func TestRoleType_String(t *testing.T) {
 	assert.Equal(t, "authenticated", RoleAuthenticated.String())
 	assert.Equal(t, "chorus", RoleChorus.String())
 }
+
+func TestToUserRole(t *testing.T) {
+	tests := []struct {
+		role         string
+		expectsError bool
+	}{
+		{role: "admin"},
+		{role: "fileuploader"},
+		{role: "data-manager_2"},
+		{role: "", expectsError: true},
+		{role: "Admin", expectsError: true},
+		{role: "-admin", expectsError: true},
+		{role: "admin; drop", expectsError: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.role, func(t *testing.T) {
+			role, err := ToUserRole(test.role)
+			if test.expectsError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, test.role, role.String())
+			}
+		})
+	}
+}
+
+func TestToPermission(t *testing.T) {
+	p, err := ToPermission("users:read")
+	assert.NoError(t, err)
+	assert.Equal(t, PermissionUsersRead, p)
+
+	_, err = ToPermission("users:admin")
+	assert.Error(t, err)
+}
@@ -2,6 +2,7 @@ package model
 
 import (
 	"errors"
+	"strconv"
 	"time"
 )
 
@@ -53,3 +54,38 @@ func ToUserStatus(status string) (UserStatus, error) {
 		return "", errors.New("unexpected UserStatus: " + status)
 	}
 }
+
+// UserFilter restricts the users returned by a listing. Empty fields are
+// not filtered on. Username is matched case insensitively.
+type UserFilter struct {
+	Status   UserStatus
+	Role     UserRole
+	Source   string
+	Search   string
+	Username string
+}
+
+var UserSortTypeToString = map[string]string{
+	"ID":        "id",
+	"FIRSTNAME": "firstname",
+	"LASTNAME":  "lastname",
+	"USERNAME":  "username",
+	"CREATEDAT": "createdat",
+}
+
+// SortValue returns the value of the column matching sortType, used as a
+// pagination keyset.
+func (u *User) SortValue(sortType string) string {
+	switch sortType {
+	case "FIRSTNAME":
+		return u.FirstName
+	case "LASTNAME":
+		return u.LastName
+	case "USERNAME":
+		return u.Username
+	case "CREATEDAT":
+		return u.CreatedAt.Format(time.RFC3339Nano)
+	default:
+		return strconv.FormatUint(u.ID, 10)
+	}
+}
@@ -5,22 +5,20 @@ import (
 
 	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
 	"github.com/CHORUS-TRE/chorus-backend/internal/utils/cache"
+	"github.com/CHORUS-TRE/chorus-backend/internal/utils/pagination"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/user/model"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/user/service"
-
-	"github.com/coocood/freecache"
 )
 
 const (
-	userCacheSize          = 100 * 1024 * 1024 // Max 100MiB stored in memory
 	defaultCacheExpiration = 5
 	longCacheExpiration    = 60
 )
 
-func UserCaching(log *logger.ContextLogger) func(service.Userer) *Caching {
+func UserCaching(log *logger.ContextLogger, backend cache.Backend) func(service.Userer) *Caching {
 	return func(next service.Userer) *Caching {
 		return &Caching{
-			cache: cache.NewCache(freecache.NewCache(userCacheSize), log),
+			cache: cache.NewCache(backend, log),
 			next:  next,
 		}
 	}
@@ -31,32 +29,15 @@ type Caching struct {
 	next  service.Userer
 }
 
-func (c *Caching) CreateRole(ctx context.Context, role string) error {
-	return c.next.CreateRole(ctx, role)
-}
-
-func (c *Caching) GetRoles(ctx context.Context) (reply []*model.Role, err error) {
-	entry := c.cache.NewEntry()
-	reply = []*model.Role{}
-
-	if ok := entry.Get(ctx, &reply); !ok {
-		reply, err = c.next.GetRoles(ctx)
-		if err == nil {
-			entry.Set(ctx, longCacheExpiration, reply)
-		}
-	}
-
-	return
-}
-
-func (c *Caching) GetUsers(ctx context.Context, req service.GetUsersReq) (reply []*model.User, err error) {
-	entry := c.cache.NewEntry(cache.WithInterface(req))
+func (c *Caching) GetUsers(ctx context.Context, req service.GetUsersReq) (reply []*model.User, cursor *pagination.ResponseCursor[pagination.KeysetCursor], count uint64, err error) {
+	entry := c.cache.NewEntry(cache.WithInterface(req)).WithTags(cache.TenantTag(req.TenantID))
 	reply = []*model.User{}
+	cursor = &pagination.ResponseCursor[pagination.KeysetCursor]{}
 
-	if ok := entry.Get(ctx, &reply); !ok {
-		reply, err = c.next.GetUsers(ctx, req)
+	if ok := entry.Get(ctx, &reply, &cursor, &count); !ok {
+		reply, cursor, count, err = c.next.GetUsers(ctx, req)
 		if err == nil {
-			entry.Set(ctx, defaultCacheExpiration, reply)
+			entry.Set(ctx, defaultCacheExpiration, reply, cursor, count)
 		}
 	}
 
@@ -64,7 +45,7 @@ func (c *Caching) GetUsers(ctx context.Context, req service.GetUsersReq) (reply
 }
 
 func (c *Caching) GetUser(ctx context.Context, req service.GetUserReq) (reply *model.User, err error) {
-	entry := c.cache.NewEntry(cache.WithInterface(req))
+	entry := c.cache.NewEntry(cache.WithInterface(req)).WithTags(cache.TenantTag(req.TenantID))
 	reply = &model.User{}
 
 	if ok := entry.Get(ctx, &reply); !ok {
@@ -78,31 +59,45 @@ func (c *Caching) GetUser(ctx context.Context, req service.GetUserReq) (reply *m
 }
 
 func (c *Caching) SoftDeleteUser(ctx context.Context, req service.DeleteUserReq) error {
-	return c.next.SoftDeleteUser(ctx, req)
+	err := c.next.SoftDeleteUser(ctx, req)
+	c.cache.Invalidate(ctx, cache.TenantTag(req.TenantID))
+	return err
 }
 
 func (c *Caching) UpdateUser(ctx context.Context, req service.UpdateUserReq) error {
-	return c.next.UpdateUser(ctx, req)
+	err := c.next.UpdateUser(ctx, req)
+	c.cache.Invalidate(ctx, cache.TenantTag(req.TenantID))
+	return err
 }
 
 func (c *Caching) CreateUser(ctx context.Context, req service.CreateUserReq) (uint64, error) {
-	return c.next.CreateUser(ctx, req)
+	id, err := c.next.CreateUser(ctx, req)
+	c.cache.Invalidate(ctx, cache.TenantTag(req.TenantID))
+	return id, err
 }
 
 func (c *Caching) UpdateUserPassword(ctx context.Context, req service.UpdateUserPasswordReq) error {
-	return c.next.UpdateUserPassword(ctx, req)
+	err := c.next.UpdateUserPassword(ctx, req)
+	c.cache.Invalidate(ctx, cache.TenantTag(req.TenantID))
+	return err
 }
 
 func (c *Caching) EnableUserTotp(ctx context.Context, req service.EnableTotpReq) error {
-	return c.next.EnableUserTotp(ctx, req)
+	err := c.next.EnableUserTotp(ctx, req)
+	c.cache.Invalidate(ctx, cache.TenantTag(req.TenantID))
+	return err
 }
 
 func (c *Caching) ResetUserTotp(ctx context.Context, req service.ResetTotpReq) (string, []string, error) {
-	return c.next.ResetUserTotp(ctx, req)
+	secret, codes, err := c.next.ResetUserTotp(ctx, req)
+	c.cache.Invalidate(ctx, cache.TenantTag(req.TenantID))
+	return secret, codes, err
 }
 
 func (c *Caching) ResetUserPassword(ctx context.Context, req service.ResetUserPasswordReq) error {
-	return c.next.ResetUserPassword(ctx, req)
+	err := c.next.ResetUserPassword(ctx, req)
+	c.cache.Invalidate(ctx, cache.TenantTag(req.TenantID))
+	return err
 }
 
 func (c *Caching) GetTotpRecoveryCodes(ctx context.Context, tenantID, userID uint64) ([]*model.TotpRecoveryCode, error) {
@@ -110,5 +105,49 @@ func (c *Caching) GetTotpRecoveryCodes(ctx context.Context, tenantID, userID uin
 }
 
 func (c *Caching) DeleteTotpRecoveryCode(ctx context.Context, req *service.DeleteTotpRecoveryCodeReq) error {
-	return c.next.DeleteTotpRecoveryCode(ctx, req)
+	err := c.next.DeleteTotpRecoveryCode(ctx, req)
+	c.cache.Invalidate(ctx, cache.TenantTag(req.TenantID))
+	return err
+}
+
+func (c *Caching) ListRoles(ctx context.Context, tenantID uint64) (reply []*model.Role, err error) {
+	return c.next.ListRoles(ctx, tenantID)
+}
+
+func (c *Caching) GetRole(ctx context.Context, tenantID, roleID uint64) (*model.Role, error) {
+	return c.next.GetRole(ctx, tenantID, roleID)
+}
+
+func (c *Caching) CreateRole(ctx context.Context, req service.CreateRoleReq) (uint64, error) {
+	id, err := c.next.CreateRole(ctx, req)
+	c.cache.Invalidate(ctx, cache.TenantTag(req.TenantID))
+	return id, err
+}
+
+func (c *Caching) UpdateRole(ctx context.Context, req service.UpdateRoleReq) error {
+	err := c.next.UpdateRole(ctx, req)
+	c.cache.Invalidate(ctx, cache.TenantTag(req.TenantID))
+	return err
+}
+
+func (c *Caching) DeleteRole(ctx context.Context, tenantID, roleID uint64) error {
+	err := c.next.DeleteRole(ctx, tenantID, roleID)
+	c.cache.Invalidate(ctx, cache.TenantTag(tenantID))
+	return err
+}
+
+// GetRolesPermissions is called on every authorized request, the changes to
+// the roles are therefore applied after at most defaultCacheExpiration.
+func (c *Caching) GetRolesPermissions(ctx context.Context, tenantID uint64, roles []string) (reply []model.Permission, err error) {
+	entry := c.cache.NewEntry(cache.WithUint64(tenantID), cache.WithInterface(roles)).WithTags(cache.TenantTag(tenantID))
+	reply = []model.Permission{}
+
+	if ok := entry.Get(ctx, &reply); !ok {
+		reply, err = c.next.GetRolesPermissions(ctx, tenantID, roles)
+		if err == nil {
+			entry.Set(ctx, defaultCacheExpiration, reply)
+		}
+	}
+
+	return
 }
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
+	"github.com/CHORUS-TRE/chorus-backend/internal/utils/pagination"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/user/model"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/user/service"
 
@@ -26,23 +27,24 @@ func Logging(logger *logger.ContextLogger) func(service.Userer) service.Userer {
 	}
 }
 
-func (c userServiceLogging) GetUsers(ctx context.Context, req service.GetUsersReq) ([]*model.User, error) {
+func (c userServiceLogging) GetUsers(ctx context.Context, req service.GetUsersReq) ([]*model.User, *pagination.ResponseCursor[pagination.KeysetCursor], uint64, error) {
 	now := time.Now()
 
-	res, err := c.next.GetUsers(ctx, req)
+	res, cursor, count, err := c.next.GetUsers(ctx, req)
 	if err != nil {
 		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
 			zap.Error(err),
 			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
 		)
-		return res, fmt.Errorf("unable to get users: %w", err)
+		return res, cursor, count, fmt.Errorf("unable to get users: %w", err)
 	}
 
 	c.logger.Info(ctx, "request completed",
 		zap.Int("num_users", len(res)),
+		logger.WithTotalItemsField(count),
 		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
 	)
-	return res, nil
+	return res, cursor, count, nil
 }
 
 func (c userServiceLogging) GetUser(ctx context.Context, req service.GetUserReq) (*model.User, error) {
@@ -124,32 +126,116 @@ func (c userServiceLogging) CreateUser(ctx context.Context, req service.CreateUs
 	return userId, nil
 }
 
-func (c userServiceLogging) CreateRole(ctx context.Context, role string) error {
-	c.logger.Debug(ctx, "request started", zap.String("role", role))
+func (c userServiceLogging) ListRoles(ctx context.Context, tenantID uint64) ([]*model.Role, error) {
+	c.logger.Debug(ctx, "request started")
 
 	now := time.Now()
 
-	err := c.next.CreateRole(ctx, role)
+	res, err := c.next.ListRoles(ctx, tenantID)
 	if err != nil {
 		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
 			zap.Error(err),
 			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
 		)
-		return err
+		return nil, err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		logger.WithCountField(len(res)),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, nil
+}
+
+func (c userServiceLogging) GetRole(ctx context.Context, tenantID, roleID uint64) (*model.Role, error) {
+	c.logger.Debug(ctx, "request started", zap.Uint64("role_id", roleID))
+
+	now := time.Now()
+
+	res, err := c.next.GetRole(ctx, tenantID, roleID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, err
 	}
 
 	c.logger.Debug(ctx, "request completed",
 		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
 	)
+	return res, nil
+}
+
+func (c userServiceLogging) CreateRole(ctx context.Context, req service.CreateRoleReq) (uint64, error) {
+	c.logger.Debug(ctx, "request started", zap.String("role", req.Role.Name))
+
+	now := time.Now()
+
+	roleID, err := c.next.CreateRole(ctx, req)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return 0, err
+	}
+
+	c.logger.Info(ctx, "request completed",
+		zap.Uint64("role_id", roleID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return roleID, nil
+}
+
+func (c userServiceLogging) UpdateRole(ctx context.Context, req service.UpdateRoleReq) error {
+	c.logger.Debug(ctx, "request started", zap.Uint64("role_id", req.Role.ID))
+
+	now := time.Now()
+
+	err := c.next.UpdateRole(ctx, req)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return err
+	}
+
+	c.logger.Info(ctx, "request completed",
+		zap.Uint64("role_id", req.Role.ID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
 	return nil
 }
 
-func (c userServiceLogging) GetRoles(ctx context.Context) ([]*model.Role, error) {
-	c.logger.Debug(ctx, "request started")
+func (c userServiceLogging) DeleteRole(ctx context.Context, tenantID, roleID uint64) error {
+	c.logger.Debug(ctx, "request started", zap.Uint64("role_id", roleID))
+
+	now := time.Now()
+
+	err := c.next.DeleteRole(ctx, tenantID, roleID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return err
+	}
+
+	c.logger.Info(ctx, "request completed",
+		zap.Uint64("role_id", roleID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return nil
+}
+
+func (c userServiceLogging) GetRolesPermissions(ctx context.Context, tenantID uint64, roles []string) ([]model.Permission, error) {
+	c.logger.Debug(ctx, "request started", zap.Strings("roles", roles))
 
 	now := time.Now()
 
-	res, err := c.next.GetRoles(ctx)
+	res, err := c.next.GetRolesPermissions(ctx, tenantID, roles)
 	if err != nil {
 		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
 			zap.Error(err),
@@ -159,7 +245,6 @@ func (c userServiceLogging) GetRoles(ctx context.Context) ([]*model.Role, error)
 	}
 
 	c.logger.Debug(ctx, "request completed",
-		zap.Any("result", res),
 		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
 	)
 	return res, nil
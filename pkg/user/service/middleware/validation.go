@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/CHORUS-TRE/chorus-backend/internal/utils/pagination"
+	common_service "github.com/CHORUS-TRE/chorus-backend/pkg/common/service"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/user/model"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/user/service"
 
@@ -24,20 +26,12 @@ func Validation(validate *val.Validate) func(service.Userer) service.Userer {
 	}
 }
 
-func (v validation) CreateRole(ctx context.Context, role string) error {
-	if !contains([]string{"admin", "authenticated", "chorus"}, role) {
-		return fmt.Errorf("invalid role '%v', should be on of: %v", role, []string{"admin", "authenticated", "chorus"})
-	}
-	return v.next.CreateRole(ctx, role)
-}
-
-func (v validation) GetRoles(ctx context.Context) ([]*model.Role, error) {
-	return v.next.GetRoles(ctx)
-}
-
-func (v validation) GetUsers(ctx context.Context, req service.GetUsersReq) ([]*model.User, error) {
+func (v validation) GetUsers(ctx context.Context, req service.GetUsersReq) ([]*model.User, *pagination.ResponseCursor[pagination.KeysetCursor], uint64, error) {
 	if err := v.validate.Struct(req); err != nil {
-		return nil, err
+		return nil, nil, 0, err
+	}
+	if req.Cursor != nil && req.Cursor.PageSize > service.MaxUsersPageSize {
+		return nil, nil, 0, fmt.Errorf("page size must not exceed %v: %w", service.MaxUsersPageSize, &common_service.InvalidParametersErr{})
 	}
 	return v.next.GetUsers(ctx, req)
 }
@@ -106,11 +100,53 @@ func (v validation) DeleteTotpRecoveryCode(ctx context.Context, req *service.Del
 	return v.next.DeleteTotpRecoveryCode(ctx, req)
 }
 
-func contains(list []string, str string) bool {
-	for _, v := range list {
-		if v == str {
-			return true
+func (v validation) ListRoles(ctx context.Context, tenantID uint64) ([]*model.Role, error) {
+	return v.next.ListRoles(ctx, tenantID)
+}
+
+func (v validation) GetRole(ctx context.Context, tenantID, roleID uint64) (*model.Role, error) {
+	return v.next.GetRole(ctx, tenantID, roleID)
+}
+
+func (v validation) CreateRole(ctx context.Context, req service.CreateRoleReq) (uint64, error) {
+	if err := v.validate.Struct(req); err != nil {
+		return 0, err
+	}
+	if err := validateRole(req.Role); err != nil {
+		return 0, err
+	}
+	return v.next.CreateRole(ctx, req)
+}
+
+func (v validation) UpdateRole(ctx context.Context, req service.UpdateRoleReq) error {
+	if err := v.validate.Struct(req); err != nil {
+		return err
+	}
+	if err := validateRole(req.Role); err != nil {
+		return err
+	}
+	return v.next.UpdateRole(ctx, req)
+}
+
+func (v validation) DeleteRole(ctx context.Context, tenantID, roleID uint64) error {
+	return v.next.DeleteRole(ctx, tenantID, roleID)
+}
+
+func (v validation) GetRolesPermissions(ctx context.Context, tenantID uint64, roles []string) ([]model.Permission, error) {
+	return v.next.GetRolesPermissions(ctx, tenantID, roles)
+}
+
+func validateRole(role *service.RoleReq) error {
+	if _, err := model.ToUserRole(role.Name); err != nil {
+		return fmt.Errorf("%v: %w", err.Error(), &common_service.InvalidParametersErr{})
+	}
+	for _, p := range role.Permissions {
+		if _, err := model.ToPermission(p.String()); err != nil {
+			return fmt.Errorf("%v: %w", err.Error(), &common_service.InvalidParametersErr{})
+		}
+		if p.IsPlatform() {
+			return fmt.Errorf("platform permission %v cannot be granted by a tenant role: %w", p, &common_service.InvalidParametersErr{})
 		}
 	}
-	return false
+	return nil
 }
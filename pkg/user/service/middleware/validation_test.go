@@ -0,0 +1,27 @@
+package middleware
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	common_service "github.com/CHORUS-TRE/chorus-backend/pkg/common/service"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/user/model"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/user/service"
+)
+
+func TestValidateRole(t *testing.T) {
+	var invalid *common_service.InvalidParametersErr
+
+	err := validateRole(&service.RoleReq{Name: "data-manager", Permissions: []model.Permission{model.PermissionUsersRead, model.PermissionWorkspacesWrite}})
+	require.NoError(t, err)
+
+	err = validateRole(&service.RoleReq{Name: "data-manager", Permissions: []model.Permission{"users:admin"}})
+	require.True(t, errors.As(err, &invalid))
+
+	for p := range model.PlatformPermissions {
+		err := validateRole(&service.RoleReq{Name: "data-manager", Permissions: []model.Permission{model.PermissionUsersRead, p}})
+		require.True(t, errors.As(err, &invalid), p)
+	}
+}
@@ -3,6 +3,8 @@ package service
 import (
 	"time"
 
+	"github.com/CHORUS-TRE/chorus-backend/internal/utils/pagination"
+	common "github.com/CHORUS-TRE/chorus-backend/pkg/common/model"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/user/model"
 )
 
@@ -40,8 +42,45 @@ type UserUpdateReq struct {
 	Roles []model.UserRole `validate:"min=1"`
 }
 
+// MaxUsersPageSize is the maximum number of users that can be requested in
+// a single page.
+const MaxUsersPageSize = 500
+
 type GetUsersReq struct {
 	TenantID uint64
+	Cursor   *pagination.RequestCursor[pagination.KeysetCursor]
+	Filter   UserFilter
+	Sort     Sort `validate:"required"`
+}
+
+type UserFilter struct {
+	Status   string `validate:"omitempty,oneof=active disabled deleted"`
+	Role     string `validate:"omitempty,safestring"`
+	Source   string `validate:"omitempty,safestring"`
+	Search   string `validate:"max=254,generalstring"`
+	Username string `validate:"max=254,generalstring"`
+}
+
+type Sort struct {
+	SortOrder string `validate:"required,oneof=DESC ASC"`
+	SortType  string `validate:"required,oneof=ID FIRSTNAME LASTNAME USERNAME CREATEDAT"`
+}
+
+func (s Sort) ToBusinessSort() common.Sort {
+	return common.Sort{
+		SortOrder: s.SortOrder,
+		SortType:  s.SortType,
+	}
+}
+
+func (f UserFilter) ToBusinessFilter() model.UserFilter {
+	return model.UserFilter{
+		Status:   model.UserStatus(f.Status),
+		Role:     model.UserRole(f.Role),
+		Source:   f.Source,
+		Search:   f.Search,
+		Username: f.Username,
+	}
 }
 
 type GetUserReq struct {
@@ -93,6 +132,24 @@ type DeleteTotpRecoveryCodeReq struct {
 	CodeID   uint64 `validate:"required,min=1"`
 }
 
+type RoleReq struct {
+	ID uint64
+
+	Name        string `validate:"required,max=63,safestring"`
+	Description string `validate:"max=1024,generalstring"`
+	Permissions []model.Permission
+}
+
+type CreateRoleReq struct {
+	TenantID uint64   `validate:"required"`
+	Role     *RoleReq `validate:"required"`
+}
+
+type UpdateRoleReq struct {
+	TenantID uint64   `validate:"required"`
+	Role     *RoleReq `validate:"required"`
+}
+
 func reqToUserBusiness(req *UserReq) *model.User {
 	return &model.User{
 		ID:              req.ID,
@@ -109,3 +166,13 @@ func reqToUserBusiness(req *UserReq) *model.User {
 		UpdatedAt:       req.UpdatedAt,
 	}
 }
+
+func reqToRoleBusiness(tenantID uint64, req *RoleReq) *model.Role {
+	return &model.Role{
+		ID:          req.ID,
+		TenantID:    &tenantID,
+		Name:        req.Name,
+		Description: req.Description,
+		Permissions: req.Permissions,
+	}
+}
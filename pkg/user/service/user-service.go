@@ -10,19 +10,25 @@ import (
 
 	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
 	"github.com/CHORUS-TRE/chorus-backend/internal/mailer"
+	"github.com/CHORUS-TRE/chorus-backend/internal/notification"
 	"github.com/CHORUS-TRE/chorus-backend/internal/utils"
 	"github.com/CHORUS-TRE/chorus-backend/internal/utils/crypto"
+	"github.com/CHORUS-TRE/chorus-backend/internal/utils/database"
+	"github.com/CHORUS-TRE/chorus-backend/internal/utils/pagination"
+	"github.com/CHORUS-TRE/chorus-backend/internal/utils/uuid"
+	"github.com/CHORUS-TRE/chorus-backend/internal/webhook"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/authentication/helper"
+	common "github.com/CHORUS-TRE/chorus-backend/pkg/common/model"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/common/service"
+	notification_model "github.com/CHORUS-TRE/chorus-backend/pkg/notification/model"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/user/model"
+	webhook_model "github.com/CHORUS-TRE/chorus-backend/pkg/webhook/model"
 )
 
 type Userer interface {
-	GetUsers(ctx context.Context, req GetUsersReq) ([]*model.User, error)
+	GetUsers(ctx context.Context, req GetUsersReq) ([]*model.User, *pagination.ResponseCursor[pagination.KeysetCursor], uint64, error)
 	GetUser(ctx context.Context, req GetUserReq) (*model.User, error)
 	CreateUser(ctx context.Context, req CreateUserReq) (uint64, error)
-	CreateRole(ctx context.Context, role string) error
-	GetRoles(ctx context.Context) ([]*model.Role, error)
 	SoftDeleteUser(ctx context.Context, req DeleteUserReq) error
 	UpdateUser(ctx context.Context, req UpdateUserReq) error
 	UpdateUserPassword(ctx context.Context, req UpdateUserPasswordReq) error
@@ -32,19 +38,31 @@ type Userer interface {
 
 	GetTotpRecoveryCodes(ctx context.Context, tenantID, userID uint64) ([]*model.TotpRecoveryCode, error)
 	DeleteTotpRecoveryCode(ctx context.Context, req *DeleteTotpRecoveryCodeReq) error
+
+	ListRoles(ctx context.Context, tenantID uint64) ([]*model.Role, error)
+	GetRole(ctx context.Context, tenantID, roleID uint64) (*model.Role, error)
+	CreateRole(ctx context.Context, req CreateRoleReq) (uint64, error)
+	UpdateRole(ctx context.Context, req UpdateRoleReq) error
+	DeleteRole(ctx context.Context, tenantID, roleID uint64) error
+	GetRolesPermissions(ctx context.Context, tenantID uint64, roles []string) ([]model.Permission, error)
 }
 
 type UserStore interface {
-	GetUsers(ctx context.Context, tenantID uint64) ([]*model.User, error)
+	GetUsers(ctx context.Context, tenantID uint64, filter model.UserFilter, sort common.Sort, cursor *pagination.RequestCursor[pagination.KeysetCursor]) ([]*model.User, *pagination.ResponseCursor[pagination.KeysetCursor], uint64, error)
 	GetUser(ctx context.Context, tenantID uint64, userID uint64) (*model.User, error)
 	CreateUser(ctx context.Context, tenantID uint64, user *model.User) (uint64, error)
-	CreateRole(ctx context.Context, role string) error
-	GetRoles(ctx context.Context) ([]*model.Role, error)
 	SoftDeleteUser(ctx context.Context, tenantID uint64, userID uint64) error
 	UpdateUser(ctx context.Context, tenantID uint64, user *model.User) error
 	GetTotpRecoveryCodes(ctx context.Context, tenantID, userID uint64) ([]*model.TotpRecoveryCode, error)
 	UpdateUserWithRecoveryCodes(ctx context.Context, tenantID uint64, user *model.User, totpRecoveryCodes []string) error
 	DeleteTotpRecoveryCode(ctx context.Context, tenantID, codeID uint64) error
+
+	ListRoles(ctx context.Context, tenantID uint64) ([]*model.Role, error)
+	GetRole(ctx context.Context, tenantID, roleID uint64) (*model.Role, error)
+	CreateRole(ctx context.Context, tenantID uint64, role *model.Role) (uint64, error)
+	UpdateRole(ctx context.Context, tenantID uint64, role *model.Role) error
+	DeleteRole(ctx context.Context, tenantID, roleID uint64) error
+	GetRolesPermissions(ctx context.Context, tenantID uint64, roles []string) ([]model.Permission, error)
 }
 
 type ErrUnauthorized struct{}
@@ -70,23 +88,27 @@ type UserService struct {
 	daemonEncryptionKey  *crypto.Secret
 	store                UserStore
 	mailer               mailer.Mailer
+	notifier             notification.Notifier
+	publisher            webhook.Publisher
 }
 
-func NewUserService(totpNumRecoveryCodes int, daemonEncryptionKey *crypto.Secret, store UserStore, mailer mailer.Mailer) *UserService {
+func NewUserService(totpNumRecoveryCodes int, daemonEncryptionKey *crypto.Secret, store UserStore, mailer mailer.Mailer, notifier notification.Notifier, publisher webhook.Publisher) *UserService {
 	return &UserService{
 		totpNumRecoveryCodes: totpNumRecoveryCodes,
 		daemonEncryptionKey:  daemonEncryptionKey,
 		store:                store,
 		mailer:               mailer,
+		notifier:             notifier,
+		publisher:            publisher,
 	}
 }
 
-func (u *UserService) GetUsers(ctx context.Context, req GetUsersReq) ([]*model.User, error) {
-	users, err := u.store.GetUsers(ctx, req.TenantID)
+func (u *UserService) GetUsers(ctx context.Context, req GetUsersReq) ([]*model.User, *pagination.ResponseCursor[pagination.KeysetCursor], uint64, error) {
+	users, cursor, count, err := u.store.GetUsers(ctx, req.TenantID, req.Filter.ToBusinessFilter(), req.Sort.ToBusinessSort(), req.Cursor)
 	if err != nil {
-		return nil, fmt.Errorf("unable to query users: %w", err)
+		return nil, nil, 0, fmt.Errorf("unable to query users: %w", err)
 	}
-	return users, nil
+	return users, cursor, count, nil
 }
 
 func (u *UserService) GetUser(ctx context.Context, req GetUserReq) (*model.User, error) {
@@ -128,6 +150,8 @@ func (u *UserService) UpdateUserPassword(ctx context.Context, req UpdateUserPass
 	if err != nil {
 		return fmt.Errorf("unable to update user %v: %w", req.UserID, err)
 	}
+
+	u.notifySecurityChange(ctx, req.TenantID, req.UserID, notification_model.NotificationTypePasswordChanged)
 	return nil
 
 }
@@ -153,9 +177,10 @@ func (u *UserService) UpdateUser(ctx context.Context, req UpdateUserReq) error {
 	user.LastName = req.User.LastName
 	user.Username = req.User.Username
 	user.Source = req.User.Source
+	disabled := user.Status != model.UserDisabled && req.User.Status == model.UserDisabled
 	user.Status = req.User.Status
 
-	if err = verifyRoles(req.User.Roles); err != nil {
+	if err = u.verifyRoles(ctx, req.TenantID, req.User.Roles); err != nil {
 		return fmt.Errorf("role verification failed: %w", err)
 	}
 	user.Roles = req.User.Roles
@@ -164,6 +189,13 @@ func (u *UserService) UpdateUser(ctx context.Context, req UpdateUserReq) error {
 		return fmt.Errorf("unable to update user %v: %w", req.User.ID, err)
 	}
 
+	if disabled {
+		webhook.Publish(ctx, u.publisher, webhook.NewEvent(req.TenantID, webhook_model.EventUserDisabled, webhook_model.EventData{
+			"userId":   user.ID,
+			"username": user.Username,
+		}))
+	}
+
 	return nil
 }
 
@@ -171,10 +203,16 @@ func (u *UserService) CreateUser(ctx context.Context, req CreateUserReq) (uint64
 
 	req.User.Roles = filterDuplicateRoles(req.User.Roles)
 
-	if err := verifyRoles(req.User.Roles); err != nil {
+	if err := u.verifyRoles(ctx, req.TenantID, req.User.Roles); err != nil {
 		return 0, err
 	}
 
+	// The users of an external source authenticate with their identity
+	// provider, they get no local password.
+	if req.User.Source != "internal" {
+		return u.createExternalUser(ctx, req)
+	}
+
 	if req.User.Password != "" {
 		return u.createUserWithPassword(ctx, req)
 	}
@@ -194,7 +232,7 @@ func (u *UserService) CreateUser(ctx context.Context, req CreateUserReq) (uint64
 
 	id, err := u.store.CreateUser(ctx, req.TenantID, user)
 	if err != nil {
-		return 0, fmt.Errorf("unable to create user %v: %w", user.Username, err)
+		return 0, fmt.Errorf("unable to create user %v: %w", user.Username, createUserErr(err))
 	}
 
 	go u.sendMailWithTempPassword("Please change your password", req.TenantID, user, password, mailer.TemporaryPasswordKey)
@@ -229,11 +267,32 @@ func (u *UserService) createUserWithPassword(ctx context.Context, req CreateUser
 
 	id, err := u.store.CreateUser(ctx, req.TenantID, reqToUserBusiness(req.User))
 	if err != nil {
-		return 0, fmt.Errorf("unable to store user: %w", err)
+		return 0, fmt.Errorf("unable to store user: %w", createUserErr(err))
 	}
 	return id, nil
 }
 
+// createExternalUser creates a user without a local password, so that it can
+// only sign in through its source.
+func (u *UserService) createExternalUser(ctx context.Context, req CreateUserReq) (uint64, error) {
+	user := reqToUserBusiness(req.User)
+	user.Password, user.PasswordChanged, user.TotpEnabled = "", false, false
+
+	id, err := u.store.CreateUser(ctx, req.TenantID, user)
+	if err != nil {
+		return 0, fmt.Errorf("unable to create user %v: %w", user.Username, createUserErr(err))
+	}
+	return id, nil
+}
+
+// createUserErr reports the users that already exist as such.
+func createUserErr(err error) error {
+	if errors.Is(err, database.ErrDuplicateKey) {
+		return fmt.Errorf("%v: %w", err, &service.ResourceAlreadyExistsErr{})
+	}
+	return err
+}
+
 func (u *UserService) EnableUserTotp(ctx context.Context, req EnableTotpReq) error {
 	user, err := u.store.GetUser(ctx, req.TenantID, req.UserID)
 	if err != nil {
@@ -255,6 +314,7 @@ func (u *UserService) EnableUserTotp(ctx context.Context, req EnableTotpReq) err
 		return fmt.Errorf("unable to update user %v: %w", req.UserID, err)
 	}
 
+	u.notifySecurityChange(ctx, req.TenantID, req.UserID, notification_model.NotificationTypeTotpChanged)
 	return nil
 }
 
@@ -301,6 +361,8 @@ func (u *UserService) ResetUserTotp(ctx context.Context, req ResetTotpReq) (stri
 		return "", nil, fmt.Errorf("unable to update user %v: %w", req.UserID, err)
 	}
 
+	u.notifySecurityChange(ctx, req.TenantID, req.UserID, notification_model.NotificationTypeTotpChanged)
+
 	return decTotpSecret, decRecoveryCodes, nil
 }
 
@@ -332,9 +394,20 @@ func (u *UserService) ResetUserPassword(ctx context.Context, req ResetUserPasswo
 
 	go u.sendMailWithTempPassword("Password reset, please change your password", req.TenantID, user, password, mailer.TemporaryPasswordKey)
 
+	u.notifySecurityChange(ctx, req.TenantID, req.UserID, notification_model.NotificationTypePasswordChanged)
 	return nil
 }
 
+// notifySecurityChange warns the user that their credentials have been
+// changed, so that they can react if they did not do it themselves.
+func (u *UserService) notifySecurityChange(ctx context.Context, tenantID, userID uint64, notifType notification_model.NotificationType) {
+	n := notification.NewNotificationBuilder(uuid.Next(), tenantID).
+		WithType(notifType).
+		WithLink(notification.AccountLink).
+		Build()
+	notification.Notify(ctx, u.notifier, n, userID)
+}
+
 func (s *UserService) GetTotpRecoveryCodes(ctx context.Context, tenantID, userID uint64) ([]*model.TotpRecoveryCode, error) {
 	recoveryCodes, err := s.store.GetTotpRecoveryCodes(ctx, tenantID, userID)
 	if err != nil {
@@ -368,20 +441,118 @@ func (u *UserService) sendMailWithTempPassword(subjectMessage string, tenantID u
 	}
 }
 
-func (u *UserService) CreateRole(ctx context.Context, role string) error {
-	return u.store.CreateRole(ctx, role)
+func (u *UserService) ListRoles(ctx context.Context, tenantID uint64) ([]*model.Role, error) {
+	roles, err := u.store.ListRoles(ctx, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query roles: %w", err)
+	}
+	return roles, nil
+}
+
+func (u *UserService) GetRole(ctx context.Context, tenantID, roleID uint64) (*model.Role, error) {
+	role, err := u.store.GetRole(ctx, tenantID, roleID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get role %v: %w", roleID, err)
+	}
+	return role, nil
+}
+
+func (u *UserService) CreateRole(ctx context.Context, req CreateRoleReq) (uint64, error) {
+	if err := u.checkRoleName(ctx, req.TenantID, 0, req.Role.Name); err != nil {
+		return 0, err
+	}
+
+	id, err := u.store.CreateRole(ctx, req.TenantID, reqToRoleBusiness(req.TenantID, req.Role))
+	if err != nil {
+		return 0, fmt.Errorf("unable to create role %v: %w", req.Role.Name, err)
+	}
+	return id, nil
+}
+
+func (u *UserService) UpdateRole(ctx context.Context, req UpdateRoleReq) error {
+	role, err := u.store.GetRole(ctx, req.TenantID, req.Role.ID)
+	if err != nil {
+		return fmt.Errorf("unable to get role %v: %w", req.Role.ID, err)
+	}
+	if role.IsBuiltIn() {
+		return fmt.Errorf("built-in role %v cannot be updated: %w", role.Name, &service.InvalidParametersErr{})
+	}
+
+	if err := u.checkRoleName(ctx, req.TenantID, req.Role.ID, req.Role.Name); err != nil {
+		return err
+	}
+
+	if err := u.store.UpdateRole(ctx, req.TenantID, reqToRoleBusiness(req.TenantID, req.Role)); err != nil {
+		return fmt.Errorf("unable to update role %v: %w", req.Role.ID, err)
+	}
+	return nil
+}
+
+func (u *UserService) DeleteRole(ctx context.Context, tenantID, roleID uint64) error {
+	role, err := u.store.GetRole(ctx, tenantID, roleID)
+	if err != nil {
+		return fmt.Errorf("unable to get role %v: %w", roleID, err)
+	}
+	if role.IsBuiltIn() {
+		return fmt.Errorf("built-in role %v cannot be deleted: %w", role.Name, &service.InvalidParametersErr{})
+	}
+
+	if err := u.store.DeleteRole(ctx, tenantID, roleID); err != nil {
+		return fmt.Errorf("unable to delete role %v: %w", roleID, err)
+	}
+	return nil
+}
+
+func (u *UserService) GetRolesPermissions(ctx context.Context, tenantID uint64, roles []string) ([]model.Permission, error) {
+	permissions, err := u.store.GetRolesPermissions(ctx, tenantID, roles)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get permissions of roles %v: %w", roles, err)
+	}
+	return permissions, nil
 }
 
-func (u *UserService) GetRoles(ctx context.Context) ([]*model.Role, error) {
-	return u.store.GetRoles(ctx)
+// checkRoleName verifies that no other role, built-in or of the tenant, is
+// named name.
+func (u *UserService) checkRoleName(ctx context.Context, tenantID, roleID uint64, name string) error {
+	roles, err := u.store.ListRoles(ctx, tenantID)
+	if err != nil {
+		return fmt.Errorf("unable to query roles: %w", err)
+	}
+	for _, r := range roles {
+		if r.Name == name && r.ID != roleID {
+			return fmt.Errorf("role %v: %w", name, &service.ResourceAlreadyExistsErr{})
+		}
+	}
+	return nil
 }
 
-func verifyRoles(roles []model.UserRole) error {
+// verifyRoles checks that the roles exist for the tenant and can be assigned.
+// The roles granting platform permissions, such as the ones created before
+// these were rejected, cannot be assigned.
+func (u *UserService) verifyRoles(ctx context.Context, tenantID uint64, roles []model.UserRole) error {
+	existing, err := u.store.ListRoles(ctx, tenantID)
+	if err != nil {
+		return fmt.Errorf("unable to query roles: %w", err)
+	}
+
+	byName := make(map[string]*model.Role, len(existing))
+	for _, r := range existing {
+		byName[r.Name] = r
+	}
+
 	for _, role := range roles {
-		if _, ok := model.ValidRoles[role]; !ok {
+		_, reserved := model.ReservedRoles[role]
+		r, ok := byName[role.String()]
+		if !ok || reserved {
 			err := &service.InvalidParametersErr{}
 			return fmt.Errorf("invalid role: %s: %w", role, err)
 		}
+		for _, p := range r.Permissions {
+			if p.IsPlatform() {
+				err := &service.InvalidParametersErr{}
+				return fmt.Errorf("role %s grants platform permission %v: %w", role, p, err)
+			}
+		}
 	}
 
 	return nil
@@ -0,0 +1,79 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/utils/database"
+	common_service "github.com/CHORUS-TRE/chorus-backend/pkg/common/service"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/user/model"
+)
+
+type roleStore struct {
+	UserStore
+	roles []*model.Role
+}
+
+func (s roleStore) ListRoles(ctx context.Context, tenantID uint64) ([]*model.Role, error) {
+	return s.roles, nil
+}
+
+func TestVerifyRoles(t *testing.T) {
+	tenantID := uint64(88888)
+	u := &UserService{store: roleStore{roles: []*model.Role{
+		{Name: "admin", Permissions: []model.Permission{model.PermissionUsersWrite}},
+		{Name: "chorus", Permissions: []model.Permission{model.PermissionTenantsInitialize}},
+		{TenantID: &tenantID, Name: "data-manager", Permissions: []model.Permission{model.PermissionWorkspacesWrite}},
+		{TenantID: &tenantID, Name: "operator", Permissions: []model.Permission{model.PermissionUsersRead, model.PermissionTenantsWrite}},
+	}}}
+	var invalid *common_service.InvalidParametersErr
+
+	require.NoError(t, u.verifyRoles(context.Background(), tenantID, []model.UserRole{"admin", "data-manager"}))
+
+	for _, role := range []model.UserRole{"missing", model.RoleChorus, "operator"} {
+		err := u.verifyRoles(context.Background(), tenantID, []model.UserRole{"data-manager", role})
+		require.True(t, errors.As(err, &invalid), role)
+	}
+}
+
+type createStore struct {
+	roleStore
+	users []*model.User
+}
+
+func (s *createStore) CreateUser(ctx context.Context, tenantID uint64, user *model.User) (uint64, error) {
+	for _, u := range s.users {
+		if u.Username == user.Username && u.Source == user.Source {
+			return 0, database.ErrDuplicateKey
+		}
+	}
+	s.users = append(s.users, user)
+	return uint64(len(s.users)), nil
+}
+
+func TestCreateUser_External(t *testing.T) {
+	store := &createStore{roleStore: roleStore{roles: []*model.Role{{Name: "authenticated"}}}}
+	// Without a mailer, sending the temporary password would panic.
+	u := &UserService{store: store}
+	req := CreateUserReq{TenantID: 1, User: &UserReq{
+		FirstName: "Jane",
+		LastName:  "Doe",
+		Username:  "jane.doe@chorus-tre.ch",
+		Source:    "scim",
+		Password:  "secret",
+		Status:    model.UserActive,
+		Roles:     []model.UserRole{model.RoleAuthenticated},
+	}}
+
+	_, err := u.CreateUser(context.Background(), req)
+	require.NoError(t, err)
+	require.Len(t, store.users, 1)
+	require.Empty(t, store.users[0].Password, "the user has no local password")
+	require.False(t, store.users[0].PasswordChanged)
+
+	_, err = u.CreateUser(context.Background(), req)
+	require.True(t, errors.As(err, new(*common_service.ResourceAlreadyExistsErr)))
+}
@@ -5,6 +5,8 @@ import (
 	"time"
 
 	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
+	"github.com/CHORUS-TRE/chorus-backend/internal/utils/pagination"
+	common "github.com/CHORUS-TRE/chorus-backend/pkg/common/model"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/user/model"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/user/service"
 
@@ -25,25 +27,26 @@ func Logging(logger *logger.ContextLogger) func(service.UserStore) service.UserS
 	}
 }
 
-func (c userStorageLogging) GetUsers(ctx context.Context, tenantID uint64) ([]*model.User, error) {
+func (c userStorageLogging) GetUsers(ctx context.Context, tenantID uint64, filter model.UserFilter, sort common.Sort, cursor *pagination.RequestCursor[pagination.KeysetCursor]) ([]*model.User, *pagination.ResponseCursor[pagination.KeysetCursor], uint64, error) {
 	c.logger.Debug(ctx, "request started")
 
 	now := time.Now()
 
-	res, err := c.next.GetUsers(ctx, tenantID)
+	res, resCursor, count, err := c.next.GetUsers(ctx, tenantID, filter, sort, cursor)
 	if err != nil {
 		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
 			zap.Error(err),
 			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
 		)
-		return nil, err
+		return nil, nil, 0, err
 	}
 
 	c.logger.Debug(ctx, "request completed",
 		logger.WithCountField(len(res)),
+		logger.WithTotalItemsField(count),
 		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
 	)
-	return res, nil
+	return res, resCursor, count, nil
 }
 
 func (c userStorageLogging) GetUser(ctx context.Context, tenantID uint64, userID uint64) (*model.User, error) {
@@ -128,32 +131,53 @@ func (c userStorageLogging) CreateUser(ctx context.Context, tenantID uint64, use
 	return userId, nil
 }
 
-func (c userStorageLogging) CreateRole(ctx context.Context, role string) error {
-	c.logger.Debug(ctx, "request started", zap.String("role", role))
+func (c userStorageLogging) ListRoles(ctx context.Context, tenantID uint64) ([]*model.Role, error) {
+	c.logger.Debug(ctx, "request started")
 
 	now := time.Now()
 
-	err := c.next.CreateRole(ctx, role)
+	res, err := c.next.ListRoles(ctx, tenantID)
 	if err != nil {
 		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
 			zap.Error(err),
 			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
 		)
-		return err
+		return nil, err
 	}
 
 	c.logger.Debug(ctx, "request completed",
+		logger.WithCountField(len(res)),
 		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
 	)
-	return nil
+	return res, nil
 }
 
-func (c userStorageLogging) GetRoles(ctx context.Context) ([]*model.Role, error) {
-	c.logger.Debug(ctx, "request started")
+func (c userStorageLogging) GetRole(ctx context.Context, tenantID, roleID uint64) (*model.Role, error) {
+	c.logger.Debug(ctx, "request started", zap.Uint64("role_id", roleID))
+
+	now := time.Now()
+
+	res, err := c.next.GetRole(ctx, tenantID, roleID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, nil
+}
+
+func (c userStorageLogging) GetRolesPermissions(ctx context.Context, tenantID uint64, roles []string) ([]model.Permission, error) {
+	c.logger.Debug(ctx, "request started", zap.Strings("roles", roles))
 
 	now := time.Now()
 
-	res, err := c.next.GetRoles(ctx)
+	res, err := c.next.GetRolesPermissions(ctx, tenantID, roles)
 	if err != nil {
 		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
 			zap.Error(err),
@@ -169,6 +193,67 @@ func (c userStorageLogging) GetRoles(ctx context.Context) ([]*model.Role, error)
 	return res, nil
 }
 
+func (c userStorageLogging) CreateRole(ctx context.Context, tenantID uint64, role *model.Role) (uint64, error) {
+	c.logger.Debug(ctx, "request started", zap.String("role", role.Name))
+
+	now := time.Now()
+
+	roleID, err := c.next.CreateRole(ctx, tenantID, role)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return 0, err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		zap.Uint64("role_id", roleID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return roleID, nil
+}
+
+func (c userStorageLogging) UpdateRole(ctx context.Context, tenantID uint64, role *model.Role) error {
+	c.logger.Debug(ctx, "request started", zap.Uint64("role_id", role.ID))
+
+	now := time.Now()
+
+	err := c.next.UpdateRole(ctx, tenantID, role)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return nil
+}
+
+func (c userStorageLogging) DeleteRole(ctx context.Context, tenantID, roleID uint64) error {
+	c.logger.Debug(ctx, "request started", zap.Uint64("role_id", roleID))
+
+	now := time.Now()
+
+	err := c.next.DeleteRole(ctx, tenantID, roleID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return nil
+}
+
 func (c userStorageLogging) GetTotpRecoveryCodes(ctx context.Context, tenantID, userID uint64) ([]*model.TotpRecoveryCode, error) {
 	c.logger.Debug(ctx, "request started")
 
@@ -2,12 +2,17 @@ package postgres
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 
 	"github.com/CHORUS-TRE/chorus-backend/internal/utils/database"
+	"github.com/CHORUS-TRE/chorus-backend/internal/utils/pagination"
 	"github.com/CHORUS-TRE/chorus-backend/internal/utils/uuid"
+	common "github.com/CHORUS-TRE/chorus-backend/pkg/common/model"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/common/storage"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/user/model"
 )
@@ -22,27 +27,107 @@ func NewUserStorage(db *sqlx.DB) *UserStorage {
 	return &UserStorage{db: db}
 }
 
-// GetUsers queries all stocked users that are not 'deleted'.
-func (s *UserStorage) GetUsers(ctx context.Context, tenantID uint64) ([]*model.User, error) {
-	const query = `
+// GetUsers queries the stocked users matching the filter, one page at a time.
+// Users that are 'deleted' are skipped unless explicitly filtered on.
+// It also returns the total number of users matching the filter.
+func (s *UserStorage) GetUsers(ctx context.Context, tenantID uint64, filter model.UserFilter, sort common.Sort, cursor *pagination.RequestCursor[pagination.KeysetCursor]) ([]*model.User, *pagination.ResponseCursor[pagination.KeysetCursor], uint64, error) {
+	args, whereClauses := buildUsersWhereClauses(tenantID, filter)
+
+	sortType := strings.ToUpper(sort.SortType)
+	column, ok := model.UserSortTypeToString[sortType]
+	if !ok {
+		sortType, column = "ID", model.UserSortTypeToString["ID"]
+	}
+	selectArgs, keysetClause, sortClause, reversed := storage.KeysetClauses(args, column, "id", strings.ToUpper(sort.SortOrder), cursor)
+
+	selectQuery := `
 SELECT id, tenantid, firstname, lastname, username, source, status, createdat, updatedat
 FROM users
-WHERE tenantid = $1 AND status != 'deleted';
-`
+` + whereClauses + keysetClause + sortClause
+	query, selectArgs, err := sqlx.In(selectQuery, selectArgs...)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	query = sqlx.Rebind(sqlx.DOLLAR, query)
+
 	var users []*model.User
-	if err := s.db.SelectContext(ctx, &users, query, tenantID); err != nil {
-		return nil, err
+	if err := s.db.SelectContext(ctx, &users, query, selectArgs...); err != nil {
+		return nil, nil, 0, err
+	}
+	if reversed {
+		storage.Reverse(users)
 	}
 
+	users, responseCursor := pagination.NewKeysetResponseCursor(users, func(u *model.User) pagination.Keyset {
+		return pagination.Keyset{ID: u.ID, Value: u.SortValue(sortType)}
+	}, cursor)
+
 	for _, u := range users {
 		roles, err := s.getUserRoles(ctx, u.ID)
 		if err != nil {
-			return nil, err
+			return nil, nil, 0, err
 		}
 		u.Roles = roles[:]
 	}
 
-	return users, nil
+	count, err := s.countUsers(ctx, whereClauses, args)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+
+	return users, responseCursor, count, nil
+}
+
+func buildUsersWhereClauses(tenantID uint64, filter model.UserFilter) ([]interface{}, string) {
+	args := []interface{}{tenantID}
+	whereClauses := "WHERE tenantid = ?"
+
+	if filter.Status != "" {
+		args = append(args, filter.Status.String())
+		whereClauses += " AND status = ?"
+	} else {
+		whereClauses += " AND status != 'deleted'"
+	}
+
+	if filter.Role != "" {
+		args = append(args, filter.Role.String())
+		whereClauses += " AND EXISTS (SELECT 1 FROM user_role ur JOIN roles r ON r.id = ur.roleid WHERE ur.userid = users.id AND r.name = ? AND (r.tenantid IS NULL OR r.tenantid = users.tenantid))"
+	}
+
+	if filter.Source != "" {
+		args = append(args, filter.Source)
+		whereClauses += " AND source = ?"
+	}
+
+	if filter.Search != "" {
+		likeQuery := "%" + storage.EscapeLike(filter.Search) + "%"
+		args = append(args, likeQuery, likeQuery, likeQuery)
+		whereClauses += ` AND (firstname ILIKE ? ESCAPE '\' OR lastname ILIKE ? ESCAPE '\' OR username ILIKE ? ESCAPE '\')`
+	}
+
+	if filter.Username != "" {
+		args = append(args, filter.Username)
+		whereClauses += " AND LOWER(username) = LOWER(?)"
+	}
+
+	return args, whereClauses
+}
+
+func (s *UserStorage) countUsers(ctx context.Context, whereClauses string, args []interface{}) (uint64, error) {
+	countQuery := `
+SELECT COUNT(id) FROM users
+` + whereClauses
+	query, args, err := sqlx.In(countQuery, args...)
+	if err != nil {
+		return 0, err
+	}
+	query = sqlx.Rebind(sqlx.DOLLAR, query)
+
+	var count uint64
+	if err := s.db.GetContext(ctx, &count, query, args...); err != nil {
+		return 0, err
+	}
+	return count, nil
 }
 
 func (s *UserStorage) GetUser(ctx context.Context, tenantID uint64, userID uint64) (*model.User, error) {
@@ -208,7 +293,7 @@ func (s *UserStorage) updateUserAndRoles(ctx context.Context, tx *sqlx.Tx, tenan
 		return database.ErrNoRowsUpdated
 	}
 
-	roles, err := s.GetRoles(ctx)
+	roleIDs, err := s.getRoleIDs(ctx, tenantID)
 	if err != nil {
 		return fmt.Errorf("unable to get roles: %w", err)
 	}
@@ -221,25 +306,21 @@ func (s *UserStorage) updateUserAndRoles(ctx context.Context, tx *sqlx.Tx, tenan
 
 	// Add new User Roles
 	for _, ur := range user.Roles {
-		found := false
-		for _, r := range roles {
-			if ur.String() == r.Name {
-				if _, err = tx.ExecContext(ctx, insertUserRoleQuery, user.ID, r.ID); err != nil {
-					return fmt.Errorf("unable to exec in: %w", err)
-				}
-				found = true
-				break
-			}
-		}
-		if !found {
+		roleID, ok := roleIDs[ur.String()]
+		if !ok {
 			return fmt.Errorf("unknown user role: %v", ur)
 		}
+		if _, err = tx.ExecContext(ctx, insertUserRoleQuery, user.ID, roleID); err != nil {
+			return fmt.Errorf("unable to exec in: %w", err)
+		}
 	}
 
 	return nil
 }
 
 // CreateUser saves the provided user object in the database 'users' table.
+// It returns database.ErrDuplicateKey when the source already has a user with
+// that username.
 func (s *UserStorage) CreateUser(ctx context.Context, tenantID uint64, user *model.User) (uint64, error) {
 	const userQuery = `
 INSERT INTO users (tenantid, firstname, lastname, username, source, password, passwordChanged, status,
@@ -264,11 +345,15 @@ INSERT INTO totp_recovery_codes (tenantid, userid, code) VALUES ($1, $2, $3);
 	err = tx.GetContext(ctx, &id,
 		userQuery, tenantID, user.FirstName, user.LastName, user.Username, user.Source, user.Password, user.PasswordChanged, user.Status, user.TotpSecret,
 	)
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == database.DuplicateKeyErrorCode && pqErr.Constraint == "users_username_source_unique" {
+		err = fmt.Errorf("user %v from %v: %w", user.Username, user.Source, database.ErrDuplicateKey)
+	}
 	if err != nil {
 		return 0, storage.Rollback(tx, err)
 	}
 
-	roles, err := s.GetRoles(ctx)
+	roleIDs, err := s.getRoleIDs(ctx, tenantID)
 	if err != nil {
 		return 0, storage.Rollback(tx, err)
 	}
@@ -276,20 +361,14 @@ INSERT INTO totp_recovery_codes (tenantid, userid, code) VALUES ($1, $2, $3);
 	// For each user role that matches a role insert an entry in the 'user_role' table.
 	var loopErr error
 	for _, ur := range user.Roles {
-		found := false
-		for _, r := range roles {
-			if ur.String() == r.Name {
-				if _, loopErr = tx.ExecContext(ctx, userRoleQuery, id, r.ID); loopErr != nil {
-					return 0, storage.Rollback(tx, loopErr)
-				}
-				found = true
-				break
-			}
-		}
-		if !found {
+		roleID, ok := roleIDs[ur.String()]
+		if !ok {
 			loopErr = fmt.Errorf("unknown user role: %v", ur)
 			return 0, storage.Rollback(tx, loopErr)
 		}
+		if _, loopErr = tx.ExecContext(ctx, userRoleQuery, id, roleID); loopErr != nil {
+			return 0, storage.Rollback(tx, loopErr)
+		}
 	}
 	// Insert TOTP recovery codes.
 	if user.TotpRecoveryCodes != nil {
@@ -323,29 +402,234 @@ FROM (
 	return roles, nil
 }
 
-// GetRoles queries all stocked roles.
-func (s *UserStorage) GetRoles(ctx context.Context) ([]*model.Role, error) {
+// getRoleIDs returns the IDs of the roles that can be assigned to the users
+// of the tenant, by name.
+func (s *UserStorage) getRoleIDs(ctx context.Context, tenantID uint64) (map[string]uint64, error) {
 	const query = `
-SELECT id, name FROM roles;
+SELECT id, name FROM roles WHERE tenantid IS NULL OR tenantid = $1;
 	`
 	var roles []*model.Role
-	if err := s.db.SelectContext(ctx, &roles, query); err != nil {
+	if err := s.db.SelectContext(ctx, &roles, query, tenantID); err != nil {
+		return nil, err
+	}
+
+	ids := make(map[string]uint64, len(roles))
+	for _, r := range roles {
+		ids[r.Name] = r.ID
+	}
+	return ids, nil
+}
+
+// ListRoles queries the built-in roles and the roles of the tenant, with
+// their permissions.
+func (s *UserStorage) ListRoles(ctx context.Context, tenantID uint64) ([]*model.Role, error) {
+	const query = `
+SELECT id, tenantid, name, description, createdat, updatedat
+FROM roles
+WHERE tenantid IS NULL OR tenantid = $1
+ORDER BY tenantid NULLS FIRST, name;
+	`
+	var roles []*model.Role
+	if err := s.db.SelectContext(ctx, &roles, query, tenantID); err != nil {
+		return nil, err
+	}
+
+	if err := s.fillRolesPermissions(ctx, roles); err != nil {
 		return nil, err
 	}
 	return roles, nil
 }
 
-func (s *UserStorage) CreateRole(ctx context.Context, role string) error {
+// GetRole queries a built-in role or a role of the tenant, with its
+// permissions.
+func (s *UserStorage) GetRole(ctx context.Context, tenantID, roleID uint64) (*model.Role, error) {
 	const query = `
-		insert into roles (name)
-			select $1
-		where not exists
-			(select * from roles where name = $1)`
+SELECT id, tenantid, name, description, createdat, updatedat
+FROM roles
+WHERE id = $2 AND (tenantid IS NULL OR tenantid = $1);
+	`
+	var role model.Role
+	if err := s.db.GetContext(ctx, &role, query, tenantID, roleID); err != nil {
+		return nil, err
+	}
+
+	if err := s.fillRolesPermissions(ctx, []*model.Role{&role}); err != nil {
+		return nil, err
+	}
+	return &role, nil
+}
+
+func (s *UserStorage) fillRolesPermissions(ctx context.Context, roles []*model.Role) error {
+	if len(roles) == 0 {
+		return nil
+	}
+
+	const selectQuery = `
+SELECT roleid, permission FROM role_permissions WHERE roleid IN (?) ORDER BY permission;
+	`
+	byID := make(map[uint64]*model.Role, len(roles))
+	ids := make([]uint64, 0, len(roles))
+	for _, r := range roles {
+		r.Permissions = []model.Permission{}
+		byID[r.ID] = r
+		ids = append(ids, r.ID)
+	}
 
-	_, err := s.db.ExecContext(ctx, query, role)
+	query, args, err := sqlx.In(selectQuery, ids)
 	if err != nil {
-		return fmt.Errorf("unable to create role: %w", err)
+		return err
 	}
+	query = sqlx.Rebind(sqlx.DOLLAR, query)
 
+	var rows []struct {
+		RoleID     uint64
+		Permission model.Permission
+	}
+	if err := s.db.SelectContext(ctx, &rows, query, args...); err != nil {
+		return fmt.Errorf("unable to get role permissions: %w", err)
+	}
+
+	for _, row := range rows {
+		byID[row.RoleID].Permissions = append(byID[row.RoleID].Permissions, row.Permission)
+	}
+	return nil
+}
+
+// GetRolesPermissions returns the permissions granted by the named roles,
+// built-in or of the tenant.
+func (s *UserStorage) GetRolesPermissions(ctx context.Context, tenantID uint64, roles []string) ([]model.Permission, error) {
+	if len(roles) == 0 {
+		return nil, nil
+	}
+
+	const selectQuery = `
+SELECT DISTINCT rp.permission
+FROM role_permissions rp
+JOIN roles r ON r.id = rp.roleid
+WHERE r.name IN (?) AND (r.tenantid IS NULL OR r.tenantid = ?);
+	`
+	query, args, err := sqlx.In(selectQuery, roles, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	query = sqlx.Rebind(sqlx.DOLLAR, query)
+
+	var permissions []model.Permission
+	if err := s.db.SelectContext(ctx, &permissions, query, args...); err != nil {
+		return nil, err
+	}
+	return permissions, nil
+}
+
+// CreateRole saves a role of the tenant and its permissions.
+func (s *UserStorage) CreateRole(ctx context.Context, tenantID uint64, role *model.Role) (uint64, error) {
+	const query = `
+INSERT INTO roles (tenantid, name, description, createdat, updatedat)
+VALUES ($1, $2, $3, NOW(), NOW()) RETURNING id;
+	`
+
+	tx, err := s.db.Beginx()
+	if err != nil {
+		return 0, err
+	}
+
+	var id uint64
+	if err := tx.GetContext(ctx, &id, query, tenantID, role.Name, role.Description); err != nil {
+		return 0, storage.Rollback(tx, fmt.Errorf("unable to create role: %w", err))
+	}
+
+	if err := insertRolePermissions(ctx, tx, id, role.Permissions); err != nil {
+		return 0, storage.Rollback(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+// UpdateRole updates a role of the tenant and replaces its permissions.
+// Built-in roles are never updated.
+func (s *UserStorage) UpdateRole(ctx context.Context, tenantID uint64, role *model.Role) error {
+	const updateQuery = `
+UPDATE roles
+SET name = $3, description = $4, updatedat = NOW()
+WHERE tenantid = $1 AND id = $2;
+	`
+	const deletePermissionsQuery = `
+DELETE FROM role_permissions WHERE roleid = $1;
+	`
+
+	tx, err := s.db.Beginx()
+	if err != nil {
+		return err
+	}
+
+	rows, err := tx.ExecContext(ctx, updateQuery, tenantID, role.ID, role.Name, role.Description)
+	if err != nil {
+		return storage.Rollback(tx, fmt.Errorf("unable to update role: %w", err))
+	}
+	affected, err := rows.RowsAffected()
+	if err != nil {
+		return storage.Rollback(tx, fmt.Errorf("unable to get rows affected: %w", err))
+	}
+	if affected == 0 {
+		return storage.Rollback(tx, database.ErrNoRowsUpdated)
+	}
+
+	if _, err := tx.ExecContext(ctx, deletePermissionsQuery, role.ID); err != nil {
+		return storage.Rollback(tx, fmt.Errorf("unable to delete role permissions: %w", err))
+	}
+	if err := insertRolePermissions(ctx, tx, role.ID, role.Permissions); err != nil {
+		return storage.Rollback(tx, err)
+	}
+
+	return tx.Commit()
+}
+
+// DeleteRole deletes a role of the tenant, unassigning it from the users.
+// Built-in roles are never deleted.
+func (s *UserStorage) DeleteRole(ctx context.Context, tenantID, roleID uint64) error {
+	const deleteUserRolesQuery = `
+DELETE FROM user_role
+WHERE roleid = (SELECT id FROM roles WHERE tenantid = $1 AND id = $2);
+	`
+	const deleteRoleQuery = `
+DELETE FROM roles WHERE tenantid = $1 AND id = $2;
+	`
+
+	tx, err := s.db.Beginx()
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, deleteUserRolesQuery, tenantID, roleID); err != nil {
+		return storage.Rollback(tx, fmt.Errorf("unable to unassign role: %w", err))
+	}
+
+	rows, err := tx.ExecContext(ctx, deleteRoleQuery, tenantID, roleID)
+	if err != nil {
+		return storage.Rollback(tx, fmt.Errorf("unable to delete role: %w", err))
+	}
+	affected, err := rows.RowsAffected()
+	if err != nil {
+		return storage.Rollback(tx, fmt.Errorf("unable to get rows affected: %w", err))
+	}
+	if affected == 0 {
+		return storage.Rollback(tx, database.ErrNoRowsDeleted)
+	}
+
+	return tx.Commit()
+}
+
+func insertRolePermissions(ctx context.Context, tx *sqlx.Tx, roleID uint64, permissions []model.Permission) error {
+	const query = `
+INSERT INTO role_permissions (roleid, permission) VALUES ($1, $2) ON CONFLICT DO NOTHING;
+	`
+	for _, p := range permissions {
+		if _, err := tx.ExecContext(ctx, query, roleID, p.String()); err != nil {
+			return fmt.Errorf("unable to insert role permission: %w", err)
+		}
+	}
 	return nil
 }
@@ -0,0 +1,44 @@
+package model
+
+import "time"
+
+// WebhookDelivery maps an entry in the 'webhook_deliveries' database table:
+// an event posted to a webhook, along with the outcome of the last attempt.
+type WebhookDelivery struct {
+	ID        uint64
+	TenantID  uint64
+	WebhookID uint64
+
+	EventID   string
+	EventType EventType
+	// Payload is the JSON encoded event posted to the webhook.
+	Payload string
+
+	Status        DeliveryStatus
+	Attempts      int
+	ResponseCode  *int
+	LastError     *string
+	NextAttemptAt time.Time
+	DeliveredAt   *time.Time
+
+	// URL and Secret are the ones of the webhook, filled in when the delivery
+	// is claimed.
+	URL    string
+	Secret string
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// DeliveryStatus represents the status of a delivery.
+type DeliveryStatus string
+
+const (
+	DeliveryPending   DeliveryStatus = "pending"
+	DeliveryDelivered DeliveryStatus = "delivered"
+	DeliveryFailed    DeliveryStatus = "failed"
+)
+
+func (s DeliveryStatus) String() string {
+	return string(s)
+}
@@ -0,0 +1,16 @@
+package model
+
+import "time"
+
+// Event is a change of state published to the webhooks of a tenant. It is
+// posted as JSON in the body of the deliveries.
+type Event struct {
+	ID        string    `json:"id"`
+	TenantID  uint64    `json:"tenantId"`
+	Type      EventType `json:"type"`
+	CreatedAt time.Time `json:"createdAt"`
+	Data      EventData `json:"data"`
+}
+
+// EventData describes the resource the event is about.
+type EventData map[string]interface{}
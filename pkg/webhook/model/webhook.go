@@ -0,0 +1,104 @@
+package model
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Webhook maps an entry in the 'webhooks' database table: an endpoint to
+// which the events of the subscribed types are posted.
+type Webhook struct {
+	ID       uint64
+	TenantID uint64
+
+	URL        string     `validate:"required,url,max=2048"`
+	EventTypes EventTypes `validate:"required,min=1,dive,required"`
+	Enabled    bool
+
+	// Secret is the key signing the deliveries, encrypted with the daemon
+	// key. It is only returned in plain text when the webhook is created.
+	Secret string
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// EventType is the type of an event published to the webhooks.
+type EventType string
+
+const (
+	EventWorkbenchCreated  EventType = "workbench.created"
+	EventWorkbenchDeleted  EventType = "workbench.deleted"
+	EventWorkbenchFailed   EventType = "workbench.failed"
+	EventUserDisabled      EventType = "user.disabled"
+	EventAppInstanceFailed EventType = "app_instance.failed"
+)
+
+func (t EventType) String() string {
+	return string(t)
+}
+
+// AllEventTypes lists the types of the events published to the webhooks.
+var AllEventTypes = []EventType{
+	EventWorkbenchCreated,
+	EventWorkbenchDeleted,
+	EventWorkbenchFailed,
+	EventUserDisabled,
+	EventAppInstanceFailed,
+}
+
+func ToEventType(eventType string) (EventType, error) {
+	for _, t := range AllEventTypes {
+		if t.String() == eventType {
+			return t, nil
+		}
+	}
+	return "", fmt.Errorf("unexpected EventType: %s", eventType)
+}
+
+// EventTypes are the event types a webhook is subscribed to, stored as a JSON
+// array in the 'eventtypes' column of the 'webhooks' table.
+type EventTypes []EventType
+
+func ToEventTypes(eventTypes []string) (EventTypes, error) {
+	var res EventTypes
+	for _, eventType := range eventTypes {
+		t, err := ToEventType(eventType)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, t)
+	}
+	return res, nil
+}
+
+func (t EventTypes) Strings() []string {
+	res := make([]string, 0, len(t))
+	for _, e := range t {
+		res = append(res, e.String())
+	}
+	return res
+}
+
+func (t EventTypes) Value() (driver.Value, error) {
+	if t == nil {
+		return []byte("[]"), nil
+	}
+	return json.Marshal(t)
+}
+
+func (t *EventTypes) Scan(src interface{}) error {
+	var b []byte
+	switch v := src.(type) {
+	case []byte:
+		b = v
+	case string:
+		b = []byte(v)
+	default:
+		return errors.New("unexpected type for webhook event types")
+	}
+	return json.Unmarshal(b, t)
+}
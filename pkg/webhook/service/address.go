@@ -0,0 +1,72 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+)
+
+// sharedAddressSpace is the carrier-grade NAT range, which some clusters use
+// for their pods and services.
+var sharedAddressSpace = &net.IPNet{IP: net.IPv4(100, 64, 0, 0), Mask: net.CIDRMask(10, 32)}
+
+// checkAddress rejects the addresses that are not publicly routable, so that
+// the webhooks cannot reach the services of the cluster nor of its hosts.
+func checkAddress(ip net.IP) error {
+	if ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsMulticast() || ip.IsUnspecified() || sharedAddressSpace.Contains(ip) {
+		return fmt.Errorf("address %v is not public", ip)
+	}
+	return nil
+}
+
+// checkHost resolves the host of a webhook and rejects it unless all its
+// addresses are public.
+func checkHost(ctx context.Context, resolver *net.Resolver, host string) error {
+	addrs, err := resolver.LookupIPAddr(ctx, host)
+	if err != nil {
+		return fmt.Errorf("unable to resolve %v: %w", host, err)
+	}
+	for _, addr := range addrs {
+		if err := checkAddress(addr.IP); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// newDeliveryClient returns the client posting the events to the webhooks.
+// It checks the address of every connection once resolved, as the host of a
+// webhook can resolve to another address than when it was created.
+func newDeliveryClient(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			ip := net.ParseIP(host)
+			if ip == nil {
+				return fmt.Errorf("unexpected address %v", address)
+			}
+			return checkAddress(ip)
+		},
+	}
+
+	return &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			// The requests are never proxied, which would bypass the check of
+			// the addresses.
+			Proxy:               nil,
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: timeout,
+			MaxIdleConns:        10,
+			IdleConnTimeout:     90 * time.Second,
+		},
+	}
+}
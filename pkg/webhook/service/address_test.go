@@ -0,0 +1,50 @@
+package service
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/CHORUS-TRE/chorus-backend/pkg/common/service"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/webhook/model"
+)
+
+func TestCheckAddress(t *testing.T) {
+	for _, addr := range []string{
+		"127.0.0.1", "::1", "10.1.2.3", "172.16.0.1", "192.168.1.1", "169.254.169.254",
+		"fe80::1", "fd00::1", "100.64.0.1", "0.0.0.0", "224.0.0.1",
+	} {
+		require.Error(t, checkAddress(net.ParseIP(addr)), addr)
+	}
+
+	for _, addr := range []string{"1.1.1.1", "2606:4700:4700::1111"} {
+		require.NoError(t, checkAddress(net.ParseIP(addr)), addr)
+	}
+}
+
+func TestCheckWebhook(t *testing.T) {
+	for _, url := range []string{"http://127.0.0.1:8080/hook", "https://[::1]/hook", "http://169.254.169.254/latest"} {
+		err := checkWebhook(context.Background(), &model.Webhook{URL: url})
+		var invalid *service.InvalidParametersErr
+		require.ErrorAs(t, err, &invalid, url)
+	}
+
+	require.NoError(t, checkWebhook(context.Background(), &model.Webhook{URL: "https://1.1.1.1/hook"}))
+}
+
+func TestDeliveryClient(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer srv.Close()
+
+	_, err := newDeliveryClient(time.Second).Get(srv.URL)
+	require.ErrorContains(t, err, "is not public")
+}
+
+func TestDeliveryLease(t *testing.T) {
+	require.Greater(t, deliveryLease(defaultDeliveryBatchSize, defaultWebhookTimeout), defaultDeliveryBatchSize*defaultWebhookTimeout)
+}
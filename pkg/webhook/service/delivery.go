@@ -0,0 +1,182 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/utils/crypto"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/webhook/model"
+)
+
+const (
+	// deliveryLeaseMargin is added to the time a replica takes to post the
+	// deliveries it claimed, should they all time out, before another one
+	// retries them.
+	deliveryLeaseMargin = time.Minute
+
+	defaultDeliveryBatchSize   = 50
+	defaultDeliveryMaxAttempts = 8
+
+	minDeliveryBackoff = 30 * time.Second
+	maxDeliveryBackoff = 6 * time.Hour
+)
+
+// The headers of the requests posting the events to the webhooks.
+const (
+	HeaderEvent     = "X-Chorus-Event"
+	HeaderDelivery  = "X-Chorus-Delivery"
+	HeaderTimestamp = "X-Chorus-Timestamp"
+	HeaderSignature = "X-Chorus-Signature"
+)
+
+// DeliverWebhooks posts the due deliveries to their webhooks. The failed
+// deliveries are retried with an exponential backoff until the maximum number
+// of attempts is reached.
+func (s *WebhookService) DeliverWebhooks(ctx context.Context) error {
+	conf := s.cfg.Services.WebhookService.Delivery
+
+	batchSize := conf.BatchSize
+	if batchSize <= 0 {
+		batchSize = defaultDeliveryBatchSize
+	}
+	maxAttempts := conf.MaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = defaultDeliveryMaxAttempts
+	}
+
+	deliveries, err := s.store.ClaimWebhookDeliveries(ctx, batchSize, deliveryLease(batchSize, s.timeout))
+	if err != nil {
+		return fmt.Errorf("unable to claim deliveries: %w", err)
+	}
+
+	var failed int
+	for _, d := range deliveries {
+		code, postErr := s.deliver(ctx, d)
+		if postErr != nil {
+			failed++
+		}
+
+		recordAttempt(d, code, postErr, maxAttempts, time.Now())
+		if err := s.store.UpdateWebhookDelivery(ctx, d); err != nil {
+			return fmt.Errorf("unable to record delivery: %w", err)
+		}
+	}
+
+	if failed != 0 {
+		return fmt.Errorf("unable to deliver %v of %v events", failed, len(deliveries))
+	}
+	return nil
+}
+
+func (s *WebhookService) deliver(ctx context.Context, d *model.WebhookDelivery) (int, error) {
+	key, err := s.daemonEncryptionKey.Get()
+	if err != nil {
+		return 0, fmt.Errorf("unable to get encryption key: %w", err)
+	}
+	defer crypto.Zero(key)
+
+	secret, err := crypto.DecryptFromString(d.Secret, key)
+	if err != nil {
+		return 0, fmt.Errorf("unable to decrypt webhook secret: %w", err)
+	}
+	defer crypto.Zero(secret)
+
+	return post(ctx, s.client, d, secret, time.Now())
+}
+
+// post posts the payload of the delivery to the webhook and returns the
+// status code of the response. Any status other than 2xx is an error. The
+// body of the response is not recorded, as the webhooks must not be able to
+// read what an address answers.
+func post(ctx context.Context, client *http.Client, d *model.WebhookDelivery, secret []byte, now time.Time) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, d.URL, bytes.NewBufferString(d.Payload))
+	if err != nil {
+		return 0, fmt.Errorf("unable to create request: %w", err)
+	}
+
+	timestamp := strconv.FormatInt(now.Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "CHORUS-Webhooks")
+	req.Header.Set(HeaderEvent, d.EventType.String())
+	req.Header.Set(HeaderDelivery, strconv.FormatUint(d.ID, 10))
+	req.Header.Set(HeaderTimestamp, timestamp)
+	req.Header.Set(HeaderSignature, Sign(secret, timestamp, []byte(d.Payload)))
+
+	res, err := client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("unable to post event: %w", err)
+	}
+	defer res.Body.Close()
+
+	_, _ = io.Copy(io.Discard, res.Body)
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return res.StatusCode, fmt.Errorf("unexpected status %v", res.StatusCode)
+	}
+	return res.StatusCode, nil
+}
+
+// Sign returns the signature of a delivery, as sent in the X-Chorus-Signature
+// header: the hex encoded HMAC-SHA256 of the timestamp and of the body joined
+// by a dot, keyed with the secret of the webhook. The receivers compute it
+// the same way and compare it in constant time.
+func Sign(secret []byte, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// recordAttempt updates the delivery with the outcome of an attempt to post
+// it.
+func recordAttempt(d *model.WebhookDelivery, code int, err error, maxAttempts int, now time.Time) {
+	d.ResponseCode = nil
+	if code != 0 {
+		d.ResponseCode = &code
+	}
+
+	if err == nil {
+		d.Status = model.DeliveryDelivered
+		d.DeliveredAt = &now
+		d.LastError = nil
+		return
+	}
+
+	msg := err.Error()
+	d.LastError = &msg
+	if d.Attempts >= maxAttempts {
+		d.Status = model.DeliveryFailed
+		return
+	}
+
+	d.Status = model.DeliveryPending
+	d.NextAttemptAt = now.Add(deliveryBackoff(d.Attempts))
+}
+
+// deliveryLease returns the time a replica has to post the deliveries it
+// claimed before another one retries them, which exceeds the time they take
+// should they all time out.
+func deliveryLease(batchSize int, timeout time.Duration) time.Duration {
+	return time.Duration(batchSize)*timeout + deliveryLeaseMargin
+}
+
+// deliveryBackoff returns the delay before the next attempt, doubling from
+// 30 seconds after each attempt.
+func deliveryBackoff(attempts int) time.Duration {
+	backoff := minDeliveryBackoff
+	for i := 1; i < attempts; i++ {
+		backoff *= 2
+		if backoff >= maxDeliveryBackoff {
+			return maxDeliveryBackoff
+		}
+	}
+	return backoff
+}
@@ -0,0 +1,81 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/CHORUS-TRE/chorus-backend/pkg/webhook/model"
+)
+
+func TestPost(t *testing.T) {
+	secret := []byte("secret")
+	now := time.Unix(1700000000, 0)
+
+	var received *http.Request
+	var body []byte
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r
+		body, _ = io.ReadAll(r.Body)
+		if r.Header.Get(HeaderEvent) == model.EventUserDisabled.String() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = w.Write([]byte("maintenance"))
+		}
+	}))
+	defer srv.Close()
+
+	d := &model.WebhookDelivery{ID: 42, URL: srv.URL, EventType: model.EventWorkbenchCreated, Payload: `{"id":"1"}`}
+	code, err := post(context.Background(), srv.Client(), d, secret, now)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, code)
+
+	require.Equal(t, `{"id":"1"}`, string(body))
+	require.Equal(t, "workbench.created", received.Header.Get(HeaderEvent))
+	require.Equal(t, "42", received.Header.Get(HeaderDelivery))
+	require.Equal(t, "1700000000", received.Header.Get(HeaderTimestamp))
+	require.Equal(t, Sign(secret, "1700000000", body), received.Header.Get(HeaderSignature))
+
+	d.EventType = model.EventUserDisabled
+	code, err = post(context.Background(), srv.Client(), d, secret, now)
+	require.EqualError(t, err, "unexpected status 503", "the response is not recorded")
+	require.Equal(t, http.StatusServiceUnavailable, code)
+}
+
+func TestSign(t *testing.T) {
+	sig := Sign([]byte("secret"), "1700000000", []byte(`{"id":"1"}`))
+	require.Equal(t, "sha256=086f6aff7bd084c98679825129c5a64dbad88c760016d6d2c0fb123f27951d54", sig)
+}
+
+func TestRecordAttempt(t *testing.T) {
+	now := time.Now()
+
+	d := &model.WebhookDelivery{Attempts: 1, Status: model.DeliveryPending}
+	recordAttempt(d, 204, nil, 3, now)
+	require.Equal(t, model.DeliveryDelivered, d.Status)
+	require.Equal(t, &now, d.DeliveredAt)
+	require.Equal(t, 204, *d.ResponseCode)
+
+	d = &model.WebhookDelivery{Attempts: 2, Status: model.DeliveryPending}
+	recordAttempt(d, 0, errors.New("connection refused"), 3, now)
+	require.Equal(t, model.DeliveryPending, d.Status)
+	require.Equal(t, now.Add(time.Minute), d.NextAttemptAt)
+	require.Equal(t, "connection refused", *d.LastError)
+	require.Nil(t, d.ResponseCode)
+
+	d = &model.WebhookDelivery{Attempts: 3, Status: model.DeliveryPending}
+	recordAttempt(d, 500, errors.New("unexpected status 500"), 3, now)
+	require.Equal(t, model.DeliveryFailed, d.Status)
+	require.Equal(t, 500, *d.ResponseCode)
+}
+
+func TestDeliveryBackoff(t *testing.T) {
+	require.Equal(t, 30*time.Second, deliveryBackoff(1))
+	require.Equal(t, 2*time.Minute, deliveryBackoff(3))
+	require.Equal(t, maxDeliveryBackoff, deliveryBackoff(20))
+}
@@ -0,0 +1,83 @@
+package middleware
+
+import (
+	"context"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
+	"github.com/CHORUS-TRE/chorus-backend/internal/utils/cache"
+	common_model "github.com/CHORUS-TRE/chorus-backend/pkg/common/model"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/webhook/model"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/webhook/service"
+)
+
+const (
+	defaultCacheExpiration = 5
+)
+
+func WebhookCaching(log *logger.ContextLogger, backend cache.Backend) func(service.Webhooker) *Caching {
+	return func(next service.Webhooker) *Caching {
+		return &Caching{
+			cache: cache.NewCache(backend, log),
+			next:  next,
+		}
+	}
+}
+
+type Caching struct {
+	cache *cache.Cache
+	next  service.Webhooker
+}
+
+func (c *Caching) ListWebhooks(ctx context.Context, tenantID uint64) (reply []*model.Webhook, err error) {
+	entry := c.cache.NewEntry(cache.WithUint64(tenantID)).WithTags(cache.TenantTag(tenantID))
+	reply = []*model.Webhook{}
+
+	if ok := entry.Get(ctx, &reply); !ok {
+		reply, err = c.next.ListWebhooks(ctx, tenantID)
+		if err == nil {
+			entry.Set(ctx, defaultCacheExpiration, reply)
+		}
+	}
+
+	return
+}
+
+func (c *Caching) GetWebhook(ctx context.Context, tenantID, webhookID uint64) (*model.Webhook, error) {
+	return c.next.GetWebhook(ctx, tenantID, webhookID)
+}
+
+func (c *Caching) CreateWebhook(ctx context.Context, webhook *model.Webhook) (uint64, string, error) {
+	id, secret, err := c.next.CreateWebhook(ctx, webhook)
+	c.cache.Invalidate(ctx, cache.TenantTag(webhook.TenantID))
+	return id, secret, err
+}
+
+func (c *Caching) UpdateWebhook(ctx context.Context, webhook *model.Webhook) error {
+	err := c.next.UpdateWebhook(ctx, webhook)
+	c.cache.Invalidate(ctx, cache.TenantTag(webhook.TenantID))
+	return err
+}
+
+func (c *Caching) DeleteWebhook(ctx context.Context, tenantID, webhookID uint64) error {
+	err := c.next.DeleteWebhook(ctx, tenantID, webhookID)
+	c.cache.Invalidate(ctx, cache.TenantTag(tenantID))
+	return err
+}
+
+// The delivery log is not cached as the admins follow it to check the
+// outcome of the deliveries and of their replays.
+func (c *Caching) ListWebhookDeliveries(ctx context.Context, tenantID, webhookID uint64, pagination common_model.Pagination) ([]*model.WebhookDelivery, error) {
+	return c.next.ListWebhookDeliveries(ctx, tenantID, webhookID, pagination)
+}
+
+func (c *Caching) ReplayWebhookDelivery(ctx context.Context, tenantID, webhookID, deliveryID uint64) (uint64, error) {
+	return c.next.ReplayWebhookDelivery(ctx, tenantID, webhookID, deliveryID)
+}
+
+func (c *Caching) PublishEvent(ctx context.Context, event *model.Event) error {
+	return c.next.PublishEvent(ctx, event)
+}
+
+func (c *Caching) DeliverWebhooks(ctx context.Context) error {
+	return c.next.DeliverWebhooks(ctx)
+}
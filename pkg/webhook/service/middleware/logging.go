@@ -0,0 +1,209 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
+	common_model "github.com/CHORUS-TRE/chorus-backend/pkg/common/model"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/webhook/model"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/webhook/service"
+
+	"go.uber.org/zap"
+)
+
+type webhookServiceLogging struct {
+	logger *logger.ContextLogger
+	next   service.Webhooker
+}
+
+func Logging(logger *logger.ContextLogger) func(service.Webhooker) service.Webhooker {
+	return func(next service.Webhooker) service.Webhooker {
+		return &webhookServiceLogging{
+			logger: logger,
+			next:   next,
+		}
+	}
+}
+
+func (c webhookServiceLogging) ListWebhooks(ctx context.Context, tenantID uint64) ([]*model.Webhook, error) {
+	now := time.Now()
+
+	res, err := c.next.ListWebhooks(ctx, tenantID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, fmt.Errorf("unable to get webhooks: %w", err)
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		logger.WithCountField(len(res)),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, nil
+}
+
+func (c webhookServiceLogging) GetWebhook(ctx context.Context, tenantID, webhookID uint64) (*model.Webhook, error) {
+	now := time.Now()
+
+	res, err := c.next.GetWebhook(ctx, tenantID, webhookID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			logger.WithWebhookIDField(webhookID),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, fmt.Errorf("unable to get webhook: %w", err)
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		logger.WithWebhookIDField(webhookID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, nil
+}
+
+func (c webhookServiceLogging) CreateWebhook(ctx context.Context, webhook *model.Webhook) (uint64, string, error) {
+	now := time.Now()
+
+	res, secret, err := c.next.CreateWebhook(ctx, webhook)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return 0, "", fmt.Errorf("unable to create webhook: %w", err)
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		logger.WithWebhookIDField(res),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, secret, nil
+}
+
+func (c webhookServiceLogging) UpdateWebhook(ctx context.Context, webhook *model.Webhook) error {
+	now := time.Now()
+
+	err := c.next.UpdateWebhook(ctx, webhook)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			logger.WithWebhookIDField(webhook.ID),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return fmt.Errorf("unable to update webhook: %w", err)
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		logger.WithWebhookIDField(webhook.ID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return nil
+}
+
+func (c webhookServiceLogging) DeleteWebhook(ctx context.Context, tenantID, webhookID uint64) error {
+	now := time.Now()
+
+	err := c.next.DeleteWebhook(ctx, tenantID, webhookID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			logger.WithWebhookIDField(webhookID),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return fmt.Errorf("unable to delete webhook: %w", err)
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		logger.WithWebhookIDField(webhookID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return nil
+}
+
+func (c webhookServiceLogging) ListWebhookDeliveries(ctx context.Context, tenantID, webhookID uint64, pagination common_model.Pagination) ([]*model.WebhookDelivery, error) {
+	now := time.Now()
+
+	res, err := c.next.ListWebhookDeliveries(ctx, tenantID, webhookID, pagination)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			logger.WithWebhookIDField(webhookID),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, fmt.Errorf("unable to get webhook deliveries: %w", err)
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		logger.WithWebhookIDField(webhookID),
+		logger.WithCountField(len(res)),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, nil
+}
+
+func (c webhookServiceLogging) ReplayWebhookDelivery(ctx context.Context, tenantID, webhookID, deliveryID uint64) (uint64, error) {
+	now := time.Now()
+
+	res, err := c.next.ReplayWebhookDelivery(ctx, tenantID, webhookID, deliveryID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			logger.WithWebhookIDField(webhookID),
+			zap.Uint64("delivery_id", deliveryID),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return 0, fmt.Errorf("unable to replay webhook delivery: %w", err)
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		logger.WithWebhookIDField(webhookID),
+		zap.Uint64("delivery_id", res),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, nil
+}
+
+func (c webhookServiceLogging) PublishEvent(ctx context.Context, event *model.Event) error {
+	now := time.Now()
+
+	err := c.next.PublishEvent(ctx, event)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			zap.String("event_id", event.ID),
+			zap.String("event_type", event.Type.String()),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return fmt.Errorf("unable to publish event: %w", err)
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		zap.String("event_id", event.ID),
+		zap.String("event_type", event.Type.String()),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return nil
+}
+
+func (c webhookServiceLogging) DeliverWebhooks(ctx context.Context) error {
+	now := time.Now()
+
+	err := c.next.DeliverWebhooks(ctx)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return fmt.Errorf("unable to deliver webhooks: %w", err)
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return nil
+}
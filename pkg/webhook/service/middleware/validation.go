@@ -0,0 +1,67 @@
+package middleware
+
+import (
+	"context"
+
+	common_model "github.com/CHORUS-TRE/chorus-backend/pkg/common/model"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/webhook/model"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/webhook/service"
+
+	val "github.com/go-playground/validator/v10"
+)
+
+type validation struct {
+	next     service.Webhooker
+	validate *val.Validate
+}
+
+func Validation(validate *val.Validate) func(service.Webhooker) service.Webhooker {
+	return func(next service.Webhooker) service.Webhooker {
+		return &validation{
+			next:     next,
+			validate: validate,
+		}
+	}
+}
+
+func (v validation) ListWebhooks(ctx context.Context, tenantID uint64) ([]*model.Webhook, error) {
+	return v.next.ListWebhooks(ctx, tenantID)
+}
+
+func (v validation) GetWebhook(ctx context.Context, tenantID, webhookID uint64) (*model.Webhook, error) {
+	return v.next.GetWebhook(ctx, tenantID, webhookID)
+}
+
+func (v validation) CreateWebhook(ctx context.Context, webhook *model.Webhook) (uint64, string, error) {
+	if err := v.validate.Struct(webhook); err != nil {
+		return 0, "", err
+	}
+	return v.next.CreateWebhook(ctx, webhook)
+}
+
+func (v validation) UpdateWebhook(ctx context.Context, webhook *model.Webhook) error {
+	if err := v.validate.Struct(webhook); err != nil {
+		return err
+	}
+	return v.next.UpdateWebhook(ctx, webhook)
+}
+
+func (v validation) DeleteWebhook(ctx context.Context, tenantID, webhookID uint64) error {
+	return v.next.DeleteWebhook(ctx, tenantID, webhookID)
+}
+
+func (v validation) ListWebhookDeliveries(ctx context.Context, tenantID, webhookID uint64, pagination common_model.Pagination) ([]*model.WebhookDelivery, error) {
+	return v.next.ListWebhookDeliveries(ctx, tenantID, webhookID, pagination)
+}
+
+func (v validation) ReplayWebhookDelivery(ctx context.Context, tenantID, webhookID, deliveryID uint64) (uint64, error) {
+	return v.next.ReplayWebhookDelivery(ctx, tenantID, webhookID, deliveryID)
+}
+
+func (v validation) PublishEvent(ctx context.Context, event *model.Event) error {
+	return v.next.PublishEvent(ctx, event)
+}
+
+func (v validation) DeliverWebhooks(ctx context.Context) error {
+	return v.next.DeliverWebhooks(ctx)
+}
@@ -0,0 +1,202 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/config"
+	"github.com/CHORUS-TRE/chorus-backend/internal/utils/crypto"
+	common_model "github.com/CHORUS-TRE/chorus-backend/pkg/common/model"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/common/service"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/webhook/model"
+)
+
+const (
+	webhookSecretSize     = 32
+	defaultWebhookTimeout = 10 * time.Second
+)
+
+type Webhooker interface {
+	ListWebhooks(ctx context.Context, tenantID uint64) ([]*model.Webhook, error)
+	GetWebhook(ctx context.Context, tenantID, webhookID uint64) (*model.Webhook, error)
+	CreateWebhook(ctx context.Context, webhook *model.Webhook) (uint64, string, error)
+	UpdateWebhook(ctx context.Context, webhook *model.Webhook) error
+	DeleteWebhook(ctx context.Context, tenantID, webhookID uint64) error
+
+	ListWebhookDeliveries(ctx context.Context, tenantID, webhookID uint64, pagination common_model.Pagination) ([]*model.WebhookDelivery, error)
+	ReplayWebhookDelivery(ctx context.Context, tenantID, webhookID, deliveryID uint64) (uint64, error)
+
+	PublishEvent(ctx context.Context, event *model.Event) error
+	DeliverWebhooks(ctx context.Context) error
+}
+
+type WebhookStore interface {
+	ListWebhooks(ctx context.Context, tenantID uint64) ([]*model.Webhook, error)
+	GetWebhook(ctx context.Context, tenantID, webhookID uint64) (*model.Webhook, error)
+	CreateWebhook(ctx context.Context, tenantID uint64, webhook *model.Webhook) (uint64, error)
+	UpdateWebhook(ctx context.Context, tenantID uint64, webhook *model.Webhook) error
+	DeleteWebhook(ctx context.Context, tenantID, webhookID uint64) error
+
+	EnqueueEvent(ctx context.Context, event *model.Event, payload []byte) (int64, error)
+	ListWebhookDeliveries(ctx context.Context, tenantID, webhookID uint64, pagination common_model.Pagination) ([]*model.WebhookDelivery, error)
+	ReplayWebhookDelivery(ctx context.Context, tenantID, webhookID, deliveryID uint64) (uint64, error)
+	ClaimWebhookDeliveries(ctx context.Context, limit int, lease time.Duration) ([]*model.WebhookDelivery, error)
+	UpdateWebhookDelivery(ctx context.Context, delivery *model.WebhookDelivery) error
+}
+
+type WebhookService struct {
+	cfg                 config.Config
+	store               WebhookStore
+	daemonEncryptionKey *crypto.Secret
+	timeout             time.Duration
+	client              *http.Client
+}
+
+func NewWebhookService(cfg config.Config, store WebhookStore, daemonEncryptionKey *crypto.Secret) *WebhookService {
+	timeout := cfg.Services.WebhookService.Delivery.Timeout
+	if timeout <= 0 {
+		timeout = defaultWebhookTimeout
+	}
+
+	return &WebhookService{
+		cfg:                 cfg,
+		store:               store,
+		daemonEncryptionKey: daemonEncryptionKey,
+		timeout:             timeout,
+		client:              newDeliveryClient(timeout),
+	}
+}
+
+func (s *WebhookService) ListWebhooks(ctx context.Context, tenantID uint64) ([]*model.Webhook, error) {
+	webhooks, err := s.store.ListWebhooks(ctx, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query webhooks: %w", err)
+	}
+	for _, w := range webhooks {
+		w.Secret = ""
+	}
+	return webhooks, nil
+}
+
+func (s *WebhookService) GetWebhook(ctx context.Context, tenantID, webhookID uint64) (*model.Webhook, error) {
+	webhook, err := s.store.GetWebhook(ctx, tenantID, webhookID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get webhook %v: %w", webhookID, err)
+	}
+	webhook.Secret = ""
+	return webhook, nil
+}
+
+// CreateWebhook creates a webhook with a new secret, which is returned in
+// plain text as it cannot be read afterwards.
+func (s *WebhookService) CreateWebhook(ctx context.Context, webhook *model.Webhook) (uint64, string, error) {
+	if err := checkWebhook(ctx, webhook); err != nil {
+		return 0, "", err
+	}
+
+	secret, err := generateSecret()
+	if err != nil {
+		return 0, "", fmt.Errorf("unable to generate webhook secret: %w", err)
+	}
+
+	key, err := s.daemonEncryptionKey.Get()
+	if err != nil {
+		return 0, "", fmt.Errorf("unable to get encryption key: %w", err)
+	}
+	defer crypto.Zero(key)
+
+	webhook.Secret, err = crypto.EncryptToString([]byte(secret), key)
+	if err != nil {
+		return 0, "", fmt.Errorf("unable to encrypt webhook secret: %w", err)
+	}
+
+	id, err := s.store.CreateWebhook(ctx, webhook.TenantID, webhook)
+	if err != nil {
+		return 0, "", fmt.Errorf("unable to create webhook: %w", err)
+	}
+	return id, secret, nil
+}
+
+func (s *WebhookService) UpdateWebhook(ctx context.Context, webhook *model.Webhook) error {
+	if err := checkWebhook(ctx, webhook); err != nil {
+		return err
+	}
+
+	if err := s.store.UpdateWebhook(ctx, webhook.TenantID, webhook); err != nil {
+		return fmt.Errorf("unable to update webhook %v: %w", webhook.ID, err)
+	}
+	return nil
+}
+
+func (s *WebhookService) DeleteWebhook(ctx context.Context, tenantID, webhookID uint64) error {
+	if err := s.store.DeleteWebhook(ctx, tenantID, webhookID); err != nil {
+		return fmt.Errorf("unable to delete webhook %v: %w", webhookID, err)
+	}
+	return nil
+}
+
+func (s *WebhookService) ListWebhookDeliveries(ctx context.Context, tenantID, webhookID uint64, pagination common_model.Pagination) ([]*model.WebhookDelivery, error) {
+	deliveries, err := s.store.ListWebhookDeliveries(ctx, tenantID, webhookID, pagination)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query deliveries of webhook %v: %w", webhookID, err)
+	}
+	return deliveries, nil
+}
+
+// ReplayWebhookDelivery posts the event of a logged delivery again, as a new
+// delivery whose ID is returned.
+func (s *WebhookService) ReplayWebhookDelivery(ctx context.Context, tenantID, webhookID, deliveryID uint64) (uint64, error) {
+	id, err := s.store.ReplayWebhookDelivery(ctx, tenantID, webhookID, deliveryID)
+	if err != nil {
+		return 0, fmt.Errorf("unable to replay delivery %v: %w", deliveryID, err)
+	}
+	return id, nil
+}
+
+// PublishEvent queues the delivery of the event to the webhooks of its
+// tenant subscribed to its type.
+func (s *WebhookService) PublishEvent(ctx context.Context, event *model.Event) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("unable to encode event %v: %w", event.ID, err)
+	}
+
+	if _, err := s.store.EnqueueEvent(ctx, event, payload); err != nil {
+		return fmt.Errorf("unable to enqueue event %v: %w", event.ID, err)
+	}
+	return nil
+}
+
+// checkWebhook checks the event types and that the URL is an absolute HTTP
+// one.
+func checkWebhook(ctx context.Context, webhook *model.Webhook) error {
+	for _, t := range webhook.EventTypes {
+		if _, err := model.ToEventType(t.String()); err != nil {
+			return fmt.Errorf("%v: %w", err.Error(), &service.InvalidParametersErr{})
+		}
+	}
+
+	u, err := url.Parse(webhook.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("webhook url must be an absolute http or https url: %w", &service.InvalidParametersErr{})
+	}
+	if err := checkHost(ctx, net.DefaultResolver, u.Hostname()); err != nil {
+		return fmt.Errorf("webhook url must reach a public address: %v: %w", err.Error(), &service.InvalidParametersErr{})
+	}
+	return nil
+}
+
+func generateSecret() (string, error) {
+	b := make([]byte, webhookSecretSize)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
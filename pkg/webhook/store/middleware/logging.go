@@ -0,0 +1,238 @@
+package middleware
+
+import (
+	"context"
+	"time"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
+	common_model "github.com/CHORUS-TRE/chorus-backend/pkg/common/model"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/webhook/model"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/webhook/service"
+
+	"go.uber.org/zap"
+)
+
+type webhookStorageLogging struct {
+	logger *logger.ContextLogger
+	next   service.WebhookStore
+}
+
+func Logging(logger *logger.ContextLogger) func(service.WebhookStore) service.WebhookStore {
+	return func(next service.WebhookStore) service.WebhookStore {
+		return &webhookStorageLogging{
+			logger: logger,
+			next:   next,
+		}
+	}
+}
+
+func (c webhookStorageLogging) ListWebhooks(ctx context.Context, tenantID uint64) ([]*model.Webhook, error) {
+	c.logger.Debug(ctx, "request started")
+
+	now := time.Now()
+
+	res, err := c.next.ListWebhooks(ctx, tenantID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		logger.WithCountField(len(res)),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, nil
+}
+
+func (c webhookStorageLogging) GetWebhook(ctx context.Context, tenantID, webhookID uint64) (*model.Webhook, error) {
+	c.logger.Debug(ctx, "request started")
+
+	now := time.Now()
+
+	res, err := c.next.GetWebhook(ctx, tenantID, webhookID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		logger.WithWebhookIDField(webhookID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, nil
+}
+
+func (c webhookStorageLogging) CreateWebhook(ctx context.Context, tenantID uint64, webhook *model.Webhook) (uint64, error) {
+	c.logger.Debug(ctx, "request started")
+
+	now := time.Now()
+
+	res, err := c.next.CreateWebhook(ctx, tenantID, webhook)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return 0, err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		logger.WithWebhookIDField(res),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, nil
+}
+
+func (c webhookStorageLogging) UpdateWebhook(ctx context.Context, tenantID uint64, webhook *model.Webhook) error {
+	c.logger.Debug(ctx, "request started")
+
+	now := time.Now()
+
+	err := c.next.UpdateWebhook(ctx, tenantID, webhook)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		logger.WithWebhookIDField(webhook.ID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return nil
+}
+
+func (c webhookStorageLogging) DeleteWebhook(ctx context.Context, tenantID, webhookID uint64) error {
+	c.logger.Debug(ctx, "request started")
+
+	now := time.Now()
+
+	err := c.next.DeleteWebhook(ctx, tenantID, webhookID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		logger.WithWebhookIDField(webhookID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return nil
+}
+
+func (c webhookStorageLogging) EnqueueEvent(ctx context.Context, event *model.Event, payload []byte) (int64, error) {
+	c.logger.Debug(ctx, "request started")
+
+	now := time.Now()
+
+	res, err := c.next.EnqueueEvent(ctx, event, payload)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return 0, err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		zap.String("event_id", event.ID),
+		zap.Int64("deliveries", res),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, nil
+}
+
+func (c webhookStorageLogging) ListWebhookDeliveries(ctx context.Context, tenantID, webhookID uint64, pagination common_model.Pagination) ([]*model.WebhookDelivery, error) {
+	c.logger.Debug(ctx, "request started")
+
+	now := time.Now()
+
+	res, err := c.next.ListWebhookDeliveries(ctx, tenantID, webhookID, pagination)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		logger.WithCountField(len(res)),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, nil
+}
+
+func (c webhookStorageLogging) ReplayWebhookDelivery(ctx context.Context, tenantID, webhookID, deliveryID uint64) (uint64, error) {
+	c.logger.Debug(ctx, "request started")
+
+	now := time.Now()
+
+	res, err := c.next.ReplayWebhookDelivery(ctx, tenantID, webhookID, deliveryID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return 0, err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		zap.Uint64("delivery_id", res),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, nil
+}
+
+func (c webhookStorageLogging) ClaimWebhookDeliveries(ctx context.Context, limit int, lease time.Duration) ([]*model.WebhookDelivery, error) {
+	c.logger.Debug(ctx, "request started")
+
+	now := time.Now()
+
+	res, err := c.next.ClaimWebhookDeliveries(ctx, limit, lease)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		logger.WithCountField(len(res)),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, nil
+}
+
+func (c webhookStorageLogging) UpdateWebhookDelivery(ctx context.Context, delivery *model.WebhookDelivery) error {
+	c.logger.Debug(ctx, "request started")
+
+	now := time.Now()
+
+	err := c.next.UpdateWebhookDelivery(ctx, delivery)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		zap.Uint64("delivery_id", delivery.ID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return nil
+}
@@ -0,0 +1,213 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/utils/database"
+	common_model "github.com/CHORUS-TRE/chorus-backend/pkg/common/model"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/webhook/model"
+)
+
+// WebhookStorage is the handler through which a PostgresDB backend can be queried.
+type WebhookStorage struct {
+	db *sqlx.DB
+}
+
+// NewWebhookStorage returns a fresh webhook service storage instance.
+func NewWebhookStorage(db *sqlx.DB) *WebhookStorage {
+	return &WebhookStorage{db: db}
+}
+
+func (s *WebhookStorage) ListWebhooks(ctx context.Context, tenantID uint64) ([]*model.Webhook, error) {
+	const query = `
+SELECT id, tenantid, url, eventtypes, secret, enabled, createdat, updatedat
+	FROM webhooks
+WHERE tenantid = $1
+ORDER BY id;
+`
+	var webhooks []*model.Webhook
+	if err := s.db.SelectContext(ctx, &webhooks, query, tenantID); err != nil {
+		return nil, err
+	}
+
+	return webhooks, nil
+}
+
+func (s *WebhookStorage) GetWebhook(ctx context.Context, tenantID, webhookID uint64) (*model.Webhook, error) {
+	const query = `
+SELECT id, tenantid, url, eventtypes, secret, enabled, createdat, updatedat
+	FROM webhooks
+WHERE tenantid = $1 AND id = $2;
+`
+	var webhook model.Webhook
+	if err := s.db.GetContext(ctx, &webhook, query, tenantID, webhookID); err != nil {
+		return nil, err
+	}
+
+	return &webhook, nil
+}
+
+func (s *WebhookStorage) CreateWebhook(ctx context.Context, tenantID uint64, webhook *model.Webhook) (uint64, error) {
+	const query = `
+INSERT INTO webhooks (tenantid, url, eventtypes, secret, enabled, createdat, updatedat)
+VALUES ($1, $2, $3, $4, $5, NOW(), NOW())
+RETURNING id;
+`
+	var id uint64
+	if err := s.db.GetContext(ctx, &id, query, tenantID, webhook.URL, webhook.EventTypes, webhook.Secret, webhook.Enabled); err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// UpdateWebhook updates the URL, the event types and the state of a webhook,
+// its secret being kept.
+func (s *WebhookStorage) UpdateWebhook(ctx context.Context, tenantID uint64, webhook *model.Webhook) error {
+	const query = `
+UPDATE webhooks
+SET url = $3, eventtypes = $4, enabled = $5, updatedat = NOW()
+WHERE tenantid = $1 AND id = $2;
+`
+	rows, err := s.db.ExecContext(ctx, query, tenantID, webhook.ID, webhook.URL, webhook.EventTypes, webhook.Enabled)
+	if err != nil {
+		return err
+	}
+	affected, err := rows.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return database.ErrNoRowsUpdated
+	}
+
+	return nil
+}
+
+// DeleteWebhook deletes a webhook along with its delivery log.
+func (s *WebhookStorage) DeleteWebhook(ctx context.Context, tenantID, webhookID uint64) error {
+	const query = `
+DELETE FROM webhooks WHERE tenantid = $1 AND id = $2;
+`
+	rows, err := s.db.ExecContext(ctx, query, tenantID, webhookID)
+	if err != nil {
+		return err
+	}
+	affected, err := rows.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return database.ErrNoRowsDeleted
+	}
+
+	return nil
+}
+
+// EnqueueEvent queues the delivery of an event to each enabled webhook of the
+// tenant subscribed to its type, and returns the number of queued deliveries.
+func (s *WebhookStorage) EnqueueEvent(ctx context.Context, event *model.Event, payload []byte) (int64, error) {
+	const query = `
+INSERT INTO webhook_deliveries (tenantid, webhookid, eventid, eventtype, payload, status, nextattemptat)
+SELECT w.tenantid, w.id, $2, $3, $4, 'pending', NOW()
+FROM webhooks w
+WHERE w.tenantid = $1 AND w.enabled AND w.eventtypes @> jsonb_build_array($3::TEXT);
+`
+	rows, err := s.db.ExecContext(ctx, query, event.TenantID, event.ID, event.Type, string(payload))
+	if err != nil {
+		return 0, err
+	}
+
+	return rows.RowsAffected()
+}
+
+// ListWebhookDeliveries returns the delivery log of a webhook, the latest
+// deliveries first.
+func (s *WebhookStorage) ListWebhookDeliveries(ctx context.Context, tenantID, webhookID uint64, pagination common_model.Pagination) ([]*model.WebhookDelivery, error) {
+	const query = `
+SELECT id, tenantid, webhookid, eventid, eventtype, payload, status, attempts, responsecode, lasterror,
+	nextattemptat, deliveredat, createdat, updatedat
+	FROM webhook_deliveries
+WHERE tenantid = $1 AND webhookid = $2
+ORDER BY createdat DESC, id DESC
+LIMIT NULLIF($3::BIGINT, 0) OFFSET $4;
+`
+	var deliveries []*model.WebhookDelivery
+	if err := s.db.SelectContext(ctx, &deliveries, query, tenantID, webhookID, pagination.Limit, pagination.Offset); err != nil {
+		return nil, err
+	}
+
+	return deliveries, nil
+}
+
+// ReplayWebhookDelivery queues a new delivery of the event of a logged
+// delivery and returns its ID.
+func (s *WebhookStorage) ReplayWebhookDelivery(ctx context.Context, tenantID, webhookID, deliveryID uint64) (uint64, error) {
+	const query = `
+INSERT INTO webhook_deliveries (tenantid, webhookid, eventid, eventtype, payload, status, nextattemptat)
+SELECT tenantid, webhookid, eventid, eventtype, payload, 'pending', NOW()
+FROM webhook_deliveries
+WHERE tenantid = $1 AND webhookid = $2 AND id = $3
+RETURNING id;
+`
+	var id uint64
+	if err := s.db.GetContext(ctx, &id, query, tenantID, webhookID, deliveryID); err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// ClaimWebhookDeliveries returns up to limit pending deliveries to enabled
+// webhooks that are due, and postpones them by lease so that no other replica
+// posts them meanwhile.
+func (s *WebhookStorage) ClaimWebhookDeliveries(ctx context.Context, limit int, lease time.Duration) ([]*model.WebhookDelivery, error) {
+	const query = `
+WITH claimed AS (
+	UPDATE webhook_deliveries d
+	SET attempts = d.attempts + 1, nextattemptat = NOW() + $2::DOUBLE PRECISION * INTERVAL '1 second', updatedat = NOW()
+	WHERE d.id IN (
+		SELECT wd.id FROM webhook_deliveries wd
+		JOIN webhooks w ON w.id = wd.webhookid
+		WHERE wd.status = 'pending' AND wd.nextattemptat <= NOW() AND w.enabled
+		ORDER BY wd.nextattemptat
+		LIMIT $1
+		FOR UPDATE OF wd SKIP LOCKED
+	)
+	RETURNING d.*
+)
+SELECT c.id, c.tenantid, c.webhookid, c.eventid, c.eventtype, c.payload, c.status, c.attempts, c.responsecode,
+	c.lasterror, c.nextattemptat, c.deliveredat, c.createdat, c.updatedat, w.url, w.secret
+FROM claimed c
+JOIN webhooks w ON w.id = c.webhookid
+ORDER BY c.createdat, c.id;
+`
+	var deliveries []*model.WebhookDelivery
+	if err := s.db.SelectContext(ctx, &deliveries, query, limit, lease.Seconds()); err != nil {
+		return nil, err
+	}
+
+	return deliveries, nil
+}
+
+func (s *WebhookStorage) UpdateWebhookDelivery(ctx context.Context, delivery *model.WebhookDelivery) error {
+	const query = `
+UPDATE webhook_deliveries
+SET status = $2, responsecode = $3, lasterror = $4, nextattemptat = $5, deliveredat = $6, updatedat = NOW()
+WHERE id = $1;
+`
+	_, err := s.db.ExecContext(ctx, query,
+		delivery.ID, delivery.Status, delivery.ResponseCode, delivery.LastError, delivery.NextAttemptAt, delivery.DeliveredAt,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to update delivery %v: %w", delivery.ID, err)
+	}
+
+	return nil
+}
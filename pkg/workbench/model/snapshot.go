@@ -0,0 +1,109 @@
+package model
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// WorkbenchSnapshot maps an entry in the 'workbench_snapshots' database
+// table. It records a workbench along with its apps and the snapshots of its
+// volumes, from which a new workbench is restored.
+type WorkbenchSnapshot struct {
+	ID uint64
+
+	TenantID    uint64
+	UserID      uint64
+	WorkspaceID uint64
+	WorkbenchID uint64
+
+	Name        string
+	Description string
+
+	Status  SnapshotStatus
+	Content SnapshotContent
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// SnapshotStatus represents the status of a workbench snapshot.
+type SnapshotStatus string
+
+const (
+	SnapshotCreating SnapshotStatus = "creating"
+	SnapshotReady    SnapshotStatus = "ready"
+	SnapshotFailed   SnapshotStatus = "failed"
+)
+
+func (s SnapshotStatus) String() string {
+	return string(s)
+}
+
+func ToSnapshotStatus(status string) (SnapshotStatus, error) {
+	switch status {
+	case SnapshotCreating.String():
+		return SnapshotCreating, nil
+	case SnapshotReady.String():
+		return SnapshotReady, nil
+	case SnapshotFailed.String():
+		return SnapshotFailed, nil
+	default:
+		return "", fmt.Errorf("unexpected SnapshotStatus: %s", status)
+	}
+}
+
+// SnapshotContent is what a snapshot records of a workbench, stored as JSON
+// in the 'content' column of the 'workbench_snapshots' table.
+type SnapshotContent struct {
+	Workbench SnapshotWorkbench `json:"workbench"`
+	Apps      []SnapshotApp     `json:"apps"`
+	Volumes   []SnapshotVolume  `json:"volumes"`
+}
+
+// SnapshotWorkbench is the configuration of the snapshotted workbench.
+type SnapshotWorkbench struct {
+	Name        string `json:"name"`
+	ShortName   string `json:"shortName"`
+	Description string `json:"description"`
+}
+
+// SnapshotApp is an app that was running in the snapshotted workbench.
+type SnapshotApp struct {
+	AppID uint64 `json:"appId"`
+	Name  string `json:"name"`
+	// AppVersionID is the version the app is restored with, the default
+	// version of the app for the snapshots taken before the apps had versions.
+	AppVersionID uint64 `json:"appVersionId,omitempty"`
+}
+
+// SnapshotVolume is a volume mounted into the apps of the snapshotted
+// workbench, along with the snapshot of its content.
+type SnapshotVolume struct {
+	Name      string            `json:"name"`
+	MountPath string            `json:"mountPath"`
+	Size      string            `json:"size"`
+	Labels    map[string]string `json:"labels,omitempty"`
+	// Snapshot is the name of the snapshot in the runtime, empty until it
+	// is taken.
+	Snapshot string `json:"snapshot,omitempty"`
+}
+
+func (c SnapshotContent) Value() (driver.Value, error) {
+	return json.Marshal(c)
+}
+
+func (c *SnapshotContent) Scan(src interface{}) error {
+	var b []byte
+	switch v := src.(type) {
+	case []byte:
+		b = v
+	case string:
+		b = []byte(v)
+	default:
+		return errors.New("unexpected type for snapshot content")
+	}
+	return json.Unmarshal(b, c)
+}
@@ -2,6 +2,7 @@ package model
 
 import (
 	"fmt"
+	"strconv"
 	"time"
 )
 
@@ -18,6 +19,9 @@ type Workbench struct {
 	Description string
 
 	Status WorkbenchStatus
+	// StartingSince is when the server of the workbench was deployed, until
+	// it is ready or failed to start.
+	StartingSince *time.Time
 
 	CreatedAt time.Time
 	UpdatedAt time.Time
@@ -49,3 +53,29 @@ func ToWorkbenchStatus(status string) (WorkbenchStatus, error) {
 		return "", fmt.Errorf("unexpected WorkbenchStatus: %s", status)
 	}
 }
+
+// WorkbenchFilter restricts the workbenchs returned by a listing.
+// Empty fields are not filtered on.
+type WorkbenchFilter struct {
+	Status  WorkbenchStatus
+	OwnerID uint64
+}
+
+var WorkbenchSortTypeToString = map[string]string{
+	"ID":        "id",
+	"NAME":      "name",
+	"CREATEDAT": "createdat",
+}
+
+// SortValue returns the value of the column matching sortType, used as a
+// pagination keyset.
+func (w *Workbench) SortValue(sortType string) string {
+	switch sortType {
+	case "NAME":
+		return w.Name
+	case "CREATEDAT":
+		return w.CreatedAt.Format(time.RFC3339Nano)
+	default:
+		return strconv.FormatUint(w.ID, 10)
+	}
+}
@@ -3,26 +3,26 @@ package middleware
 import (
 	"context"
 	"net/http"
+	"time"
 
+	"github.com/CHORUS-TRE/chorus-backend/internal/client/runtime"
 	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
 	"github.com/CHORUS-TRE/chorus-backend/internal/utils/cache"
+	"github.com/CHORUS-TRE/chorus-backend/internal/utils/pagination"
 	common_model "github.com/CHORUS-TRE/chorus-backend/pkg/common/model"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/workbench/model"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/workbench/service"
-
-	"github.com/coocood/freecache"
 )
 
 const (
-	workbenchCacheSize     = 100 * 1024 * 1024 // Max 100MiB stored in memory
 	defaultCacheExpiration = 5
 	longCacheExpiration    = 60
 )
 
-func WorkbenchCaching(log *logger.ContextLogger) func(service.Workbencher) *Caching {
+func WorkbenchCaching(log *logger.ContextLogger, backend cache.Backend) func(service.Workbencher) *Caching {
 	return func(next service.Workbencher) *Caching {
 		return &Caching{
-			cache: cache.NewCache(freecache.NewCache(workbenchCacheSize), log),
+			cache: cache.NewCache(backend, log),
 			next:  next,
 		}
 	}
@@ -33,14 +33,15 @@ type Caching struct {
 	next  service.Workbencher
 }
 
-func (c *Caching) ListWorkbenchs(ctx context.Context, tenantID uint64, pagination common_model.Pagination) (reply []*model.Workbench, err error) {
-	entry := c.cache.NewEntry(cache.WithUint64(tenantID), cache.WithInterface(pagination))
+func (c *Caching) ListWorkbenchs(ctx context.Context, req service.ListWorkbenchsReq) (reply []*model.Workbench, cursor *pagination.ResponseCursor[pagination.KeysetCursor], count uint64, err error) {
+	entry := c.cache.NewEntry(cache.WithInterface(req)).WithTags(cache.TenantTag(req.TenantID))
 	reply = []*model.Workbench{}
+	cursor = &pagination.ResponseCursor[pagination.KeysetCursor]{}
 
-	if ok := entry.Get(ctx, &reply); !ok {
-		reply, err = c.next.ListWorkbenchs(ctx, tenantID, pagination)
+	if ok := entry.Get(ctx, &reply, &cursor, &count); !ok {
+		reply, cursor, count, err = c.next.ListWorkbenchs(ctx, req)
 		if err == nil {
-			entry.Set(ctx, defaultCacheExpiration, reply)
+			entry.Set(ctx, defaultCacheExpiration, reply, cursor, count)
 		}
 	}
 
@@ -48,7 +49,7 @@ func (c *Caching) ListWorkbenchs(ctx context.Context, tenantID uint64, paginatio
 }
 
 func (c *Caching) GetWorkbench(ctx context.Context, tenantID, workbenchID uint64) (reply *model.Workbench, err error) {
-	entry := c.cache.NewEntry(cache.WithUint64(tenantID), cache.WithUint64(workbenchID))
+	entry := c.cache.NewEntry(cache.WithUint64(tenantID), cache.WithUint64(workbenchID)).WithTags(cache.TenantTag(tenantID))
 	reply = &model.Workbench{}
 
 	if ok := entry.Get(ctx, &reply); !ok {
@@ -61,19 +62,92 @@ func (c *Caching) GetWorkbench(ctx context.Context, tenantID, workbenchID uint64
 	return
 }
 
+// GetWorkbenchRuntime is not cached as it reports the live state of the
+// runtime.
+func (c *Caching) GetWorkbenchRuntime(ctx context.Context, tenantID, userID, workbenchID uint64) (*runtime.Details, error) {
+	return c.next.GetWorkbenchRuntime(ctx, tenantID, userID, workbenchID)
+}
+
 func (c *Caching) ProxyWorkbench(ctx context.Context, tenantID, workbenchID uint64, w http.ResponseWriter, r *http.Request) error {
 	return c.next.ProxyWorkbench(ctx, tenantID, workbenchID, w, r)
 
 }
 
+// ListWorkbenchVolumes is not cached as it reports the live usage of the volumes.
+func (c *Caching) ListWorkbenchVolumes(ctx context.Context, tenantID, workbenchID uint64) ([]runtime.MountedVolume, error) {
+	return c.next.ListWorkbenchVolumes(ctx, tenantID, workbenchID)
+}
+
 func (c *Caching) DeleteWorkbench(ctx context.Context, tenantID, workbenchID uint64) error {
-	return c.next.DeleteWorkbench(ctx, tenantID, workbenchID)
+	err := c.next.DeleteWorkbench(ctx, tenantID, workbenchID)
+	c.cache.Invalidate(ctx, cache.TenantTag(tenantID))
+	return err
+}
+
+func (c *Caching) StreamWorkbenchLogs(ctx context.Context, req service.StreamWorkbenchLogsReq, send func(line string) error) error {
+	return c.next.StreamWorkbenchLogs(ctx, req, send)
+}
+
+func (c *Caching) RestoreWorkbench(ctx context.Context, tenantID, workbenchID uint64) error {
+	err := c.next.RestoreWorkbench(ctx, tenantID, workbenchID)
+	c.cache.Invalidate(ctx, cache.TenantTag(tenantID))
+	return err
+}
+
+func (c *Caching) PurgeWorkbenchs(ctx context.Context, deletedBefore time.Time) (common_model.Purged, error) {
+	purged, err := c.next.PurgeWorkbenchs(ctx, deletedBefore)
+	for tenantID := range purged {
+		c.cache.Invalidate(ctx, cache.TenantTag(tenantID))
+	}
+	return purged, err
+}
+
+func (c *Caching) CheckWorkbenchsStarted(ctx context.Context) error {
+	return c.next.CheckWorkbenchsStarted(ctx)
+}
+
+func (c *Caching) CheckSnapshotsReady(ctx context.Context) error {
+	return c.next.CheckSnapshotsReady(ctx)
 }
 
 func (c *Caching) UpdateWorkbench(ctx context.Context, workbench *model.Workbench) error {
-	return c.next.UpdateWorkbench(ctx, workbench)
+	err := c.next.UpdateWorkbench(ctx, workbench)
+	c.cache.Invalidate(ctx, cache.TenantTag(workbench.TenantID))
+	return err
 }
 
 func (c *Caching) CreateWorkbench(ctx context.Context, workbench *model.Workbench) (uint64, error) {
-	return c.next.CreateWorkbench(ctx, workbench)
+	id, err := c.next.CreateWorkbench(ctx, workbench)
+	c.cache.Invalidate(ctx, cache.TenantTag(workbench.TenantID))
+	return id, err
+}
+
+func (c *Caching) StopWorkbenchs(ctx context.Context, tenantID uint64) error {
+	err := c.next.StopWorkbenchs(ctx, tenantID)
+	c.cache.Invalidate(ctx, cache.TenantTag(tenantID))
+	return err
+}
+
+func (c *Caching) SnapshotWorkbench(ctx context.Context, req service.SnapshotWorkbenchReq) (uint64, error) {
+	id, err := c.next.SnapshotWorkbench(ctx, req)
+	c.cache.Invalidate(ctx, cache.TenantTag(req.TenantID))
+	return id, err
+}
+
+func (c *Caching) RestoreWorkbenchSnapshot(ctx context.Context, tenantID, userID, snapshotID uint64) (uint64, error) {
+	id, err := c.next.RestoreWorkbenchSnapshot(ctx, tenantID, userID, snapshotID)
+	c.cache.Invalidate(ctx, cache.TenantTag(tenantID))
+	return id, err
+}
+
+// ListWorkbenchSnapshots is not cached, the status of the snapshots being
+// updated by CheckSnapshotsReady.
+func (c *Caching) ListWorkbenchSnapshots(ctx context.Context, tenantID, userID, workspaceID uint64) ([]*model.WorkbenchSnapshot, error) {
+	return c.next.ListWorkbenchSnapshots(ctx, tenantID, userID, workspaceID)
+}
+
+func (c *Caching) DeleteWorkbenchSnapshot(ctx context.Context, tenantID, userID, snapshotID uint64) error {
+	err := c.next.DeleteWorkbenchSnapshot(ctx, tenantID, userID, snapshotID)
+	c.cache.Invalidate(ctx, cache.TenantTag(tenantID))
+	return err
 }
@@ -0,0 +1,52 @@
+package middleware
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
+	"github.com/CHORUS-TRE/chorus-backend/internal/utils/cache"
+	common_model "github.com/CHORUS-TRE/chorus-backend/pkg/common/model"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/workbench/model"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/workbench/service"
+	"github.com/CHORUS-TRE/chorus-backend/tests/unit"
+)
+
+type workbencher struct {
+	service.Workbencher
+	gets int
+}
+
+func (w *workbencher) GetWorkbench(ctx context.Context, tenantID, workbenchID uint64) (*model.Workbench, error) {
+	w.gets++
+	return &model.Workbench{ID: workbenchID, TenantID: tenantID}, nil
+}
+
+func (w *workbencher) PurgeWorkbenchs(ctx context.Context, deletedBefore time.Time) (common_model.Purged, error) {
+	return common_model.Purged{1: 2}, nil
+}
+
+func TestCaching_PurgeWorkbenchs(t *testing.T) {
+	unit.InitTestLogger()
+	ctx := context.Background()
+
+	next := &workbencher{}
+	c := WorkbenchCaching(logger.TechLog, cache.NewLocalBackend(1024*1024))(next)
+
+	_, err := c.GetWorkbench(ctx, 1, 10)
+	require.NoError(t, err)
+	_, err = c.GetWorkbench(ctx, 1, 10)
+	require.NoError(t, err)
+	require.Equal(t, 1, next.gets)
+
+	purged, err := c.PurgeWorkbenchs(ctx, time.Now())
+	require.NoError(t, err)
+	require.Equal(t, int64(2), purged.Total())
+
+	_, err = c.GetWorkbench(ctx, 1, 10)
+	require.NoError(t, err)
+	require.Equal(t, 2, next.gets, "the purge invalidates the tenants of the purged workbenches")
+}
@@ -6,7 +6,9 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/CHORUS-TRE/chorus-backend/internal/client/runtime"
 	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
+	"github.com/CHORUS-TRE/chorus-backend/internal/utils/pagination"
 	common_model "github.com/CHORUS-TRE/chorus-backend/pkg/common/model"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/workbench/model"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/workbench/service"
@@ -28,23 +30,24 @@ func Logging(logger *logger.ContextLogger) func(service.Workbencher) service.Wor
 	}
 }
 
-func (c workbenchServiceLogging) ListWorkbenchs(ctx context.Context, tenantID uint64, pagination common_model.Pagination) ([]*model.Workbench, error) {
+func (c workbenchServiceLogging) ListWorkbenchs(ctx context.Context, req service.ListWorkbenchsReq) ([]*model.Workbench, *pagination.ResponseCursor[pagination.KeysetCursor], uint64, error) {
 	now := time.Now()
 
-	res, err := c.next.ListWorkbenchs(ctx, tenantID, pagination)
+	res, cursor, count, err := c.next.ListWorkbenchs(ctx, req)
 	if err != nil {
 		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
 			zap.Error(err),
 			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
 		)
-		return res, fmt.Errorf("unable to get workbenchs: %w", err)
+		return res, cursor, count, fmt.Errorf("unable to get workbenchs: %w", err)
 	}
 
 	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
 		zap.Int("num_workbenchs", len(res)),
+		logger.WithTotalItemsField(count),
 		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
 	)
-	return res, nil
+	return res, cursor, count, nil
 }
 
 func (c workbenchServiceLogging) ProxyWorkbench(ctx context.Context, tenantID, workbenchID uint64, w http.ResponseWriter, r *http.Request) error {
@@ -86,6 +89,48 @@ func (c workbenchServiceLogging) GetWorkbench(ctx context.Context, tenantID, wor
 	return res, nil
 }
 
+func (c workbenchServiceLogging) GetWorkbenchRuntime(ctx context.Context, tenantID, userID, workbenchID uint64) (*runtime.Details, error) {
+	now := time.Now()
+
+	res, err := c.next.GetWorkbenchRuntime(ctx, tenantID, userID, workbenchID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			logger.WithWorkbenchIDField(workbenchID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return res, fmt.Errorf("unable to get workbench runtime: %w", err)
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		logger.WithWorkbenchIDField(workbenchID),
+		zap.Int("num_pods", len(res.Pods)),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, nil
+}
+
+func (c workbenchServiceLogging) ListWorkbenchVolumes(ctx context.Context, tenantID, workbenchID uint64) ([]runtime.MountedVolume, error) {
+	now := time.Now()
+
+	res, err := c.next.ListWorkbenchVolumes(ctx, tenantID, workbenchID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			logger.WithWorkbenchIDField(workbenchID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return res, fmt.Errorf("unable to list workbench volumes: %w", err)
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		logger.WithWorkbenchIDField(workbenchID),
+		zap.Int("num_volumes", len(res)),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, nil
+}
+
 func (c workbenchServiceLogging) DeleteWorkbench(ctx context.Context, tenantID, workbenchID uint64) error {
 	now := time.Now()
 
@@ -106,6 +151,108 @@ func (c workbenchServiceLogging) DeleteWorkbench(ctx context.Context, tenantID,
 	return nil
 }
 
+func (c workbenchServiceLogging) StreamWorkbenchLogs(ctx context.Context, req service.StreamWorkbenchLogsReq, send func(line string) error) error {
+	now := time.Now()
+
+	var lines int
+	err := c.next.StreamWorkbenchLogs(ctx, req, func(line string) error {
+		lines++
+		return send(line)
+	})
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			logger.WithWorkbenchIDField(req.WorkbenchID),
+			logger.WithUserIDField(req.UserID),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return fmt.Errorf("unable to stream workbench logs: %w", err)
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		logger.WithWorkbenchIDField(req.WorkbenchID),
+		logger.WithUserIDField(req.UserID),
+		zap.Int("num_lines", lines),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return nil
+}
+
+func (c workbenchServiceLogging) RestoreWorkbench(ctx context.Context, tenantID, workbenchID uint64) error {
+	now := time.Now()
+
+	err := c.next.RestoreWorkbench(ctx, tenantID, workbenchID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			logger.WithWorkbenchIDField(workbenchID),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return fmt.Errorf("unable to restore workbench: %w", err)
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		logger.WithWorkbenchIDField(workbenchID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return nil
+}
+
+func (c workbenchServiceLogging) PurgeWorkbenchs(ctx context.Context, deletedBefore time.Time) (common_model.Purged, error) {
+	now := time.Now()
+
+	purged, err := c.next.PurgeWorkbenchs(ctx, deletedBefore)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return purged, fmt.Errorf("unable to purge workbenchs: %w", err)
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		zap.Int64("num_purged", purged.Total()),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return purged, nil
+}
+
+func (c workbenchServiceLogging) CheckWorkbenchsStarted(ctx context.Context) error {
+	now := time.Now()
+
+	err := c.next.CheckWorkbenchsStarted(ctx)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return fmt.Errorf("unable to check starting workbenchs: %w", err)
+	}
+
+	c.logger.Debug(ctx, logger.LoggerMessageRequestCompleted,
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return nil
+}
+
+func (c workbenchServiceLogging) CheckSnapshotsReady(ctx context.Context) error {
+	now := time.Now()
+
+	err := c.next.CheckSnapshotsReady(ctx)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return fmt.Errorf("unable to check creating snapshots: %w", err)
+	}
+
+	c.logger.Debug(ctx, logger.LoggerMessageRequestCompleted,
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return nil
+}
+
 func (c workbenchServiceLogging) UpdateWorkbench(ctx context.Context, workbench *model.Workbench) error {
 	now := time.Now()
 
@@ -144,3 +291,106 @@ func (c workbenchServiceLogging) CreateWorkbench(ctx context.Context, workbench
 	)
 	return workbenchId, nil
 }
+
+func (c workbenchServiceLogging) StopWorkbenchs(ctx context.Context, tenantID uint64) error {
+	now := time.Now()
+
+	err := c.next.StopWorkbenchs(ctx, tenantID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			logger.WithTenantIDField(tenantID),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return fmt.Errorf("unable to stop workbenchs: %w", err)
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		logger.WithTenantIDField(tenantID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return nil
+}
+
+func (c workbenchServiceLogging) SnapshotWorkbench(ctx context.Context, req service.SnapshotWorkbenchReq) (uint64, error) {
+	now := time.Now()
+
+	snapshotID, err := c.next.SnapshotWorkbench(ctx, req)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			logger.WithWorkbenchIDField(req.WorkbenchID),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return 0, fmt.Errorf("unable to snapshot workbench: %w", err)
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		logger.WithWorkbenchIDField(req.WorkbenchID),
+		logger.WithSnapshotIDField(snapshotID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return snapshotID, nil
+}
+
+func (c workbenchServiceLogging) RestoreWorkbenchSnapshot(ctx context.Context, tenantID, userID, snapshotID uint64) (uint64, error) {
+	now := time.Now()
+
+	workbenchID, err := c.next.RestoreWorkbenchSnapshot(ctx, tenantID, userID, snapshotID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			logger.WithSnapshotIDField(snapshotID),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return 0, fmt.Errorf("unable to restore workbench snapshot: %w", err)
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		logger.WithSnapshotIDField(snapshotID),
+		logger.WithWorkbenchIDField(workbenchID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return workbenchID, nil
+}
+
+func (c workbenchServiceLogging) ListWorkbenchSnapshots(ctx context.Context, tenantID, userID, workspaceID uint64) ([]*model.WorkbenchSnapshot, error) {
+	now := time.Now()
+
+	res, err := c.next.ListWorkbenchSnapshots(ctx, tenantID, userID, workspaceID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			logger.WithWorkspaceIDField(workspaceID),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, fmt.Errorf("unable to list workbench snapshots: %w", err)
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		logger.WithWorkspaceIDField(workspaceID),
+		zap.Int("num_snapshots", len(res)),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, nil
+}
+
+func (c workbenchServiceLogging) DeleteWorkbenchSnapshot(ctx context.Context, tenantID, userID, snapshotID uint64) error {
+	now := time.Now()
+
+	err := c.next.DeleteWorkbenchSnapshot(ctx, tenantID, userID, snapshotID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			logger.WithSnapshotIDField(snapshotID),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return fmt.Errorf("unable to delete workbench snapshot: %w", err)
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		logger.WithSnapshotIDField(snapshotID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return nil
+}
@@ -2,9 +2,14 @@ package middleware
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"time"
 
+	"github.com/CHORUS-TRE/chorus-backend/internal/client/runtime"
+	"github.com/CHORUS-TRE/chorus-backend/internal/utils/pagination"
 	common_model "github.com/CHORUS-TRE/chorus-backend/pkg/common/model"
+	common_service "github.com/CHORUS-TRE/chorus-backend/pkg/common/service"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/workbench/model"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/workbench/service"
 
@@ -25,11 +30,14 @@ func Validation(validate *val.Validate) func(service.Workbencher) service.Workbe
 	}
 }
 
-func (v validation) ListWorkbenchs(ctx context.Context, tenantID uint64, pagination common_model.Pagination) ([]*model.Workbench, error) {
-	if err := v.validate.Struct(pagination); err != nil {
-		return nil, err
+func (v validation) ListWorkbenchs(ctx context.Context, req service.ListWorkbenchsReq) ([]*model.Workbench, *pagination.ResponseCursor[pagination.KeysetCursor], uint64, error) {
+	if err := v.validate.Struct(req); err != nil {
+		return nil, nil, 0, err
 	}
-	return v.next.ListWorkbenchs(ctx, tenantID, pagination)
+	if req.Cursor != nil && req.Cursor.PageSize > service.MaxWorkbenchsPageSize {
+		return nil, nil, 0, fmt.Errorf("page size must not exceed %v: %w", service.MaxWorkbenchsPageSize, &common_service.InvalidParametersErr{})
+	}
+	return v.next.ListWorkbenchs(ctx, req)
 }
 func (v validation) ProxyWorkbench(ctx context.Context, tenantID, workbenchID uint64, w http.ResponseWriter, r *http.Request) error {
 	return v.next.ProxyWorkbench(ctx, tenantID, workbenchID, w, r)
@@ -39,10 +47,41 @@ func (v validation) GetWorkbench(ctx context.Context, tenantID, workbenchID uint
 	return v.next.GetWorkbench(ctx, tenantID, workbenchID)
 }
 
+func (v validation) GetWorkbenchRuntime(ctx context.Context, tenantID, userID, workbenchID uint64) (*runtime.Details, error) {
+	return v.next.GetWorkbenchRuntime(ctx, tenantID, userID, workbenchID)
+}
+
+func (v validation) ListWorkbenchVolumes(ctx context.Context, tenantID, workbenchID uint64) ([]runtime.MountedVolume, error) {
+	return v.next.ListWorkbenchVolumes(ctx, tenantID, workbenchID)
+}
+
 func (v validation) DeleteWorkbench(ctx context.Context, tenantID, workbenchID uint64) error {
 	return v.next.DeleteWorkbench(ctx, tenantID, workbenchID)
 }
 
+func (v validation) StreamWorkbenchLogs(ctx context.Context, req service.StreamWorkbenchLogsReq, send func(line string) error) error {
+	if err := v.validate.Struct(req); err != nil {
+		return err
+	}
+	return v.next.StreamWorkbenchLogs(ctx, req, send)
+}
+
+func (v validation) RestoreWorkbench(ctx context.Context, tenantID, workbenchID uint64) error {
+	return v.next.RestoreWorkbench(ctx, tenantID, workbenchID)
+}
+
+func (v validation) PurgeWorkbenchs(ctx context.Context, deletedBefore time.Time) (common_model.Purged, error) {
+	return v.next.PurgeWorkbenchs(ctx, deletedBefore)
+}
+
+func (v validation) CheckWorkbenchsStarted(ctx context.Context) error {
+	return v.next.CheckWorkbenchsStarted(ctx)
+}
+
+func (v validation) CheckSnapshotsReady(ctx context.Context) error {
+	return v.next.CheckSnapshotsReady(ctx)
+}
+
 func (v validation) UpdateWorkbench(ctx context.Context, workbench *model.Workbench) error {
 	if err := v.validate.Struct(workbench); err != nil {
 		return v.next.UpdateWorkbench(ctx, workbench)
@@ -56,3 +95,26 @@ func (v validation) CreateWorkbench(ctx context.Context, workbench *model.Workbe
 	}
 	return v.next.CreateWorkbench(ctx, workbench)
 }
+
+func (v validation) StopWorkbenchs(ctx context.Context, tenantID uint64) error {
+	return v.next.StopWorkbenchs(ctx, tenantID)
+}
+
+func (v validation) SnapshotWorkbench(ctx context.Context, req service.SnapshotWorkbenchReq) (uint64, error) {
+	if err := v.validate.Struct(req); err != nil {
+		return 0, err
+	}
+	return v.next.SnapshotWorkbench(ctx, req)
+}
+
+func (v validation) RestoreWorkbenchSnapshot(ctx context.Context, tenantID, userID, snapshotID uint64) (uint64, error) {
+	return v.next.RestoreWorkbenchSnapshot(ctx, tenantID, userID, snapshotID)
+}
+
+func (v validation) ListWorkbenchSnapshots(ctx context.Context, tenantID, userID, workspaceID uint64) ([]*model.WorkbenchSnapshot, error) {
+	return v.next.ListWorkbenchSnapshots(ctx, tenantID, userID, workspaceID)
+}
+
+func (v validation) DeleteWorkbenchSnapshot(ctx context.Context, tenantID, userID, snapshotID uint64) error {
+	return v.next.DeleteWorkbenchSnapshot(ctx, tenantID, userID, snapshotID)
+}
@@ -0,0 +1,70 @@
+package service
+
+import (
+	"time"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/client/runtime"
+	"github.com/CHORUS-TRE/chorus-backend/internal/utils/pagination"
+	common_model "github.com/CHORUS-TRE/chorus-backend/pkg/common/model"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/workbench/model"
+)
+
+// MaxWorkbenchsPageSize is the maximum number of workbenchs that can be requested
+// in a single page.
+const MaxWorkbenchsPageSize = 500
+
+type ListWorkbenchsReq struct {
+	TenantID uint64
+	Cursor   *pagination.RequestCursor[pagination.KeysetCursor]
+	Filter   WorkbenchFilter
+	Sort     Sort `validate:"required"`
+}
+
+type WorkbenchFilter struct {
+	Status  string `validate:"omitempty,oneof=active inactive deleted"`
+	OwnerID uint64
+}
+
+type Sort struct {
+	SortOrder string `validate:"required,oneof=DESC ASC"`
+	SortType  string `validate:"required,oneof=ID NAME CREATEDAT"`
+}
+
+func (s Sort) ToBusinessSort() common_model.Sort {
+	return common_model.Sort{
+		SortOrder: s.SortOrder,
+		SortType:  s.SortType,
+	}
+}
+
+func (f WorkbenchFilter) ToBusinessFilter() model.WorkbenchFilter {
+	return model.WorkbenchFilter{
+		Status:  model.WorkbenchStatus(f.Status),
+		OwnerID: f.OwnerID,
+	}
+}
+
+// StreamWorkbenchLogsReq selects the logs of the server of a workbench, which
+// are only streamed to the members of its workspace.
+type StreamWorkbenchLogsReq struct {
+	TenantID    uint64 `validate:"required"`
+	UserID      uint64 `validate:"required"`
+	WorkbenchID uint64 `validate:"required"`
+	Follow      bool
+	TailLines   int64 `validate:"gte=0,lte=10000"`
+	SinceTime   time.Time
+}
+
+func (r StreamWorkbenchLogsReq) LogOptions() runtime.LogOptions {
+	return runtime.LogOptions{Follow: r.Follow, TailLines: r.TailLines, SinceTime: r.SinceTime}
+}
+
+// SnapshotWorkbenchReq takes a snapshot of a workbench, named after the
+// workbench when no name is given.
+type SnapshotWorkbenchReq struct {
+	TenantID    uint64 `validate:"required"`
+	UserID      uint64 `validate:"required"`
+	WorkbenchID uint64 `validate:"required"`
+	Name        string `validate:"max=255"`
+	Description string `validate:"max=1024"`
+}
@@ -0,0 +1,424 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/client/runtime"
+	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
+	appinstance_model "github.com/CHORUS-TRE/chorus-backend/pkg/app-instance/model"
+	app_model "github.com/CHORUS-TRE/chorus-backend/pkg/app/model"
+	common_service "github.com/CHORUS-TRE/chorus-backend/pkg/common/service"
+	quota_model "github.com/CHORUS-TRE/chorus-backend/pkg/quota/model"
+	webhook_model "github.com/CHORUS-TRE/chorus-backend/pkg/webhook/model"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/workbench/model"
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// AppInstanceCreator deploys the apps of the restored workbenches.
+type AppInstanceCreator interface {
+	CreateAppInstance(ctx context.Context, appInstance *appinstance_model.AppInstance) (uint64, error)
+}
+
+// AppVersionGetter returns the versions the apps of the restored workbenches
+// were snapshotted with.
+type AppVersionGetter interface {
+	GetAppVersion(ctx context.Context, tenantID, appVersionID uint64) (*app_model.AppVersion, error)
+}
+
+// SnapshotWorkbench records the apps running in a workbench of the user and
+// takes a snapshot of the volumes mounted into them, which include the home
+// volume of the user. The snapshot counts towards the quotas until it is
+// deleted. It is being created until its volumes are snapshotted, which
+// CheckSnapshotsReady records, and is failed when a volume cannot be.
+func (s *WorkbenchService) SnapshotWorkbench(ctx context.Context, req SnapshotWorkbenchReq) (uint64, error) {
+	workbench, err := s.store.GetWorkbench(ctx, req.TenantID, req.WorkbenchID)
+	if err != nil {
+		return 0, fmt.Errorf("unable to get workbench %v: %w", req.WorkbenchID, err)
+	}
+	if workbench.Status == model.WorkbenchDeleted {
+		return 0, fmt.Errorf("workbench %v is deleted: %w", req.WorkbenchID, &common_service.FailedPreconditionErr{})
+	}
+
+	if err := s.checkMember(ctx, req.TenantID, workbench.WorkspaceID, req.UserID); err != nil {
+		return 0, err
+	}
+	if workbench.UserID != req.UserID {
+		return 0, fmt.Errorf("user %v does not own workbench %v: %w", req.UserID, req.WorkbenchID, &common_service.PermissionDeniedErr{})
+	}
+
+	release, err := s.quota.CheckQuota(ctx, req.TenantID, workbench.WorkspaceID, req.UserID, quota_model.Usage{Snapshots: 1})
+	if err != nil {
+		return 0, fmt.Errorf("unable to snapshot workbench %v: %w", req.WorkbenchID, err)
+	}
+	defer release()
+
+	apps, err := s.store.ListWorkbenchApps(ctx, req.TenantID, req.WorkbenchID)
+	if err != nil {
+		return 0, fmt.Errorf("unable to get the apps of workbench %v: %w", req.WorkbenchID, err)
+	}
+
+	namespace := s.getWorkspaceName(workbench.WorkspaceID)
+	mounts, err := s.mounts(ctx, workbench)
+	if err != nil {
+		return 0, fmt.Errorf("unable to get the volumes of workbench %v: %w", req.WorkbenchID, err)
+	}
+	statuses, err := s.runtime.Volumes(ctx, namespace)
+	if err != nil {
+		return 0, fmt.Errorf("unable to get the volumes of workbench %v: %w", req.WorkbenchID, err)
+	}
+
+	name := req.Name
+	if name == "" {
+		name = workbench.Name
+	}
+	snapshot := &model.WorkbenchSnapshot{
+		TenantID:    req.TenantID,
+		UserID:      req.UserID,
+		WorkspaceID: workbench.WorkspaceID,
+		WorkbenchID: workbench.ID,
+		Name:        name,
+		Description: req.Description,
+		Status:      model.SnapshotCreating,
+		Content: model.SnapshotContent{
+			Workbench: model.SnapshotWorkbench{
+				Name:        workbench.Name,
+				ShortName:   workbench.ShortName,
+				Description: workbench.Description,
+			},
+			Apps:    apps,
+			Volumes: s.snapshotVolumes(runtime.MountVolumes(mounts, statuses)),
+		},
+	}
+
+	id, err := s.store.CreateWorkbenchSnapshot(ctx, req.TenantID, snapshot)
+	release()
+	if err != nil {
+		return 0, fmt.Errorf("unable to create snapshot of workbench %v: %w", req.WorkbenchID, err)
+	}
+	snapshot.ID = id
+
+	for i, v := range snapshot.Content.Volumes {
+		snapshotName := getSnapshotName(id, v.Name)
+		if err = s.runtime.CreateVolumeSnapshot(ctx, namespace, v.Name, snapshotName); err != nil {
+			snapshot.Status = model.SnapshotFailed
+			err = fmt.Errorf("unable to snapshot volume %v: %w", v.Name, err)
+			break
+		}
+		snapshot.Content.Volumes[i].Snapshot = snapshotName
+	}
+	// The runtimes copying the volumes synchronously have them snapshotted
+	// already.
+	if err == nil {
+		if ready, readyErr := s.snapshotReady(ctx, snapshot); readyErr == nil && ready {
+			snapshot.Status = model.SnapshotReady
+		}
+	}
+
+	if updateErr := s.store.UpdateWorkbenchSnapshot(ctx, req.TenantID, snapshot); updateErr != nil {
+		return 0, fmt.Errorf("unable to update snapshot %v: %w", id, updateErr)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("unable to snapshot workbench %v: %w", req.WorkbenchID, err)
+	}
+
+	return id, nil
+}
+
+// snapshotTimeout is how long the volumes of a snapshot can take to be
+// snapshotted before the snapshot is failed.
+const snapshotTimeout = time.Hour
+
+// CheckSnapshotsReady records the snapshots whose volumes were snapshotted,
+// or failed to be, since the last check.
+func (s *WorkbenchService) CheckSnapshotsReady(ctx context.Context) error {
+	snapshots, err := s.store.ListCreatingSnapshots(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to query creating snapshots: %w", err)
+	}
+
+	var failed int
+	for _, snapshot := range snapshots {
+		status := model.SnapshotReady
+		ready, err := s.snapshotReady(ctx, snapshot)
+		switch {
+		case errors.Is(err, runtime.ErrSnapshotFailed), errors.Is(err, runtime.ErrSnapshotNotFound):
+			logger.TechLog.Warn(ctx, "unable to snapshot the volumes of snapshot", logger.WithSnapshotIDField(snapshot.ID), zap.Error(err))
+			status = model.SnapshotFailed
+		case err != nil:
+			failed++
+			logger.TechLog.Error(ctx, "unable to check the volumes of snapshot", logger.WithSnapshotIDField(snapshot.ID), zap.Error(err))
+			continue
+		case !ready && time.Since(snapshot.CreatedAt) < snapshotTimeout:
+			continue
+		case !ready:
+			status = model.SnapshotFailed
+		}
+
+		if _, err := s.store.SetWorkbenchSnapshotStatus(ctx, snapshot.TenantID, snapshot.ID, status); err != nil {
+			failed++
+			logger.TechLog.Error(ctx, "unable to record the status of snapshot", logger.WithSnapshotIDField(snapshot.ID), zap.Error(err))
+		}
+	}
+
+	if failed != 0 {
+		return fmt.Errorf("unable to check %v of %v creating snapshots", failed, len(snapshots))
+	}
+	return nil
+}
+
+// snapshotReady returns whether all the volumes of a snapshot are
+// snapshotted. The volumes still being snapshotted by SnapshotWorkbench have
+// no snapshot yet.
+func (s *WorkbenchService) snapshotReady(ctx context.Context, snapshot *model.WorkbenchSnapshot) (bool, error) {
+	namespace := s.getWorkspaceName(snapshot.WorkspaceID)
+	for _, v := range snapshot.Content.Volumes {
+		if v.Snapshot == "" {
+			return false, nil
+		}
+		ready, err := s.runtime.VolumeSnapshotReady(ctx, namespace, v.Snapshot)
+		if err != nil {
+			return false, fmt.Errorf("unable to check the snapshot of volume %v: %w", v.Name, err)
+		}
+		if !ready {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// snapshotVolumes returns the volumes to snapshot. The volumes are restored
+// with their capacity, or with the configured size of their kind when the
+// runtime does not report it.
+func (s *WorkbenchService) snapshotVolumes(volumes []runtime.MountedVolume) []model.SnapshotVolume {
+	cfg := s.cfg.Clients.WorkbenchRuntime.Volumes
+	res := make([]model.SnapshotVolume, 0, len(volumes))
+	for _, v := range volumes {
+		size := runtime.SharedVolume(cfg).Size
+		if v.Labels[runtime.LabelVolumeKind] == runtime.VolumeKindHome {
+			size = runtime.HomeVolume(cfg, 0).Size
+		}
+		if v.CapacityBytes != 0 {
+			size = resource.NewQuantity(int64(v.CapacityBytes), resource.BinarySI).String()
+		}
+
+		res = append(res, model.SnapshotVolume{
+			Name:      v.Name,
+			MountPath: v.MountPath,
+			Size:      size,
+			Labels:    v.Labels,
+		})
+	}
+	return res
+}
+
+// RestoreWorkbenchSnapshot creates a workbench owned by the user from a
+// snapshot of the user: its volumes are restored as new volumes of the
+// workspace mounted at the same paths, and its apps are started again. The
+// snapshot is kept, and the workbench is removed when it cannot be restored.
+func (s *WorkbenchService) RestoreWorkbenchSnapshot(ctx context.Context, tenantID, userID, snapshotID uint64) (uint64, error) {
+	snapshot, err := s.store.GetWorkbenchSnapshot(ctx, tenantID, snapshotID)
+	if err != nil {
+		return 0, fmt.Errorf("unable to get snapshot %v: %w", snapshotID, err)
+	}
+	if snapshot.Status != model.SnapshotReady {
+		return 0, fmt.Errorf("snapshot %v is %v: %w", snapshotID, snapshot.Status, &common_service.FailedPreconditionErr{})
+	}
+
+	if err := s.checkMember(ctx, tenantID, snapshot.WorkspaceID, userID); err != nil {
+		return 0, err
+	}
+	if snapshot.UserID != userID {
+		return 0, fmt.Errorf("user %v does not own snapshot %v: %w", userID, snapshotID, &common_service.PermissionDeniedErr{})
+	}
+
+	apps, err := s.restoredApps(ctx, tenantID, snapshot.Content.Apps)
+	if err != nil {
+		return 0, fmt.Errorf("unable to restore snapshot %v: %w", snapshotID, err)
+	}
+
+	release, err := s.quota.CheckQuota(ctx, tenantID, snapshot.WorkspaceID, userID, quota_model.Usage{Workbenches: 1})
+	if err != nil {
+		return 0, fmt.Errorf("unable to restore snapshot %v: %w", snapshotID, err)
+	}
+
+	workbench := &model.Workbench{
+		TenantID:    tenantID,
+		UserID:      userID,
+		WorkspaceID: snapshot.WorkspaceID,
+		Name:        snapshot.Content.Workbench.Name,
+		ShortName:   snapshot.Content.Workbench.ShortName,
+		Description: snapshot.Content.Workbench.Description,
+		Status:      model.WorkbenchActive,
+	}
+	id, err := s.store.CreateWorkbench(ctx, tenantID, workbench)
+	release()
+	if err != nil {
+		return 0, fmt.Errorf("unable to create workbench: %w", err)
+	}
+	workbench.ID = id
+
+	namespace, workbenchName := s.getWorkspaceName(workbench.WorkspaceID), s.getWorkbenchName(id)
+
+	mounts, err := s.restoreVolumes(ctx, namespace, workbenchName, snapshot.Content.Volumes)
+	if err == nil {
+		err = s.runtime.CreateWorkbench(namespace, workbenchName, mounts)
+	}
+	if err != nil {
+		s.rollbackRestore(ctx, workbench)
+		return 0, fmt.Errorf("unable to restore workbench %v: %w", id, err)
+	}
+
+	for _, app := range apps {
+		_, err := s.appInstances.CreateAppInstance(ctx, &appinstance_model.AppInstance{
+			TenantID:     tenantID,
+			UserID:       userID,
+			AppID:        app.AppID,
+			WorkspaceID:  workbench.WorkspaceID,
+			WorkbenchID:  id,
+			AppVersionID: app.AppVersionID,
+			Status:       appinstance_model.AppInstanceActive,
+		})
+		if err != nil {
+			s.rollbackRestore(ctx, workbench)
+			return 0, fmt.Errorf("unable to restore app %v of workbench %v: %w", app.Name, id, err)
+		}
+	}
+
+	s.publish(ctx, workbench, webhook_model.EventWorkbenchCreated)
+
+	return id, nil
+}
+
+// restoredApps returns the apps of a snapshot along with the versions they
+// are restored with. The apps whose version has since been deprecated are
+// restored with the default version of their app.
+func (s *WorkbenchService) restoredApps(ctx context.Context, tenantID uint64, apps []model.SnapshotApp) ([]model.SnapshotApp, error) {
+	restored := make([]model.SnapshotApp, 0, len(apps))
+	for _, app := range apps {
+		if app.AppVersionID != 0 {
+			version, err := s.appVersions.GetAppVersion(ctx, tenantID, app.AppVersionID)
+			if err != nil {
+				return nil, fmt.Errorf("unable to get version %v of app %v: %w", app.AppVersionID, app.Name, err)
+			}
+			if version.Deprecated {
+				app.AppVersionID = 0
+			}
+		}
+		restored = append(restored, app)
+	}
+	return restored, nil
+}
+
+// rollbackRestore removes a workbench that could not be restored from a
+// snapshot, along with its apps and the volumes restored for it.
+func (s *WorkbenchService) rollbackRestore(ctx context.Context, workbench *model.Workbench) {
+	namespace, workbenchName := s.getWorkspaceName(workbench.WorkspaceID), s.getWorkbenchName(workbench.ID)
+	if err := s.runtime.DeleteWorkbench(namespace, workbenchName); err != nil {
+		logger.TechLog.Error(ctx, "unable to delete workbench", logger.WithWorkbenchIDField(workbench.ID), zap.Error(err))
+	}
+	if err := s.runtime.DeleteWorkbenchVolumes(ctx, namespace, workbenchName); err != nil {
+		logger.TechLog.Error(ctx, "unable to delete the volumes of workbench", logger.WithWorkbenchIDField(workbench.ID), zap.Error(err))
+	}
+	if err := s.store.RemoveWorkbench(ctx, workbench.TenantID, workbench.ID); err != nil {
+		logger.TechLog.Error(ctx, "unable to remove workbench", logger.WithWorkbenchIDField(workbench.ID), zap.Error(err))
+	}
+}
+
+// restoreVolumes restores the volumes of a snapshot for a workbench, named
+// after the workbench so that they do not replace the volumes in use, and
+// labelled so that they are deleted along with it.
+func (s *WorkbenchService) restoreVolumes(ctx context.Context, namespace, workbenchName string, volumes []model.SnapshotVolume) ([]runtime.VolumeMount, error) {
+	mounts := make([]runtime.VolumeMount, 0, len(volumes))
+	for _, v := range volumes {
+		labels := map[string]string{runtime.LabelWorkbench: workbenchName}
+		for k, l := range v.Labels {
+			labels[k] = l
+		}
+		volume := runtime.Volume{
+			Name:         workbenchName + "-" + v.Name,
+			StorageClass: s.cfg.Clients.WorkbenchRuntime.Volumes.StorageClass,
+			Size:         v.Size,
+			Labels:       labels,
+		}
+		if err := s.runtime.RestoreVolume(ctx, namespace, volume, v.Snapshot); err != nil {
+			return nil, fmt.Errorf("unable to restore volume %v: %w", v.Name, err)
+		}
+		mounts = append(mounts, runtime.VolumeMount{Name: volume.Name, MountPath: v.MountPath})
+	}
+	return mounts, nil
+}
+
+// ListWorkbenchSnapshots returns the snapshots the user took of the
+// workbenches of a workspace the user is a member of.
+func (s *WorkbenchService) ListWorkbenchSnapshots(ctx context.Context, tenantID, userID, workspaceID uint64) ([]*model.WorkbenchSnapshot, error) {
+	if err := s.checkMember(ctx, tenantID, workspaceID, userID); err != nil {
+		return nil, err
+	}
+
+	snapshots, err := s.store.ListWorkbenchSnapshots(ctx, tenantID, workspaceID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query the snapshots of workspace %v: %w", workspaceID, err)
+	}
+	return snapshots, nil
+}
+
+// DeleteWorkbenchSnapshot deletes the snapshots of the volumes of a
+// workbench snapshot of the user, then the snapshot. The volumes restored
+// from it are kept.
+func (s *WorkbenchService) DeleteWorkbenchSnapshot(ctx context.Context, tenantID, userID, snapshotID uint64) error {
+	snapshot, err := s.store.GetWorkbenchSnapshot(ctx, tenantID, snapshotID)
+	if err != nil {
+		return fmt.Errorf("unable to get snapshot %v: %w", snapshotID, err)
+	}
+
+	if err := s.checkMember(ctx, tenantID, snapshot.WorkspaceID, userID); err != nil {
+		return err
+	}
+	if snapshot.UserID != userID {
+		return fmt.Errorf("user %v does not own snapshot %v: %w", userID, snapshotID, &common_service.PermissionDeniedErr{})
+	}
+
+	namespace := s.getWorkspaceName(snapshot.WorkspaceID)
+	for _, v := range snapshot.Content.Volumes {
+		if v.Snapshot == "" {
+			continue
+		}
+		if err := s.runtime.DeleteVolumeSnapshot(ctx, namespace, v.Snapshot); err != nil {
+			return fmt.Errorf("unable to delete the snapshot of volume %v: %w", v.Name, err)
+		}
+	}
+
+	if err := s.store.DeleteWorkbenchSnapshot(ctx, tenantID, snapshotID); err != nil {
+		return fmt.Errorf("unable to delete snapshot %v: %w", snapshotID, err)
+	}
+	return nil
+}
+
+// mounts returns the volumes mounted into the apps of a workbench. A
+// workbench that is not deployed mounts the volumes of its owner.
+func (s *WorkbenchService) mounts(ctx context.Context, workbench *model.Workbench) ([]runtime.VolumeMount, error) {
+	mounts, err := s.runtime.Mounts(ctx, s.getWorkspaceName(workbench.WorkspaceID), s.getWorkbenchName(workbench.ID))
+	if errors.Is(err, runtime.ErrWorkbenchNotFound) {
+		return runtime.WorkbenchMounts(s.cfg.Clients.WorkbenchRuntime.Volumes, workbench.UserID), nil
+	}
+	return mounts, err
+}
+
+func (s *WorkbenchService) checkMember(ctx context.Context, tenantID, workspaceID, userID uint64) error {
+	isMember, err := s.members.IsWorkspaceMember(ctx, tenantID, workspaceID, userID)
+	if err != nil {
+		return fmt.Errorf("unable to check membership of workspace %v: %w", workspaceID, err)
+	}
+	if !isMember {
+		return fmt.Errorf("user %v is not a member of workspace %v: %w", userID, workspaceID, &common_service.PermissionDeniedErr{})
+	}
+	return nil
+}
+
+func getSnapshotName(snapshotID uint64, volumeName string) string {
+	return fmt.Sprintf("snapshot%v-%v", snapshotID, volumeName)
+}
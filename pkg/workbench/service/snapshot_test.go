@@ -0,0 +1,251 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/client/runtime"
+	"github.com/CHORUS-TRE/chorus-backend/internal/config"
+	appinstance_model "github.com/CHORUS-TRE/chorus-backend/pkg/app-instance/model"
+	app_model "github.com/CHORUS-TRE/chorus-backend/pkg/app/model"
+	common_service "github.com/CHORUS-TRE/chorus-backend/pkg/common/service"
+	quota_model "github.com/CHORUS-TRE/chorus-backend/pkg/quota/model"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/workbench/model"
+	"github.com/CHORUS-TRE/chorus-backend/tests/unit"
+)
+
+var errNotReady = errors.New("not ready")
+
+type appInstances struct {
+	created []*appinstance_model.AppInstance
+}
+
+func (a *appInstances) CreateAppInstance(ctx context.Context, appInstance *appinstance_model.AppInstance) (uint64, error) {
+	a.created = append(a.created, appInstance)
+	return uint64(len(a.created)), nil
+}
+
+type appVersions map[uint64]*app_model.AppVersion
+
+func (v appVersions) GetAppVersion(ctx context.Context, tenantID, appVersionID uint64) (*app_model.AppVersion, error) {
+	version, ok := v[appVersionID]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	return version, nil
+}
+
+func (s *workbenchStore) CreateWorkbench(ctx context.Context, tenantID uint64, workbench *model.Workbench) (uint64, error) {
+	created := *workbench
+	created.ID = uint64(len(s.workbenchs) + 1)
+	s.workbenchs = append(s.workbenchs, &created)
+	return created.ID, nil
+}
+
+func (s *workbenchStore) RemoveWorkbench(ctx context.Context, tenantID, workbenchID uint64) error {
+	s.removed = append(s.removed, workbenchID)
+	return nil
+}
+
+func (s *workbenchStore) ListWorkbenchApps(ctx context.Context, tenantID, workbenchID uint64) ([]model.SnapshotApp, error) {
+	return nil, nil
+}
+
+func (s *workbenchStore) GetWorkbenchSnapshot(ctx context.Context, tenantID, snapshotID uint64) (*model.WorkbenchSnapshot, error) {
+	if snapshot, ok := s.snapshots[snapshotID]; ok {
+		return snapshot, nil
+	}
+	return nil, sql.ErrNoRows
+}
+
+func (s *workbenchStore) CreateWorkbenchSnapshot(ctx context.Context, tenantID uint64, snapshot *model.WorkbenchSnapshot) (uint64, error) {
+	created := *snapshot
+	created.ID = uint64(len(s.snapshots) + 1)
+	created.CreatedAt = time.Now()
+	s.snapshots[created.ID] = &created
+	return created.ID, nil
+}
+
+func (s *workbenchStore) UpdateWorkbenchSnapshot(ctx context.Context, tenantID uint64, snapshot *model.WorkbenchSnapshot) error {
+	s.snapshots[snapshot.ID].Status = snapshot.Status
+	s.snapshots[snapshot.ID].Content = snapshot.Content
+	return nil
+}
+
+func (s *workbenchStore) ListCreatingSnapshots(ctx context.Context) ([]*model.WorkbenchSnapshot, error) {
+	var res []*model.WorkbenchSnapshot
+	for _, snapshot := range s.snapshots {
+		if snapshot.Status == model.SnapshotCreating {
+			res = append(res, snapshot)
+		}
+	}
+	return res, nil
+}
+
+func (s *workbenchStore) SetWorkbenchSnapshotStatus(ctx context.Context, tenantID, snapshotID uint64, status model.SnapshotStatus) (bool, error) {
+	snapshot := s.snapshots[snapshotID]
+	if snapshot.Status != model.SnapshotCreating {
+		return false, nil
+	}
+	snapshot.Status = status
+	return true, nil
+}
+
+func (r *workbenchRuntime) Mounts(ctx context.Context, namespace, workbenchName string) ([]runtime.VolumeMount, error) {
+	return nil, runtime.ErrWorkbenchNotFound
+}
+
+func (r *workbenchRuntime) Volumes(ctx context.Context, namespace string) ([]runtime.VolumeStatus, error) {
+	return nil, nil
+}
+
+func (r *workbenchRuntime) CreateVolumeSnapshot(ctx context.Context, namespace, volumeName, snapshotName string) error {
+	if _, ok := r.snapshots[snapshotName]; !ok {
+		r.snapshots[snapshotName] = errNotReady
+	}
+	return nil
+}
+
+func (r *workbenchRuntime) VolumeSnapshotReady(ctx context.Context, namespace, snapshotName string) (bool, error) {
+	err, ok := r.snapshots[snapshotName]
+	if !ok {
+		return false, runtime.ErrSnapshotNotFound
+	}
+	if errors.Is(err, errNotReady) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func (r *workbenchRuntime) RestoreVolume(ctx context.Context, namespace string, volume runtime.Volume, snapshotName string) error {
+	return nil
+}
+
+func (r *workbenchRuntime) CreateWorkbench(namespace, workbenchName string, mounts []runtime.VolumeMount) error {
+	return r.createErr
+}
+
+func (r *workbenchRuntime) DeleteWorkbench(namespace, workbenchName string) error {
+	return nil
+}
+
+func (r *workbenchRuntime) DeleteWorkbenchVolumes(ctx context.Context, namespace, workbenchName string) error {
+	r.deletedVolumes = append(r.deletedVolumes, workbenchName)
+	return nil
+}
+
+type quotaChecker struct{}
+
+func (quotaChecker) CheckQuota(ctx context.Context, tenantID, workspaceID, userID uint64, requested quota_model.Usage) (func(), error) {
+	return func() {}, nil
+}
+
+func TestSnapshotWorkbench(t *testing.T) {
+	unit.InitTestLogger()
+
+	store := &workbenchStore{
+		workbenchs: []*model.Workbench{{ID: 1, TenantID: 1, WorkspaceID: 2, UserID: 3, Name: "analysis"}},
+		snapshots:  map[uint64]*model.WorkbenchSnapshot{},
+	}
+	rt := &workbenchRuntime{snapshots: map[string]error{}}
+	s := NewWorkbenchService(config.Config{}, store, rt, quotaChecker{}, &members{userIDs: []uint64{3, 4}}, nil, nil, nil, nil)
+
+	_, err := s.SnapshotWorkbench(context.Background(), SnapshotWorkbenchReq{TenantID: 1, UserID: 4, WorkbenchID: 1})
+	require.ErrorAs(t, err, new(*common_service.PermissionDeniedErr), "a member cannot snapshot the home volume of another member")
+
+	id, err := s.SnapshotWorkbench(context.Background(), SnapshotWorkbenchReq{TenantID: 1, UserID: 3, WorkbenchID: 1})
+	require.NoError(t, err)
+	require.Equal(t, model.SnapshotCreating, store.snapshots[id].Status, "the snapshot is created until its volumes are snapshotted")
+	require.Len(t, rt.snapshots, 2)
+
+	require.NoError(t, s.CheckSnapshotsReady(context.Background()))
+	require.Equal(t, model.SnapshotCreating, store.snapshots[id].Status)
+
+	for name := range rt.snapshots {
+		rt.snapshots[name] = nil
+	}
+	require.NoError(t, s.CheckSnapshotsReady(context.Background()))
+	require.Equal(t, model.SnapshotReady, store.snapshots[id].Status)
+}
+
+func TestCheckSnapshotsReady_Failed(t *testing.T) {
+	unit.InitTestLogger()
+
+	snapshot := func(id uint64, createdAt time.Time) *model.WorkbenchSnapshot {
+		return &model.WorkbenchSnapshot{
+			ID: id, TenantID: 1, WorkspaceID: 2, Status: model.SnapshotCreating, CreatedAt: createdAt,
+			Content: model.SnapshotContent{Volumes: []model.SnapshotVolume{{Name: "home-user3", Snapshot: getSnapshotName(id, "home-user3")}}},
+		}
+	}
+	store := &workbenchStore{snapshots: map[uint64]*model.WorkbenchSnapshot{
+		1: snapshot(1, time.Now()),
+		2: snapshot(2, time.Now().Add(-2*snapshotTimeout)),
+		3: snapshot(3, time.Now()),
+	}}
+	rt := &workbenchRuntime{snapshots: map[string]error{
+		"snapshot1-home-user3": runtime.ErrSnapshotFailed,
+		"snapshot2-home-user3": errNotReady,
+	}}
+	s := NewWorkbenchService(config.Config{}, store, rt, nil, nil, nil, nil, nil, nil)
+
+	require.NoError(t, s.CheckSnapshotsReady(context.Background()))
+	require.Equal(t, model.SnapshotFailed, store.snapshots[1].Status, "a volume that cannot be snapshotted fails the snapshot")
+	require.Equal(t, model.SnapshotFailed, store.snapshots[2].Status, "a snapshot that takes too long is failed")
+	require.Equal(t, model.SnapshotFailed, store.snapshots[3].Status, "a snapshot whose volume snapshot is gone is failed")
+}
+
+func TestRestoreWorkbenchSnapshot(t *testing.T) {
+	unit.InitTestLogger()
+
+	store := &workbenchStore{snapshots: map[uint64]*model.WorkbenchSnapshot{
+		1: {
+			ID: 1, TenantID: 1, UserID: 3, WorkspaceID: 2, Status: model.SnapshotReady,
+			Content: model.SnapshotContent{Volumes: []model.SnapshotVolume{{Name: "home-user3", Size: "5Gi", Snapshot: "snapshot1-home-user3"}}},
+		},
+	}}
+	rt := &workbenchRuntime{createErr: errors.New("helm install failed")}
+	s := NewWorkbenchService(config.Config{}, store, rt, quotaChecker{}, &members{userIDs: []uint64{3, 4}}, nil, nil, nil, nil)
+
+	_, err := s.RestoreWorkbenchSnapshot(context.Background(), 1, 4, 1)
+	require.ErrorAs(t, err, new(*common_service.PermissionDeniedErr), "a member cannot restore the snapshot of another member")
+	require.Empty(t, store.workbenchs)
+
+	_, err = s.RestoreWorkbenchSnapshot(context.Background(), 1, 3, 1)
+	require.Error(t, err)
+	require.Equal(t, []uint64{1}, store.removed, "the workbench that failed to restore is removed")
+	require.Equal(t, []string{"workbench1"}, rt.deletedVolumes, "the volumes restored for it are deleted")
+}
+
+func TestRestoreWorkbenchSnapshot_DeprecatedVersion(t *testing.T) {
+	unit.InitTestLogger()
+
+	snapshot := &model.WorkbenchSnapshot{
+		ID: 1, TenantID: 1, UserID: 3, WorkspaceID: 2, Status: model.SnapshotReady,
+		Content: model.SnapshotContent{Apps: []model.SnapshotApp{
+			{AppID: 5, Name: "vscode", AppVersionID: 50},
+			{AppID: 6, Name: "jupyter", AppVersionID: 60},
+		}},
+	}
+	store := &workbenchStore{snapshots: map[uint64]*model.WorkbenchSnapshot{1: snapshot}}
+	versions := appVersions{50: {ID: 50, AppID: 5, Deprecated: true}}
+	instances := &appInstances{}
+	s := NewWorkbenchService(config.Config{}, store, &workbenchRuntime{}, quotaChecker{}, &members{userIDs: []uint64{3}}, instances, versions, nil, &publisher{})
+
+	// A version that is gone fails the restore before the workbench is
+	// created.
+	_, err := s.RestoreWorkbenchSnapshot(context.Background(), 1, 3, 1)
+	require.Error(t, err)
+	require.Empty(t, store.workbenchs)
+
+	versions[60] = &app_model.AppVersion{ID: 60, AppID: 6}
+	_, err = s.RestoreWorkbenchSnapshot(context.Background(), 1, 3, 1)
+	require.NoError(t, err)
+	require.Len(t, instances.created, 2)
+	require.Zero(t, instances.created[0].AppVersionID, "the deprecated version is replaced with the default one")
+	require.Equal(t, uint64(60), instances.created[1].AppVersionID)
+}
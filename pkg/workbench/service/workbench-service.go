@@ -8,30 +8,74 @@ import (
 	"net/url"
 	"regexp"
 	"sync"
+	"time"
 
-	"github.com/CHORUS-TRE/chorus-backend/internal/client/helm"
+	"github.com/CHORUS-TRE/chorus-backend/internal/client/runtime"
 	"github.com/CHORUS-TRE/chorus-backend/internal/config"
 	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
+	"github.com/CHORUS-TRE/chorus-backend/internal/notification"
+	"github.com/CHORUS-TRE/chorus-backend/internal/utils/pagination"
+	"github.com/CHORUS-TRE/chorus-backend/internal/utils/uuid"
+	"github.com/CHORUS-TRE/chorus-backend/internal/webhook"
 	common_model "github.com/CHORUS-TRE/chorus-backend/pkg/common/model"
+	notification_model "github.com/CHORUS-TRE/chorus-backend/pkg/notification/model"
+	quota_model "github.com/CHORUS-TRE/chorus-backend/pkg/quota/model"
+	webhook_model "github.com/CHORUS-TRE/chorus-backend/pkg/webhook/model"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/workbench/model"
 	"go.uber.org/zap"
 )
 
 type Workbencher interface {
 	GetWorkbench(ctx context.Context, tenantID, workbenchID uint64) (*model.Workbench, error)
-	ListWorkbenchs(ctx context.Context, tenantID uint64, pagination common_model.Pagination) ([]*model.Workbench, error)
+	GetWorkbenchRuntime(ctx context.Context, tenantID, userID, workbenchID uint64) (*runtime.Details, error)
+	ListWorkbenchs(ctx context.Context, req ListWorkbenchsReq) ([]*model.Workbench, *pagination.ResponseCursor[pagination.KeysetCursor], uint64, error)
 	CreateWorkbench(ctx context.Context, workbench *model.Workbench) (uint64, error)
 	ProxyWorkbench(ctx context.Context, tenantID, workbenchID uint64, w http.ResponseWriter, r *http.Request) error
 	UpdateWorkbench(ctx context.Context, workbench *model.Workbench) error
 	DeleteWorkbench(ctx context.Context, tenantId, workbenchId uint64) error
+	RestoreWorkbench(ctx context.Context, tenantID, workbenchID uint64) error
+	PurgeWorkbenchs(ctx context.Context, deletedBefore time.Time) (common_model.Purged, error)
+	CheckWorkbenchsStarted(ctx context.Context) error
+	StopWorkbenchs(ctx context.Context, tenantID uint64) error
+	StreamWorkbenchLogs(ctx context.Context, req StreamWorkbenchLogsReq, send func(line string) error) error
+	ListWorkbenchVolumes(ctx context.Context, tenantID, workbenchID uint64) ([]runtime.MountedVolume, error)
+	SnapshotWorkbench(ctx context.Context, req SnapshotWorkbenchReq) (uint64, error)
+	RestoreWorkbenchSnapshot(ctx context.Context, tenantID, userID, snapshotID uint64) (uint64, error)
+	ListWorkbenchSnapshots(ctx context.Context, tenantID, userID, workspaceID uint64) ([]*model.WorkbenchSnapshot, error)
+	DeleteWorkbenchSnapshot(ctx context.Context, tenantID, userID, snapshotID uint64) error
+	CheckSnapshotsReady(ctx context.Context) error
 }
 
 type WorkbenchStore interface {
 	GetWorkbench(ctx context.Context, tenantID uint64, workbenchID uint64) (*model.Workbench, error)
-	ListWorkbenchs(ctx context.Context, tenantID uint64, pagination common_model.Pagination) ([]*model.Workbench, error)
+	ListWorkbenchs(ctx context.Context, tenantID uint64, filter model.WorkbenchFilter, sort common_model.Sort, cursor *pagination.RequestCursor[pagination.KeysetCursor]) ([]*model.Workbench, *pagination.ResponseCursor[pagination.KeysetCursor], uint64, error)
 	CreateWorkbench(ctx context.Context, tenantID uint64, workbench *model.Workbench) (uint64, error)
 	UpdateWorkbench(ctx context.Context, tenantID uint64, workbench *model.Workbench) error
 	DeleteWorkbench(ctx context.Context, tenantID uint64, workbenchID uint64) error
+	RemoveWorkbench(ctx context.Context, tenantID uint64, workbenchID uint64) error
+	RestoreWorkbench(ctx context.Context, tenantID uint64, workbenchID uint64, deletedAfter time.Time) error
+	ListPurgeableWorkbenchs(ctx context.Context, deletedBefore time.Time) ([]*model.Workbench, error)
+	PurgeWorkbenchs(ctx context.Context, deletedBefore time.Time, workbenchIDs []uint64) (common_model.Purged, error)
+	ListStartingWorkbenchs(ctx context.Context) ([]*model.Workbench, error)
+	SetWorkbenchStarted(ctx context.Context, tenantID, workbenchID uint64) (bool, error)
+	GetWorkbenchSnapshot(ctx context.Context, tenantID, snapshotID uint64) (*model.WorkbenchSnapshot, error)
+	ListWorkbenchSnapshots(ctx context.Context, tenantID, workspaceID, userID uint64) ([]*model.WorkbenchSnapshot, error)
+	CreateWorkbenchSnapshot(ctx context.Context, tenantID uint64, snapshot *model.WorkbenchSnapshot) (uint64, error)
+	UpdateWorkbenchSnapshot(ctx context.Context, tenantID uint64, snapshot *model.WorkbenchSnapshot) error
+	ListCreatingSnapshots(ctx context.Context) ([]*model.WorkbenchSnapshot, error)
+	SetWorkbenchSnapshotStatus(ctx context.Context, tenantID, snapshotID uint64, status model.SnapshotStatus) (bool, error)
+	DeleteWorkbenchSnapshot(ctx context.Context, tenantID, snapshotID uint64) error
+	ListWorkbenchApps(ctx context.Context, tenantID, workbenchID uint64) ([]model.SnapshotApp, error)
+}
+
+// QuotaChecker checks that the new workbenches fit in the quotas.
+type QuotaChecker interface {
+	CheckQuota(ctx context.Context, tenantID, workspaceID, userID uint64, requested quota_model.Usage) (func(), error)
+}
+
+// MembershipChecker checks that the users are members of the workspaces.
+type MembershipChecker interface {
+	IsWorkspaceMember(ctx context.Context, tenantID, workspaceID, userID uint64) (bool, error)
 }
 
 type proxyID struct {
@@ -46,43 +90,99 @@ type proxy struct {
 }
 
 type WorkbenchService struct {
-	cfg        config.Config
-	store      WorkbenchStore
-	client     helm.HelmClienter
-	rwMutex    sync.RWMutex
-	proxyCache map[proxyID]*proxy
+	cfg          config.Config
+	store        WorkbenchStore
+	runtime      runtime.WorkbenchRuntime
+	quota        QuotaChecker
+	members      MembershipChecker
+	appInstances AppInstanceCreator
+	appVersions  AppVersionGetter
+	notifier     notification.Notifier
+	publisher    webhook.Publisher
+	rwMutex      sync.RWMutex
+	proxyCache   map[proxyID]*proxy
 }
 
-func NewWorkbenchService(cfg config.Config, store WorkbenchStore, client helm.HelmClienter) *WorkbenchService {
+func NewWorkbenchService(cfg config.Config, store WorkbenchStore, runtime runtime.WorkbenchRuntime, quota QuotaChecker, members MembershipChecker, appInstances AppInstanceCreator, appVersions AppVersionGetter, notifier notification.Notifier, publisher webhook.Publisher) *WorkbenchService {
 	return &WorkbenchService{
-		cfg:        cfg,
-		store:      store,
-		client:     client,
-		proxyCache: make(map[proxyID]*proxy),
+		cfg:          cfg,
+		store:        store,
+		runtime:      runtime,
+		quota:        quota,
+		members:      members,
+		appInstances: appInstances,
+		appVersions:  appVersions,
+		notifier:     notifier,
+		publisher:    publisher,
+		proxyCache:   make(map[proxyID]*proxy),
 	}
 }
 
-func (s *WorkbenchService) ListWorkbenchs(ctx context.Context, tenantID uint64, pagination common_model.Pagination) ([]*model.Workbench, error) {
-	workbenchs, err := s.store.ListWorkbenchs(ctx, tenantID, pagination)
+func (s *WorkbenchService) ListWorkbenchs(ctx context.Context, req ListWorkbenchsReq) ([]*model.Workbench, *pagination.ResponseCursor[pagination.KeysetCursor], uint64, error) {
+	workbenchs, cursor, count, err := s.store.ListWorkbenchs(ctx, req.TenantID, req.Filter.ToBusinessFilter(), req.Sort.ToBusinessSort(), req.Cursor)
 	if err != nil {
-		return nil, fmt.Errorf("unable to query workbenchs: %w", err)
+		return nil, nil, 0, fmt.Errorf("unable to query workbenchs: %w", err)
 	}
-	return workbenchs, nil
+	return workbenchs, cursor, count, nil
 }
 
 func (s *WorkbenchService) GetWorkbench(ctx context.Context, tenantID, workbenchID uint64) (*model.Workbench, error) {
 	workbench, err := s.store.GetWorkbench(ctx, tenantID, workbenchID)
 	if err != nil {
-		return nil, fmt.Errorf("unable to get workbench %v: %w", workbench.ID, err)
+		return nil, fmt.Errorf("unable to get workbench %v: %w", workbenchID, err)
 	}
 
 	return workbench, nil
 }
 
+// GetWorkbenchRuntime returns the live state of the server of a workbench as
+// observed in the runtime, along with its recent events, to the members of
+// its workspace.
+func (s *WorkbenchService) GetWorkbenchRuntime(ctx context.Context, tenantID, userID, workbenchID uint64) (*runtime.Details, error) {
+	workbench, err := s.store.GetWorkbench(ctx, tenantID, workbenchID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get workbench %v: %w", workbenchID, err)
+	}
+
+	if err := s.checkMember(ctx, tenantID, workbench.WorkspaceID, userID); err != nil {
+		return nil, err
+	}
+
+	details, err := s.runtime.WorkbenchDetails(ctx, s.getWorkspaceName(workbench.WorkspaceID), s.getWorkbenchName(workbenchID))
+	if err != nil {
+		return nil, fmt.Errorf("unable to get the runtime details of workbench %v: %w", workbenchID, err)
+	}
+	return details, nil
+}
+
+// StreamWorkbenchLogs calls send for every line of the logs of the container
+// of the server of a workbench, until they end or the context is done.
+func (s *WorkbenchService) StreamWorkbenchLogs(ctx context.Context, req StreamWorkbenchLogsReq, send func(line string) error) error {
+	workbench, err := s.store.GetWorkbench(ctx, req.TenantID, req.WorkbenchID)
+	if err != nil {
+		return fmt.Errorf("unable to get workbench %v: %w", req.WorkbenchID, err)
+	}
+
+	if err := s.checkMember(ctx, req.TenantID, workbench.WorkspaceID, req.UserID); err != nil {
+		return err
+	}
+
+	logs, err := s.runtime.WorkbenchLogs(ctx, s.getWorkspaceName(workbench.WorkspaceID), s.getWorkbenchName(req.WorkbenchID), req.LogOptions())
+	if err != nil {
+		return fmt.Errorf("unable to get the logs of workbench %v: %w", req.WorkbenchID, err)
+	}
+	defer logs.Close()
+
+	if err := runtime.SendLines(ctx, logs, send); err != nil {
+		return fmt.Errorf("unable to stream the logs of workbench %v: %w", req.WorkbenchID, err)
+	}
+	return nil
+}
+
 func (s *WorkbenchService) DeleteWorkbench(ctx context.Context, tenantID, workbenchID uint64) error {
 	workbench, err := s.store.GetWorkbench(ctx, tenantID, workbenchID)
 	if err != nil {
-		return fmt.Errorf("unable to get workbench %v: %w", workbench.ID, err)
+		return fmt.Errorf("unable to get workbench %v: %w", workbenchID, err)
 	}
 
 	err = s.store.DeleteWorkbench(ctx, tenantID, workbenchID)
@@ -90,11 +190,129 @@ func (s *WorkbenchService) DeleteWorkbench(ctx context.Context, tenantID, workbe
 		return fmt.Errorf("unable to delete workbench %v: %w", workbenchID, err)
 	}
 
-	err = s.client.DeleteWorkbench(s.getWorkspaceName(workbench.WorkspaceID), s.getWorkbenchName(workbenchID))
+	err = s.runtime.DeleteWorkbench(s.getWorkspaceName(workbench.WorkspaceID), s.getWorkbenchName(workbenchID))
 	if err != nil {
 		return fmt.Errorf("unable to delete workbench %v: %w", workbenchID, err)
 	}
 
+	s.publish(ctx, workbench, webhook_model.EventWorkbenchDeleted)
+	return nil
+}
+
+// RestoreWorkbench restores a workbench deleted during the grace period,
+// along with the app instances deleted with it. The workbench is left
+// inactive and is not redeployed.
+func (s *WorkbenchService) RestoreWorkbench(ctx context.Context, tenantID, workbenchID uint64) error {
+	deletedAfter := time.Now().Add(-s.cfg.Services.SoftDelete.GracePeriod)
+	if err := s.store.RestoreWorkbench(ctx, tenantID, workbenchID, deletedAfter); err != nil {
+		return fmt.Errorf("unable to restore workbench %v: %w", workbenchID, err)
+	}
+	return nil
+}
+
+// PurgeWorkbenchs hard-deletes the workbenches deleted before deletedBefore
+// once their own volumes, such as the ones restored from a snapshot, are
+// deleted. The workbenches whose volumes cannot be deleted are left for the
+// next purge.
+func (s *WorkbenchService) PurgeWorkbenchs(ctx context.Context, deletedBefore time.Time) (common_model.Purged, error) {
+	workbenchs, err := s.store.ListPurgeableWorkbenchs(ctx, deletedBefore)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list the workbenchs to purge: %w", err)
+	}
+
+	var tornDown []uint64
+	for _, workbench := range workbenchs {
+		if err := s.runtime.DeleteWorkbenchVolumes(ctx, s.getWorkspaceName(workbench.WorkspaceID), s.getWorkbenchName(workbench.ID)); err != nil {
+			logger.TechLog.Error(ctx, "unable to delete the volumes of workbench", logger.WithWorkbenchIDField(workbench.ID), zap.Error(err))
+			continue
+		}
+		tornDown = append(tornDown, workbench.ID)
+	}
+
+	purged, err := s.store.PurgeWorkbenchs(ctx, deletedBefore, tornDown)
+	if err != nil {
+		return nil, fmt.Errorf("unable to purge workbenchs: %w", err)
+	}
+	return purged, nil
+}
+
+// workbenchStartTimeout is how long the server of a workbench can take to
+// start before it is reported as failed.
+const workbenchStartTimeout = 15 * time.Minute
+
+// CheckWorkbenchsStarted notifies the owners of the workbenches whose server
+// became ready or failed to start since the last check.
+func (s *WorkbenchService) CheckWorkbenchsStarted(ctx context.Context) error {
+	workbenchs, err := s.store.ListStartingWorkbenchs(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to query starting workbenchs: %w", err)
+	}
+
+	var failed int
+	for _, workbench := range workbenchs {
+		details, err := s.runtime.WorkbenchDetails(ctx, s.getWorkspaceName(workbench.WorkspaceID), s.getWorkbenchName(workbench.ID))
+		if err != nil {
+			failed++
+			logger.TechLog.Error(ctx, "unable to get the runtime details of workbench", logger.WithWorkbenchIDField(workbench.ID), zap.Error(err))
+			continue
+		}
+
+		ready, crashed := details.Started()
+		if !ready && !crashed && time.Since(*workbench.StartingSince) < workbenchStartTimeout {
+			continue
+		}
+		s.setStarted(ctx, workbench, ready)
+	}
+
+	if failed != 0 {
+		return fmt.Errorf("unable to check %v of %v starting workbenchs", failed, len(workbenchs))
+	}
+	return nil
+}
+
+// setStarted records that the server of a workbench is ready or failed to
+// start and notifies its owner, unless it was already recorded.
+func (s *WorkbenchService) setStarted(ctx context.Context, workbench *model.Workbench, ready bool) {
+	set, err := s.store.SetWorkbenchStarted(ctx, workbench.TenantID, workbench.ID)
+	if err != nil {
+		logger.TechLog.Error(ctx, "unable to record the start of workbench", logger.WithWorkbenchIDField(workbench.ID), zap.Error(err))
+		return
+	}
+	if !set {
+		return
+	}
+
+	if ready {
+		s.notify(ctx, workbench, notification_model.NotificationTypeWorkbenchReady)
+		return
+	}
+	s.notify(ctx, workbench, notification_model.NotificationTypeWorkbenchFailed)
+	s.publish(ctx, workbench, webhook_model.EventWorkbenchFailed)
+}
+
+// StopWorkbenchs uninstalls the releases of all the workbenches of a tenant
+// and marks the active ones as inactive.
+func (s *WorkbenchService) StopWorkbenchs(ctx context.Context, tenantID uint64) error {
+	workbenchs, _, _, err := s.store.ListWorkbenchs(ctx, tenantID, model.WorkbenchFilter{}, common_model.Sort{}, nil)
+	if err != nil {
+		return fmt.Errorf("unable to query workbenchs: %w", err)
+	}
+
+	for _, workbench := range workbenchs {
+		err = s.runtime.DeleteWorkbench(s.getWorkspaceName(workbench.WorkspaceID), s.getWorkbenchName(workbench.ID))
+		if err != nil {
+			return fmt.Errorf("unable to stop workbench %v: %w", workbench.ID, err)
+		}
+
+		if workbench.Status != model.WorkbenchActive {
+			continue
+		}
+		workbench.Status = model.WorkbenchInactive
+		if err := s.store.UpdateWorkbench(ctx, tenantID, workbench); err != nil {
+			return fmt.Errorf("unable to update workbench %v: %w", workbench.ID, err)
+		}
+	}
+
 	return nil
 }
 
@@ -107,21 +325,87 @@ func (s *WorkbenchService) UpdateWorkbench(ctx context.Context, workbench *model
 }
 
 func (s *WorkbenchService) CreateWorkbench(ctx context.Context, workbench *model.Workbench) (uint64, error) {
+	release, err := s.quota.CheckQuota(ctx, workbench.TenantID, workbench.WorkspaceID, workbench.UserID, quota_model.Usage{Workbenches: 1})
+	if err != nil {
+		return 0, fmt.Errorf("unable to create workbench: %w", err)
+	}
+
 	id, err := s.store.CreateWorkbench(ctx, workbench.TenantID, workbench)
+	release()
 	if err != nil {
 		return 0, fmt.Errorf("unable to create workbench %v: %w", workbench.ID, err)
 	}
 
+	workbench.ID = id
+
 	namespace, workbenchName := s.getWorkspaceName(workbench.WorkspaceID), s.getWorkbenchName(id)
 
-	err = s.client.CreateWorkbench(namespace, workbenchName)
+	err = s.createVolumes(ctx, namespace, workbench.UserID)
+	if err == nil {
+		err = s.runtime.CreateWorkbench(namespace, workbenchName, runtime.WorkbenchMounts(s.cfg.Clients.WorkbenchRuntime.Volumes, workbench.UserID))
+	}
 	if err != nil {
+		s.setStarted(ctx, workbench, false)
 		return 0, fmt.Errorf("unable to create workbench %v: %w", workbench.ID, err)
 	}
+	s.publish(ctx, workbench, webhook_model.EventWorkbenchCreated)
 
 	return id, nil
 }
 
+// createVolumes creates the volumes mounted into the apps of a workbench of a
+// user, unless they exist: the shared volume of the workspace, created along
+// with the workspace before the volumes were introduced, and the home volume
+// of the user.
+func (s *WorkbenchService) createVolumes(ctx context.Context, namespace string, userID uint64) error {
+	cfg := s.cfg.Clients.WorkbenchRuntime.Volumes
+	for _, volume := range []runtime.Volume{runtime.SharedVolume(cfg), runtime.HomeVolume(cfg, userID)} {
+		if err := s.runtime.CreateVolume(ctx, namespace, volume); err != nil {
+			return fmt.Errorf("unable to create volume %v: %w", volume.Name, err)
+		}
+	}
+	return nil
+}
+
+// ListWorkbenchVolumes returns the volumes mounted into the apps of a
+// workbench along with their usage.
+func (s *WorkbenchService) ListWorkbenchVolumes(ctx context.Context, tenantID, workbenchID uint64) ([]runtime.MountedVolume, error) {
+	workbench, err := s.store.GetWorkbench(ctx, tenantID, workbenchID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get workbench %v: %w", workbenchID, err)
+	}
+
+	mounts, err := s.mounts(ctx, workbench)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get the volumes of workbench %v: %w", workbenchID, err)
+	}
+	statuses, err := s.runtime.Volumes(ctx, s.getWorkspaceName(workbench.WorkspaceID))
+	if err != nil {
+		return nil, fmt.Errorf("unable to get the volumes of workbench %v: %w", workbenchID, err)
+	}
+
+	return runtime.MountVolumes(mounts, statuses), nil
+}
+
+func (s *WorkbenchService) notify(ctx context.Context, workbench *model.Workbench, notifType notification_model.NotificationType) {
+	n := notification.NewNotificationBuilder(uuid.Next(), workbench.TenantID).
+		WithType(notifType).
+		WithLink(notification.WorkbenchLink(workbench.WorkspaceID, workbench.ID)).
+		WithParam("workbench", workbench.Name).
+		Build()
+	notification.Notify(ctx, s.notifier, n, workbench.UserID)
+}
+
+func (s *WorkbenchService) publish(ctx context.Context, workbench *model.Workbench, eventType webhook_model.EventType) {
+	event := webhook.NewEvent(workbench.TenantID, eventType, webhook_model.EventData{
+		"workbenchId": workbench.ID,
+		"workspaceId": workbench.WorkspaceID,
+		"userId":      workbench.UserID,
+		"name":        workbench.Name,
+	})
+	webhook.Publish(ctx, s.publisher, event)
+}
+
 func (s *WorkbenchService) getProxy(proxyID proxyID) (*proxy, error) {
 	// TODO error handling, port forwarding re-creation, cache eviction, cleaning on cache evit and sig stop
 	s.rwMutex.RLock()
@@ -139,7 +423,7 @@ func (s *WorkbenchService) getProxy(proxyID proxyID) (*proxy, error) {
 	var stopChan chan struct{}
 	var err error
 	if !s.cfg.Services.WorkbenchService.BackendInK8S {
-		port, stopChan, err = s.client.CreatePortForward(proxyID.namespace, proxyID.workbench)
+		port, stopChan, err = s.runtime.CreatePortForward(proxyID.namespace, proxyID.workbench)
 		if err != nil {
 			return nil, fmt.Errorf("Failed to create port forward: %w", err)
 		}
@@ -0,0 +1,166 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/client/runtime"
+	"github.com/CHORUS-TRE/chorus-backend/internal/config"
+	common_model "github.com/CHORUS-TRE/chorus-backend/pkg/common/model"
+	common_service "github.com/CHORUS-TRE/chorus-backend/pkg/common/service"
+	notification_model "github.com/CHORUS-TRE/chorus-backend/pkg/notification/model"
+	notification_service "github.com/CHORUS-TRE/chorus-backend/pkg/notification/service"
+	webhook_model "github.com/CHORUS-TRE/chorus-backend/pkg/webhook/model"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/workbench/model"
+	"github.com/CHORUS-TRE/chorus-backend/tests/unit"
+)
+
+type workbenchStore struct {
+	WorkbenchStore
+	workbenchs []*model.Workbench
+	snapshots  map[uint64]*model.WorkbenchSnapshot
+	removed    []uint64
+}
+
+func (s *workbenchStore) ListStartingWorkbenchs(ctx context.Context) ([]*model.Workbench, error) {
+	var res []*model.Workbench
+	for _, w := range s.workbenchs {
+		if w.StartingSince != nil {
+			res = append(res, w)
+		}
+	}
+	return res, nil
+}
+
+func (s *workbenchStore) SetWorkbenchStarted(ctx context.Context, tenantID, workbenchID uint64) (bool, error) {
+	for _, w := range s.workbenchs {
+		if w.ID == workbenchID && w.StartingSince != nil {
+			w.StartingSince = nil
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (s *workbenchStore) GetWorkbench(ctx context.Context, tenantID, workbenchID uint64) (*model.Workbench, error) {
+	for _, w := range s.workbenchs {
+		if w.ID == workbenchID {
+			return w, nil
+		}
+	}
+	return nil, sql.ErrNoRows
+}
+
+type members struct {
+	userIDs []uint64
+}
+
+func (m *members) IsWorkspaceMember(ctx context.Context, tenantID, workspaceID, userID uint64) (bool, error) {
+	return slices.Contains(m.userIDs, userID), nil
+}
+
+type workbenchRuntime struct {
+	runtime.WorkbenchRuntime
+	details map[string]*runtime.Details
+	// snapshots holds whether the volume snapshots are ready, or why they
+	// failed.
+	snapshots      map[string]error
+	createErr      error
+	deletedVolumes []string
+}
+
+func (r *workbenchRuntime) WorkbenchDetails(ctx context.Context, namespace, workbenchName string) (*runtime.Details, error) {
+	if d, ok := r.details[workbenchName]; ok {
+		return d, nil
+	}
+	return &runtime.Details{}, nil
+}
+
+type notifier struct {
+	types []notification_model.NotificationType
+}
+
+func (n *notifier) CreateNotification(ctx context.Context, req notification_service.CreateNotificationRequest) error {
+	n.types = append(n.types, req.Notification.Type)
+	return nil
+}
+
+type publisher struct {
+	types []webhook_model.EventType
+}
+
+func (p *publisher) PublishEvent(ctx context.Context, event *webhook_model.Event) error {
+	p.types = append(p.types, event.Type)
+	return nil
+}
+
+func TestCheckWorkbenchsStarted(t *testing.T) {
+	unit.InitTestLogger()
+
+	now := time.Now()
+	expired := now.Add(-2 * workbenchStartTimeout)
+	running := &runtime.Details{Pods: []runtime.PodDetails{{Phase: "Running", Containers: []runtime.ContainerDetails{{Ready: true}}}}}
+	crashing := &runtime.Details{Pods: []runtime.PodDetails{{Phase: "Running", Containers: []runtime.ContainerDetails{{Reason: runtime.ReasonCrashLoopBackOff}}}}}
+
+	store := &workbenchStore{workbenchs: []*model.Workbench{
+		{ID: 1, StartingSince: &now},
+		{ID: 2, StartingSince: &now},
+		{ID: 3, StartingSince: &now},
+		{ID: 4, StartingSince: &expired},
+	}}
+	rt := &workbenchRuntime{details: map[string]*runtime.Details{"workbench1": running, "workbench2": crashing}}
+	n, p := &notifier{}, &publisher{}
+	s := NewWorkbenchService(config.Config{}, store, rt, nil, nil, nil, nil, n, p)
+
+	require.NoError(t, s.CheckWorkbenchsStarted(context.Background()))
+	require.Equal(t, []notification_model.NotificationType{
+		notification_model.NotificationTypeWorkbenchReady,
+		notification_model.NotificationTypeWorkbenchFailed,
+		notification_model.NotificationTypeWorkbenchFailed,
+	}, n.types)
+	require.Equal(t, []webhook_model.EventType{webhook_model.EventWorkbenchFailed, webhook_model.EventWorkbenchFailed}, p.types)
+	require.NotNil(t, store.workbenchs[2].StartingSince, "a workbench still starting is checked again")
+
+	require.NoError(t, s.CheckWorkbenchsStarted(context.Background()))
+	require.Len(t, n.types, 3, "the owners are notified once")
+}
+
+func TestGetWorkbenchRuntime(t *testing.T) {
+	running := &runtime.Details{Pods: []runtime.PodDetails{{Phase: "Running"}}}
+	store := &workbenchStore{workbenchs: []*model.Workbench{{ID: 1, WorkspaceID: 2}}}
+	rt := &workbenchRuntime{details: map[string]*runtime.Details{"workbench1": running}}
+	s := NewWorkbenchService(config.Config{}, store, rt, nil, &members{userIDs: []uint64{3}}, nil, nil, nil, nil)
+
+	details, err := s.GetWorkbenchRuntime(context.Background(), 1, 3, 1)
+	require.NoError(t, err)
+	require.Equal(t, running, details)
+
+	_, err = s.GetWorkbenchRuntime(context.Background(), 1, 4, 1)
+	require.ErrorAs(t, err, new(*common_service.PermissionDeniedErr), "a user outside the workspace cannot see the runtime state")
+}
+
+func (s *workbenchStore) ListPurgeableWorkbenchs(ctx context.Context, deletedBefore time.Time) ([]*model.Workbench, error) {
+	return s.workbenchs, nil
+}
+
+func (s *workbenchStore) PurgeWorkbenchs(ctx context.Context, deletedBefore time.Time, workbenchIDs []uint64) (common_model.Purged, error) {
+	s.removed = append(s.removed, workbenchIDs...)
+	return common_model.Purged{1: int64(len(workbenchIDs))}, nil
+}
+
+func TestPurgeWorkbenchs(t *testing.T) {
+	store := &workbenchStore{workbenchs: []*model.Workbench{{ID: 1, TenantID: 1, WorkspaceID: 2}}}
+	rt := &workbenchRuntime{}
+	s := NewWorkbenchService(config.Config{}, store, rt, nil, nil, nil, nil, nil, nil)
+
+	purged, err := s.PurgeWorkbenchs(context.Background(), time.Now())
+	require.NoError(t, err)
+	require.Equal(t, int64(1), purged.Total())
+	require.Equal(t, []string{"workbench1"}, rt.deletedVolumes, "the volumes restored for the workbench are deleted with it")
+	require.Equal(t, []uint64{1}, store.removed)
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
+	"github.com/CHORUS-TRE/chorus-backend/internal/utils/pagination"
 	common_model "github.com/CHORUS-TRE/chorus-backend/pkg/common/model"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/workbench/model"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/workbench/service"
@@ -26,25 +27,26 @@ func Logging(logger *logger.ContextLogger) func(service.WorkbenchStore) service.
 	}
 }
 
-func (c workbenchStorageLogging) ListWorkbenchs(ctx context.Context, tenantID uint64, pagination common_model.Pagination) ([]*model.Workbench, error) {
+func (c workbenchStorageLogging) ListWorkbenchs(ctx context.Context, tenantID uint64, filter model.WorkbenchFilter, sort common_model.Sort, cursor *pagination.RequestCursor[pagination.KeysetCursor]) ([]*model.Workbench, *pagination.ResponseCursor[pagination.KeysetCursor], uint64, error) {
 	c.logger.Debug(ctx, "request started")
 
 	now := time.Now()
 
-	res, err := c.next.ListWorkbenchs(ctx, tenantID, pagination)
+	res, resCursor, count, err := c.next.ListWorkbenchs(ctx, tenantID, filter, sort, cursor)
 	if err != nil {
 		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
 			zap.Error(err),
 			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
 		)
-		return nil, err
+		return nil, nil, 0, err
 	}
 
 	c.logger.Debug(ctx, "request completed",
 		logger.WithCountField(len(res)),
+		logger.WithTotalItemsField(count),
 		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
 	)
-	return res, nil
+	return res, resCursor, count, nil
 }
 
 func (c workbenchStorageLogging) GetWorkbench(ctx context.Context, tenantID uint64, workbenchID uint64) (*model.Workbench, error) {
@@ -88,6 +90,124 @@ func (c workbenchStorageLogging) DeleteWorkbench(ctx context.Context, tenantID,
 	return nil
 }
 
+func (c workbenchStorageLogging) RemoveWorkbench(ctx context.Context, tenantID, workbenchID uint64) error {
+	c.logger.Debug(ctx, "request started")
+	now := time.Now()
+
+	err := c.next.RemoveWorkbench(ctx, tenantID, workbenchID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			logger.WithWorkbenchIDField(workbenchID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return err
+	}
+	c.logger.Debug(ctx, "request completed",
+		logger.WithWorkbenchIDField(workbenchID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return nil
+}
+
+func (c workbenchStorageLogging) RestoreWorkbench(ctx context.Context, tenantID, workbenchID uint64, deletedAfter time.Time) error {
+	c.logger.Debug(ctx, "request started")
+	now := time.Now()
+
+	err := c.next.RestoreWorkbench(ctx, tenantID, workbenchID, deletedAfter)
+	if err != nil {
+		c.logger.Error(ctx, "request completed",
+			logger.WithWorkbenchIDField(workbenchID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return err
+	}
+	c.logger.Debug(ctx, "request completed",
+		logger.WithWorkbenchIDField(workbenchID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return nil
+}
+
+func (c workbenchStorageLogging) ListStartingWorkbenchs(ctx context.Context) ([]*model.Workbench, error) {
+	c.logger.Debug(ctx, "request started")
+	now := time.Now()
+
+	res, err := c.next.ListStartingWorkbenchs(ctx)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, err
+	}
+	c.logger.Debug(ctx, "request completed",
+		zap.Int("num_workbenchs", len(res)),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, nil
+}
+
+func (c workbenchStorageLogging) SetWorkbenchStarted(ctx context.Context, tenantID, workbenchID uint64) (bool, error) {
+	c.logger.Debug(ctx, "request started")
+	now := time.Now()
+
+	set, err := c.next.SetWorkbenchStarted(ctx, tenantID, workbenchID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			logger.WithWorkbenchIDField(workbenchID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return false, err
+	}
+	c.logger.Debug(ctx, "request completed",
+		logger.WithWorkbenchIDField(workbenchID),
+		zap.Bool("set", set),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return set, nil
+}
+
+func (c workbenchStorageLogging) ListPurgeableWorkbenchs(ctx context.Context, deletedBefore time.Time) ([]*model.Workbench, error) {
+	c.logger.Debug(ctx, "request started")
+	now := time.Now()
+
+	res, err := c.next.ListPurgeableWorkbenchs(ctx, deletedBefore)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, err
+	}
+	c.logger.Debug(ctx, "request completed",
+		zap.Int("num_workbenchs", len(res)),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, nil
+}
+
+func (c workbenchStorageLogging) PurgeWorkbenchs(ctx context.Context, deletedBefore time.Time, workbenchIDs []uint64) (common_model.Purged, error) {
+	c.logger.Debug(ctx, "request started")
+	now := time.Now()
+
+	purged, err := c.next.PurgeWorkbenchs(ctx, deletedBefore, workbenchIDs)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return purged, err
+	}
+	c.logger.Debug(ctx, "request completed",
+		zap.Int64("num_purged", purged.Total()),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return purged, nil
+}
+
 func (c workbenchStorageLogging) UpdateWorkbench(ctx context.Context, tenantID uint64, workbench *model.Workbench) error {
 	c.logger.Debug(ctx, "request started")
 	now := time.Now()
@@ -128,3 +248,173 @@ func (c workbenchStorageLogging) CreateWorkbench(ctx context.Context, tenantID u
 	)
 	return workbenchId, nil
 }
+
+func (c workbenchStorageLogging) GetWorkbenchSnapshot(ctx context.Context, tenantID, snapshotID uint64) (*model.WorkbenchSnapshot, error) {
+	c.logger.Debug(ctx, "request started")
+	now := time.Now()
+
+	res, err := c.next.GetWorkbenchSnapshot(ctx, tenantID, snapshotID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			logger.WithSnapshotIDField(snapshotID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		logger.WithSnapshotIDField(snapshotID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, nil
+}
+
+func (c workbenchStorageLogging) ListWorkbenchSnapshots(ctx context.Context, tenantID, workspaceID, userID uint64) ([]*model.WorkbenchSnapshot, error) {
+	c.logger.Debug(ctx, "request started")
+	now := time.Now()
+
+	res, err := c.next.ListWorkbenchSnapshots(ctx, tenantID, workspaceID, userID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			logger.WithWorkspaceIDField(workspaceID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		logger.WithWorkspaceIDField(workspaceID),
+		logger.WithCountField(len(res)),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, nil
+}
+
+func (c workbenchStorageLogging) CreateWorkbenchSnapshot(ctx context.Context, tenantID uint64, snapshot *model.WorkbenchSnapshot) (uint64, error) {
+	c.logger.Debug(ctx, "request started")
+	now := time.Now()
+
+	snapshotID, err := c.next.CreateWorkbenchSnapshot(ctx, tenantID, snapshot)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			logger.WithWorkbenchIDField(snapshot.WorkbenchID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return 0, err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		logger.WithSnapshotIDField(snapshotID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return snapshotID, nil
+}
+
+func (c workbenchStorageLogging) UpdateWorkbenchSnapshot(ctx context.Context, tenantID uint64, snapshot *model.WorkbenchSnapshot) error {
+	c.logger.Debug(ctx, "request started")
+	now := time.Now()
+
+	err := c.next.UpdateWorkbenchSnapshot(ctx, tenantID, snapshot)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			logger.WithSnapshotIDField(snapshot.ID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		logger.WithSnapshotIDField(snapshot.ID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return nil
+}
+
+func (c workbenchStorageLogging) ListCreatingSnapshots(ctx context.Context) ([]*model.WorkbenchSnapshot, error) {
+	c.logger.Debug(ctx, "request started")
+	now := time.Now()
+
+	res, err := c.next.ListCreatingSnapshots(ctx)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		logger.WithCountField(len(res)),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, nil
+}
+
+func (c workbenchStorageLogging) SetWorkbenchSnapshotStatus(ctx context.Context, tenantID, snapshotID uint64, status model.SnapshotStatus) (bool, error) {
+	c.logger.Debug(ctx, "request started")
+	now := time.Now()
+
+	set, err := c.next.SetWorkbenchSnapshotStatus(ctx, tenantID, snapshotID, status)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			logger.WithSnapshotIDField(snapshotID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return false, err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		logger.WithSnapshotIDField(snapshotID),
+		zap.Bool("set", set),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return set, nil
+}
+
+func (c workbenchStorageLogging) DeleteWorkbenchSnapshot(ctx context.Context, tenantID, snapshotID uint64) error {
+	c.logger.Debug(ctx, "request started")
+	now := time.Now()
+
+	err := c.next.DeleteWorkbenchSnapshot(ctx, tenantID, snapshotID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			logger.WithSnapshotIDField(snapshotID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		logger.WithSnapshotIDField(snapshotID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return nil
+}
+
+func (c workbenchStorageLogging) ListWorkbenchApps(ctx context.Context, tenantID, workbenchID uint64) ([]model.SnapshotApp, error) {
+	c.logger.Debug(ctx, "request started")
+	now := time.Now()
+
+	res, err := c.next.ListWorkbenchApps(ctx, tenantID, workbenchID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			logger.WithWorkbenchIDField(workbenchID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		logger.WithWorkbenchIDField(workbenchID),
+		logger.WithCountField(len(res)),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, nil
+}
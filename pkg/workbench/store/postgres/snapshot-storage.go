@@ -0,0 +1,154 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/utils/database"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/workbench/model"
+)
+
+func (s *WorkbenchStorage) GetWorkbenchSnapshot(ctx context.Context, tenantID, snapshotID uint64) (*model.WorkbenchSnapshot, error) {
+	const query = `
+SELECT id, tenantid, userid, workspaceid, workbenchid, name, description, status, content, createdat, updatedat
+	FROM workbench_snapshots
+WHERE tenantid = $1 AND id = $2;
+`
+	var snapshot model.WorkbenchSnapshot
+	if err := s.db.GetContext(ctx, &snapshot, query, tenantID, snapshotID); err != nil {
+		return nil, err
+	}
+
+	return &snapshot, nil
+}
+
+// ListWorkbenchSnapshots returns the snapshots a user took of the workbenches
+// of a workspace, the most recent first.
+func (s *WorkbenchStorage) ListWorkbenchSnapshots(ctx context.Context, tenantID, workspaceID, userID uint64) ([]*model.WorkbenchSnapshot, error) {
+	const query = `
+SELECT id, tenantid, userid, workspaceid, workbenchid, name, description, status, content, createdat, updatedat
+	FROM workbench_snapshots
+WHERE tenantid = $1 AND workspaceid = $2 AND userid = $3
+ORDER BY id DESC;
+`
+	var snapshots []*model.WorkbenchSnapshot
+	if err := s.db.SelectContext(ctx, &snapshots, query, tenantID, workspaceID, userID); err != nil {
+		return nil, err
+	}
+
+	return snapshots, nil
+}
+
+func (s *WorkbenchStorage) CreateWorkbenchSnapshot(ctx context.Context, tenantID uint64, snapshot *model.WorkbenchSnapshot) (uint64, error) {
+	const query = `
+INSERT INTO workbench_snapshots (tenantid, userid, workspaceid, workbenchid, name, description, status, content, createdat, updatedat)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NOW(), NOW())
+RETURNING id;
+`
+	var id uint64
+	err := s.db.GetContext(ctx, &id, query,
+		tenantID, snapshot.UserID, snapshot.WorkspaceID, snapshot.WorkbenchID, snapshot.Name, snapshot.Description, snapshot.Status, snapshot.Content,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// UpdateWorkbenchSnapshot updates the status and the content of a snapshot,
+// which are only known once its volumes are snapshotted.
+func (s *WorkbenchStorage) UpdateWorkbenchSnapshot(ctx context.Context, tenantID uint64, snapshot *model.WorkbenchSnapshot) error {
+	const query = `
+UPDATE workbench_snapshots
+SET status = $3, content = $4, updatedat = NOW()
+WHERE tenantid = $1 AND id = $2;
+`
+	rows, err := s.db.ExecContext(ctx, query, tenantID, snapshot.ID, snapshot.Status, snapshot.Content)
+	if err != nil {
+		return err
+	}
+	affected, err := rows.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return database.ErrNoRowsUpdated
+	}
+
+	return nil
+}
+
+// ListCreatingSnapshots returns the snapshots of all the tenants whose
+// volumes are being snapshotted.
+func (s *WorkbenchStorage) ListCreatingSnapshots(ctx context.Context) ([]*model.WorkbenchSnapshot, error) {
+	const query = `
+SELECT id, tenantid, userid, workspaceid, workbenchid, name, description, status, content, createdat, updatedat
+	FROM workbench_snapshots
+WHERE status = 'creating';
+`
+	var snapshots []*model.WorkbenchSnapshot
+	if err := s.db.SelectContext(ctx, &snapshots, query); err != nil {
+		return nil, err
+	}
+
+	return snapshots, nil
+}
+
+// SetWorkbenchSnapshotStatus records that the volumes of a snapshot are
+// snapshotted or failed to be. It returns false when the snapshot is no
+// longer being created, such as when another replica recorded it.
+func (s *WorkbenchStorage) SetWorkbenchSnapshotStatus(ctx context.Context, tenantID, snapshotID uint64, status model.SnapshotStatus) (bool, error) {
+	const query = `
+UPDATE workbench_snapshots SET status = $3, updatedat = NOW()
+WHERE tenantid = $1 AND id = $2 AND status = 'creating';
+`
+	rows, err := s.db.ExecContext(ctx, query, tenantID, snapshotID, status)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := rows.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected == 1, nil
+}
+
+func (s *WorkbenchStorage) DeleteWorkbenchSnapshot(ctx context.Context, tenantID, snapshotID uint64) error {
+	const query = `
+DELETE FROM workbench_snapshots WHERE tenantid = $1 AND id = $2;
+`
+	rows, err := s.db.ExecContext(ctx, query, tenantID, snapshotID)
+	if err != nil {
+		return err
+	}
+	affected, err := rows.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return database.ErrNoRowsDeleted
+	}
+
+	return nil
+}
+
+// ListWorkbenchApps returns the apps of the active app instances of a
+// workbench, which are the ones deployed in it.
+func (s *WorkbenchStorage) ListWorkbenchApps(ctx context.Context, tenantID, workbenchID uint64) ([]model.SnapshotApp, error) {
+	const query = `
+SELECT a.id AS appid, a.name, ai.appversionid
+	FROM app_instances ai
+	JOIN apps a ON a.id = ai.appid
+WHERE ai.tenantid = $1 AND ai.workbenchid = $2 AND ai.status = 'active'
+ORDER BY ai.id;
+`
+	var apps []model.SnapshotApp
+	if err := s.db.SelectContext(ctx, &apps, query, tenantID, workbenchID); err != nil {
+		return nil, err
+	}
+
+	return apps, nil
+}
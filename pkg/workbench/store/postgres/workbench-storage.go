@@ -3,12 +3,16 @@ package postgres
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 
 	"github.com/CHORUS-TRE/chorus-backend/internal/utils/database"
-	"github.com/CHORUS-TRE/chorus-backend/internal/utils/uuid"
+	"github.com/CHORUS-TRE/chorus-backend/internal/utils/pagination"
 	common_model "github.com/CHORUS-TRE/chorus-backend/pkg/common/model"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/common/storage"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/workbench/model"
 )
 
@@ -24,7 +28,7 @@ func NewWorkbenchStorage(db *sqlx.DB) *WorkbenchStorage {
 
 func (s *WorkbenchStorage) GetWorkbench(ctx context.Context, tenantID uint64, workbenchID uint64) (*model.Workbench, error) {
 	const query = `
-		SELECT id, tenantid, userid, workspaceid, name, shortname, description, status, createdat, updatedat
+		SELECT id, tenantid, userid, workspaceid, name, shortname, description, status, startingsince, createdat, updatedat, deletedat
 			FROM workbenchs
 		WHERE tenantid = $1 AND id = $2;
 	`
@@ -37,25 +41,91 @@ func (s *WorkbenchStorage) GetWorkbench(ctx context.Context, tenantID uint64, wo
 	return &workbench, nil
 }
 
-func (s *WorkbenchStorage) ListWorkbenchs(ctx context.Context, tenantID uint64, pagination common_model.Pagination) ([]*model.Workbench, error) {
-	const query = `
-SELECT id, tenantid, userid, workspaceid, name, shortname, description, status, createdat, updatedat
-	FROM workbenchs
-WHERE tenantid = $1 AND status != 'deleted';
-`
+// ListWorkbenchs queries the stocked workbenchs matching the filter, one page at a
+// time. Workbenchs that are 'deleted' are skipped unless explicitly filtered on.
+// It also returns the total number of workbenchs matching the filter.
+func (s *WorkbenchStorage) ListWorkbenchs(ctx context.Context, tenantID uint64, filter model.WorkbenchFilter, sort common_model.Sort, cursor *pagination.RequestCursor[pagination.KeysetCursor]) ([]*model.Workbench, *pagination.ResponseCursor[pagination.KeysetCursor], uint64, error) {
+	args, whereClauses := buildWorkbenchsWhereClauses(tenantID, filter)
+
+	sortType := strings.ToUpper(sort.SortType)
+	column, ok := model.WorkbenchSortTypeToString[sortType]
+	if !ok {
+		sortType, column = "ID", model.WorkbenchSortTypeToString["ID"]
+	}
+	selectArgs, keysetClause, sortClause, reversed := storage.KeysetClauses(args, column, "id", strings.ToUpper(sort.SortOrder), cursor)
+
+	selectQuery := `
+SELECT id, tenantid, userid, workspaceid, name, shortname, description, status, startingsince, createdat, updatedat, deletedat
+FROM workbenchs
+` + whereClauses + keysetClause + sortClause
+	query, selectArgs, err := sqlx.In(selectQuery, selectArgs...)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	query = sqlx.Rebind(sqlx.DOLLAR, query)
+
 	var workbenchs []*model.Workbench
-	if err := s.db.SelectContext(ctx, &workbenchs, query, tenantID); err != nil {
-		return nil, err
+	if err := s.db.SelectContext(ctx, &workbenchs, query, selectArgs...); err != nil {
+		return nil, nil, 0, err
+	}
+	if reversed {
+		storage.Reverse(workbenchs)
 	}
 
-	return workbenchs, nil
+	workbenchs, responseCursor := pagination.NewKeysetResponseCursor(workbenchs, func(w *model.Workbench) pagination.Keyset {
+		return pagination.Keyset{ID: w.ID, Value: w.SortValue(sortType)}
+	}, cursor)
+
+	count, err := s.countWorkbenchs(ctx, whereClauses, args)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+
+	return workbenchs, responseCursor, count, nil
 }
 
-// CreateWorkbench saves the provided workbench object in the database 'workbenchs' table.
+func buildWorkbenchsWhereClauses(tenantID uint64, filter model.WorkbenchFilter) ([]interface{}, string) {
+	args := []interface{}{tenantID}
+	whereClauses := "WHERE tenantid = ?"
+
+	if filter.Status != "" {
+		args = append(args, filter.Status.String())
+		whereClauses += " AND status = ?"
+	} else {
+		whereClauses += " AND status != 'deleted'"
+	}
+
+	if filter.OwnerID != 0 {
+		args = append(args, filter.OwnerID)
+		whereClauses += " AND userid = ?"
+	}
+
+	return args, whereClauses
+}
+
+func (s *WorkbenchStorage) countWorkbenchs(ctx context.Context, whereClauses string, args []interface{}) (uint64, error) {
+	countQuery := `
+SELECT COUNT(id) FROM workbenchs
+` + whereClauses
+	query, args, err := sqlx.In(countQuery, args...)
+	if err != nil {
+		return 0, err
+	}
+	query = sqlx.Rebind(sqlx.DOLLAR, query)
+
+	var count uint64
+	if err := s.db.GetContext(ctx, &count, query, args...); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// CreateWorkbench saves the provided workbench object in the database 'workbenchs' table,
+// its server starting.
 func (s *WorkbenchStorage) CreateWorkbench(ctx context.Context, tenantID uint64, workbench *model.Workbench) (uint64, error) {
 	const workbenchQuery = `
-INSERT INTO workbenchs (tenantid, userid, workspaceid, name, shortname, description, status, createdat, updatedat)
-VALUES ($1, $2, $3, $4, $5, $6, $7, NOW(), NOW()) RETURNING id;
+INSERT INTO workbenchs (tenantid, userid, workspaceid, name, shortname, description, status, startingsince, createdat, updatedat)
+VALUES ($1, $2, $3, $4, $5, $6, $7, NOW(), NOW(), NOW()) RETURNING id;
 	`
 
 	var id uint64
@@ -73,7 +143,7 @@ func (s *WorkbenchStorage) UpdateWorkbench(ctx context.Context, tenantID uint64,
 	const workbenchUpdateQuery = `
 		UPDATE workbenchs
 		SET status = $3, description = $4, updatedat = NOW()
-		WHERE tenantid = $1 AND id = $2;
+		WHERE tenantid = $1 AND id = $2 AND status != 'deleted';
 	`
 
 	// Update User
@@ -93,26 +163,188 @@ func (s *WorkbenchStorage) UpdateWorkbench(ctx context.Context, tenantID uint64,
 	return err
 }
 
+// DeleteWorkbench marks a workbench as deleted along with its app instances.
 func (s *WorkbenchStorage) DeleteWorkbench(ctx context.Context, tenantID uint64, workbenchID uint64) error {
-	const query = `
-		UPDATE workbenchs	SET 
-			(status, name, updatedat, deletedat) = 
-			($3, concat(name, $4::TEXT), NOW(), NOW())
-		WHERE tenantid = $1 AND id = $2;
-	`
+	const workbenchQuery = `
+UPDATE workbenchs SET status = 'deleted', updatedat = NOW(), deletedat = NOW()
+WHERE tenantid = $1 AND id = $2 AND status != 'deleted';
+`
+	const appInstancesQuery = `
+UPDATE app_instances SET status = 'deleted', updatedat = NOW(), deletedat = NOW()
+WHERE tenantid = $1 AND workbenchid = $2 AND status != 'deleted';
+`
 
-	rows, err := s.db.ExecContext(ctx, query, tenantID, workbenchID, model.WorkbenchDeleted.String(), "-"+uuid.Next())
+	tx, err := s.db.BeginTxx(ctx, nil)
 	if err != nil {
-		return fmt.Errorf("unable to exec: %w", err)
+		return err
+	}
+
+	rows, err := tx.ExecContext(ctx, workbenchQuery, tenantID, workbenchID)
+	if err != nil {
+		return storage.Rollback(tx, fmt.Errorf("unable to exec: %w", err))
 	}
 	affected, err := rows.RowsAffected()
 	if err != nil {
-		return fmt.Errorf("unable to get rows affected: %w", err)
+		return storage.Rollback(tx, fmt.Errorf("unable to get rows affected: %w", err))
+	}
+	if affected == 0 {
+		return storage.Rollback(tx, database.ErrNoRowsDeleted)
+	}
+
+	if _, err := tx.ExecContext(ctx, appInstancesQuery, tenantID, workbenchID); err != nil {
+		return storage.Rollback(tx, fmt.Errorf("unable to delete app instances: %w", err))
+	}
+
+	return tx.Commit()
+}
+
+// RemoveWorkbench hard-deletes a workbench that was just created, whose
+// creation failed, along with its app instances.
+func (s *WorkbenchStorage) RemoveWorkbench(ctx context.Context, tenantID uint64, workbenchID uint64) error {
+	const appInstancesQuery = `
+DELETE FROM app_instances WHERE tenantid = $1 AND workbenchid = $2;
+`
+	const workbenchQuery = `
+DELETE FROM workbenchs WHERE tenantid = $1 AND id = $2;
+`
+
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, appInstancesQuery, tenantID, workbenchID); err != nil {
+		return storage.Rollback(tx, fmt.Errorf("unable to remove app instances: %w", err))
 	}
 
+	rows, err := tx.ExecContext(ctx, workbenchQuery, tenantID, workbenchID)
+	if err != nil {
+		return storage.Rollback(tx, fmt.Errorf("unable to exec: %w", err))
+	}
+	affected, err := rows.RowsAffected()
+	if err != nil {
+		return storage.Rollback(tx, fmt.Errorf("unable to get rows affected: %w", err))
+	}
 	if affected == 0 {
-		return database.ErrNoRowsDeleted
+		return storage.Rollback(tx, database.ErrNoRowsDeleted)
+	}
+
+	return tx.Commit()
+}
+
+// RestoreWorkbench restores a workbench deleted after deletedAfter, along with
+// the app instances deleted with it, as long as its workspace is not deleted.
+// The restored workbench and app instances are inactive.
+func (s *WorkbenchStorage) RestoreWorkbench(ctx context.Context, tenantID uint64, workbenchID uint64, deletedAfter time.Time) error {
+	const selectQuery = `
+SELECT wb.deletedat FROM workbenchs wb
+WHERE wb.tenantid = $1 AND wb.id = $2 AND wb.status = 'deleted' AND wb.deletedat > $3
+	AND EXISTS (SELECT 1 FROM workspaces w WHERE w.id = wb.workspaceid AND w.status != 'deleted')
+FOR UPDATE OF wb;
+`
+	const workbenchQuery = `
+UPDATE workbenchs SET status = 'inactive', updatedat = NOW(), deletedat = NULL
+WHERE tenantid = $1 AND id = $2;
+`
+	// The app instances deleted along with the workbench share its deletion
+	// date.
+	const appInstancesQuery = `
+UPDATE app_instances SET status = 'inactive', updatedat = NOW(), deletedat = NULL
+WHERE tenantid = $1 AND workbenchid = $2 AND status = 'deleted' AND deletedat = $3;
+`
+
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	var deletedAt time.Time
+	if err := tx.GetContext(ctx, &deletedAt, selectQuery, tenantID, workbenchID, deletedAfter); err != nil {
+		return storage.Rollback(tx, err)
+	}
+
+	if _, err := tx.ExecContext(ctx, workbenchQuery, tenantID, workbenchID); err != nil {
+		return storage.Rollback(tx, fmt.Errorf("unable to restore workbench: %w", err))
+	}
+	if _, err := tx.ExecContext(ctx, appInstancesQuery, tenantID, workbenchID, deletedAt); err != nil {
+		return storage.Rollback(tx, fmt.Errorf("unable to restore app instances: %w", err))
+	}
+
+	return tx.Commit()
+}
+
+// ListStartingWorkbenchs returns the active workbenches of all the tenants
+// whose server is starting.
+func (s *WorkbenchStorage) ListStartingWorkbenchs(ctx context.Context) ([]*model.Workbench, error) {
+	const query = `
+SELECT id, tenantid, userid, workspaceid, name, shortname, description, status, startingsince, createdat, updatedat, deletedat
+FROM workbenchs
+WHERE startingsince IS NOT NULL AND status = 'active';
+`
+
+	var workbenchs []*model.Workbench
+	if err := s.db.SelectContext(ctx, &workbenchs, query); err != nil {
+		return nil, err
+	}
+	return workbenchs, nil
+}
+
+// SetWorkbenchStarted records that the server of a workbench is ready or
+// failed to start. It returns false when it was already recorded, such as by
+// another replica.
+func (s *WorkbenchStorage) SetWorkbenchStarted(ctx context.Context, tenantID, workbenchID uint64) (bool, error) {
+	const query = `
+UPDATE workbenchs SET startingsince = NULL, updatedat = NOW()
+WHERE tenantid = $1 AND id = $2 AND startingsince IS NOT NULL;
+`
+	rows, err := s.db.ExecContext(ctx, query, tenantID, workbenchID)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := rows.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected == 1, nil
+}
+
+// purgeableWorkbenchsCondition selects the workbenches deleted before $1
+// whose app instances are purged.
+const purgeableWorkbenchsCondition = `
+WHERE wb.status = 'deleted' AND wb.deletedat < $1
+	AND NOT EXISTS (SELECT 1 FROM app_instances ai WHERE ai.workbenchid = wb.id)
+`
+
+// ListPurgeableWorkbenchs returns the workbenches that PurgeWorkbenchs would
+// purge.
+func (s *WorkbenchStorage) ListPurgeableWorkbenchs(ctx context.Context, deletedBefore time.Time) ([]*model.Workbench, error) {
+	const query = `
+SELECT wb.id, wb.tenantid, wb.userid, wb.workspaceid, wb.name, wb.shortname, wb.description, wb.status, wb.startingsince, wb.createdat, wb.updatedat, wb.deletedat
+FROM workbenchs wb` + purgeableWorkbenchsCondition + `;`
+
+	var workbenchs []*model.Workbench
+	if err := s.db.SelectContext(ctx, &workbenchs, query, deletedBefore); err != nil {
+		return nil, err
+	}
+	return workbenchs, nil
+}
+
+// PurgeWorkbenchs hard-deletes the workbenches among workbenchIDs deleted
+// before deletedBefore once their app instances are purged, and returns their
+// number per tenant.
+func (s *WorkbenchStorage) PurgeWorkbenchs(ctx context.Context, deletedBefore time.Time, workbenchIDs []uint64) (common_model.Purged, error) {
+	const query = `
+DELETE FROM workbenchs wb` + purgeableWorkbenchsCondition + `	AND wb.id = ANY($2)
+RETURNING wb.tenantid;
+`
+	if len(workbenchIDs) == 0 {
+		return common_model.Purged{}, nil
+	}
+	ids := make([]int64, 0, len(workbenchIDs))
+	for _, id := range workbenchIDs {
+		ids = append(ids, int64(id))
 	}
 
-	return nil
+	return storage.Purge(ctx, s.db, query, deletedBefore, pq.Array(ids))
 }
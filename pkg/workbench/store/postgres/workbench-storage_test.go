@@ -0,0 +1,50 @@
+package postgres
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/CHORUS-TRE/chorus-backend/pkg/workbench/model"
+)
+
+func TestBuildWorkbenchsWhereClauses(t *testing.T) {
+	tests := []struct {
+		name         string
+		filter       model.WorkbenchFilter
+		expectsArgs  []interface{}
+		expectsWhere string
+	}{
+		{
+			name:         "Without filter, skips the deleted workbenches",
+			expectsArgs:  []interface{}{uint64(1)},
+			expectsWhere: "WHERE tenantid = ? AND status != 'deleted'",
+		},
+		{
+			name:         "With a status, returns the workbenches with that status",
+			filter:       model.WorkbenchFilter{Status: model.WorkbenchDeleted},
+			expectsArgs:  []interface{}{uint64(1), "deleted"},
+			expectsWhere: "WHERE tenantid = ? AND status = ?",
+		},
+		{
+			name:         "With an owner, returns the workbenches of that user",
+			filter:       model.WorkbenchFilter{OwnerID: 42},
+			expectsArgs:  []interface{}{uint64(1), uint64(42)},
+			expectsWhere: "WHERE tenantid = ? AND status != 'deleted' AND userid = ?",
+		},
+		{
+			name:         "With a status and an owner, combines both",
+			filter:       model.WorkbenchFilter{Status: model.WorkbenchDeleted, OwnerID: 42},
+			expectsArgs:  []interface{}{uint64(1), "deleted", uint64(42)},
+			expectsWhere: "WHERE tenantid = ? AND status = ? AND userid = ?",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			args, where := buildWorkbenchsWhereClauses(1, tt.filter)
+			require.Equal(t, tt.expectsArgs, args)
+			require.Equal(t, tt.expectsWhere, where)
+		})
+	}
+}
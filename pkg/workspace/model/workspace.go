@@ -1,7 +1,9 @@
 package model
 
 import (
+	"errors"
 	"fmt"
+	"strconv"
 	"time"
 )
 
@@ -23,6 +25,36 @@ type Workspace struct {
 	DeletedAt *time.Time
 }
 
+// WorkspaceMember maps an entry in the 'workspace_members' database table.
+type WorkspaceMember struct {
+	ID uint64
+
+	TenantID    uint64
+	WorkspaceID uint64
+	UserID      uint64
+
+	CreatedAt time.Time
+}
+
+// ErrRunningWorkbenchs is returned when deleting a workspace that has running
+// workbenches without forcing it.
+var ErrRunningWorkbenchs = errors.New("running workbenchs")
+
+// WorkspaceTeardown reports the teardown of a deleted workspace, whose
+// workbenches are uninstalled. Its namespace is kept until the workspace is
+// purged, so that it can be restored in the meantime.
+type WorkspaceTeardown struct {
+	Workbenchs []WorkbenchTeardown
+}
+
+// WorkbenchTeardown reports whether a workbench of a deleted workspace was
+// uninstalled, and the error preventing it otherwise.
+type WorkbenchTeardown struct {
+	WorkbenchID uint64
+	Uninstalled bool
+	Error       string
+}
+
 // WorkspaceStatus represents the status of a workspace.
 type WorkspaceStatus string
 
@@ -48,3 +80,30 @@ func ToWorkspaceStatus(status string) (WorkspaceStatus, error) {
 		return "", fmt.Errorf("unexpected WorkspaceStatus: %s", status)
 	}
 }
+
+// WorkspaceFilter restricts the workspaces returned by a listing.
+// Empty fields are not filtered on. Name is matched case insensitively.
+type WorkspaceFilter struct {
+	Status  WorkspaceStatus
+	OwnerID uint64
+	Name    string
+}
+
+var WorkspaceSortTypeToString = map[string]string{
+	"ID":        "id",
+	"NAME":      "name",
+	"CREATEDAT": "createdat",
+}
+
+// SortValue returns the value of the column matching sortType, used as a
+// pagination keyset.
+func (w *Workspace) SortValue(sortType string) string {
+	switch sortType {
+	case "NAME":
+		return w.Name
+	case "CREATEDAT":
+		return w.CreatedAt.Format(time.RFC3339Nano)
+	default:
+		return strconv.FormatUint(w.ID, 10)
+	}
+}
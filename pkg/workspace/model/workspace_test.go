@@ -0,0 +1,25 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkspace_SortValue(t *testing.T) {
+	createdAt := time.Date(2024, 3, 1, 12, 30, 0, 500, time.UTC)
+	w := &Workspace{ID: 42, Name: "analysis", CreatedAt: createdAt}
+
+	expected := map[string]string{
+		"ID":        "42",
+		"NAME":      "analysis",
+		"CREATEDAT": "2024-03-01T12:30:00.0000005Z",
+	}
+	require.Len(t, WorkspaceSortTypeToString, len(expected), "every sort type has a keyset value")
+	for sortType, value := range expected {
+		require.Contains(t, WorkspaceSortTypeToString, sortType)
+		require.Equal(t, value, w.SortValue(sortType), sortType)
+	}
+	require.Equal(t, "42", w.SortValue("UNKNOWN"), "an unknown sort type falls back to the ID")
+}
@@ -2,26 +2,26 @@ package middleware
 
 import (
 	"context"
+	"time"
 
+	"github.com/CHORUS-TRE/chorus-backend/internal/client/runtime"
 	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
 	"github.com/CHORUS-TRE/chorus-backend/internal/utils/cache"
+	"github.com/CHORUS-TRE/chorus-backend/internal/utils/pagination"
 	common_model "github.com/CHORUS-TRE/chorus-backend/pkg/common/model"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/workspace/model"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/workspace/service"
-
-	"github.com/coocood/freecache"
 )
 
 const (
-	workspaceCacheSize     = 100 * 1024 * 1024 // Max 100MiB stored in memory
 	defaultCacheExpiration = 5
 	longCacheExpiration    = 60
 )
 
-func WorkspaceCaching(log *logger.ContextLogger) func(service.Workspaceer) *Caching {
+func WorkspaceCaching(log *logger.ContextLogger, backend cache.Backend) func(service.Workspaceer) *Caching {
 	return func(next service.Workspaceer) *Caching {
 		return &Caching{
-			cache: cache.NewCache(freecache.NewCache(workspaceCacheSize), log),
+			cache: cache.NewCache(backend, log),
 			next:  next,
 		}
 	}
@@ -32,14 +32,15 @@ type Caching struct {
 	next  service.Workspaceer
 }
 
-func (c *Caching) ListWorkspaces(ctx context.Context, tenantID uint64, pagination common_model.Pagination) (reply []*model.Workspace, err error) {
-	entry := c.cache.NewEntry(cache.WithUint64(tenantID), cache.WithInterface(pagination))
+func (c *Caching) ListWorkspaces(ctx context.Context, req service.ListWorkspacesReq) (reply []*model.Workspace, cursor *pagination.ResponseCursor[pagination.KeysetCursor], count uint64, err error) {
+	entry := c.cache.NewEntry(cache.WithInterface(req)).WithTags(cache.TenantTag(req.TenantID))
 	reply = []*model.Workspace{}
+	cursor = &pagination.ResponseCursor[pagination.KeysetCursor]{}
 
-	if ok := entry.Get(ctx, &reply); !ok {
-		reply, err = c.next.ListWorkspaces(ctx, tenantID, pagination)
+	if ok := entry.Get(ctx, &reply, &cursor, &count); !ok {
+		reply, cursor, count, err = c.next.ListWorkspaces(ctx, req)
 		if err == nil {
-			entry.Set(ctx, defaultCacheExpiration, reply)
+			entry.Set(ctx, defaultCacheExpiration, reply, cursor, count)
 		}
 	}
 
@@ -47,7 +48,7 @@ func (c *Caching) ListWorkspaces(ctx context.Context, tenantID uint64, paginatio
 }
 
 func (c *Caching) GetWorkspace(ctx context.Context, tenantID, workspaceID uint64) (reply *model.Workspace, err error) {
-	entry := c.cache.NewEntry(cache.WithUint64(tenantID), cache.WithUint64(workspaceID))
+	entry := c.cache.NewEntry(cache.WithUint64(tenantID), cache.WithUint64(workspaceID)).WithTags(cache.TenantTag(tenantID))
 	reply = &model.Workspace{}
 
 	if ok := entry.Get(ctx, &reply); !ok {
@@ -60,14 +61,63 @@ func (c *Caching) GetWorkspace(ctx context.Context, tenantID, workspaceID uint64
 	return
 }
 
-func (c *Caching) DeleteWorkspace(ctx context.Context, tenantID, workspaceID uint64) error {
-	return c.next.DeleteWorkspace(ctx, tenantID, workspaceID)
+func (c *Caching) DeleteWorkspace(ctx context.Context, tenantID, workspaceID uint64, force bool) (*model.WorkspaceTeardown, error) {
+	teardown, err := c.next.DeleteWorkspace(ctx, tenantID, workspaceID, force)
+	c.cache.Invalidate(ctx, cache.TenantTag(tenantID))
+	return teardown, err
+}
+
+func (c *Caching) RestoreWorkspace(ctx context.Context, tenantID, workspaceID uint64) error {
+	err := c.next.RestoreWorkspace(ctx, tenantID, workspaceID)
+	c.cache.Invalidate(ctx, cache.TenantTag(tenantID))
+	return err
+}
+
+func (c *Caching) ProvisionWorkspaceNamespace(ctx context.Context, tenantID, workspaceID uint64) error {
+	return c.next.ProvisionWorkspaceNamespace(ctx, tenantID, workspaceID)
+}
+
+// ListWorkspaceVolumes is not cached as it reports the live usage of the volumes.
+func (c *Caching) ListWorkspaceVolumes(ctx context.Context, tenantID, workspaceID uint64) ([]runtime.VolumeStatus, error) {
+	return c.next.ListWorkspaceVolumes(ctx, tenantID, workspaceID)
+}
+
+func (c *Caching) PurgeWorkspaces(ctx context.Context, deletedBefore time.Time) (common_model.Purged, error) {
+	purged, err := c.next.PurgeWorkspaces(ctx, deletedBefore)
+	for tenantID := range purged {
+		c.cache.Invalidate(ctx, cache.TenantTag(tenantID))
+	}
+	return purged, err
 }
 
 func (c *Caching) UpdateWorkspace(ctx context.Context, workspace *model.Workspace) error {
-	return c.next.UpdateWorkspace(ctx, workspace)
+	err := c.next.UpdateWorkspace(ctx, workspace)
+	c.cache.Invalidate(ctx, cache.TenantTag(workspace.TenantID))
+	return err
 }
 
 func (c *Caching) CreateWorkspace(ctx context.Context, workspace *model.Workspace) (uint64, error) {
-	return c.next.CreateWorkspace(ctx, workspace)
+	id, err := c.next.CreateWorkspace(ctx, workspace)
+	c.cache.Invalidate(ctx, cache.TenantTag(workspace.TenantID))
+	return id, err
+}
+
+func (c *Caching) ListWorkspaceMembers(ctx context.Context, tenantID, workspaceID uint64) ([]*model.WorkspaceMember, error) {
+	return c.next.ListWorkspaceMembers(ctx, tenantID, workspaceID)
+}
+
+func (c *Caching) IsWorkspaceMember(ctx context.Context, tenantID, workspaceID, userID uint64) (bool, error) {
+	return c.next.IsWorkspaceMember(ctx, tenantID, workspaceID, userID)
+}
+
+func (c *Caching) AddWorkspaceMember(ctx context.Context, tenantID, workspaceID, userID uint64) error {
+	err := c.next.AddWorkspaceMember(ctx, tenantID, workspaceID, userID)
+	c.cache.Invalidate(ctx, cache.TenantTag(tenantID))
+	return err
+}
+
+func (c *Caching) RemoveWorkspaceMember(ctx context.Context, tenantID, workspaceID, userID uint64) error {
+	err := c.next.RemoveWorkspaceMember(ctx, tenantID, workspaceID, userID)
+	c.cache.Invalidate(ctx, cache.TenantTag(tenantID))
+	return err
 }
@@ -5,7 +5,9 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/CHORUS-TRE/chorus-backend/internal/client/runtime"
 	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
+	"github.com/CHORUS-TRE/chorus-backend/internal/utils/pagination"
 	common_model "github.com/CHORUS-TRE/chorus-backend/pkg/common/model"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/workspace/model"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/workspace/service"
@@ -27,23 +29,24 @@ func Logging(logger *logger.ContextLogger) func(service.Workspaceer) service.Wor
 	}
 }
 
-func (c workspaceServiceLogging) ListWorkspaces(ctx context.Context, tenantID uint64, pagination common_model.Pagination) ([]*model.Workspace, error) {
+func (c workspaceServiceLogging) ListWorkspaces(ctx context.Context, req service.ListWorkspacesReq) ([]*model.Workspace, *pagination.ResponseCursor[pagination.KeysetCursor], uint64, error) {
 	now := time.Now()
 
-	res, err := c.next.ListWorkspaces(ctx, tenantID, pagination)
+	res, cursor, count, err := c.next.ListWorkspaces(ctx, req)
 	if err != nil {
 		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
 			zap.Error(err),
 			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
 		)
-		return res, fmt.Errorf("unable to get workspaces: %w", err)
+		return res, cursor, count, fmt.Errorf("unable to get workspaces: %w", err)
 	}
 
 	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
 		zap.Int("num_workspaces", len(res)),
+		logger.WithTotalItemsField(count),
 		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
 	)
-	return res, nil
+	return res, cursor, count, nil
 }
 
 func (c workspaceServiceLogging) GetWorkspace(ctx context.Context, tenantID, workspaceID uint64) (*model.Workspace, error) {
@@ -66,17 +69,70 @@ func (c workspaceServiceLogging) GetWorkspace(ctx context.Context, tenantID, wor
 	return res, nil
 }
 
-func (c workspaceServiceLogging) DeleteWorkspace(ctx context.Context, tenantID, workspaceID uint64) error {
+func (c workspaceServiceLogging) DeleteWorkspace(ctx context.Context, tenantID, workspaceID uint64, force bool) (*model.WorkspaceTeardown, error) {
+	now := time.Now()
+
+	teardown, err := c.next.DeleteWorkspace(ctx, tenantID, workspaceID, force)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			logger.WithWorkspaceIDField(workspaceID),
+			zap.Bool("force", force),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return teardown, fmt.Errorf("unable to delete workspace: %w", err)
+	}
+
+	for _, wt := range teardown.Workbenchs {
+		if !wt.Uninstalled {
+			c.logger.Warn(ctx, "unable to uninstall workbench",
+				logger.WithWorkspaceIDField(workspaceID),
+				logger.WithWorkbenchIDField(wt.WorkbenchID),
+				zap.String("error", wt.Error),
+			)
+		}
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		logger.WithWorkspaceIDField(workspaceID),
+		zap.Bool("force", force),
+		zap.Int("num_workbenchs", len(teardown.Workbenchs)),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return teardown, nil
+}
+
+func (c workspaceServiceLogging) RestoreWorkspace(ctx context.Context, tenantID, workspaceID uint64) error {
+	now := time.Now()
+
+	err := c.next.RestoreWorkspace(ctx, tenantID, workspaceID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			logger.WithWorkspaceIDField(workspaceID),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return fmt.Errorf("unable to restore workspace: %w", err)
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		logger.WithWorkspaceIDField(workspaceID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return nil
+}
+
+func (c workspaceServiceLogging) ProvisionWorkspaceNamespace(ctx context.Context, tenantID, workspaceID uint64) error {
 	now := time.Now()
 
-	err := c.next.DeleteWorkspace(ctx, tenantID, workspaceID)
+	err := c.next.ProvisionWorkspaceNamespace(ctx, tenantID, workspaceID)
 	if err != nil {
 		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
 			zap.Error(err),
 			logger.WithWorkspaceIDField(workspaceID),
 			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
 		)
-		return fmt.Errorf("unable to delete workspace: %w", err)
+		return fmt.Errorf("unable to provision workspace namespace: %w", err)
 	}
 
 	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
@@ -86,6 +142,46 @@ func (c workspaceServiceLogging) DeleteWorkspace(ctx context.Context, tenantID,
 	return nil
 }
 
+func (c workspaceServiceLogging) ListWorkspaceVolumes(ctx context.Context, tenantID, workspaceID uint64) ([]runtime.VolumeStatus, error) {
+	now := time.Now()
+
+	res, err := c.next.ListWorkspaceVolumes(ctx, tenantID, workspaceID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			logger.WithWorkspaceIDField(workspaceID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return res, fmt.Errorf("unable to list workspace volumes: %w", err)
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		logger.WithWorkspaceIDField(workspaceID),
+		zap.Int("num_volumes", len(res)),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, nil
+}
+
+func (c workspaceServiceLogging) PurgeWorkspaces(ctx context.Context, deletedBefore time.Time) (common_model.Purged, error) {
+	now := time.Now()
+
+	purged, err := c.next.PurgeWorkspaces(ctx, deletedBefore)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return purged, fmt.Errorf("unable to purge workspaces: %w", err)
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		zap.Int64("num_purged", purged.Total()),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return purged, nil
+}
+
 func (c workspaceServiceLogging) UpdateWorkspace(ctx context.Context, workspace *model.Workspace) error {
 	now := time.Now()
 
@@ -124,3 +220,91 @@ func (c workspaceServiceLogging) CreateWorkspace(ctx context.Context, workspace
 	)
 	return workspaceId, nil
 }
+
+func (c workspaceServiceLogging) ListWorkspaceMembers(ctx context.Context, tenantID, workspaceID uint64) ([]*model.WorkspaceMember, error) {
+	now := time.Now()
+
+	res, err := c.next.ListWorkspaceMembers(ctx, tenantID, workspaceID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			logger.WithWorkspaceIDField(workspaceID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return res, fmt.Errorf("unable to get workspace members: %w", err)
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		logger.WithWorkspaceIDField(workspaceID),
+		zap.Int("num_members", len(res)),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, nil
+}
+
+func (c workspaceServiceLogging) IsWorkspaceMember(ctx context.Context, tenantID, workspaceID, userID uint64) (bool, error) {
+	now := time.Now()
+
+	res, err := c.next.IsWorkspaceMember(ctx, tenantID, workspaceID, userID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			logger.WithWorkspaceIDField(workspaceID),
+			logger.WithUserIDField(userID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return res, fmt.Errorf("unable to check workspace membership: %w", err)
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		logger.WithWorkspaceIDField(workspaceID),
+		logger.WithUserIDField(userID),
+		zap.Bool("is_member", res),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, nil
+}
+
+func (c workspaceServiceLogging) AddWorkspaceMember(ctx context.Context, tenantID, workspaceID, userID uint64) error {
+	now := time.Now()
+
+	err := c.next.AddWorkspaceMember(ctx, tenantID, workspaceID, userID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			logger.WithWorkspaceIDField(workspaceID),
+			logger.WithUserIDField(userID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return fmt.Errorf("unable to add workspace member: %w", err)
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		logger.WithWorkspaceIDField(workspaceID),
+		logger.WithUserIDField(userID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return nil
+}
+
+func (c workspaceServiceLogging) RemoveWorkspaceMember(ctx context.Context, tenantID, workspaceID, userID uint64) error {
+	now := time.Now()
+
+	err := c.next.RemoveWorkspaceMember(ctx, tenantID, workspaceID, userID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			logger.WithWorkspaceIDField(workspaceID),
+			logger.WithUserIDField(userID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return fmt.Errorf("unable to remove workspace member: %w", err)
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		logger.WithWorkspaceIDField(workspaceID),
+		logger.WithUserIDField(userID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return nil
+}
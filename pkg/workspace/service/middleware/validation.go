@@ -2,8 +2,13 @@ package middleware
 
 import (
 	"context"
+	"fmt"
+	"time"
 
+	"github.com/CHORUS-TRE/chorus-backend/internal/client/runtime"
+	"github.com/CHORUS-TRE/chorus-backend/internal/utils/pagination"
 	common_model "github.com/CHORUS-TRE/chorus-backend/pkg/common/model"
+	common_service "github.com/CHORUS-TRE/chorus-backend/pkg/common/service"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/workspace/model"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/workspace/service"
 
@@ -24,19 +29,38 @@ func Validation(validate *val.Validate) func(service.Workspaceer) service.Worksp
 	}
 }
 
-func (v validation) ListWorkspaces(ctx context.Context, tenantID uint64, pagination common_model.Pagination) ([]*model.Workspace, error) {
-	if err := v.validate.Struct(pagination); err != nil {
-		return nil, err
+func (v validation) ListWorkspaces(ctx context.Context, req service.ListWorkspacesReq) ([]*model.Workspace, *pagination.ResponseCursor[pagination.KeysetCursor], uint64, error) {
+	if err := v.validate.Struct(req); err != nil {
+		return nil, nil, 0, err
 	}
-	return v.next.ListWorkspaces(ctx, tenantID, pagination)
+	if req.Cursor != nil && req.Cursor.PageSize > service.MaxWorkspacesPageSize {
+		return nil, nil, 0, fmt.Errorf("page size must not exceed %v: %w", service.MaxWorkspacesPageSize, &common_service.InvalidParametersErr{})
+	}
+	return v.next.ListWorkspaces(ctx, req)
 }
 
 func (v validation) GetWorkspace(ctx context.Context, tenantID, workspaceID uint64) (*model.Workspace, error) {
 	return v.next.GetWorkspace(ctx, tenantID, workspaceID)
 }
 
-func (v validation) DeleteWorkspace(ctx context.Context, tenantID, workspaceID uint64) error {
-	return v.next.DeleteWorkspace(ctx, tenantID, workspaceID)
+func (v validation) DeleteWorkspace(ctx context.Context, tenantID, workspaceID uint64, force bool) (*model.WorkspaceTeardown, error) {
+	return v.next.DeleteWorkspace(ctx, tenantID, workspaceID, force)
+}
+
+func (v validation) RestoreWorkspace(ctx context.Context, tenantID, workspaceID uint64) error {
+	return v.next.RestoreWorkspace(ctx, tenantID, workspaceID)
+}
+
+func (v validation) ProvisionWorkspaceNamespace(ctx context.Context, tenantID, workspaceID uint64) error {
+	return v.next.ProvisionWorkspaceNamespace(ctx, tenantID, workspaceID)
+}
+
+func (v validation) ListWorkspaceVolumes(ctx context.Context, tenantID, workspaceID uint64) ([]runtime.VolumeStatus, error) {
+	return v.next.ListWorkspaceVolumes(ctx, tenantID, workspaceID)
+}
+
+func (v validation) PurgeWorkspaces(ctx context.Context, deletedBefore time.Time) (common_model.Purged, error) {
+	return v.next.PurgeWorkspaces(ctx, deletedBefore)
 }
 
 func (v validation) UpdateWorkspace(ctx context.Context, workspace *model.Workspace) error {
@@ -52,3 +76,19 @@ func (v validation) CreateWorkspace(ctx context.Context, workspace *model.Worksp
 	}
 	return v.next.CreateWorkspace(ctx, workspace)
 }
+
+func (v validation) ListWorkspaceMembers(ctx context.Context, tenantID, workspaceID uint64) ([]*model.WorkspaceMember, error) {
+	return v.next.ListWorkspaceMembers(ctx, tenantID, workspaceID)
+}
+
+func (v validation) IsWorkspaceMember(ctx context.Context, tenantID, workspaceID, userID uint64) (bool, error) {
+	return v.next.IsWorkspaceMember(ctx, tenantID, workspaceID, userID)
+}
+
+func (v validation) AddWorkspaceMember(ctx context.Context, tenantID, workspaceID, userID uint64) error {
+	return v.next.AddWorkspaceMember(ctx, tenantID, workspaceID, userID)
+}
+
+func (v validation) RemoveWorkspaceMember(ctx context.Context, tenantID, workspaceID, userID uint64) error {
+	return v.next.RemoveWorkspaceMember(ctx, tenantID, workspaceID, userID)
+}
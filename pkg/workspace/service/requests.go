@@ -0,0 +1,44 @@
+package service
+
+import (
+	"github.com/CHORUS-TRE/chorus-backend/internal/utils/pagination"
+	common_model "github.com/CHORUS-TRE/chorus-backend/pkg/common/model"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/workspace/model"
+)
+
+// MaxWorkspacesPageSize is the maximum number of workspaces that can be requested
+// in a single page.
+const MaxWorkspacesPageSize = 500
+
+type ListWorkspacesReq struct {
+	TenantID uint64
+	Cursor   *pagination.RequestCursor[pagination.KeysetCursor]
+	Filter   WorkspaceFilter
+	Sort     Sort `validate:"required"`
+}
+
+type WorkspaceFilter struct {
+	Status  string `validate:"omitempty,oneof=active inactive deleted"`
+	OwnerID uint64
+	Name    string `validate:"max=255,generalstring"`
+}
+
+type Sort struct {
+	SortOrder string `validate:"required,oneof=DESC ASC"`
+	SortType  string `validate:"required,oneof=ID NAME CREATEDAT"`
+}
+
+func (s Sort) ToBusinessSort() common_model.Sort {
+	return common_model.Sort{
+		SortOrder: s.SortOrder,
+		SortType:  s.SortType,
+	}
+}
+
+func (f WorkspaceFilter) ToBusinessFilter() model.WorkspaceFilter {
+	return model.WorkspaceFilter{
+		Status:  model.WorkspaceStatus(f.Status),
+		OwnerID: f.OwnerID,
+		Name:    f.Name,
+	}
+}
@@ -2,74 +2,180 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
-	"github.com/CHORUS-TRE/chorus-backend/internal/client/helm"
+	"github.com/CHORUS-TRE/chorus-backend/internal/client/k8s"
+	"github.com/CHORUS-TRE/chorus-backend/internal/client/runtime"
+	"github.com/CHORUS-TRE/chorus-backend/internal/config"
+	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
+	"github.com/CHORUS-TRE/chorus-backend/internal/notification"
+	"github.com/CHORUS-TRE/chorus-backend/internal/utils/pagination"
+	"github.com/CHORUS-TRE/chorus-backend/internal/utils/uuid"
 	common_model "github.com/CHORUS-TRE/chorus-backend/pkg/common/model"
+	common_service "github.com/CHORUS-TRE/chorus-backend/pkg/common/service"
+	notification_model "github.com/CHORUS-TRE/chorus-backend/pkg/notification/model"
+	quota_model "github.com/CHORUS-TRE/chorus-backend/pkg/quota/model"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/workspace/model"
+
+	"go.uber.org/zap"
 )
 
 type Workspaceer interface {
 	GetWorkspace(ctx context.Context, tenantID, workspaceID uint64) (*model.Workspace, error)
-	ListWorkspaces(ctx context.Context, tenantID uint64, pagination common_model.Pagination) ([]*model.Workspace, error)
+	ListWorkspaces(ctx context.Context, req ListWorkspacesReq) ([]*model.Workspace, *pagination.ResponseCursor[pagination.KeysetCursor], uint64, error)
 	CreateWorkspace(ctx context.Context, workspace *model.Workspace) (uint64, error)
 	UpdateWorkspace(ctx context.Context, workspace *model.Workspace) error
-	DeleteWorkspace(ctx context.Context, tenantId, workspaceId uint64) error
+	DeleteWorkspace(ctx context.Context, tenantID, workspaceID uint64, force bool) (*model.WorkspaceTeardown, error)
+	RestoreWorkspace(ctx context.Context, tenantID, workspaceID uint64) error
+	PurgeWorkspaces(ctx context.Context, deletedBefore time.Time) (common_model.Purged, error)
+	ProvisionWorkspaceNamespace(ctx context.Context, tenantID, workspaceID uint64) error
+	ListWorkspaceVolumes(ctx context.Context, tenantID, workspaceID uint64) ([]runtime.VolumeStatus, error)
+	ListWorkspaceMembers(ctx context.Context, tenantID, workspaceID uint64) ([]*model.WorkspaceMember, error)
+	IsWorkspaceMember(ctx context.Context, tenantID, workspaceID, userID uint64) (bool, error)
+	AddWorkspaceMember(ctx context.Context, tenantID, workspaceID, userID uint64) error
+	RemoveWorkspaceMember(ctx context.Context, tenantID, workspaceID, userID uint64) error
 }
 
 type WorkspaceStore interface {
 	GetWorkspace(ctx context.Context, tenantID uint64, workspaceID uint64) (*model.Workspace, error)
-	ListWorkspaces(ctx context.Context, tenantID uint64, pagination common_model.Pagination) ([]*model.Workspace, error)
+	ListWorkspaces(ctx context.Context, tenantID uint64, filter model.WorkspaceFilter, sort common_model.Sort, cursor *pagination.RequestCursor[pagination.KeysetCursor]) ([]*model.Workspace, *pagination.ResponseCursor[pagination.KeysetCursor], uint64, error)
 	CreateWorkspace(ctx context.Context, tenantID uint64, workspace *model.Workspace) (uint64, error)
+	RemoveWorkspace(ctx context.Context, tenantID uint64, workspaceID uint64) error
 	UpdateWorkspace(ctx context.Context, tenantID uint64, workspace *model.Workspace) error
-	DeleteWorkspace(ctx context.Context, tenantID uint64, workspaceID uint64) error
+	DeleteWorkspace(ctx context.Context, tenantID uint64, workspaceID uint64, force bool) ([]uint64, error)
+	RestoreWorkspace(ctx context.Context, tenantID uint64, workspaceID uint64, deletedAfter time.Time) error
+	ListPurgeableWorkspaces(ctx context.Context, deletedBefore time.Time) ([]uint64, error)
+	PurgeWorkspaces(ctx context.Context, deletedBefore time.Time, workspaceIDs []uint64) (common_model.Purged, error)
+	ListWorkspaceMembers(ctx context.Context, tenantID, workspaceID uint64) ([]*model.WorkspaceMember, error)
+	AddWorkspaceMember(ctx context.Context, tenantID, workspaceID, userID uint64) error
+	RemoveWorkspaceMember(ctx context.Context, tenantID, workspaceID, userID uint64) error
+}
+
+// QuotaGetter returns the quotas applying to a workspace, which bound the
+// resources of its namespace.
+type QuotaGetter interface {
+	GetUsage(ctx context.Context, tenantID, workspaceID, userID uint64) ([]*quota_model.QuotaUsage, error)
 }
 
 type WorkspaceService struct {
-	store  WorkspaceStore
-	client helm.HelmClienter
+	cfg         config.Config
+	store       WorkspaceStore
+	runtime     runtime.WorkbenchRuntime
+	provisioner k8s.NamespaceProvisioner
+	quota       QuotaGetter
+	notifier    notification.Notifier
 }
 
-func NewWorkspaceService(store WorkspaceStore, client helm.HelmClienter) *WorkspaceService {
+func NewWorkspaceService(cfg config.Config, store WorkspaceStore, runtime runtime.WorkbenchRuntime, provisioner k8s.NamespaceProvisioner, quota QuotaGetter, notifier notification.Notifier) *WorkspaceService {
 	return &WorkspaceService{
-		store:  store,
-		client: client,
+		cfg:         cfg,
+		store:       store,
+		runtime:     runtime,
+		provisioner: provisioner,
+		quota:       quota,
+		notifier:    notifier,
 	}
 }
 
-func (u *WorkspaceService) ListWorkspaces(ctx context.Context, tenantID uint64, pagination common_model.Pagination) ([]*model.Workspace, error) {
-	workspaces, err := u.store.ListWorkspaces(ctx, tenantID, pagination)
+func (u *WorkspaceService) ListWorkspaces(ctx context.Context, req ListWorkspacesReq) ([]*model.Workspace, *pagination.ResponseCursor[pagination.KeysetCursor], uint64, error) {
+	workspaces, cursor, count, err := u.store.ListWorkspaces(ctx, req.TenantID, req.Filter.ToBusinessFilter(), req.Sort.ToBusinessSort(), req.Cursor)
 	if err != nil {
-		return nil, fmt.Errorf("unable to query workspaces: %w", err)
+		return nil, nil, 0, fmt.Errorf("unable to query workspaces: %w", err)
 	}
-	return workspaces, nil
+	return workspaces, cursor, count, nil
 }
 
 func (u *WorkspaceService) GetWorkspace(ctx context.Context, tenantID, workspaceID uint64) (*model.Workspace, error) {
 	workspace, err := u.store.GetWorkspace(ctx, tenantID, workspaceID)
 	if err != nil {
-		return nil, fmt.Errorf("unable to get workspace %v: %w", workspace.ID, err)
+		return nil, fmt.Errorf("unable to get workspace %v: %w", workspaceID, err)
 	}
 
 	return workspace, nil
 }
 
-func (u *WorkspaceService) DeleteWorkspace(ctx context.Context, tenantID, workspaceID uint64) error {
-	err := u.store.DeleteWorkspace(ctx, tenantID, workspaceID)
+// DeleteWorkspace deletes a workspace along with its workbenches and app
+// instances, and uninstalls the workbenches. A workspace with running
+// workbenches is only deleted when force is set. The namespace of the
+// workspace is kept until it is purged, and the teardown is reported rather
+// than failing the deletion: what it leaves behind is removed with the
+// namespace.
+func (u *WorkspaceService) DeleteWorkspace(ctx context.Context, tenantID, workspaceID uint64, force bool) (*model.WorkspaceTeardown, error) {
+	workbenchIDs, err := u.store.DeleteWorkspace(ctx, tenantID, workspaceID, force)
+	if errors.Is(err, model.ErrRunningWorkbenchs) {
+		return nil, fmt.Errorf("unable to delete workspace %v: %v: %w", workspaceID, err, &common_service.FailedPreconditionErr{})
+	}
 	if err != nil {
-		return fmt.Errorf("unable to delete workspace %v: %w", workspaceID, err)
+		return nil, fmt.Errorf("unable to delete workspace %v: %w", workspaceID, err)
+	}
+
+	return u.teardown(workspaceID, workbenchIDs), nil
+}
+
+// teardown uninstalls the workbenches of a deleted workspace, which also
+// removes their app instances. Uninstalling a workbench that is not installed
+// succeeds, so that the teardown can be run again.
+func (u *WorkspaceService) teardown(workspaceID uint64, workbenchIDs []uint64) *model.WorkspaceTeardown {
+	namespace := u.getWorkspaceName(workspaceID)
+
+	teardown := &model.WorkspaceTeardown{}
+	for _, workbenchID := range workbenchIDs {
+		wt := model.WorkbenchTeardown{WorkbenchID: workbenchID}
+		if err := u.runtime.DeleteWorkbench(namespace, u.getWorkbenchName(workbenchID)); err != nil {
+			wt.Error = err.Error()
+		} else {
+			wt.Uninstalled = true
+		}
+		teardown.Workbenchs = append(teardown.Workbenchs, wt)
 	}
 
-	// TODO implement delete all workspaces and appInstances
+	return teardown
+}
 
-	// err = u.client.DeleteWorkbench(u.getWorkbenchName(workspaceID), u.getWorkbenchName(workspaceID))
-	// if err != nil {
-	// 	return fmt.Errorf("unable to delete workbench %v: %w", workspaceID, err)
-	// }
+// RestoreWorkspace restores a workspace deleted during the grace period to
+// the status it had before, along with the workbenches and app instances
+// deleted with it, which are left inactive. Its namespace is provisioned
+// again, in case it drifted or was removed while the workspace was deleted.
+func (u *WorkspaceService) RestoreWorkspace(ctx context.Context, tenantID, workspaceID uint64) error {
+	deletedAfter := time.Now().Add(-u.cfg.Services.SoftDelete.GracePeriod)
+	if err := u.store.RestoreWorkspace(ctx, tenantID, workspaceID, deletedAfter); err != nil {
+		return fmt.Errorf("unable to restore workspace %v: %w", workspaceID, err)
+	}
 
+	if err := u.ProvisionWorkspaceNamespace(ctx, tenantID, workspaceID); err != nil {
+		return fmt.Errorf("unable to restore the namespace of workspace %v: %w", workspaceID, err)
+	}
 	return nil
 }
 
+// PurgeWorkspaces hard-deletes the workspaces deleted before deletedBefore
+// once their namespace is deleted. The workspaces whose namespace cannot be
+// deleted are left for the next purge.
+func (u *WorkspaceService) PurgeWorkspaces(ctx context.Context, deletedBefore time.Time) (common_model.Purged, error) {
+	workspaceIDs, err := u.store.ListPurgeableWorkspaces(ctx, deletedBefore)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list the workspaces to purge: %w", err)
+	}
+
+	var tornDown []uint64
+	for _, workspaceID := range workspaceIDs {
+		namespace := u.getWorkspaceName(workspaceID)
+		if err := u.runtime.DeleteNamespace(namespace); err != nil {
+			logger.TechLog.Error(ctx, "unable to delete namespace", logger.WithWorkspaceIDField(workspaceID), zap.Error(err))
+			continue
+		}
+		tornDown = append(tornDown, workspaceID)
+	}
+
+	purged, err := u.store.PurgeWorkspaces(ctx, deletedBefore, tornDown)
+	if err != nil {
+		return nil, fmt.Errorf("unable to purge workspaces: %w", err)
+	}
+	return purged, nil
+}
+
 func (u *WorkspaceService) UpdateWorkspace(ctx context.Context, workspace *model.Workspace) error {
 	if err := u.store.UpdateWorkspace(ctx, workspace.TenantID, workspace); err != nil {
 		return fmt.Errorf("unable to update workspace %v: %w", workspace.ID, err)
@@ -84,16 +190,147 @@ func (u *WorkspaceService) CreateWorkspace(ctx context.Context, workspace *model
 		return 0, fmt.Errorf("unable to create workspace %v: %w", workspace.ID, err)
 	}
 
-	// should we do something as we can lazily create namespace with helm if needed..?
-
-	// err = u.client.CreateWorkbench(u.getWorkbenchName(id), u.getWorkbenchName(id))
-	// if err != nil {
-	// 	return 0, fmt.Errorf("unable to create workbench %v: %w", workspace.ID, err)
-	// }
+	if err := u.ProvisionWorkspaceNamespace(ctx, workspace.TenantID, id); err != nil {
+		u.rollbackCreate(ctx, workspace.TenantID, id)
+		return 0, fmt.Errorf("unable to create workspace %v: %w", id, err)
+	}
 
 	return id, nil
 }
 
-// func (u *WorkspaceService) getWorkbenchName(id uint64) string {
-// 	return "workbench" + fmt.Sprintf("%v", id)
-// }
+// rollbackCreate removes a workspace whose namespace could not be
+// provisioned, along with what was provisioned of it.
+func (u *WorkspaceService) rollbackCreate(ctx context.Context, tenantID, workspaceID uint64) {
+	if err := u.runtime.DeleteNamespace(u.getWorkspaceName(workspaceID)); err != nil {
+		logger.TechLog.Error(ctx, "unable to delete namespace", logger.WithWorkspaceIDField(workspaceID), zap.Error(err))
+	}
+	if err := u.store.RemoveWorkspace(ctx, tenantID, workspaceID); err != nil {
+		logger.TechLog.Error(ctx, "unable to remove workspace", logger.WithWorkspaceIDField(workspaceID), zap.Error(err))
+	}
+}
+
+// ProvisionWorkspaceNamespace applies the isolation of the namespace of a
+// workspace, its resources being bounded by the quota of the workspace. It is
+// run again to reconcile the namespace with the quota when it changes.
+func (u *WorkspaceService) ProvisionWorkspaceNamespace(ctx context.Context, tenantID, workspaceID uint64) error {
+	usages, err := u.quota.GetUsage(ctx, tenantID, workspaceID, 0)
+	if err != nil {
+		return fmt.Errorf("unable to get the quota of workspace %v: %w", workspaceID, err)
+	}
+
+	spec := k8s.NamespaceSpec{
+		Name: u.getWorkspaceName(workspaceID),
+		Labels: map[string]string{
+			"chorus-tre.ch/tenant-id":    fmt.Sprintf("%v", tenantID),
+			"chorus-tre.ch/workspace-id": fmt.Sprintf("%v", workspaceID),
+		},
+	}
+	for _, usage := range usages {
+		if usage.Scope == quota_model.QuotaScopeWorkspace && usage.Quota != nil {
+			spec.MaxCPU = usage.Quota.MaxCPU
+			spec.MaxMemory = usage.Quota.MaxMemory
+		}
+	}
+
+	if err := u.provisioner.ProvisionNamespace(ctx, spec); err != nil {
+		return fmt.Errorf("unable to provision the namespace of workspace %v: %w", workspaceID, err)
+	}
+
+	// The home volumes of the members are created along with their first
+	// workbench.
+	shared := runtime.SharedVolume(u.cfg.Clients.WorkbenchRuntime.Volumes)
+	if err := u.runtime.CreateVolume(ctx, spec.Name, shared); err != nil {
+		return fmt.Errorf("unable to create the shared volume of workspace %v: %w", workspaceID, err)
+	}
+	return nil
+}
+
+// ListWorkspaceVolumes returns the volumes of a workspace along with their
+// usage: the volume shared by its members and their home volumes.
+func (u *WorkspaceService) ListWorkspaceVolumes(ctx context.Context, tenantID, workspaceID uint64) ([]runtime.VolumeStatus, error) {
+	if _, err := u.store.GetWorkspace(ctx, tenantID, workspaceID); err != nil {
+		return nil, fmt.Errorf("unable to get workspace %v: %w", workspaceID, err)
+	}
+
+	volumes, err := u.runtime.Volumes(ctx, u.getWorkspaceName(workspaceID))
+	if err != nil {
+		return nil, fmt.Errorf("unable to get the volumes of workspace %v: %w", workspaceID, err)
+	}
+	return volumes, nil
+}
+
+func (u *WorkspaceService) ListWorkspaceMembers(ctx context.Context, tenantID, workspaceID uint64) ([]*model.WorkspaceMember, error) {
+	members, err := u.store.ListWorkspaceMembers(ctx, tenantID, workspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query members of workspace %v: %w", workspaceID, err)
+	}
+	return members, nil
+}
+
+// IsWorkspaceMember returns whether the user owns the workspace or is one of
+// its members.
+func (u *WorkspaceService) IsWorkspaceMember(ctx context.Context, tenantID, workspaceID, userID uint64) (bool, error) {
+	workspace, err := u.store.GetWorkspace(ctx, tenantID, workspaceID)
+	if err != nil {
+		return false, fmt.Errorf("unable to get workspace %v: %w", workspaceID, err)
+	}
+	if workspace.UserID == userID {
+		return true, nil
+	}
+
+	members, err := u.store.ListWorkspaceMembers(ctx, tenantID, workspaceID)
+	if err != nil {
+		return false, fmt.Errorf("unable to query members of workspace %v: %w", workspaceID, err)
+	}
+	for _, m := range members {
+		if m.UserID == userID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (u *WorkspaceService) AddWorkspaceMember(ctx context.Context, tenantID, workspaceID, userID uint64) error {
+	workspace, err := u.store.GetWorkspace(ctx, tenantID, workspaceID)
+	if err != nil {
+		return fmt.Errorf("unable to get workspace %v: %w", workspaceID, err)
+	}
+
+	members, err := u.store.ListWorkspaceMembers(ctx, tenantID, workspaceID)
+	if err != nil {
+		return fmt.Errorf("unable to query members of workspace %v: %w", workspaceID, err)
+	}
+	for _, m := range members {
+		if m.UserID == userID {
+			return nil
+		}
+	}
+
+	if err := u.store.AddWorkspaceMember(ctx, tenantID, workspaceID, userID); err != nil {
+		return fmt.Errorf("unable to add user %v to workspace %v: %w", userID, workspaceID, err)
+	}
+
+	n := notification.NewNotificationBuilder(uuid.Next(), tenantID).
+		WithType(notification_model.NotificationTypeWorkspaceMemberAdded).
+		WithLink(notification.WorkspaceLink(workspaceID)).
+		WithParam("workspace", workspace.Name).
+		Build()
+	notification.Notify(ctx, u.notifier, n, userID)
+
+	return nil
+}
+
+func (u *WorkspaceService) RemoveWorkspaceMember(ctx context.Context, tenantID, workspaceID, userID uint64) error {
+	if err := u.store.RemoveWorkspaceMember(ctx, tenantID, workspaceID, userID); err != nil {
+		return fmt.Errorf("unable to remove user %v from workspace %v: %w", userID, workspaceID, err)
+	}
+	return nil
+}
+
+func (u *WorkspaceService) getWorkspaceName(id uint64) string {
+	return fmt.Sprintf("workspace%v", id)
+}
+
+func (u *WorkspaceService) getWorkbenchName(id uint64) string {
+	return fmt.Sprintf("workbench%v", id)
+}
@@ -0,0 +1,181 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/client/k8s"
+	"github.com/CHORUS-TRE/chorus-backend/internal/client/runtime"
+	"github.com/CHORUS-TRE/chorus-backend/internal/config"
+	"github.com/CHORUS-TRE/chorus-backend/internal/utils/grpc"
+	common_model "github.com/CHORUS-TRE/chorus-backend/pkg/common/model"
+	quota_model "github.com/CHORUS-TRE/chorus-backend/pkg/quota/model"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/workspace/model"
+	"github.com/CHORUS-TRE/chorus-backend/tests/unit"
+)
+
+type workspaceStore struct {
+	WorkspaceStore
+
+	running      bool
+	workbenchIDs []uint64
+	purgeable    []uint64
+
+	deletedAfter  time.Time
+	deletedBefore time.Time
+	purgedIDs     []uint64
+	removed       []uint64
+}
+
+func (s *workspaceStore) CreateWorkspace(ctx context.Context, tenantID uint64, workspace *model.Workspace) (uint64, error) {
+	return 2, nil
+}
+
+func (s *workspaceStore) RemoveWorkspace(ctx context.Context, tenantID uint64, workspaceID uint64) error {
+	s.removed = append(s.removed, workspaceID)
+	return nil
+}
+
+func (s *workspaceStore) DeleteWorkspace(ctx context.Context, tenantID uint64, workspaceID uint64, force bool) ([]uint64, error) {
+	if s.running && !force {
+		return nil, model.ErrRunningWorkbenchs
+	}
+	return s.workbenchIDs, nil
+}
+
+func (s *workspaceStore) RestoreWorkspace(ctx context.Context, tenantID uint64, workspaceID uint64, deletedAfter time.Time) error {
+	s.deletedAfter = deletedAfter
+	return nil
+}
+
+func (s *workspaceStore) ListPurgeableWorkspaces(ctx context.Context, deletedBefore time.Time) ([]uint64, error) {
+	return s.purgeable, nil
+}
+
+func (s *workspaceStore) PurgeWorkspaces(ctx context.Context, deletedBefore time.Time, workspaceIDs []uint64) (common_model.Purged, error) {
+	s.deletedBefore = deletedBefore
+	s.purgedIDs = workspaceIDs
+	return common_model.Purged{1: int64(len(workspaceIDs))}, nil
+}
+
+type workspaceRuntime struct {
+	runtime.WorkbenchRuntime
+
+	failing    map[string]bool
+	workbenchs []string
+	namespaces []string
+	volumes    []string
+}
+
+func (r *workspaceRuntime) CreateVolume(ctx context.Context, namespace string, volume runtime.Volume) error {
+	r.volumes = append(r.volumes, namespace)
+	return nil
+}
+
+func (r *workspaceRuntime) DeleteWorkbench(namespace, workbenchName string) error {
+	if r.failing[workbenchName] {
+		return errors.New("unreachable")
+	}
+	r.workbenchs = append(r.workbenchs, workbenchName)
+	return nil
+}
+
+func (r *workspaceRuntime) DeleteNamespace(namespace string) error {
+	if r.failing[namespace] {
+		return errors.New("unreachable")
+	}
+	r.namespaces = append(r.namespaces, namespace)
+	return nil
+}
+
+type quotaGetter struct{}
+
+func (quotaGetter) GetUsage(ctx context.Context, tenantID, workspaceID, userID uint64) ([]*quota_model.QuotaUsage, error) {
+	return nil, nil
+}
+
+type namespaceProvisioner struct {
+	err        error
+	namespaces []string
+}
+
+func (p *namespaceProvisioner) ProvisionNamespace(ctx context.Context, spec k8s.NamespaceSpec) error {
+	if p.err != nil {
+		return p.err
+	}
+	p.namespaces = append(p.namespaces, spec.Name)
+	return nil
+}
+
+func TestCreateWorkspace(t *testing.T) {
+	unit.InitTestLogger()
+
+	store := &workspaceStore{}
+	rt := &workspaceRuntime{}
+	provisioner := &namespaceProvisioner{}
+	s := NewWorkspaceService(config.Config{}, store, rt, provisioner, quotaGetter{}, nil)
+
+	id, err := s.CreateWorkspace(context.Background(), &model.Workspace{TenantID: 1})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), id)
+	require.Equal(t, []string{"workspace2"}, provisioner.namespaces)
+	require.Equal(t, []string{"workspace2"}, rt.volumes)
+
+	provisioner.err = errors.New("forbidden")
+	_, err = s.CreateWorkspace(context.Background(), &model.Workspace{TenantID: 1})
+	require.Error(t, err)
+	require.Equal(t, []uint64{2}, store.removed, "a workspace without namespace is removed")
+	require.Equal(t, []string{"workspace2"}, rt.namespaces, "what was provisioned of the namespace is deleted")
+}
+
+func TestDeleteWorkspace(t *testing.T) {
+	store := &workspaceStore{running: true, workbenchIDs: []uint64{3, 4}}
+	rt := &workspaceRuntime{failing: map[string]bool{"workbench4": true}}
+	s := NewWorkspaceService(config.Config{}, store, rt, nil, nil, nil)
+
+	_, err := s.DeleteWorkspace(context.Background(), 1, 2, false)
+	require.Equal(t, codes.FailedPrecondition, grpc.ErrorCode(err), "a workspace with running workbenchs is kept")
+	require.Empty(t, rt.workbenchs)
+
+	teardown, err := s.DeleteWorkspace(context.Background(), 1, 2, true)
+	require.NoError(t, err, "a failed uninstallation does not fail the deletion")
+	require.Equal(t, []model.WorkbenchTeardown{
+		{WorkbenchID: 3, Uninstalled: true},
+		{WorkbenchID: 4, Error: "unreachable"},
+	}, teardown.Workbenchs)
+	require.Empty(t, rt.namespaces, "the namespace is kept until the workspace is purged")
+}
+
+func TestRestoreWorkspace(t *testing.T) {
+	var cfg config.Config
+	cfg.Services.SoftDelete.GracePeriod = 24 * time.Hour
+
+	store := &workspaceStore{}
+	provisioner := &namespaceProvisioner{}
+	s := NewWorkspaceService(cfg, store, &workspaceRuntime{}, provisioner, quotaGetter{}, nil)
+
+	require.NoError(t, s.RestoreWorkspace(context.Background(), 1, 2))
+	require.WithinDuration(t, time.Now().Add(-24*time.Hour), store.deletedAfter, time.Minute, "only the workspaces deleted during the grace period are restored")
+	require.Equal(t, []string{"workspace2"}, provisioner.namespaces, "the namespace is provisioned again")
+}
+
+func TestPurgeWorkspaces(t *testing.T) {
+	unit.InitTestLogger()
+
+	store := &workspaceStore{purgeable: []uint64{2, 3, 4}}
+	rt := &workspaceRuntime{failing: map[string]bool{"workspace3": true}}
+	s := NewWorkspaceService(config.Config{}, store, rt, nil, nil, nil)
+
+	deletedBefore := time.Now().Add(-time.Hour)
+	purged, err := s.PurgeWorkspaces(context.Background(), deletedBefore)
+	require.NoError(t, err)
+	require.Equal(t, deletedBefore, store.deletedBefore)
+	require.Equal(t, []string{"workspace2", "workspace4"}, rt.namespaces)
+	require.Equal(t, []uint64{2, 4}, store.purgedIDs, "the workspaces whose namespace is left are purged later")
+	require.Equal(t, common_model.Purged{1: 2}, purged)
+}
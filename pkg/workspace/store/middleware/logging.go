@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
+	"github.com/CHORUS-TRE/chorus-backend/internal/utils/pagination"
 	common_model "github.com/CHORUS-TRE/chorus-backend/pkg/common/model"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/workspace/model"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/workspace/service"
@@ -26,25 +27,26 @@ func Logging(logger *logger.ContextLogger) func(service.WorkspaceStore) service.
 	}
 }
 
-func (c workspaceStorageLogging) ListWorkspaces(ctx context.Context, tenantID uint64, pagination common_model.Pagination) ([]*model.Workspace, error) {
+func (c workspaceStorageLogging) ListWorkspaces(ctx context.Context, tenantID uint64, filter model.WorkspaceFilter, sort common_model.Sort, cursor *pagination.RequestCursor[pagination.KeysetCursor]) ([]*model.Workspace, *pagination.ResponseCursor[pagination.KeysetCursor], uint64, error) {
 	c.logger.Debug(ctx, "request started")
 
 	now := time.Now()
 
-	res, err := c.next.ListWorkspaces(ctx, tenantID, pagination)
+	res, resCursor, count, err := c.next.ListWorkspaces(ctx, tenantID, filter, sort, cursor)
 	if err != nil {
 		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
 			zap.Error(err),
 			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
 		)
-		return nil, err
+		return nil, nil, 0, err
 	}
 
 	c.logger.Debug(ctx, "request completed",
 		logger.WithCountField(len(res)),
+		logger.WithTotalItemsField(count),
 		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
 	)
-	return res, nil
+	return res, resCursor, count, nil
 }
 
 func (c workspaceStorageLogging) GetWorkspace(ctx context.Context, tenantID uint64, workspaceID uint64) (*model.Workspace, error) {
@@ -68,17 +70,96 @@ func (c workspaceStorageLogging) GetWorkspace(ctx context.Context, tenantID uint
 	return res, nil
 }
 
-func (c workspaceStorageLogging) DeleteWorkspace(ctx context.Context, tenantID, workspaceID uint64) error {
+func (c workspaceStorageLogging) DeleteWorkspace(ctx context.Context, tenantID, workspaceID uint64, force bool) ([]uint64, error) {
 	c.logger.Debug(ctx, "request started")
 	now := time.Now()
 
-	err := c.next.DeleteWorkspace(ctx, tenantID, workspaceID)
+	workbenchIDs, err := c.next.DeleteWorkspace(ctx, tenantID, workspaceID, force)
 	if err != nil {
 		c.logger.Error(ctx, "request completed",
 			logger.WithWorkspaceIDField(workspaceID),
 			zap.Error(err),
 			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
 		)
+		return nil, err
+	}
+	c.logger.Debug(ctx, "request completed",
+		logger.WithWorkspaceIDField(workspaceID),
+		zap.Int("num_workbenchs", len(workbenchIDs)),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return workbenchIDs, nil
+}
+
+func (c workspaceStorageLogging) RestoreWorkspace(ctx context.Context, tenantID, workspaceID uint64, deletedAfter time.Time) error {
+	c.logger.Debug(ctx, "request started")
+	now := time.Now()
+
+	err := c.next.RestoreWorkspace(ctx, tenantID, workspaceID, deletedAfter)
+	if err != nil {
+		c.logger.Error(ctx, "request completed",
+			logger.WithWorkspaceIDField(workspaceID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return err
+	}
+	c.logger.Debug(ctx, "request completed",
+		logger.WithWorkspaceIDField(workspaceID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return nil
+}
+
+func (c workspaceStorageLogging) ListPurgeableWorkspaces(ctx context.Context, deletedBefore time.Time) ([]uint64, error) {
+	c.logger.Debug(ctx, "request started")
+	now := time.Now()
+
+	workspaceIDs, err := c.next.ListPurgeableWorkspaces(ctx, deletedBefore)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, err
+	}
+	c.logger.Debug(ctx, "request completed",
+		logger.WithCountField(len(workspaceIDs)),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return workspaceIDs, nil
+}
+
+func (c workspaceStorageLogging) PurgeWorkspaces(ctx context.Context, deletedBefore time.Time, workspaceIDs []uint64) (common_model.Purged, error) {
+	c.logger.Debug(ctx, "request started")
+	now := time.Now()
+
+	purged, err := c.next.PurgeWorkspaces(ctx, deletedBefore, workspaceIDs)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return purged, err
+	}
+	c.logger.Debug(ctx, "request completed",
+		zap.Int64("num_purged", purged.Total()),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return purged, nil
+}
+
+func (c workspaceStorageLogging) RemoveWorkspace(ctx context.Context, tenantID, workspaceID uint64) error {
+	c.logger.Debug(ctx, "request started")
+	now := time.Now()
+
+	err := c.next.RemoveWorkspace(ctx, tenantID, workspaceID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			logger.WithWorkspaceIDField(workspaceID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
 		return err
 	}
 	c.logger.Debug(ctx, "request completed",
@@ -128,3 +209,71 @@ func (c workspaceStorageLogging) CreateWorkspace(ctx context.Context, tenantID u
 	)
 	return workspaceId, nil
 }
+
+func (c workspaceStorageLogging) ListWorkspaceMembers(ctx context.Context, tenantID, workspaceID uint64) ([]*model.WorkspaceMember, error) {
+	c.logger.Debug(ctx, "request started")
+	now := time.Now()
+
+	res, err := c.next.ListWorkspaceMembers(ctx, tenantID, workspaceID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			logger.WithWorkspaceIDField(workspaceID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		logger.WithWorkspaceIDField(workspaceID),
+		logger.WithCountField(len(res)),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, nil
+}
+
+func (c workspaceStorageLogging) AddWorkspaceMember(ctx context.Context, tenantID, workspaceID, userID uint64) error {
+	c.logger.Debug(ctx, "request started")
+	now := time.Now()
+
+	err := c.next.AddWorkspaceMember(ctx, tenantID, workspaceID, userID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			logger.WithWorkspaceIDField(workspaceID),
+			logger.WithUserIDField(userID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		logger.WithWorkspaceIDField(workspaceID),
+		logger.WithUserIDField(userID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return nil
+}
+
+func (c workspaceStorageLogging) RemoveWorkspaceMember(ctx context.Context, tenantID, workspaceID, userID uint64) error {
+	c.logger.Debug(ctx, "request started")
+	now := time.Now()
+
+	err := c.next.RemoveWorkspaceMember(ctx, tenantID, workspaceID, userID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			logger.WithWorkspaceIDField(workspaceID),
+			logger.WithUserIDField(userID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		logger.WithWorkspaceIDField(workspaceID),
+		logger.WithUserIDField(userID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return nil
+}
@@ -3,12 +3,16 @@ package postgres
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 
 	"github.com/CHORUS-TRE/chorus-backend/internal/utils/database"
-	"github.com/CHORUS-TRE/chorus-backend/internal/utils/uuid"
+	"github.com/CHORUS-TRE/chorus-backend/internal/utils/pagination"
 	common_model "github.com/CHORUS-TRE/chorus-backend/pkg/common/model"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/common/storage"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/workspace/model"
 )
 
@@ -24,7 +28,7 @@ func NewWorkspaceStorage(db *sqlx.DB) *WorkspaceStorage {
 
 func (s *WorkspaceStorage) GetWorkspace(ctx context.Context, tenantID uint64, workspaceID uint64) (*model.Workspace, error) {
 	const query = `
-		SELECT id, tenantid, userid, name, shortname, description, status, createdat, updatedat
+		SELECT id, tenantid, userid, name, shortname, description, status, createdat, updatedat, deletedat
 			FROM workspaces
 		WHERE tenantid = $1 AND id = $2;
 	`
@@ -37,18 +41,88 @@ func (s *WorkspaceStorage) GetWorkspace(ctx context.Context, tenantID uint64, wo
 	return &workspace, nil
 }
 
-func (s *WorkspaceStorage) ListWorkspaces(ctx context.Context, tenantID uint64, pagination common_model.Pagination) ([]*model.Workspace, error) {
-	const query = `
-SELECT id, tenantid, userid, name, shortname, description, status, createdat, updatedat
-	FROM workspaces
-WHERE tenantid = $1 AND status != 'deleted';
-`
+// ListWorkspaces queries the stocked workspaces matching the filter, one page at a
+// time. Workspaces that are 'deleted' are skipped unless explicitly filtered on.
+// It also returns the total number of workspaces matching the filter.
+func (s *WorkspaceStorage) ListWorkspaces(ctx context.Context, tenantID uint64, filter model.WorkspaceFilter, sort common_model.Sort, cursor *pagination.RequestCursor[pagination.KeysetCursor]) ([]*model.Workspace, *pagination.ResponseCursor[pagination.KeysetCursor], uint64, error) {
+	args, whereClauses := buildWorkspacesWhereClauses(tenantID, filter)
+
+	sortType := strings.ToUpper(sort.SortType)
+	column, ok := model.WorkspaceSortTypeToString[sortType]
+	if !ok {
+		sortType, column = "ID", model.WorkspaceSortTypeToString["ID"]
+	}
+	selectArgs, keysetClause, sortClause, reversed := storage.KeysetClauses(args, column, "id", strings.ToUpper(sort.SortOrder), cursor)
+
+	selectQuery := `
+SELECT id, tenantid, userid, name, shortname, description, status, createdat, updatedat, deletedat
+FROM workspaces
+` + whereClauses + keysetClause + sortClause
+	query, selectArgs, err := sqlx.In(selectQuery, selectArgs...)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	query = sqlx.Rebind(sqlx.DOLLAR, query)
+
 	var workspaces []*model.Workspace
-	if err := s.db.SelectContext(ctx, &workspaces, query, tenantID); err != nil {
-		return nil, err
+	if err := s.db.SelectContext(ctx, &workspaces, query, selectArgs...); err != nil {
+		return nil, nil, 0, err
+	}
+	if reversed {
+		storage.Reverse(workspaces)
+	}
+
+	workspaces, responseCursor := pagination.NewKeysetResponseCursor(workspaces, func(w *model.Workspace) pagination.Keyset {
+		return pagination.Keyset{ID: w.ID, Value: w.SortValue(sortType)}
+	}, cursor)
+
+	count, err := s.countWorkspaces(ctx, whereClauses, args)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+
+	return workspaces, responseCursor, count, nil
+}
+
+func buildWorkspacesWhereClauses(tenantID uint64, filter model.WorkspaceFilter) ([]interface{}, string) {
+	args := []interface{}{tenantID}
+	whereClauses := "WHERE tenantid = ?"
+
+	if filter.Status != "" {
+		args = append(args, filter.Status.String())
+		whereClauses += " AND status = ?"
+	} else {
+		whereClauses += " AND status != 'deleted'"
 	}
 
-	return workspaces, nil
+	if filter.OwnerID != 0 {
+		args = append(args, filter.OwnerID)
+		whereClauses += " AND userid = ?"
+	}
+
+	if filter.Name != "" {
+		args = append(args, filter.Name)
+		whereClauses += " AND LOWER(name) = LOWER(?)"
+	}
+
+	return args, whereClauses
+}
+
+func (s *WorkspaceStorage) countWorkspaces(ctx context.Context, whereClauses string, args []interface{}) (uint64, error) {
+	countQuery := `
+SELECT COUNT(id) FROM workspaces
+` + whereClauses
+	query, args, err := sqlx.In(countQuery, args...)
+	if err != nil {
+		return 0, err
+	}
+	query = sqlx.Rebind(sqlx.DOLLAR, query)
+
+	var count uint64
+	if err := s.db.GetContext(ctx, &count, query, args...); err != nil {
+		return 0, err
+	}
+	return count, nil
 }
 
 // CreateWorkspace saves the provided workspace object in the database 'workspaces' table.
@@ -69,11 +143,34 @@ VALUES ($1, $2, $3, $4, $5, $6, NOW(), NOW()) RETURNING id;
 	return id, nil
 }
 
+// RemoveWorkspace hard-deletes a workspace that was just created, whose
+// creation failed.
+func (s *WorkspaceStorage) RemoveWorkspace(ctx context.Context, tenantID uint64, workspaceID uint64) error {
+	const query = `
+DELETE FROM workspaces WHERE tenantid = $1 AND id = $2;
+`
+
+	rows, err := s.db.ExecContext(ctx, query, tenantID, workspaceID)
+	if err != nil {
+		return fmt.Errorf("unable to exec: %w", err)
+	}
+	affected, err := rows.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("unable to get rows affected: %w", err)
+	}
+
+	if affected == 0 {
+		return database.ErrNoRowsDeleted
+	}
+
+	return nil
+}
+
 func (s *WorkspaceStorage) UpdateWorkspace(ctx context.Context, tenantID uint64, workspace *model.Workspace) (err error) {
 	const workspaceUpdateQuery = `
 		UPDATE workspaces
 		SET status = $3, description = $4, updatedat = NOW()
-		WHERE tenantid = $1 AND id = $2;
+		WHERE tenantid = $1 AND id = $2 AND status != 'deleted';
 	`
 
 	// Update User
@@ -93,15 +190,253 @@ func (s *WorkspaceStorage) UpdateWorkspace(ctx context.Context, tenantID uint64,
 	return err
 }
 
-func (s *WorkspaceStorage) DeleteWorkspace(ctx context.Context, tenantID uint64, workspaceID uint64) error {
+// DeleteWorkspace marks a workspace as deleted along with its workbenches and
+// their app instances, and returns the IDs of the workbenches deleted with it.
+// Unless force is set, a workspace with running workbenches is not deleted and
+// model.ErrRunningWorkbenchs is returned.
+func (s *WorkspaceStorage) DeleteWorkspace(ctx context.Context, tenantID uint64, workspaceID uint64, force bool) ([]uint64, error) {
+	const workspaceQuery = `
+UPDATE workspaces SET status = 'deleted', deletedstatus = status, updatedat = NOW(), deletedat = NOW()
+WHERE tenantid = $1 AND id = $2 AND status != 'deleted';
+`
+	// The workbenches are counted once the workspace is locked by its update,
+	// so that none is started before it is deleted.
+	const runningQuery = `
+SELECT COUNT(*) FROM workbenchs
+WHERE tenantid = $1 AND workspaceid = $2 AND status = 'active';
+`
+	const workbenchsQuery = `
+UPDATE workbenchs SET status = 'deleted', updatedat = NOW(), deletedat = NOW()
+WHERE tenantid = $1 AND workspaceid = $2 AND status != 'deleted'
+RETURNING id;
+`
+	const appInstancesQuery = `
+UPDATE app_instances SET status = 'deleted', updatedat = NOW(), deletedat = NOW()
+WHERE tenantid = $1 AND workspaceid = $2 AND status != 'deleted';
+`
+
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := tx.ExecContext(ctx, workspaceQuery, tenantID, workspaceID)
+	if err != nil {
+		return nil, storage.Rollback(tx, fmt.Errorf("unable to exec: %w", err))
+	}
+	affected, err := rows.RowsAffected()
+	if err != nil {
+		return nil, storage.Rollback(tx, fmt.Errorf("unable to get rows affected: %w", err))
+	}
+	if affected == 0 {
+		return nil, storage.Rollback(tx, database.ErrNoRowsDeleted)
+	}
+
+	if !force {
+		var running uint64
+		if err := tx.GetContext(ctx, &running, runningQuery, tenantID, workspaceID); err != nil {
+			return nil, storage.Rollback(tx, fmt.Errorf("unable to count running workbenchs: %w", err))
+		}
+		if running != 0 {
+			return nil, storage.Rollback(tx, fmt.Errorf("%v running workbenchs: %w", running, model.ErrRunningWorkbenchs))
+		}
+	}
+
+	var workbenchIDs []uint64
+	if err := tx.SelectContext(ctx, &workbenchIDs, workbenchsQuery, tenantID, workspaceID); err != nil {
+		return nil, storage.Rollback(tx, fmt.Errorf("unable to delete workbenchs: %w", err))
+	}
+	if _, err := tx.ExecContext(ctx, appInstancesQuery, tenantID, workspaceID); err != nil {
+		return nil, storage.Rollback(tx, fmt.Errorf("unable to delete app instances: %w", err))
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return workbenchIDs, nil
+}
+
+// RestoreWorkspace restores a workspace deleted after deletedAfter to the
+// status it had before, along with the workbenches and app instances deleted
+// with it. The restored workbenches and app instances are inactive.
+func (s *WorkspaceStorage) RestoreWorkspace(ctx context.Context, tenantID uint64, workspaceID uint64, deletedAfter time.Time) error {
+	const selectQuery = `
+SELECT deletedat FROM workspaces
+WHERE tenantid = $1 AND id = $2 AND status = 'deleted' AND deletedat > $3
+FOR UPDATE;
+`
+	const workspaceQuery = `
+UPDATE workspaces SET status = COALESCE(deletedstatus, 'active'), deletedstatus = NULL, updatedat = NOW(), deletedat = NULL
+WHERE tenantid = $1 AND id = $2;
+`
+	// The workbenches and app instances deleted along with the workspace
+	// share its deletion date.
+	const workbenchsQuery = `
+UPDATE workbenchs SET status = 'inactive', updatedat = NOW(), deletedat = NULL
+WHERE tenantid = $1 AND workspaceid = $2 AND status = 'deleted' AND deletedat = $3;
+`
+	const appInstancesQuery = `
+UPDATE app_instances SET status = 'inactive', updatedat = NOW(), deletedat = NULL
+WHERE tenantid = $1 AND workspaceid = $2 AND status = 'deleted' AND deletedat = $3;
+`
+
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	var deletedAt time.Time
+	if err := tx.GetContext(ctx, &deletedAt, selectQuery, tenantID, workspaceID, deletedAfter); err != nil {
+		return storage.Rollback(tx, err)
+	}
+
+	if _, err := tx.ExecContext(ctx, workspaceQuery, tenantID, workspaceID); err != nil {
+		return storage.Rollback(tx, fmt.Errorf("unable to restore workspace: %w", err))
+	}
+	if _, err := tx.ExecContext(ctx, workbenchsQuery, tenantID, workspaceID, deletedAt); err != nil {
+		return storage.Rollback(tx, fmt.Errorf("unable to restore workbenchs: %w", err))
+	}
+	if _, err := tx.ExecContext(ctx, appInstancesQuery, tenantID, workspaceID, deletedAt); err != nil {
+		return storage.Rollback(tx, fmt.Errorf("unable to restore app instances: %w", err))
+	}
+
+	return tx.Commit()
+}
+
+// purgeableWorkspacesQuery selects the workspaces deleted before $1 whose
+// workbenches and app instances are purged.
+const purgeableWorkspacesQuery = `
+SELECT w.id FROM workspaces w
+WHERE w.status = 'deleted' AND w.deletedat < $1
+	AND NOT EXISTS (SELECT 1 FROM workbenchs wb WHERE wb.workspaceid = w.id)
+	AND NOT EXISTS (SELECT 1 FROM app_instances ai WHERE ai.workspaceid = w.id)
+`
+
+// ListPurgeableWorkspaces returns the IDs of the workspaces that
+// PurgeWorkspaces would purge.
+func (s *WorkspaceStorage) ListPurgeableWorkspaces(ctx context.Context, deletedBefore time.Time) ([]uint64, error) {
+	var ids []uint64
+	if err := s.db.SelectContext(ctx, &ids, purgeableWorkspacesQuery, deletedBefore); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// PurgeWorkspaces hard-deletes the workspaces among workspaceIDs deleted
+// before deletedBefore, along with their members and quotas, once their
+// workbenches and app instances are purged. It returns the number of purged
+// workspaces per tenant.
+func (s *WorkspaceStorage) PurgeWorkspaces(ctx context.Context, deletedBefore time.Time, workspaceIDs []uint64) (common_model.Purged, error) {
+	const selectQuery = purgeableWorkspacesQuery + `	AND w.id = ANY($2)
+FOR UPDATE;
+`
+	purgeQueries := []string{
+		`DELETE FROM workspace_members WHERE workspaceid = ANY($1);`,
+		`DELETE FROM quotas WHERE scope = 'workspace' AND scopeid = ANY($1);`,
+	}
+	const purgeQuery = `DELETE FROM workspaces WHERE id = ANY($1) RETURNING tenantid;`
+
+	if len(workspaceIDs) == 0 {
+		return common_model.Purged{}, nil
+	}
+	candidates := make([]int64, 0, len(workspaceIDs))
+	for _, id := range workspaceIDs {
+		candidates = append(candidates, int64(id))
+	}
+
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids pq.Int64Array
+	if err := tx.SelectContext(ctx, &ids, selectQuery, deletedBefore, pq.Array(candidates)); err != nil {
+		return nil, storage.Rollback(tx, err)
+	}
+	if len(ids) == 0 {
+		return common_model.Purged{}, tx.Rollback()
+	}
+
+	for _, q := range purgeQueries {
+		if _, err := tx.ExecContext(ctx, q, ids); err != nil {
+			return nil, storage.Rollback(tx, fmt.Errorf("unable to purge workspaces: %w", err))
+		}
+	}
+	purged, err := storage.Purge(ctx, tx, purgeQuery, ids)
+	if err != nil {
+		return nil, storage.Rollback(tx, fmt.Errorf("unable to purge workspaces: %w", err))
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return purged, nil
+}
+
+// ListWorkspaceMembers returns the members of the workspace, skipping the
+// users that have been deleted.
+func (s *WorkspaceStorage) ListWorkspaceMembers(ctx context.Context, tenantID, workspaceID uint64) ([]*model.WorkspaceMember, error) {
 	const query = `
-		UPDATE workspaces	SET 
-			(status, name, updatedat, deletedat) = 
-			($3, concat(name, $4::TEXT), NOW(), NOW())
-		WHERE tenantid = $1 AND id = $2;
-	`
+SELECT m.id, m.tenantid, m.workspaceid, m.userid, m.createdat
+	FROM workspace_members m
+	JOIN users u ON u.id = m.userid
+WHERE m.tenantid = $1 AND m.workspaceid = $2 AND u.status != 'deleted'
+ORDER BY m.id;
+`
+	var members []*model.WorkspaceMember
+	if err := s.db.SelectContext(ctx, &members, query, tenantID, workspaceID); err != nil {
+		return nil, err
+	}
+
+	return members, nil
+}
 
-	rows, err := s.db.ExecContext(ctx, query, tenantID, workspaceID, model.WorkspaceDeleted.String(), "-"+uuid.Next())
+// AddWorkspaceMember adds the user to the members of the workspace. Adding an
+// existing member is a no-op, while adding to a workspace or a user that does
+// not exist returns database.ErrNoRowsUpdated.
+func (s *WorkspaceStorage) AddWorkspaceMember(ctx context.Context, tenantID, workspaceID, userID uint64) error {
+	const query = `
+INSERT INTO workspace_members (tenantid, workspaceid, userid, createdat)
+SELECT $1, w.id, u.id, NOW()
+	FROM workspaces w, users u
+WHERE w.tenantid = $1 AND w.id = $2 AND w.status != 'deleted'
+	AND u.tenantid = $1 AND u.id = $3 AND u.status != 'deleted'
+ON CONFLICT (workspaceid, userid) DO NOTHING;
+`
+	rows, err := s.db.ExecContext(ctx, query, tenantID, workspaceID, userID)
+	if err != nil {
+		return fmt.Errorf("unable to exec: %w", err)
+	}
+	affected, err := rows.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("unable to get rows affected: %w", err)
+	}
+	if affected != 0 {
+		return nil
+	}
+
+	// Nothing was inserted: either the user is already a member or the
+	// workspace or the user does not exist.
+	const existsQuery = `
+SELECT EXISTS (SELECT 1 FROM workspace_members WHERE tenantid = $1 AND workspaceid = $2 AND userid = $3);
+`
+	var exists bool
+	if err := s.db.GetContext(ctx, &exists, existsQuery, tenantID, workspaceID, userID); err != nil {
+		return err
+	}
+	if !exists {
+		return database.ErrNoRowsUpdated
+	}
+
+	return nil
+}
+
+func (s *WorkspaceStorage) RemoveWorkspaceMember(ctx context.Context, tenantID, workspaceID, userID uint64) error {
+	const query = `
+DELETE FROM workspace_members
+WHERE tenantid = $1 AND workspaceid = $2 AND userid = $3;
+`
+	rows, err := s.db.ExecContext(ctx, query, tenantID, workspaceID, userID)
 	if err != nil {
 		return fmt.Errorf("unable to exec: %w", err)
 	}
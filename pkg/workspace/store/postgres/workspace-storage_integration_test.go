@@ -0,0 +1,117 @@
+//go:build integration
+
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/CHORUS-TRE/chorus-backend/pkg/workspace/model"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/workspace/store/postgres"
+	"github.com/CHORUS-TRE/chorus-backend/tests/helpers"
+)
+
+const tenantID = 88888
+
+func setupTables(t *testing.T) {
+	cleanTables(t)
+	t.Cleanup(func() { cleanTables(t) })
+
+	q := `
+	INSERT INTO tenants (id, name) VALUES (88888, 'test tenant');
+
+	INSERT INTO users (id, tenantid, firstname, lastname, username, password, status, createdat, updatedat)
+	VALUES (90000, 88888, 'hello', 'moto', 'hmoto', '', 'active', NOW(), NOW());
+
+	INSERT INTO workspaces (id, tenantid, userid, name, shortname, description, status, createdat, updatedat)
+	VALUES (91000, 88888, 90000, 'inactive', 'inactive', '', 'inactive', NOW(), NOW()),
+		(91001, 88888, 90000, 'active', 'active', '', 'active', NOW(), NOW());
+
+	INSERT INTO workbenchs (id, tenantid, userid, workspaceid, name, shortname, description, status, createdat, updatedat)
+	VALUES (92000, 88888, 90000, 91000, 'workbench', 'workbench', '', 'active', NOW(), NOW());
+	`
+	helpers.Populate(q)
+}
+
+func cleanTables(t *testing.T) {
+	q := `
+	DELETE FROM workbenchs WHERE tenantid = 88888;
+	DELETE FROM workspaces WHERE tenantid = 88888;
+	DELETE FROM users WHERE tenantid = 88888;
+	DELETE FROM tenants WHERE id = 88888;
+	`
+	_, err := helpers.DB().ExecContext(context.Background(), q)
+	require.NoError(t, err)
+}
+
+func status(t *testing.T, table string, id uint64) string {
+	var s string
+	require.NoError(t, helpers.DB().Get(&s, `SELECT status FROM `+table+` WHERE id = $1`, id))
+	return s
+}
+
+func TestWorkspaceStorage_DeleteRestore(t *testing.T) {
+	setupTables(t)
+	ctx := context.Background()
+	s := postgres.NewWorkspaceStorage(helpers.DB())
+
+	_, err := s.DeleteWorkspace(ctx, tenantID, 91000, false)
+	require.ErrorIs(t, err, model.ErrRunningWorkbenchs)
+	require.Equal(t, "inactive", status(t, "workspaces", 91000), "a workspace with running workbenchs is kept")
+
+	workbenchIDs, err := s.DeleteWorkspace(ctx, tenantID, 91000, true)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{92000}, workbenchIDs)
+	require.Equal(t, "deleted", status(t, "workspaces", 91000))
+	require.Equal(t, "deleted", status(t, "workbenchs", 92000))
+
+	_, err = s.DeleteWorkspace(ctx, tenantID, 91000, true)
+	require.Error(t, err, "a deleted workspace cannot be deleted again")
+
+	err = s.RestoreWorkspace(ctx, tenantID, 91000, time.Now().Add(time.Hour))
+	require.Error(t, err, "a workspace deleted before the grace period cannot be restored")
+
+	require.NoError(t, s.RestoreWorkspace(ctx, tenantID, 91000, time.Now().Add(-time.Hour)))
+	require.Equal(t, "inactive", status(t, "workspaces", 91000), "the workspace gets its status back")
+	require.Equal(t, "inactive", status(t, "workbenchs", 92000), "the workbench is restored inactive")
+
+	_, err = s.DeleteWorkspace(ctx, tenantID, 91001, false)
+	require.NoError(t, err)
+	require.NoError(t, s.RestoreWorkspace(ctx, tenantID, 91001, time.Now().Add(-time.Hour)))
+	require.Equal(t, "active", status(t, "workspaces", 91001))
+
+	err = s.RestoreWorkspace(ctx, tenantID, 91001, time.Now().Add(-time.Hour))
+	require.Error(t, err, "a workspace that is not deleted cannot be restored")
+}
+
+func TestWorkspaceStorage_PurgeWorkspaces(t *testing.T) {
+	setupTables(t)
+	ctx := context.Background()
+	s := postgres.NewWorkspaceStorage(helpers.DB())
+
+	_, err := s.DeleteWorkspace(ctx, tenantID, 91000, true)
+	require.NoError(t, err)
+	_, err = s.DeleteWorkspace(ctx, tenantID, 91001, true)
+	require.NoError(t, err)
+
+	ids, err := s.ListPurgeableWorkspaces(ctx, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	require.NotContains(t, ids, uint64(91001), "the workspaces deleted during the grace period are kept")
+
+	ids, err = s.ListPurgeableWorkspaces(ctx, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	require.Contains(t, ids, uint64(91001))
+	require.NotContains(t, ids, uint64(91000), "the workspace with a workbench left is kept")
+
+	purged, err := s.PurgeWorkspaces(ctx, time.Now().Add(time.Hour), []uint64{91000})
+	require.NoError(t, err)
+	require.Zero(t, purged[tenantID], "only the workspaces without workbench are purged")
+
+	purged, err = s.PurgeWorkspaces(ctx, time.Now().Add(time.Hour), []uint64{91001})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), purged[tenantID])
+	require.Equal(t, 1, helpers.CountRow(t, helpers.DB(), "workspaces", "tenantid", tenantID))
+}
@@ -857,6 +857,8 @@ func setupTables() {
 	INSERT INTO roles (id, name) VALUES (2, 'operator');
 	INSERT INTO roles (id, name) VALUES (3, 'chorus');
 
+	INSERT INTO role_permissions (roleid, permission) VALUES (1, 'users:self'), (1, 'users:read'), (1, 'users:write');
+
 	INSERT INTO user_role (id, userid, roleid) VALUES(92001, 90000, 1);
 	INSERT INTO user_role (id, userid, roleid) VALUES(92002, 90000, 2);
 	INSERT INTO user_role (id, userid, roleid) VALUES(92003, 90001, 3);
@@ -878,6 +880,8 @@ func setupTablesWithTotpUser() {
 	INSERT INTO roles (id, name) VALUES (2, 'operator');
 	INSERT INTO roles (id, name) VALUES (3, 'chorus');
 
+	INSERT INTO role_permissions (roleid, permission) VALUES (1, 'users:self'), (1, 'users:read'), (1, 'users:write');
+
 	INSERT INTO user_role (id, userid, roleid) VALUES(92001, 90000, 1);
 	INSERT INTO user_role (id, userid, roleid) VALUES(92002, 90000, 2);
 